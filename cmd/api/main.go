@@ -10,10 +10,12 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/nguyentantai21042004/kanban-api/config"
 	"github.com/nguyentantai21042004/kanban-api/internal/appconfig/postgre"
+	"github.com/nguyentantai21042004/kanban-api/internal/appconfig/redis"
 	"github.com/nguyentantai21042004/kanban-api/internal/httpserver"
 	"github.com/nguyentantai21042004/kanban-api/pkg/discord"
 	pkgCrt "github.com/nguyentantai21042004/kanban-api/pkg/encrypter"
 	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
 )
 
 // @Name Kanban API
@@ -52,10 +54,24 @@ func main() {
 	}
 	defer postgre.Disconnect(context.Background(), postgresDB)
 
+	// Initialize Redis (optional, rate limits fall back to memory without it)
+	redisClient, err := redis.Connect(context.Background(), cfg.Redis)
+	if err != nil {
+		log.Println("Failed to connect to Redis, using in-memory rate limits: ", err)
+	}
+	defer redis.Disconnect()
+
 	// =============================================================================
 	// MESSAGE QUEUE CONFIGURATION
 	// =============================================================================
 
+	// Initialize RabbitMQ
+	amqpConn, err := rabbitmq.Dial(cfg.RabbitMQ.URL, true)
+	if err != nil {
+		log.Fatal("Failed to connect to RabbitMQ: ", err)
+	}
+	defer amqpConn.Close()
+
 	// =============================================================================
 	// STORAGE CONFIGURATION
 	// =============================================================================
@@ -100,19 +116,30 @@ func main() {
 		Mode:   cfg.HTTPServer.Mode,
 
 		// Database Configuration
-		PostgresDB: postgresDB,
+		PostgresDB:  postgresDB,
+		RedisClient: redisClient,
+
+		// Message Queue Configuration
+		AMQPConn: amqpConn,
 
 		// Storage Configuration
 		// MinIOClient: minioClient,
 
 		// Authentication & Security Configuration
-		JwtSecretKey: cfg.JWT.SecretKey,
-		Encrypter:    encrypter,
-		InternalKey:  cfg.InternalConfig.InternalKey,
+		JwtSecretKey:    cfg.JWT.SecretKey,
+		AccessTokenTTL:  cfg.JWT.AccessTokenTTL,
+		RefreshTokenTTL: cfg.JWT.RefreshTokenTTL,
+		Encrypter:       encrypter,
+		InternalKey:     cfg.InternalConfig.InternalKey,
+		LoginProtection: cfg.LoginProtection,
+		OIDC:            cfg.OIDC,
 
 		// WebSocket Configuration
 		WebSocketConfig: cfg.WebSocket,
 
+		// Board Configuration
+		BoardConfig: cfg.Board,
+
 		// Monitoring & Notification Configuration
 		DiscordConfig: discordWebhook,
 	})
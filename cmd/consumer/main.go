@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	_ "github.com/lib/pq"
+	"github.com/nguyentantai21042004/kanban-api/config"
+	"github.com/nguyentantai21042004/kanban-api/internal/appconfig/postgre"
+	"github.com/nguyentantai21042004/kanban-api/internal/consumer"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	pkgCrt "github.com/nguyentantai21042004/kanban-api/pkg/encrypter"
+	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+)
+
+func main() {
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("Failed to load config: ", err)
+	}
+
+	// Initialize logger first (needed for all other services)
+	l := pkgLog.InitializeZapLogger(pkgLog.ZapConfig{
+		Level:    cfg.Logger.Level,
+		Mode:     cfg.Logger.Mode,
+		Encoding: cfg.Logger.Encoding,
+	})
+
+	// Initialize Encrypter
+	encrypter := pkgCrt.NewEncrypter(cfg.Encrypter.Key)
+
+	// =============================================================================
+	// DATABASE CONFIGURATION
+	// =============================================================================
+
+	// Initialize PostgreSQL
+	postgresDB, err := postgre.Connect(context.Background(), cfg.Postgres)
+	if err != nil {
+		log.Fatal("Failed to connect to PostgreSQL: ", err)
+	}
+	defer postgre.Disconnect(context.Background(), postgresDB)
+
+	// =============================================================================
+	// MESSAGE QUEUE CONFIGURATION
+	// =============================================================================
+
+	// Initialize RabbitMQ
+	amqpConn, err := rabbitmq.Dial(cfg.RabbitMQ.URL, true)
+	if err != nil {
+		log.Fatal("Failed to connect to RabbitMQ: ", err)
+	}
+	defer amqpConn.Close()
+
+	// =============================================================================
+	// CONSUMER CONFIGURATION
+	// =============================================================================
+
+	srv, err := consumer.New(l, consumer.ConsumerConfig{
+		JwtSecretKey: cfg.JWT.SecretKey,
+		AMQPConn:     amqpConn,
+		Encrypter:    encrypter,
+		InternalKey:  cfg.InternalConfig.InternalKey,
+		PostgresDB:   postgresDB,
+		SMTP: email.SMTPConfig{
+			Host:     cfg.SMTP.Host,
+			Port:     cfg.SMTP.Port,
+			Username: cfg.SMTP.Username,
+			Password: cfg.SMTP.Password,
+			From:     cfg.SMTP.From,
+			FromName: cfg.SMTP.FromName,
+		},
+	})
+	if err != nil {
+		log.Fatal("Failed to initialize consumer: ", err)
+	}
+
+	// =============================================================================
+	// START CONSUMER
+	// =============================================================================
+
+	if err := srv.Run(); err != nil {
+		log.Fatal("Failed to run consumer: ", err)
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/caarlos0/env/v9"
 )
 
@@ -11,26 +13,66 @@ type Config struct {
 
 	// Database Configuration
 	Postgres PostgresConfig
+	Redis    RedisConfig
+
+	// Message Queue Configuration
+	RabbitMQ RabbitMQConfig
 
 	// Storage Configuration
 	MinIO MinIOConfig
 
 	// Authentication & Security Configuration
-	JWT            JWTConfig
-	Encrypter      EncrypterConfig
-	InternalConfig InternalConfig
+	JWT             JWTConfig
+	LoginProtection LoginProtectionConfig
+	OIDC            OIDCConfig
+	Encrypter       EncrypterConfig
+	InternalConfig  InternalConfig
 
 	// WebSocket Configuration
 	WebSocket WebSocketConfig
 
+	// Board Configuration
+	Board BoardConfig
+
 	// Monitoring & Notification Configuration
 	Discord DiscordConfig
+
+	// External Services Configuration
+	SMTP SMTPConfig
 }
 
 // JWTConfig is the configuration for the JWT,
 // which is used to generate and verify the JWT.
 type JWTConfig struct {
-	SecretKey string `env:"JWT_SECRET"`
+	SecretKey       string        `env:"JWT_SECRET"`
+	AccessTokenTTL  time.Duration `env:"JWT_ACCESS_TOKEN_TTL" envDefault:"15m"`
+	RefreshTokenTTL time.Duration `env:"JWT_REFRESH_TOKEN_TTL" envDefault:"720h"`
+}
+
+// LoginProtectionConfig is the configuration for the login throttling,
+// which is used to slow down password guessing.
+type LoginProtectionConfig struct {
+	MaxAttempts      int           `env:"LOGIN_MAX_ATTEMPTS" envDefault:"10"`
+	MaxAttemptsPerIP int           `env:"LOGIN_MAX_ATTEMPTS_PER_IP" envDefault:"50"`
+	AttemptWindow    time.Duration `env:"LOGIN_ATTEMPT_WINDOW" envDefault:"15m"`
+	MaxFailures      int           `env:"LOGIN_MAX_FAILURES" envDefault:"5"`
+	LockoutDuration  time.Duration `env:"LOGIN_LOCKOUT_DURATION" envDefault:"15m"`
+}
+
+// OIDCConfig is the configuration for the OpenID Connect provider,
+// which is used for single sign-on. It is disabled without an issuer.
+type OIDCConfig struct {
+	IssuerURL    string   `env:"OIDC_ISSUER_URL"`
+	ClientID     string   `env:"OIDC_CLIENT_ID"`
+	ClientSecret string   `env:"OIDC_CLIENT_SECRET"`
+	RedirectURL  string   `env:"OIDC_REDIRECT_URL"`
+	Scopes       []string `env:"OIDC_SCOPES" envDefault:"email,profile" envSeparator:","`
+}
+
+// BoardConfig is the configuration for the boards,
+// which is used to pick the template new boards start with.
+type BoardConfig struct {
+	DefaultTemplate string `env:"BOARD_DEFAULT_TEMPLATE" envDefault:"classic"`
 }
 
 // HTTPServerConfig is the configuration for the HTTP server,
@@ -59,6 +101,20 @@ type PostgresConfig struct {
 	DBName   string `env:"POSTGRES_DB" envDefault:"postgres"`
 }
 
+// RedisConfig is the configuration for the Redis,
+// which is used to share rate limits between instances. It is optional.
+type RedisConfig struct {
+	Addr     string `env:"REDIS_ADDR"`
+	Password string `env:"REDIS_PASSWORD"`
+	DB       int    `env:"REDIS_DB" envDefault:"0"`
+}
+
+// RabbitMQConfig is the configuration for the RabbitMQ,
+// which is used to publish and consume background jobs.
+type RabbitMQConfig struct {
+	URL string `env:"RABBITMQ_URL"`
+}
+
 type MinIOConfig struct {
 	Endpoint  string `env:"MINIO_ENDPOINT" envDefault:"localhost:9000"`
 	AccessKey string `env:"MINIO_ACCESS_KEY" envDefault:"minioadmin"`
@@ -73,6 +129,17 @@ type DiscordConfig struct {
 	ReportBugToken string `env:"DISCORD_REPORT_BUG_TOKEN"`
 }
 
+// SMTPConfig is the configuration for the SMTP server,
+// which is used by the consumer to deliver emails.
+type SMTPConfig struct {
+	Host     string `env:"SMTP_HOST"`
+	Port     int    `env:"SMTP_PORT" envDefault:"587"`
+	Username string `env:"SMTP_USERNAME"`
+	Password string `env:"SMTP_PASSWORD"`
+	From     string `env:"SMTP_FROM"`
+	FromName string `env:"SMTP_FROM_NAME" envDefault:"Kanban"`
+}
+
 // EncrypterConfig is the configuration for the encrypter,
 // which is used to encrypt and decrypt the data.
 type EncrypterConfig struct {
@@ -1,12 +1,34 @@
 package http
 
-var ()
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+)
+
+var (
+	errWrongQuery        = pkgErrors.NewHTTPError(10801, "Wrong query")
+	errUserNotFound      = pkgErrors.NewHTTPError(10803, "User not found")
+	errRoleNotFound      = pkgErrors.NewHTTPError(10804, "Role not found")
+	errWorkspaceNotFound = pkgErrors.NewHTTPError(10805, "Workspace not found")
+)
 
 func (h handler) mapErrorCode(err error) error {
 	switch err {
+	case auth.ErrUserNotFound:
+		return errUserNotFound
+	case role.ErrRoleNotFound:
+		return errRoleNotFound
+	case workspaces.ErrNotFound:
+		return errWorkspaceNotFound
 	default:
 		return err
 	}
 }
 
-var NotFound = []error{}
+var NotFound = []error{
+	errUserNotFound,
+	errRoleNotFound,
+	errWorkspaceNotFound,
+}
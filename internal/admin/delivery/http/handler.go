@@ -14,9 +14,11 @@ import (
 // @Produce json
 // @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
 // @Param period query string false "7d | 30d | 90d" default(7d)
+// @Param workspace_id query string false "Report the figures of a single workspace"
 // @Success 200 {object} dashboardResp "Success"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/admin/dashboard [GET]
 func (h handler) Dashboard(c *gin.Context) {
@@ -53,6 +55,7 @@ func (h handler) Dashboard(c *gin.Context) {
 // @Success 200 {object} admin.HealthOutput "Success"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/admin/health [GET]
 func (h handler) Health(c *gin.Context) {
@@ -83,6 +86,7 @@ func (h handler) Health(c *gin.Context) {
 // @Success 200 {object} usersResp "Success"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/admin/users [GET]
 func (h handler) Users(c *gin.Context) {
@@ -112,6 +116,7 @@ func (h handler) Users(c *gin.Context) {
 // @Success 200 {object} admin.UserItem "Success"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/admin/users [POST]
 func (h handler) CreateUser(c *gin.Context) {
@@ -142,6 +147,7 @@ func (h handler) CreateUser(c *gin.Context) {
 // @Success 200 {object} admin.UserItem "Success"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/admin/users/{id} [PUT]
 func (h handler) UpdateUser(c *gin.Context) {
@@ -170,6 +176,7 @@ func (h handler) UpdateUser(c *gin.Context) {
 // @Success 200 {object} []admin.RoleItem "Success"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/admin/roles [GET]
 func (h handler) Roles(c *gin.Context) {
@@ -188,3 +195,104 @@ func (h handler) Roles(c *gin.Context) {
 	}
 	respondOK(c, roles)
 }
+
+// @Summary Admin update role 2FA policy
+// @Description Require (or stop requiring) two-factor authentication for every user of a role
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Role ID"
+// @Param body body updateRoleMFAReq true "Update role 2FA policy"
+// @Success 200 {object} admin.RoleItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/admin/roles/{id}/mfa [PUT]
+func (h handler) UpdateRoleMFA(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, sc, req, err := h.processUpdateRoleMFARequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.admin.http.UpdateRoleMFA.processUpdateRoleMFARequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+	o, err := h.uc.UpdateRoleMFA(ctx, sc, id, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.admin.http.UpdateRoleMFA.uc.UpdateRoleMFA: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.admin.http.UpdateRoleMFA.uc.UpdateRoleMFA: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+	respondOK(c, o)
+}
+
+// @Summary Admin list user sessions
+// @Description List the active sessions (devices) of a user
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "User ID"
+// @Success 200 {object} []admin.SessionItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/admin/users/{id}/sessions [GET]
+func (h handler) UserSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, sc, err := h.processUserIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.admin.http.UserSessions.processUserIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+	o, err := h.uc.UserSessions(ctx, sc, id)
+	if err != nil {
+		h.l.Errorf(ctx, "internal.admin.http.UserSessions.uc.UserSessions: %v", err)
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+	respondOK(c, o)
+}
+
+// @Summary Admin revoke user sessions
+// @Description Revoke every session and token of a user, forcing them to log in again
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/admin/users/{id}/revoke-sessions [POST]
+func (h handler) RevokeUserSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	id, sc, err := h.processUserIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.admin.http.RevokeUserSessions.processUserIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+	if err := h.uc.RevokeUserSessions(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.admin.http.RevokeUserSessions.uc.RevokeUserSessions: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.admin.http.RevokeUserSessions.uc.RevokeUserSessions: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+	respondOK(c, nil)
+}
@@ -14,6 +14,9 @@ type Handler interface {
 	UpdateUser(c *gin.Context)
 	Health(c *gin.Context)
 	Roles(c *gin.Context)
+	UpdateRoleMFA(c *gin.Context)
+	UserSessions(c *gin.Context)
+	RevokeUserSessions(c *gin.Context)
 }
 
 type handler struct {
@@ -5,11 +5,13 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/admin"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
 )
 
 type dashboardReq struct {
-	Period string `form:"period"`
+	Period      string `form:"period"`
+	WorkspaceID string `form:"workspace_id"`
 }
 
 func (h handler) processDashboardRequest(c *gin.Context) (dashboardReq, models.Scope, error) {
@@ -25,12 +27,18 @@ func (h handler) processDashboardRequest(c *gin.Context) (dashboardReq, models.S
 	if err := c.ShouldBindQuery(&req); err != nil {
 		return dashboardReq{}, models.Scope{}, err
 	}
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			h.l.Errorf(ctx, "internal.admin.delivery.http.processDashboardRequest.IsUUID: %v", err)
+			return dashboardReq{}, models.Scope{}, errWrongQuery
+		}
+	}
 
 	return req, scope.NewScope(p), nil
 }
 
 func (r dashboardReq) toInput() admin.DashboardInput {
-	return admin.DashboardInput{Period: r.Period}
+	return admin.DashboardInput{Period: r.Period, WorkspaceID: r.WorkspaceID}
 }
 
 // Admin users list/create/update
@@ -99,6 +107,19 @@ func (h handler) processCreateUserRequest(c *gin.Context) (createUserReq, models
 	return req, scope.NewScope(p), nil
 }
 
+func (h handler) processUserIDRequest(c *gin.Context) (string, models.Scope, error) {
+	ctx := c.Request.Context()
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		return "", models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+	id := c.Param("id")
+	if id == "" {
+		return "", models.Scope{}, errWrongQuery
+	}
+	return id, scope.NewScope(p), nil
+}
+
 func (h handler) processUpdateUserRequest(c *gin.Context) (string, models.Scope, updateUserReq, error) {
 	ctx := c.Request.Context()
 	p, ok := scope.GetPayloadFromContext(ctx)
@@ -112,3 +133,28 @@ func (h handler) processUpdateUserRequest(c *gin.Context) (string, models.Scope,
 	}
 	return id, scope.NewScope(p), req, nil
 }
+
+type updateRoleMFAReq struct {
+	Required *bool `json:"required" binding:"required"`
+}
+
+func (r updateRoleMFAReq) toInput() admin.UpdateRoleMFAInput {
+	return admin.UpdateRoleMFAInput{Required: *r.Required}
+}
+
+func (h handler) processUpdateRoleMFARequest(c *gin.Context) (string, models.Scope, updateRoleMFAReq, error) {
+	ctx := c.Request.Context()
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		return "", models.Scope{}, updateRoleMFAReq{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+	id := c.Param("id")
+	if id == "" {
+		return "", models.Scope{}, updateRoleMFAReq{}, errWrongQuery
+	}
+	var req updateRoleMFAReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return "", models.Scope{}, updateRoleMFAReq{}, errWrongQuery
+	}
+	return id, scope.NewScope(p), req, nil
+}
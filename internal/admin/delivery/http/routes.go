@@ -3,14 +3,22 @@ package http
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 )
 
 func MapAdminRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
 	r.Use(mw.Auth())
-	r.GET("/dashboard", h.Dashboard)
-	r.GET("/users", h.Users)
-	r.POST("/users", h.CreateUser)
-	r.PUT("/users/:id", h.UpdateUser)
-	r.GET("/roles", h.Roles)
-	r.GET("/health", h.Health)
+
+	manageUsers := mw.RequirePermission(models.PermissionUserManage)
+	manageRoles := mw.RequirePermission(models.PermissionRoleManage)
+
+	r.GET("/dashboard", mw.RequirePermission(models.PermissionAdminDashboardView), h.Dashboard)
+	r.GET("/users", manageUsers, h.Users)
+	r.POST("/users", manageUsers, h.CreateUser)
+	r.PUT("/users/:id", manageUsers, h.UpdateUser)
+	r.GET("/users/:id/sessions", manageUsers, h.UserSessions)
+	r.POST("/users/:id/revoke-sessions", manageUsers, h.RevokeUserSessions)
+	r.GET("/roles", manageRoles, h.Roles)
+	r.PUT("/roles/:id/mfa", manageRoles, h.UpdateRoleMFA)
+	r.GET("/health", mw.RequirePermission(models.PermissionAdminHealthView), h.Health)
 }
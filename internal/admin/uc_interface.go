@@ -13,5 +13,8 @@ type UseCase interface {
 	CreateUser(ctx context.Context, sc models.Scope, ip CreateUserInput) (UserItem, error)
 	UpdateUser(ctx context.Context, sc models.Scope, id string, ip UpdateUserInput) (UserItem, error)
 	Roles(ctx context.Context, sc models.Scope) ([]RoleItem, error)
+	UpdateRoleMFA(ctx context.Context, sc models.Scope, id string, ip UpdateRoleMFAInput) (RoleItem, error)
 	Health(ctx context.Context, sc models.Scope) (HealthOutput, error)
+	UserSessions(ctx context.Context, sc models.Scope, id string) ([]SessionItem, error)
+	RevokeUserSessions(ctx context.Context, sc models.Scope, id string) error
 }
@@ -1,7 +1,8 @@
 package admin
 
 type DashboardInput struct {
-	Period string // 7d | 30d | 90d
+	Period      string // 7d | 30d | 90d
+	WorkspaceID string // empty for the whole system
 }
 
 type DashboardUsers struct {
@@ -43,9 +44,14 @@ type UsersInput struct {
 }
 
 type RoleItem struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Alias string `json:"alias"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Alias       string `json:"alias"`
+	MFARequired bool   `json:"mfa_required"`
+}
+
+type UpdateRoleMFAInput struct {
+	Required bool `json:"required"`
 }
 
 type UserItem struct {
@@ -59,6 +65,15 @@ type UserItem struct {
 	LastLoginAt *string  `json:"last_login_at"`
 }
 
+type SessionItem struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
 type UsersOutput struct {
 	Items []UserItem `json:"items"`
 	Meta  struct {
@@ -30,28 +30,43 @@ func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip admin.D
 	prevStart := start.AddDate(0, 0, -days)
 	prevEnd := start.AddDate(0, 0, -1)
 
+	if ip.WorkspaceID != "" {
+		if _, err := uc.workspaceUC.Detail(ctx, sc, ip.WorkspaceID); err != nil {
+			uc.l.Warnf(ctx, "internal.admin.usecase.Dashboard.workspaceUC.Detail: %v", err)
+			return admin.DashboardOutput{}, err
+		}
+	}
+
 	// Cards dashboard
-	cardsDash, err := uc.cardUC.Dashboard(ctx, sc, cards.DashboardInput{From: start, To: end})
+	cardsDash, err := uc.cardUC.Dashboard(ctx, sc, cards.DashboardInput{From: start, To: end, WorkspaceID: ip.WorkspaceID})
 	if err != nil {
 		return admin.DashboardOutput{}, err
 	}
 
 	// Boards dashboard (totals)
-	boardsDash, err := uc.boardUC.Dashboard(ctx, sc, boards.DashboardInput{From: start, To: end})
+	boardsDash, err := uc.boardUC.Dashboard(ctx, sc, boards.DashboardInput{From: start, To: end, WorkspaceID: ip.WorkspaceID})
 	if err != nil {
 		return admin.DashboardOutput{}, err
 	}
 	// Active boards from cards activity
 	boardsDash.Active = int64(len(cardsDash.ActiveBoardIDs))
 
-	// Users dashboard (total)
+	// Users dashboard (total), the members of the workspace when reporting per workspace
 	usersDash, err := uc.userUC.Dashboard(ctx, sc, user.DashboardInput{From: start, To: end})
 	if err != nil {
 		return admin.DashboardOutput{}, err
 	}
+	if ip.WorkspaceID != "" {
+		ms, err := uc.workspaceUC.ListMembers(ctx, sc, ip.WorkspaceID)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.admin.usecase.Dashboard.workspaceUC.ListMembers: %v", err)
+			return admin.DashboardOutput{}, err
+		}
+		usersDash.Total = int64(len(ms.Members))
+	}
 	// Active/growth from cards dashboard
 	usersDash.Active = int64(len(cardsDash.ActiveUserIDs))
-	prevCardsDash, err := uc.cardUC.Dashboard(ctx, sc, cards.DashboardInput{From: prevStart, To: prevEnd})
+	prevCardsDash, err := uc.cardUC.Dashboard(ctx, sc, cards.DashboardInput{From: prevStart, To: prevEnd, WorkspaceID: ip.WorkspaceID})
 	if err != nil {
 		return admin.DashboardOutput{}, err
 	}
@@ -18,11 +18,32 @@ func (uc implUsecase) Roles(ctx context.Context, sc models.Scope) ([]admin.RoleI
 	items := make([]admin.RoleItem, len(roles))
 	for i, r := range roles {
 		items[i] = admin.RoleItem{
-			ID:    r.ID,
-			Name:  r.Name,
-			Alias: r.Alias,
+			ID:          r.ID,
+			Name:        r.Name,
+			Alias:       r.Alias,
+			MFARequired: r.MFARequired,
 		}
 	}
 
 	return items, nil
 }
+
+// UpdateRoleMFA turns mandatory two-factor authentication on or off for a role.
+// Users of the role without 2FA are asked to enroll on their next login.
+func (uc implUsecase) UpdateRoleMFA(ctx context.Context, sc models.Scope, id string, ip admin.UpdateRoleMFAInput) (admin.RoleItem, error) {
+	r, err := uc.roleUC.UpdateMFARequired(ctx, sc, role.UpdateMFARequiredInput{
+		ID:       id,
+		Required: ip.Required,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.admin.usecase.UpdateRoleMFA.roleUC.UpdateMFARequired: %v", err)
+		return admin.RoleItem{}, err
+	}
+
+	return admin.RoleItem{
+		ID:          r.ID,
+		Name:        r.Name,
+		Alias:       r.Alias,
+		MFARequired: r.MFARequired,
+	}, nil
+}
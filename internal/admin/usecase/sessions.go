@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/admin"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (uc implUsecase) UserSessions(ctx context.Context, sc models.Scope, id string) ([]admin.SessionItem, error) {
+	ss, err := uc.authUC.ListSessions(ctx, sc, auth.ListSessionsInput{UserID: id})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.admin.usecase.UserSessions.authUC.ListSessions: %v", err)
+		return nil, err
+	}
+
+	items := make([]admin.SessionItem, len(ss))
+	for i, s := range ss {
+		items[i] = admin.SessionItem{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt.UTC().Format(time.RFC3339),
+			LastUsedAt: s.LastUsedAt.UTC().Format(time.RFC3339),
+			ExpiresAt:  s.ExpiresAt.UTC().Format(time.RFC3339),
+		}
+	}
+
+	return items, nil
+}
+
+func (uc implUsecase) RevokeUserSessions(ctx context.Context, sc models.Scope, id string) error {
+	if err := uc.authUC.RevokeUserSessions(ctx, sc, id); err != nil {
+		uc.l.Errorf(ctx, "internal.admin.usecase.RevokeUserSessions.authUC.RevokeUserSessions: %v", err)
+		return err
+	}
+
+	return nil
+}
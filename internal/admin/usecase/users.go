@@ -46,16 +46,14 @@ func (uc implUsecase) Users(ctx context.Context, sc models.Scope, ip admin.Users
 				r = admin.RoleItem{ID: u.RoleID}
 			}
 		}
-		var lastLogin *string
 		items = append(items, admin.UserItem{
-			ID:          u.ID,
-			Username:    u.Username,
-			FullName:    u.FullName,
-			Role:        r,
-			IsActive:    u.IsActive,
-			CreatedAt:   u.CreatedAt.UTC().Format(time.RFC3339),
-			UpdatedAt:   u.UpdatedAt.UTC().Format(time.RFC3339),
-			LastLoginAt: lastLogin,
+			ID:        u.ID,
+			Username:  u.Username,
+			FullName:  u.FullName,
+			Role:      r,
+			IsActive:  u.IsActive,
+			CreatedAt: u.CreatedAt.UTC().Format(time.RFC3339),
+			UpdatedAt: u.UpdatedAt.UTC().Format(time.RFC3339),
 		})
 	}
 
@@ -70,6 +68,22 @@ func (uc implUsecase) Users(ctx context.Context, sc models.Scope, ip admin.Users
 	}
 	pageItems := items[start:end]
 
+	ids := make([]string, len(pageItems))
+	for i, it := range pageItems {
+		ids[i] = it.ID
+	}
+	lastLogins, err := uc.authUC.GetLastLogins(ctx, sc, ids)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.admin.usecase.Users.authUC.GetLastLogins: %v", err)
+		return admin.UsersOutput{}, err
+	}
+	for i := range pageItems {
+		if at, ok := lastLogins[pageItems[i].ID]; ok {
+			s := at.UTC().Format(time.RFC3339)
+			pageItems[i].LastLoginAt = &s
+		}
+	}
+
 	var out admin.UsersOutput
 	out.Items = pageItems
 	out.Meta.Count = int64(len(pageItems))
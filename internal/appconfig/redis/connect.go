@@ -0,0 +1,70 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/config"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	defaultConnectTimeout = 5 * time.Second
+)
+
+var (
+	instance *redis.Client
+	once     sync.Once
+	mu       sync.RWMutex
+)
+
+// Connect initializes and connects to Redis. It returns a nil client when no
+// address is configured, Redis is optional.
+func Connect(ctx context.Context, cfg config.RedisConfig) (*redis.Client, error) {
+	var err error
+
+	once.Do(func() {
+		if cfg.Addr == "" {
+			return
+		}
+
+		client := redis.NewClient(&redis.Options{
+			Addr:     cfg.Addr,
+			Password: cfg.Password,
+			DB:       cfg.DB,
+		})
+
+		// Set timeout for connection
+		connectCtx, cancel := context.WithTimeout(ctx, defaultConnectTimeout)
+		defer cancel()
+
+		// Test connection
+		if pingErr := client.Ping(connectCtx).Err(); pingErr != nil {
+			client.Close()
+			err = fmt.Errorf("failed to ping Redis: %w", pingErr)
+			return
+		}
+
+		instance = client
+		log.Printf("Redis client initialized successfully")
+	})
+
+	return instance, err
+}
+
+// Disconnect closes the Redis connection
+func Disconnect() error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if instance != nil {
+		if err := instance.Close(); err != nil {
+			return fmt.Errorf("failed to close Redis connection: %w", err)
+		}
+		instance = nil
+	}
+	return nil
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // @Summary Login
-// @Description Login with email and password
+// @Description Login with email and password. When two-factor authentication applies, an MFA challenge is returned instead of the tokens
 // @Tags Auth
 // @Accept json
 // @Produce json
@@ -32,6 +32,11 @@ func (h handler) Login(c *gin.Context) {
 		return
 	}
 
+	if o.MFAStatus != "" {
+		response.OK(c, h.newMFAChallengeResp(o))
+		return
+	}
+
 	response.OK(c, h.newLoginResp(o))
 }
 
@@ -79,13 +84,13 @@ func (h handler) RefreshToken(c *gin.Context) {
 func (h handler) Logout(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	sc, err := h.processLogoutRequest(c)
+	ip, sc, err := h.processLogoutRequest(c)
 	if err != nil {
 		response.Error(c, h.mapErrorCode(err), h.d)
 		return
 	}
 
-	err = h.uc.Logout(ctx, sc)
+	err = h.uc.Logout(ctx, sc, ip)
 	if err != nil {
 		h.l.Errorf(ctx, "internal.auth.http.Logout.uc.Logout: %v", err)
 		response.Error(c, h.mapErrorCode(err), h.d)
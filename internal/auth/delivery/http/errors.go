@@ -1,6 +1,8 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/auth"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 )
@@ -11,6 +13,30 @@ var (
 	errInvalidToken       = pkgErrors.NewHTTPError(10703, "Invalid token")
 	errTokenExpired       = pkgErrors.NewHTTPError(10704, "Token expired")
 	errUnauthorized       = pkgErrors.NewHTTPError(10705, "Unauthorized")
+	errTokenReused        = pkgErrors.NewHTTPError(10706, "Refresh token reused, please login again")
+	errSessionNotFound    = pkgErrors.NewHTTPError(10707, "Session not found")
+	errUserExists         = pkgErrors.NewHTTPError(10708, "User already exists")
+	errInvalidOTP         = pkgErrors.NewHTTPError(10709, "Invalid verification code")
+	errOTPExpired         = pkgErrors.NewHTTPError(10710, "Verification code expired")
+	errTooManyAttempts    = pkgErrors.NewHTTPError(10711, "Too many attempts, please request a new code")
+	errUserNotFound       = pkgErrors.NewHTTPError(10713, "User not found")
+	errWrongPassword      = pkgErrors.NewHTTPError(10714, "Current password is incorrect")
+	errSamePassword       = pkgErrors.NewHTTPError(10715, "New password must differ from the current one")
+	errInvalidResetToken  = pkgErrors.NewHTTPError(10716, "Invalid reset token")
+	errMFANotSetUp        = pkgErrors.NewHTTPError(10717, "Two-factor authentication is not set up")
+	errMFAAlreadyEnabled  = pkgErrors.NewHTTPError(10718, "Two-factor authentication is already enabled")
+	errInvalidMFACode     = pkgErrors.NewHTTPError(10719, "Invalid two-factor code")
+	errMFARequired        = pkgErrors.NewHTTPError(10720, "Two-factor authentication is required for this role")
+	errTooManyLogins      = &pkgErrors.HTTPError{Code: 10721, Message: "Too many login attempts, please try again later", StatusCode: http.StatusTooManyRequests}
+	errAccountLocked      = &pkgErrors.HTTPError{Code: 10722, Message: "Account temporarily locked after too many failed logins", StatusCode: http.StatusLocked}
+	errTokenNotFound      = pkgErrors.NewHTTPError(10723, "Personal access token not found")
+	errInvalidExpiry      = pkgErrors.NewHTTPError(10724, "Expiry must be in the future")
+	errLoginRequired      = &pkgErrors.HTTPError{Code: 10725, Message: "Personal access tokens cannot manage tokens, please login", StatusCode: http.StatusForbidden}
+	errOIDCDisabled       = &pkgErrors.HTTPError{Code: 10726, Message: "Single sign-on is not configured", StatusCode: http.StatusNotFound}
+	errInvalidOIDCState   = pkgErrors.NewHTTPError(10727, "Invalid or expired single sign-on state, please start again")
+	errOIDCFailed         = &pkgErrors.HTTPError{Code: 10728, Message: "Single sign-on failed", StatusCode: http.StatusUnauthorized}
+	errOIDCEmailRequired  = &pkgErrors.HTTPError{Code: 10729, Message: "The identity provider returned no verified email", StatusCode: http.StatusForbidden}
+	errTooManyCodes       = &pkgErrors.HTTPError{Code: 10730, Message: "Too many verification codes requested, please try again later", StatusCode: http.StatusTooManyRequests}
 )
 
 func (h handler) mapErrorCode(err error) error {
@@ -23,6 +49,54 @@ func (h handler) mapErrorCode(err error) error {
 		return errTokenExpired
 	case auth.ErrUnauthorized:
 		return errUnauthorized
+	case auth.ErrTokenReused:
+		return errTokenReused
+	case auth.ErrSessionNotFound:
+		return errSessionNotFound
+	case auth.ErrUserExists:
+		return errUserExists
+	case auth.ErrInvalidOTP:
+		return errInvalidOTP
+	case auth.ErrOTPExpired:
+		return errOTPExpired
+	case auth.ErrTooManyAttempts:
+		return errTooManyAttempts
+	case auth.ErrUserNotFound:
+		return errUserNotFound
+	case auth.ErrWrongPassword:
+		return errWrongPassword
+	case auth.ErrSamePassword:
+		return errSamePassword
+	case auth.ErrInvalidResetToken:
+		return errInvalidResetToken
+	case auth.ErrMFANotSetUp:
+		return errMFANotSetUp
+	case auth.ErrMFAAlreadyEnabled:
+		return errMFAAlreadyEnabled
+	case auth.ErrInvalidMFACode:
+		return errInvalidMFACode
+	case auth.ErrMFARequired:
+		return errMFARequired
+	case auth.ErrTooManyLogins:
+		return errTooManyLogins
+	case auth.ErrAccountLocked:
+		return errAccountLocked
+	case auth.ErrTokenNotFound:
+		return errTokenNotFound
+	case auth.ErrInvalidExpiry:
+		return errInvalidExpiry
+	case auth.ErrLoginRequired:
+		return errLoginRequired
+	case auth.ErrOIDCDisabled:
+		return errOIDCDisabled
+	case auth.ErrInvalidOIDCState:
+		return errInvalidOIDCState
+	case auth.ErrOIDCFailed:
+		return errOIDCFailed
+	case auth.ErrOIDCEmailRequired:
+		return errOIDCEmailRequired
+	case auth.ErrTooManyCodes:
+		return errTooManyCodes
 	default:
 		return err
 	}
@@ -31,4 +105,27 @@ func (h handler) mapErrorCode(err error) error {
 var NotFound = []error{
 	errInvalidCredentials,
 	errInvalidToken,
+	errSessionNotFound,
+	errUserExists,
+	errInvalidOTP,
+	errOTPExpired,
+	errTooManyAttempts,
+	errUserNotFound,
+	errWrongPassword,
+	errSamePassword,
+	errInvalidResetToken,
+	errMFANotSetUp,
+	errMFAAlreadyEnabled,
+	errInvalidMFACode,
+	errMFARequired,
+	errTooManyLogins,
+	errAccountLocked,
+	errTokenNotFound,
+	errInvalidExpiry,
+	errLoginRequired,
+	errOIDCDisabled,
+	errInvalidOIDCState,
+	errOIDCFailed,
+	errOIDCEmailRequired,
+	errTooManyCodes,
 }
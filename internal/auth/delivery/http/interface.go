@@ -4,6 +4,26 @@ import "github.com/gin-gonic/gin"
 
 type Handler interface {
 	Login(c *gin.Context)
+	Register(c *gin.Context)
+	Verify(c *gin.Context)
+	ResendVerification(c *gin.Context)
+	ChangePassword(c *gin.Context)
+	ForgotPassword(c *gin.Context)
+	ResetPassword(c *gin.Context)
+	VerifyMFA(c *gin.Context)
+	SetupMFAChallenge(c *gin.Context)
+	StartOIDCLogin(c *gin.Context)
+	OIDCCallback(c *gin.Context)
+	SetupMFA(c *gin.Context)
+	EnableMFA(c *gin.Context)
+	DisableMFA(c *gin.Context)
+	RegenerateRecoveryCodes(c *gin.Context)
 	RefreshToken(c *gin.Context)
 	Logout(c *gin.Context)
+	ListSessions(c *gin.Context)
+	RevokeSession(c *gin.Context)
+	RevokeAllSessions(c *gin.Context)
+	CreatePersonalAccessToken(c *gin.Context)
+	ListPersonalAccessTokens(c *gin.Context)
+	RevokePersonalAccessToken(c *gin.Context)
 }
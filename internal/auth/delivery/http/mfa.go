@@ -0,0 +1,220 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Verify MFA
+// @Description Complete a login with the MFA token and a TOTP or recovery code. For a pending enrollment the first code enables 2FA and recovery codes are returned
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body verifyMFAReq true "Verify MFA request"
+// @Success 200 {object} loginResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/mfa/verify [POST]
+func (h handler) VerifyMFA(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processVerifyMFARequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.VerifyMFA(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.VerifyMFA.uc.VerifyMFA: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.VerifyMFA.uc.VerifyMFA: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newLoginResp(o))
+}
+
+// @Summary Set up MFA during login
+// @Description Generate a TOTP secret for a user whose role requires 2FA but who has not enrolled yet
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body mfaChallengeReq true "MFA challenge request"
+// @Success 200 {object} setupMFAResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/mfa/challenge/setup [POST]
+func (h handler) SetupMFAChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processSetupMFAChallengeRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.SetupMFAChallenge(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.SetupMFAChallenge.uc.SetupMFAChallenge: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.SetupMFAChallenge.uc.SetupMFAChallenge: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newSetupMFAResp(o))
+}
+
+// @Summary Set up MFA
+// @Description Generate a new TOTP secret and provisioning URI for the current user; 2FA stays off until enabled
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} setupMFAResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/mfa/setup [POST]
+func (h handler) SetupMFA(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	sc, err := h.processSetupMFARequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.SetupMFA(ctx, sc)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.SetupMFA.uc.SetupMFA: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.SetupMFA.uc.SetupMFA: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newSetupMFAResp(o))
+}
+
+// @Summary Enable MFA
+// @Description Confirm the pending TOTP secret with a code and receive the recovery codes
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body enableMFAReq true "Enable MFA request"
+// @Success 200 {object} recoveryCodesResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/mfa/enable [POST]
+func (h handler) EnableMFA(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processEnableMFARequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.EnableMFA(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.EnableMFA.uc.EnableMFA: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.EnableMFA.uc.EnableMFA: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newRecoveryCodesResp(o))
+}
+
+// @Summary Disable MFA
+// @Description Turn off 2FA with a TOTP or recovery code; not allowed when the role requires 2FA
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body mfaCodeReq true "MFA code request"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/mfa/disable [POST]
+func (h handler) DisableMFA(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processMFACodeRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.DisableMFA(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.DisableMFA.uc.DisableMFA: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.DisableMFA.uc.DisableMFA: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary Regenerate recovery codes
+// @Description Replace all recovery codes of the current user; requires a TOTP code
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body mfaCodeReq true "MFA code request"
+// @Success 200 {object} recoveryCodesResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/mfa/recovery-codes [POST]
+func (h handler) RegenerateRecoveryCodes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processMFACodeRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.RegenerateRecoveryCodes(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.RegenerateRecoveryCodes.uc.RegenerateRecoveryCodes: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.RegenerateRecoveryCodes.uc.RegenerateRecoveryCodes: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newRecoveryCodesResp(o))
+}
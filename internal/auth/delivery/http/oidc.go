@@ -0,0 +1,75 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Start SSO login
+// @Description Start a login at the OpenID Connect provider. The client sends the user to the returned URL, the provider redirects back to the configured redirect URL with a code and state
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} startOIDCLoginResp "Success"
+// @Failure 404 {object} response.Resp "SSO not configured"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/oidc/login [GET]
+func (h handler) StartOIDCLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	o, err := h.uc.StartOIDCLogin(ctx, models.Scope{})
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.StartOIDCLogin.uc.StartOIDCLogin: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.StartOIDCLogin.uc.StartOIDCLogin: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newStartOIDCLoginResp(o))
+}
+
+// @Summary Complete SSO login
+// @Description Complete the login with the code and state the OpenID Connect provider redirected with. Unknown users are linked by email or created with the default role. When two-factor authentication applies, an MFA challenge is returned instead of the tokens
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body oidcCallbackReq true "OIDC callback request"
+// @Success 200 {object} loginResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/oidc/callback [POST]
+func (h handler) OIDCCallback(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processOIDCCallbackRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.OIDCCallback(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.OIDCCallback.uc.OIDCCallback: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.OIDCCallback.uc.OIDCCallback: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	if o.MFAStatus != "" {
+		response.OK(c, h.newMFAChallengeResp(o))
+		return
+	}
+
+	response.OK(c, h.newLoginResp(o))
+}
@@ -0,0 +1,107 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Change password
+// @Description Change the password of the current user after checking the current one
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param Accept-Language header string false "Language of the notification email" Enums(en, vi)
+// @Param request body changePasswordReq true "Change password request"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/password/change [POST]
+func (h handler) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processChangePasswordRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.ChangePassword(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.ChangePassword.uc.ChangePassword: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.ChangePassword.uc.ChangePassword: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary Forgot password
+// @Description Send a single-use password reset token to the email (username) of the account
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language of the reset email" Enums(en, vi)
+// @Param request body forgotPasswordReq true "Forgot password request"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/password/forgot [POST]
+func (h handler) ForgotPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processForgotPasswordRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.ForgotPassword(ctx, sc, req.toInput()); err != nil {
+		h.l.Errorf(ctx, "internal.auth.http.ForgotPassword.uc.ForgotPassword: %v", err)
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary Reset password
+// @Description Set a new password with a reset token; all sessions of the user are revoked
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language of the notification email" Enums(en, vi)
+// @Param request body resetPasswordReq true "Reset password request"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/password/reset [POST]
+func (h handler) ResetPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processResetPasswordRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.ResetPassword(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.ResetPassword.uc.ResetPassword: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.ResetPassword.uc.ResetPassword: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
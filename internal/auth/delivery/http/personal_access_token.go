@@ -0,0 +1,109 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Create a personal access token
+// @Description Create a named token for scripts and CI. Send it as "Authorization: Bearer <token>"; it is returned only once. Requires a login session
+// @Tags Personal Access Tokens
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param request body createPersonalAccessTokenReq true "Create token request"
+// @Success 200 {object} createPersonalAccessTokenResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/users/me/tokens [POST]
+func (h handler) CreatePersonalAccessToken(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processCreatePersonalAccessTokenRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.CreatePersonalAccessToken(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.CreatePersonalAccessToken.uc.CreatePersonalAccessToken: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.CreatePersonalAccessToken.uc.CreatePersonalAccessToken: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newCreatePersonalAccessTokenResp(o))
+}
+
+// @Summary List personal access tokens
+// @Description List the personal access tokens of the current user that were not revoked
+// @Tags Personal Access Tokens
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} []personalAccessTokenItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/users/me/tokens [GET]
+func (h handler) ListPersonalAccessTokens(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	sc, err := h.processListPersonalAccessTokensRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	ts, err := h.uc.ListPersonalAccessTokens(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "internal.auth.http.ListPersonalAccessTokens.uc.ListPersonalAccessTokens: %v", err)
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	response.OK(c, h.newPersonalAccessTokensResp(ts))
+}
+
+// @Summary Revoke a personal access token
+// @Description Revoke one of the current user's personal access tokens. Requires a login session
+// @Tags Personal Access Tokens
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path string true "Token ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/users/me/tokens/{id} [DELETE]
+func (h handler) RevokePersonalAccessToken(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processRevokePersonalAccessTokenRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.RevokePersonalAccessToken(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.RevokePersonalAccessToken.uc.RevokePersonalAccessToken: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.RevokePersonalAccessToken.uc.RevokePersonalAccessToken: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
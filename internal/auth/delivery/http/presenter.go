@@ -1,7 +1,10 @@
 package http
 
 import (
+	"time"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 )
 
 type respObj struct {
@@ -11,14 +14,18 @@ type respObj struct {
 }
 
 type loginReq struct {
-	Username string `json:"username" binding:"required"`
-	Password string `json:"password" binding:"required"`
+	Username  string `json:"username" binding:"required"`
+	Password  string `json:"password" binding:"required"`
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
 }
 
 func (req loginReq) toInput() auth.LoginInput {
 	return auth.LoginInput{
-		Username: req.Username,
-		Password: req.Password,
+		Username:  req.Username,
+		Password:  req.Password,
+		UserAgent: req.UserAgent,
+		IPAddress: req.IPAddress,
 	}
 }
 
@@ -33,8 +40,11 @@ func (req refreshTokenReq) toInput() auth.RefreshTokenInput {
 }
 
 type loginResp struct {
-	AccessToken string   `json:"access_token"`
-	User        userInfo `json:"user"`
+	AccessToken  string   `json:"access_token"`
+	RefreshToken string   `json:"refresh_token"`
+	User         userInfo `json:"user"`
+	// RecoveryCodes is only set when the login also completed a forced 2FA enrollment.
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
 }
 
 type userInfo struct {
@@ -51,7 +61,9 @@ type refreshTokenResp struct {
 
 func (h handler) newLoginResp(o auth.LoginOutput) loginResp {
 	return loginResp{
-		AccessToken: o.AssToken,
+		AccessToken:   o.AssToken,
+		RefreshToken:  o.RfrToken,
+		RecoveryCodes: o.RecoveryCodes,
 		User: userInfo{
 			ID:       o.User.ID,
 			Username: o.User.Username,
@@ -65,9 +77,292 @@ func (h handler) newLoginResp(o auth.LoginOutput) loginResp {
 	}
 }
 
+type sessionItem struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	Current    bool      `json:"current"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+func (h handler) newSessionsResp(ss []models.Session, currentID string) []sessionItem {
+	items := make([]sessionItem, len(ss))
+	for i, s := range ss {
+		items[i] = sessionItem{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			Current:    s.ID == currentID,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+		}
+	}
+	return items
+}
+
 func (h handler) newRefreshTokenResp(o auth.RefreshTokenOutput) refreshTokenResp {
 	return refreshTokenResp{
 		AccessToken:  o.AssToken,
 		RefreshToken: o.RfrToken,
 	}
 }
+
+type registerReq struct {
+	Username string `json:"username" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6"`
+	FullName string `json:"full_name" binding:"required"`
+}
+
+func (req registerReq) toInput() auth.RegisterInput {
+	return auth.RegisterInput{
+		Username: req.Username,
+		Password: req.Password,
+		FullName: req.FullName,
+	}
+}
+
+type registerResp struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"full_name"`
+	IsActive bool   `json:"is_active"`
+}
+
+func (h handler) newRegisterResp(o auth.RegisterOutput) registerResp {
+	return registerResp{
+		ID:       o.User.ID,
+		Username: o.User.Username,
+		FullName: o.User.FullName,
+		IsActive: o.User.IsActive,
+	}
+}
+
+type verifyReq struct {
+	Username string `json:"username" binding:"required"`
+	OTP      string `json:"otp" binding:"required,len=6"`
+}
+
+func (req verifyReq) toInput() auth.VerifyInput {
+	return auth.VerifyInput{
+		Username: req.Username,
+		OTP:      req.OTP,
+	}
+}
+
+type resendVerificationReq struct {
+	Username string `json:"username" binding:"required"`
+}
+
+func (req resendVerificationReq) toInput() auth.ResendVerificationInput {
+	return auth.ResendVerificationInput{
+		Username: req.Username,
+	}
+}
+
+type changePasswordReq struct {
+	CurrentPassword string `json:"current_password" binding:"required"`
+	NewPassword     string `json:"new_password" binding:"required,min=6"`
+}
+
+func (req changePasswordReq) toInput() auth.ChangePasswordInput {
+	return auth.ChangePasswordInput{
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	}
+}
+
+type forgotPasswordReq struct {
+	Username string `json:"username" binding:"required"`
+}
+
+func (req forgotPasswordReq) toInput() auth.ForgotPasswordInput {
+	return auth.ForgotPasswordInput{
+		Username: req.Username,
+	}
+}
+
+type resetPasswordReq struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=6"`
+}
+
+func (req resetPasswordReq) toInput() auth.ResetPasswordInput {
+	return auth.ResetPasswordInput{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	}
+}
+
+type mfaChallengeResp struct {
+	Status    string    `json:"status"`
+	MFAToken  string    `json:"mfa_token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (h handler) newMFAChallengeResp(o auth.LoginOutput) mfaChallengeResp {
+	return mfaChallengeResp{
+		Status:    o.MFAStatus,
+		MFAToken:  o.MFAToken,
+		ExpiresAt: o.MFAExpiresAt,
+	}
+}
+
+type verifyMFAReq struct {
+	MFAToken     string `json:"mfa_token" binding:"required"`
+	Code         string `json:"code" binding:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code"`
+	UserAgent    string `json:"-"`
+	IPAddress    string `json:"-"`
+}
+
+func (req verifyMFAReq) toInput() auth.VerifyMFAInput {
+	return auth.VerifyMFAInput{
+		MFAToken:     req.MFAToken,
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+		UserAgent:    req.UserAgent,
+		IPAddress:    req.IPAddress,
+	}
+}
+
+type startOIDCLoginResp struct {
+	AuthURL   string    `json:"auth_url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (h handler) newStartOIDCLoginResp(o auth.StartOIDCLoginOutput) startOIDCLoginResp {
+	return startOIDCLoginResp{
+		AuthURL:   o.AuthURL,
+		ExpiresAt: o.ExpiresAt,
+	}
+}
+
+type oidcCallbackReq struct {
+	Code      string `json:"code" binding:"required"`
+	State     string `json:"state" binding:"required"`
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+func (req oidcCallbackReq) toInput() auth.OIDCCallbackInput {
+	return auth.OIDCCallbackInput{
+		Code:      req.Code,
+		State:     req.State,
+		UserAgent: req.UserAgent,
+		IPAddress: req.IPAddress,
+	}
+}
+
+type mfaChallengeReq struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+}
+
+func (req mfaChallengeReq) toInput() auth.MFAChallengeInput {
+	return auth.MFAChallengeInput{
+		MFAToken: req.MFAToken,
+	}
+}
+
+type setupMFAResp struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+func (h handler) newSetupMFAResp(o auth.SetupMFAOutput) setupMFAResp {
+	return setupMFAResp{
+		Secret:          o.Secret,
+		ProvisioningURI: o.ProvisioningURI,
+	}
+}
+
+type enableMFAReq struct {
+	Code string `json:"code" binding:"required"`
+}
+
+func (req enableMFAReq) toInput() auth.EnableMFAInput {
+	return auth.EnableMFAInput{
+		Code: req.Code,
+	}
+}
+
+type recoveryCodesResp struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (h handler) newRecoveryCodesResp(o auth.EnableMFAOutput) recoveryCodesResp {
+	return recoveryCodesResp{
+		RecoveryCodes: o.RecoveryCodes,
+	}
+}
+
+type mfaCodeReq struct {
+	Code         string `json:"code" binding:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (req mfaCodeReq) toInput() auth.MFACodeInput {
+	return auth.MFACodeInput{
+		Code:         req.Code,
+		RecoveryCode: req.RecoveryCode,
+	}
+}
+
+type createPersonalAccessTokenReq struct {
+	Name      string     `json:"name" binding:"required,max=100"`
+	Scope     string     `json:"scope" binding:"required,oneof=read write"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (req createPersonalAccessTokenReq) toInput() auth.CreatePersonalAccessTokenInput {
+	return auth.CreatePersonalAccessTokenInput{
+		Name:      req.Name,
+		Scope:     req.Scope,
+		ExpiresAt: req.ExpiresAt,
+	}
+}
+
+type personalAccessTokenItem struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scope       string     `json:"scope"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type createPersonalAccessTokenResp struct {
+	personalAccessTokenItem
+	// Token is shown only once.
+	Token string `json:"token"`
+}
+
+func (h handler) newPersonalAccessTokenItem(t models.PersonalAccessToken) personalAccessTokenItem {
+	return personalAccessTokenItem{
+		ID:          t.ID,
+		Name:        t.Name,
+		TokenPrefix: t.TokenPrefix,
+		Scope:       t.Scope,
+		ExpiresAt:   t.ExpiresAt,
+		LastUsedAt:  t.LastUsedAt,
+		CreatedAt:   t.CreatedAt,
+	}
+}
+
+func (h handler) newCreatePersonalAccessTokenResp(o auth.CreatePersonalAccessTokenOutput) createPersonalAccessTokenResp {
+	return createPersonalAccessTokenResp{
+		personalAccessTokenItem: h.newPersonalAccessTokenItem(o.Token),
+		Token:                   o.Secret,
+	}
+}
+
+func (h handler) newPersonalAccessTokensResp(ts []models.PersonalAccessToken) []personalAccessTokenItem {
+	items := make([]personalAccessTokenItem, len(ts))
+	for i, t := range ts {
+		items[i] = h.newPersonalAccessTokenItem(t)
+	}
+	return items
+}
@@ -1,7 +1,10 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
@@ -15,6 +18,86 @@ func (h handler) processLoginRequest(c *gin.Context) (loginReq, models.Scope, er
 		h.l.Errorf(ctx, "internal.auth.delivery.http.processLoginRequest.c.ShouldBindJSON: %v", err)
 		return loginReq{}, models.Scope{}, errWrongQuery
 	}
+	req.UserAgent = c.Request.UserAgent()
+	req.IPAddress = c.ClientIP()
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processRegisterRequest(c *gin.Context) (registerReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req registerReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processRegisterRequest.c.ShouldBindJSON: %v", err)
+		return registerReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processVerifyRequest(c *gin.Context) (verifyReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req verifyReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processVerifyRequest.c.ShouldBindJSON: %v", err)
+		return verifyReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processResendVerificationRequest(c *gin.Context) (resendVerificationReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req resendVerificationReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processResendVerificationRequest.c.ShouldBindJSON: %v", err)
+		return resendVerificationReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processChangePasswordRequest(c *gin.Context) (changePasswordReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processChangePasswordRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return changePasswordReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req changePasswordReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processChangePasswordRequest.c.ShouldBindJSON: %v", err)
+		return changePasswordReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processForgotPasswordRequest(c *gin.Context) (forgotPasswordReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req forgotPasswordReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processForgotPasswordRequest.c.ShouldBindJSON: %v", err)
+		return forgotPasswordReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processResetPasswordRequest(c *gin.Context) (resetPasswordReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req resetPasswordReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processResetPasswordRequest.c.ShouldBindJSON: %v", err)
+		return resetPasswordReq{}, models.Scope{}, errWrongQuery
+	}
 
 	return req, models.Scope{}, nil
 }
@@ -31,14 +114,184 @@ func (h handler) processRefreshTokenRequest(c *gin.Context) (refreshTokenReq, mo
 	return req, models.Scope{}, nil
 }
 
-func (h handler) processLogoutRequest(c *gin.Context) (models.Scope, error) {
+func (h handler) processLogoutRequest(c *gin.Context) (auth.LogoutInput, models.Scope, error) {
 	ctx := c.Request.Context()
 
 	p, ok := scope.GetPayloadFromContext(ctx)
 	if !ok {
 		h.l.Errorf(ctx, "internal.auth.delivery.http.processLogoutRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return auth.LogoutInput{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	return auth.LogoutInput{
+		TokenID:   p.Id,
+		SessionID: p.SessionID,
+		ExpiresAt: time.Unix(p.ExpiresAt, 0),
+	}, scope.NewScope(p), nil
+}
+
+func (h handler) processListSessionsRequest(c *gin.Context) (string, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processListSessionsRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return "", models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	return p.SessionID, scope.NewScope(p), nil
+}
+
+func (h handler) processRevokeSessionRequest(c *gin.Context) (string, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processRevokeSessionRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return "", models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processRevokeSessionRequest.c.Param: missing id parameter")
+		return "", models.Scope{}, errWrongQuery
+	}
+
+	return id, scope.NewScope(p), nil
+}
+
+func (h handler) processVerifyMFARequest(c *gin.Context) (verifyMFAReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req verifyMFAReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processVerifyMFARequest.c.ShouldBindJSON: %v", err)
+		return verifyMFAReq{}, models.Scope{}, errWrongQuery
+	}
+	req.UserAgent = c.Request.UserAgent()
+	req.IPAddress = c.ClientIP()
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processOIDCCallbackRequest(c *gin.Context) (oidcCallbackReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req oidcCallbackReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processOIDCCallbackRequest.c.ShouldBindJSON: %v", err)
+		return oidcCallbackReq{}, models.Scope{}, errWrongQuery
+	}
+	req.UserAgent = c.Request.UserAgent()
+	req.IPAddress = c.ClientIP()
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processSetupMFAChallengeRequest(c *gin.Context) (mfaChallengeReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	var req mfaChallengeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processSetupMFAChallengeRequest.c.ShouldBindJSON: %v", err)
+		return mfaChallengeReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, models.Scope{}, nil
+}
+
+func (h handler) processSetupMFARequest(c *gin.Context) (models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processSetupMFARequest.jwt.GetPayloadFromContext: %v", "payload not found")
 		return models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
 	}
 
 	return scope.NewScope(p), nil
 }
+
+func (h handler) processEnableMFARequest(c *gin.Context) (enableMFAReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processEnableMFARequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return enableMFAReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req enableMFAReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processEnableMFARequest.c.ShouldBindJSON: %v", err)
+		return enableMFAReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processMFACodeRequest(c *gin.Context) (mfaCodeReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processMFACodeRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return mfaCodeReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req mfaCodeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processMFACodeRequest.c.ShouldBindJSON: %v", err)
+		return mfaCodeReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processCreatePersonalAccessTokenRequest(c *gin.Context) (createPersonalAccessTokenReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processCreatePersonalAccessTokenRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return createPersonalAccessTokenReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req createPersonalAccessTokenReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processCreatePersonalAccessTokenRequest.c.ShouldBindJSON: %v", err)
+		return createPersonalAccessTokenReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processListPersonalAccessTokensRequest(c *gin.Context) (models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processListPersonalAccessTokensRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	return scope.NewScope(p), nil
+}
+
+func (h handler) processRevokePersonalAccessTokenRequest(c *gin.Context) (string, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processRevokePersonalAccessTokenRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return "", models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		h.l.Errorf(ctx, "internal.auth.delivery.http.processRevokePersonalAccessTokenRequest.c.Param: missing id parameter")
+		return "", models.Scope{}, errWrongQuery
+	}
+
+	return id, scope.NewScope(p), nil
+}
@@ -0,0 +1,111 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Register
+// @Description Create an inactive account and send a verification code to the email (username)
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language of the verification email" Enums(en, vi)
+// @Param request body registerReq true "Register request"
+// @Success 200 {object} registerResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/register [POST]
+func (h handler) Register(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processRegisterRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	o, err := h.uc.Register(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.Register.uc.Register: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.Register.uc.Register: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newRegisterResp(o))
+}
+
+// @Summary Verify email
+// @Description Activate a registered account with the code sent by email
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body verifyReq true "Verify request"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/verify [POST]
+func (h handler) Verify(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processVerifyRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.Verify(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.Verify.uc.Verify: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.Verify.uc.Verify: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary Resend verification code
+// @Description Send a new verification code to an account that is not verified yet. The answer is the same for unknown and verified accounts. At most 3 codes are sent to a username per hour
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Accept-Language header string false "Language of the verification email" Enums(en, vi)
+// @Param request body resendVerificationReq true "Resend verification request"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 429 {object} response.Resp "Too Many Requests"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/verify/resend [POST]
+func (h handler) ResendVerification(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processResendVerificationRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.ResendVerification(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.ResendVerification.uc.ResendVerification: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.ResendVerification.uc.ResendVerification: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
@@ -1,13 +1,48 @@
 package http
 
 import (
+	"time"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
+// publicRateLimit guards the unauthenticated endpoints that can be used to
+// guess credentials or to send emails. Login has its own per-username limit too.
+var publicRateLimit = middleware.RateLimitPolicy{
+	Name:   "auth_public",
+	Limit:  30,
+	Window: time.Minute,
+}
+
 func MapAuthRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
-	r.POST("/login", h.Login)
+	rl := mw.RateLimit(publicRateLimit)
+
+	r.POST("/login", rl, h.Login)
+	r.POST("/register", rl, h.Register)
+	r.POST("/verify", rl, h.Verify)
+	r.POST("/verify/resend", rl, h.ResendVerification)
+	r.POST("/password/change", mw.Auth(), h.ChangePassword)
+	r.POST("/password/forgot", rl, h.ForgotPassword)
+	r.POST("/password/reset", rl, h.ResetPassword)
+	r.POST("/mfa/verify", rl, h.VerifyMFA)
+	r.POST("/mfa/challenge/setup", rl, h.SetupMFAChallenge)
+	r.GET("/oidc/login", rl, h.StartOIDCLogin)
+	r.POST("/oidc/callback", rl, h.OIDCCallback)
+	r.POST("/mfa/setup", mw.Auth(), h.SetupMFA)
+	r.POST("/mfa/enable", mw.Auth(), h.EnableMFA)
+	r.POST("/mfa/disable", mw.Auth(), h.DisableMFA)
+	r.POST("/mfa/recovery-codes", mw.Auth(), h.RegenerateRecoveryCodes)
 	r.POST("/refresh", h.RefreshToken)
 	r.POST("/logout", mw.Auth(), h.Logout)
+	r.GET("/sessions", mw.Auth(), h.ListSessions)
+	r.DELETE("/sessions", mw.Auth(), h.RevokeAllSessions)
+	r.DELETE("/sessions/:id", mw.Auth(), h.RevokeSession)
+}
+
+func MapPersonalAccessTokenRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.POST("", mw.Auth(), h.CreatePersonalAccessToken)
+	r.GET("", mw.Auth(), h.ListPersonalAccessTokens)
+	r.DELETE("/:id", mw.Auth(), h.RevokePersonalAccessToken)
 }
@@ -0,0 +1,104 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary List my sessions
+// @Description List the active sessions (devices) of the current user
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} []sessionItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/sessions [GET]
+func (h handler) ListSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	currentID, sc, err := h.processListSessionsRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	ss, err := h.uc.ListSessions(ctx, sc, auth.ListSessionsInput{UserID: sc.UserID})
+	if err != nil {
+		h.l.Errorf(ctx, "internal.auth.http.ListSessions.uc.ListSessions: %v", err)
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	response.OK(c, h.newSessionsResp(ss, currentID))
+}
+
+// @Summary Revoke a session
+// @Description Revoke one of the current user's sessions
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Param id path string true "Session ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/sessions/{id} [DELETE]
+func (h handler) RevokeSession(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processRevokeSessionRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.RevokeSession(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.auth.http.RevokeSession.uc.RevokeSession: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.auth.http.RevokeSession.uc.RevokeSession: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary Revoke all my sessions
+// @Description Revoke every session of the current user, including this one
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/auth/sessions [DELETE]
+func (h handler) RevokeAllSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	_, sc, err := h.processListSessionsRequest(c)
+	if err != nil {
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	if err := h.uc.RevokeAllSessions(ctx, sc); err != nil {
+		h.l.Errorf(ctx, "internal.auth.http.RevokeAllSessions.uc.RevokeAllSessions: %v", err)
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
@@ -0,0 +1,18 @@
+package rabbitmq
+
+import (
+	pkgRabbit "github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+)
+
+const (
+	SendEmailQueueName  = "auth_send_email"
+	SendEmailRoutingKey = "auth.send_email"
+)
+
+var (
+	SendEmailExc = pkgRabbit.ExchangeArgs{
+		Name:    "auth_send_email_exc",
+		Type:    pkgRabbit.ExchangeTypeDirect,
+		Durable: true,
+	}
+)
@@ -0,0 +1,61 @@
+package consumer
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	pkgRabbit "github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func (c Consumer) Consume() {
+	go c.consume(rabbitmq.SendEmailExc, rabbitmq.SendEmailQueueName, rabbitmq.SendEmailRoutingKey, c.sendEmailWorker)
+}
+
+func (c Consumer) consume(exc pkgRabbit.ExchangeArgs, queueName, routingKey string, workerFunc func(msg amqp.Delivery)) {
+	ctx := context.Background()
+
+	ch, err := c.conn.Channel()
+	if err != nil {
+		c.l.Fatalf(ctx, "internal.auth.delivery.rabbitmq.consumer.consume.Channel: %v", err)
+		return
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(exc); err != nil {
+		c.l.Fatalf(ctx, "internal.auth.delivery.rabbitmq.consumer.consume.ExchangeDeclare: %v", err)
+		return
+	}
+
+	q, err := ch.QueueDeclare(pkgRabbit.QueueArgs{
+		Name:    queueName,
+		Durable: true,
+	})
+	if err != nil {
+		c.l.Fatalf(ctx, "internal.auth.delivery.rabbitmq.consumer.consume.QueueDeclare: %v", err)
+		return
+	}
+
+	if err := ch.QueueBind(pkgRabbit.QueueBindArgs{
+		Queue:      q.Name,
+		Exchange:   exc.Name,
+		RoutingKey: routingKey,
+	}); err != nil {
+		c.l.Fatalf(ctx, "internal.auth.delivery.rabbitmq.consumer.consume.QueueBind: %v", err)
+		return
+	}
+
+	msgs, err := ch.Consume(pkgRabbit.ConsumeArgs{
+		Queue: q.Name,
+	})
+	if err != nil {
+		c.l.Fatalf(ctx, "internal.auth.delivery.rabbitmq.consumer.consume.Consume: %v", err)
+		return
+	}
+
+	c.l.Infof(ctx, "Queue %s is being consumed", q.Name)
+
+	for msg := range msgs {
+		workerFunc(msg)
+	}
+}
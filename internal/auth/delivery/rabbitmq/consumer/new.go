@@ -0,0 +1,21 @@
+package consumer
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
+	pkgRabbit "github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+)
+
+type Consumer struct {
+	l      pkgLog.Logger
+	conn   *pkgRabbit.Connection
+	sender email.Sender
+}
+
+func NewConsumer(l pkgLog.Logger, conn *pkgRabbit.Connection, sender email.Sender) Consumer {
+	return Consumer{
+		l:      l,
+		conn:   conn,
+		sender: sender,
+	}
+}
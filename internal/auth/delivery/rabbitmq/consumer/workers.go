@@ -0,0 +1,51 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+func (c Consumer) sendEmailWorker(d amqp.Delivery) {
+	ctx := context.Background()
+
+	if err := c.handleSendEmail(ctx, d.Body); err != nil {
+		c.l.Errorf(ctx, "internal.auth.delivery.rabbitmq.consumer.sendEmailWorker.handleSendEmail: %v", err)
+		d.Nack(false, false)
+		return
+	}
+
+	d.Ack(false)
+}
+
+func (c Consumer) handleSendEmail(ctx context.Context, body []byte) error {
+	var msg rabbitmq.SendEmailMsg
+	if err := json.Unmarshal(body, &msg); err != nil {
+		return err
+	}
+
+	return c.sender.Send(ctx, newEmail(msg))
+}
+
+func newEmail(msg rabbitmq.SendEmailMsg) email.Email {
+	attachments := make([]email.Attachment, len(msg.Attachments))
+	for i, a := range msg.Attachments {
+		attachments[i] = email.Attachment{
+			Filename:    a.Filename,
+			ContentType: a.ContentType,
+			Data:        a.Data,
+		}
+	}
+
+	return email.Email{
+		Recipient:   msg.Recipient,
+		Subject:     msg.Subject,
+		Body:        msg.Body,
+		CC:          msg.CcAddresses,
+		ReplyTo:     msg.ReplyTo,
+		Attachments: attachments,
+	}
+}
@@ -0,0 +1,49 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	sent []email.Email
+}
+
+func (s *fakeSender) Send(ctx context.Context, e email.Email) error {
+	s.sent = append(s.sent, e)
+	return nil
+}
+
+func TestHandleSendEmail(t *testing.T) {
+	sender := &fakeSender{}
+	c := Consumer{sender: sender}
+
+	body, err := json.Marshal(rabbitmq.SendEmailMsg{
+		Subject:     "Verify your account",
+		Recipient:   "john@example.com",
+		Body:        "<p>123456</p>",
+		CcAddresses: []string{"support@example.com"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, c.handleSendEmail(context.Background(), body))
+	require.Len(t, sender.sent, 1)
+	assert.Equal(t, "john@example.com", sender.sent[0].Recipient)
+	assert.Equal(t, "Verify your account", sender.sent[0].Subject)
+	assert.Equal(t, "<p>123456</p>", sender.sent[0].Body)
+	assert.Equal(t, []string{"support@example.com"}, sender.sent[0].CC)
+}
+
+func TestHandleSendEmailInvalidBody(t *testing.T) {
+	sender := &fakeSender{}
+	c := Consumer{sender: sender}
+
+	assert.Error(t, c.handleSendEmail(context.Background(), []byte("not json")))
+	assert.Empty(t, sender.sent)
+}
@@ -0,0 +1,32 @@
+package producer
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
+	pkgRabbit "github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+)
+
+//go:generate mockery --name Producer
+type Producer interface {
+	PublishSendEmail(ctx context.Context, msg rabbitmq.SendEmailMsg) error
+	// Run declares the exchanges and opens the publishing channel.
+	Run() error
+	Close()
+}
+
+type implProducer struct {
+	l           pkgLog.Logger
+	conn        *pkgRabbit.Connection
+	sendEmailWr *pkgRabbit.Channel
+}
+
+var _ Producer = &implProducer{}
+
+func New(l pkgLog.Logger, conn *pkgRabbit.Connection) Producer {
+	return &implProducer{
+		l:    l,
+		conn: conn,
+	}
+}
@@ -0,0 +1,53 @@
+package producer
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	pkgRabbit "github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+)
+
+func (p *implProducer) Run() error {
+	ch, err := p.conn.Channel()
+	if err != nil {
+		return err
+	}
+
+	if err := ch.ExchangeDeclare(rabbitmq.SendEmailExc); err != nil {
+		return err
+	}
+
+	p.sendEmailWr = ch
+	return nil
+}
+
+func (p *implProducer) Close() {
+	if p.sendEmailWr != nil {
+		p.sendEmailWr.Close()
+	}
+}
+
+func (p *implProducer) PublishSendEmail(ctx context.Context, msg rabbitmq.SendEmailMsg) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		p.l.Errorf(ctx, "internal.auth.delivery.rabbitmq.producer.PublishSendEmail.json.Marshal: %v", err)
+		return err
+	}
+
+	err = p.sendEmailWr.Publish(ctx, pkgRabbit.PublishArgs{
+		Exchange:   rabbitmq.SendEmailExc.Name,
+		RoutingKey: rabbitmq.SendEmailRoutingKey,
+		Msg: pkgRabbit.Publishing{
+			ContentType:  pkgRabbit.ContentTypeJSON,
+			DeliveryMode: 2, // persistent
+			Body:         body,
+		},
+	})
+	if err != nil {
+		p.l.Errorf(ctx, "internal.auth.delivery.rabbitmq.producer.PublishSendEmail.Publish: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,8 @@
+package repository
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("record not found")
+	ErrNoFilter = errors.New("no filter given")
+)
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	CreateRefreshToken(ctx context.Context, sc models.Scope, opts CreateRefreshTokenOptions) (models.RefreshToken, error)
+	DetailRefreshToken(ctx context.Context, sc models.Scope, ID string) (models.RefreshToken, error)
+	// UseRefreshToken marks the token as exchanged. It returns ErrNotFound when the
+	// token does not exist or was already used or revoked, so only one caller wins.
+	UseRefreshToken(ctx context.Context, sc models.Scope, ID string) error
+
+	CreateSession(ctx context.Context, sc models.Scope, opts CreateSessionOptions) (models.Session, error)
+	DetailSession(ctx context.Context, sc models.Scope, ID string) (models.Session, error)
+	ListSessions(ctx context.Context, sc models.Scope, opts ListSessionsOptions) ([]models.Session, error)
+	TouchSession(ctx context.Context, sc models.Scope, opts TouchSessionOptions) error
+	// RevokeSessions revokes the matching sessions together with their refresh tokens.
+	RevokeSessions(ctx context.Context, sc models.Scope, opts RevokeSessionsOptions) error
+	GetLastLogins(ctx context.Context, sc models.Scope, userIDs []string) (map[string]time.Time, error)
+
+	RevokeToken(ctx context.Context, sc models.Scope, opts RevokeTokenOptions) error
+	RevokeUserTokens(ctx context.Context, sc models.Scope, opts RevokeUserTokensOptions) error
+	IsTokenRevoked(ctx context.Context, sc models.Scope, opts IsTokenRevokedOptions) (bool, error)
+	CreateEmailVerification(ctx context.Context, sc models.Scope, opts CreateEmailVerificationOptions) (models.EmailVerification, error)
+	// GetPendingEmailVerification returns the latest verification of the user that
+	// has not been confirmed yet.
+	GetPendingEmailVerification(ctx context.Context, sc models.Scope, userID string) (models.EmailVerification, error)
+	IncreaseEmailVerificationAttempts(ctx context.Context, sc models.Scope, ID string) error
+	// ConfirmEmailVerification marks the verification as used. It returns ErrNotFound
+	// when it was already confirmed, so a code can only be redeemed once.
+	ConfirmEmailVerification(ctx context.Context, sc models.Scope, ID string) error
+
+	CreatePasswordReset(ctx context.Context, sc models.Scope, opts CreatePasswordResetOptions) (models.PasswordReset, error)
+	GetPasswordReset(ctx context.Context, sc models.Scope, tokenHash string) (models.PasswordReset, error)
+	// UsePasswordReset marks the token as redeemed. It returns ErrNotFound when the
+	// token was already used, so a reset link works only once.
+	UsePasswordReset(ctx context.Context, sc models.Scope, ID string) error
+
+	// UpsertUserMFA starts a new (pending) TOTP enrollment, replacing any previous one.
+	UpsertUserMFA(ctx context.Context, sc models.Scope, opts UpsertUserMFAOptions) (models.UserMFA, error)
+	DetailUserMFA(ctx context.Context, sc models.Scope, userID string) (models.UserMFA, error)
+	EnableUserMFA(ctx context.Context, sc models.Scope, opts UseMFAStepOptions) error
+	// UseMFAStep records the time step of an accepted code. It returns ErrNotFound
+	// when the step is not newer than the last one, so a code cannot be replayed.
+	UseMFAStep(ctx context.Context, sc models.Scope, opts UseMFAStepOptions) error
+	// DeleteUserMFA removes the enrollment together with its recovery codes.
+	DeleteUserMFA(ctx context.Context, sc models.Scope, userID string) error
+	// ReplaceRecoveryCodes invalidates the current recovery codes of the user and stores new ones.
+	ReplaceRecoveryCodes(ctx context.Context, sc models.Scope, opts ReplaceRecoveryCodesOptions) error
+	// UseRecoveryCode marks the code as used. It returns ErrNotFound when the code
+	// does not exist or was already used.
+	UseRecoveryCode(ctx context.Context, sc models.Scope, opts UseRecoveryCodeOptions) error
+
+	CreatePersonalAccessToken(ctx context.Context, sc models.Scope, opts CreatePersonalAccessTokenOptions) (models.PersonalAccessToken, error)
+	// ListPersonalAccessTokens returns the tokens of the user that were not revoked, newest first.
+	ListPersonalAccessTokens(ctx context.Context, sc models.Scope, userID string) ([]models.PersonalAccessToken, error)
+	GetPersonalAccessToken(ctx context.Context, sc models.Scope, tokenHash string) (models.PersonalAccessToken, error)
+	// RevokePersonalAccessToken returns ErrNotFound when the token does not belong
+	// to the user or was already revoked.
+	RevokePersonalAccessToken(ctx context.Context, sc models.Scope, opts RevokePersonalAccessTokenOptions) error
+	// RevokeUserPersonalAccessTokens revokes every token of the user that was not revoked yet.
+	RevokeUserPersonalAccessTokens(ctx context.Context, sc models.Scope, userID string) error
+	// TouchPersonalAccessToken updates last_used_at at most once a minute.
+	TouchPersonalAccessToken(ctx context.Context, sc models.Scope, ID string) error
+
+	CreateLoginAttempt(ctx context.Context, sc models.Scope, opts CreateLoginAttemptOptions) error
+	DetailLoginLockout(ctx context.Context, sc models.Scope, userID string) (models.LoginLockout, error)
+	// RegisterLoginFailure counts a failed login and locks the account once the
+	// count reaches the maximum. A failure after an expired lockout starts over at one.
+	RegisterLoginFailure(ctx context.Context, sc models.Scope, opts RegisterLoginFailureOptions) (models.LoginLockout, error)
+	// ResetLoginFailures clears the failure count after a successful login.
+	ResetLoginFailures(ctx context.Context, sc models.Scope, userID string) error
+
+	CreateOIDCLoginState(ctx context.Context, sc models.Scope, opts CreateOIDCLoginStateOptions) (models.OIDCLoginState, error)
+	// ConsumeOIDCLoginState deletes the state and returns it. It returns
+	// ErrNotFound when the state does not exist or was already consumed.
+	ConsumeOIDCLoginState(ctx context.Context, sc models.Scope, stateHash string) (models.OIDCLoginState, error)
+	DetailUserIdentity(ctx context.Context, sc models.Scope, opts DetailUserIdentityOptions) (models.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, sc models.Scope, opts CreateUserIdentityOptions) (models.UserIdentity, error)
+	// TouchUserIdentity records a login with the identity and its current email.
+	TouchUserIdentity(ctx context.Context, sc models.Scope, opts TouchUserIdentityOptions) error
+
+	// DeleteExpired removes revocation entries, refresh tokens, email
+	// verifications, password resets and OIDC login states that are past their
+	// expiry, and login attempts older than the audit retention.
+	DeleteExpired(ctx context.Context, sc models.Scope) error
+}
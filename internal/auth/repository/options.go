@@ -0,0 +1,141 @@
+package repository
+
+import "time"
+
+type CreateRefreshTokenOptions struct {
+	ID        string
+	UserID    string
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+type CreateSessionOptions struct {
+	ID        string
+	UserID    string
+	UserAgent string
+	IPAddress string
+	ExpiresAt time.Time
+}
+
+type ListSessionsOptions struct {
+	UserID     string
+	ActiveOnly bool
+}
+
+type TouchSessionOptions struct {
+	ID        string
+	ExpiresAt time.Time
+}
+
+// RevokeSessionsOptions selects sessions by ID, by user, or both.
+type RevokeSessionsOptions struct {
+	UserID string
+	IDs    []string
+}
+
+type RevokeTokenOptions struct {
+	JTI       string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+type RevokeUserTokensOptions struct {
+	UserID        string
+	RevokedBefore time.Time
+	ExpiresAt     time.Time
+}
+
+type IsTokenRevokedOptions struct {
+	JTI       string
+	UserID    string
+	SessionID string
+	IssuedAt  time.Time
+}
+
+type CreateEmailVerificationOptions struct {
+	UserID    string
+	OTPHash   string
+	ExpiresAt time.Time
+}
+
+type CreatePasswordResetOptions struct {
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+type UpsertUserMFAOptions struct {
+	UserID          string
+	SecretEncrypted string
+}
+
+type UseMFAStepOptions struct {
+	UserID string
+	Step   int64
+}
+
+type ReplaceRecoveryCodesOptions struct {
+	UserID     string
+	CodeHashes []string
+}
+
+type UseRecoveryCodeOptions struct {
+	UserID   string
+	CodeHash string
+}
+
+type CreateLoginAttemptOptions struct {
+	// UserID is empty when the username does not match any user.
+	UserID    string
+	Username  string
+	IPAddress string
+	UserAgent string
+	Reason    string
+}
+
+type RegisterLoginFailureOptions struct {
+	UserID string
+	// MaxFailures is the number of consecutive failures that locks the account.
+	MaxFailures int
+	// LockedUntil is applied when this failure reaches MaxFailures.
+	LockedUntil time.Time
+}
+
+type CreatePersonalAccessTokenOptions struct {
+	UserID      string
+	Name        string
+	TokenHash   string
+	TokenPrefix string
+	Scope       string
+	ExpiresAt   *time.Time
+}
+
+type RevokePersonalAccessTokenOptions struct {
+	ID     string
+	UserID string
+}
+
+type CreateOIDCLoginStateOptions struct {
+	StateHash    string
+	CodeVerifier string
+	Nonce        string
+	ExpiresAt    time.Time
+}
+
+type DetailUserIdentityOptions struct {
+	Issuer  string
+	Subject string
+}
+
+type CreateUserIdentityOptions struct {
+	UserID  string
+	Issuer  string
+	Subject string
+	Email   string
+}
+
+type TouchUserIdentityOptions struct {
+	ID    string
+	Email string
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+const increaseAttemptsQuery = `
+	UPDATE email_verifications
+	SET attempts = attempts + 1, updated_at = $2
+	WHERE id = $1
+`
+
+func (r implRepository) CreateEmailVerification(ctx context.Context, sc models.Scope, opts repository.CreateEmailVerificationOptions) (models.EmailVerification, error) {
+	m := r.buildEmailVerificationModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreateEmailVerification.Insert: %v", err)
+		return models.EmailVerification{}, err
+	}
+
+	return models.NewEmailVerification(m), nil
+}
+
+func (r implRepository) GetPendingEmailVerification(ctx context.Context, sc models.Scope, userID string) (models.EmailVerification, error) {
+	qr, err := r.buildPendingEmailVerificationQuery(ctx, userID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.GetPendingEmailVerification.buildPendingEmailVerificationQuery: %v", err)
+		return models.EmailVerification{}, err
+	}
+
+	v, err := dbmodels.EmailVerifications(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.GetPendingEmailVerification.One.NoRows: %v", err)
+			return models.EmailVerification{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.GetPendingEmailVerification.One: %v", err)
+		return models.EmailVerification{}, err
+	}
+
+	return models.NewEmailVerification(*v), nil
+}
+
+func (r implRepository) IncreaseEmailVerificationAttempts(ctx context.Context, sc models.Scope, ID string) error {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.IncreaseEmailVerificationAttempts.InvalidID: %v", err)
+		return err
+	}
+
+	if _, err := r.database.ExecContext(ctx, increaseAttemptsQuery, ID, r.clock()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.IncreaseEmailVerificationAttempts.ExecContext: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) ConfirmEmailVerification(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildEmailVerificationDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ConfirmEmailVerification.buildEmailVerificationDetailQuery: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	qr = append(qr, dbmodels.EmailVerificationWhere.VerifiedAt.IsNull())
+	n, err := dbmodels.EmailVerifications(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.EmailVerificationColumns.VerifiedAt: null.TimeFrom(now),
+		dbmodels.EmailVerificationColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ConfirmEmailVerification.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// loginAttemptRetention is how long failed login attempts are kept for auditing.
+const loginAttemptRetention = 90 * 24 * time.Hour
+
+// registerLoginFailureQuery increments the failure count in a single statement
+// so concurrent failures cannot be lost.
+const registerLoginFailureQuery = `
+	INSERT INTO login_lockouts AS l (user_id, failed_attempts, locked_until, created_at, updated_at)
+	VALUES ($1, 1, CASE WHEN $2::int <= 1 THEN $3::timestamptz END, $4, $4)
+	ON CONFLICT (user_id) DO UPDATE SET
+		failed_attempts = CASE WHEN l.locked_until IS NOT NULL THEN 1 ELSE l.failed_attempts + 1 END,
+		locked_until = CASE
+			WHEN (CASE WHEN l.locked_until IS NOT NULL THEN 1 ELSE l.failed_attempts + 1 END) >= $2::int THEN $3::timestamptz
+		END,
+		updated_at = $4
+	RETURNING user_id, failed_attempts, locked_until, created_at, updated_at
+`
+
+func (r implRepository) CreateLoginAttempt(ctx context.Context, sc models.Scope, opts repository.CreateLoginAttemptOptions) error {
+	m := r.buildLoginAttemptModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreateLoginAttempt.Insert: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) DetailLoginLockout(ctx context.Context, sc models.Scope, userID string) (models.LoginLockout, error) {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailLoginLockout.InvalidID: %v", err)
+		return models.LoginLockout{}, err
+	}
+
+	l, err := dbmodels.FindLoginLockout(ctx, r.database, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.LoginLockout{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailLoginLockout.FindLoginLockout: %v", err)
+		return models.LoginLockout{}, err
+	}
+
+	return models.NewLoginLockout(*l), nil
+}
+
+func (r implRepository) RegisterLoginFailure(ctx context.Context, sc models.Scope, opts repository.RegisterLoginFailureOptions) (models.LoginLockout, error) {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RegisterLoginFailure.InvalidID: %v", err)
+		return models.LoginLockout{}, err
+	}
+
+	var l dbmodels.LoginLockout
+	var lockedUntil null.Time
+	row := r.database.QueryRowContext(ctx, registerLoginFailureQuery, opts.UserID, opts.MaxFailures, opts.LockedUntil, r.clock())
+	if err := row.Scan(&l.UserID, &l.FailedAttempts, &lockedUntil, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RegisterLoginFailure.Scan: %v", err)
+		return models.LoginLockout{}, err
+	}
+	l.LockedUntil = lockedUntil
+
+	return models.NewLoginLockout(l), nil
+}
+
+func (r implRepository) ResetLoginFailures(ctx context.Context, sc models.Scope, userID string) error {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ResetLoginFailures.InvalidID: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.LoginLockouts(dbmodels.LoginLockoutWhere.UserID.EQ(userID)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ResetLoginFailures.DeleteAll: %v", err)
+		return err
+	}
+
+	return nil
+}
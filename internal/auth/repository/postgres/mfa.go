@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) UpsertUserMFA(ctx context.Context, sc models.Scope, opts repository.UpsertUserMFAOptions) (models.UserMFA, error) {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UpsertUserMFA.InvalidID: %v", err)
+		return models.UserMFA{}, err
+	}
+
+	m := r.buildUserMFAModel(opts)
+	err := m.Upsert(ctx, r.database, true, []string{dbmodels.UserMfaColumns.UserID},
+		boil.Whitelist(
+			dbmodels.UserMfaColumns.SecretEncrypted,
+			dbmodels.UserMfaColumns.EnabledAt,
+			dbmodels.UserMfaColumns.LastUsedStep,
+			dbmodels.UserMfaColumns.UpdatedAt,
+		), boil.Infer())
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UpsertUserMFA.Upsert: %v", err)
+		return models.UserMFA{}, err
+	}
+
+	return models.NewUserMFA(m), nil
+}
+
+func (r implRepository) DetailUserMFA(ctx context.Context, sc models.Scope, userID string) (models.UserMFA, error) {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailUserMFA.InvalidID: %v", err)
+		return models.UserMFA{}, err
+	}
+
+	m, err := dbmodels.FindUserMfa(ctx, r.database, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.UserMFA{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailUserMFA.FindUserMfa: %v", err)
+		return models.UserMFA{}, err
+	}
+
+	return models.NewUserMFA(*m), nil
+}
+
+func (r implRepository) EnableUserMFA(ctx context.Context, sc models.Scope, opts repository.UseMFAStepOptions) error {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.EnableUserMFA.InvalidID: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	n, err := dbmodels.UserMfas(
+		dbmodels.UserMfaWhere.UserID.EQ(opts.UserID),
+		dbmodels.UserMfaWhere.EnabledAt.IsNull(),
+	).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.UserMfaColumns.EnabledAt:    null.TimeFrom(now),
+		dbmodels.UserMfaColumns.LastUsedStep: opts.Step,
+		dbmodels.UserMfaColumns.UpdatedAt:    now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.EnableUserMFA.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) UseMFAStep(ctx context.Context, sc models.Scope, opts repository.UseMFAStepOptions) error {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UseMFAStep.InvalidID: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.UserMfas(
+		dbmodels.UserMfaWhere.UserID.EQ(opts.UserID),
+		dbmodels.UserMfaWhere.LastUsedStep.LT(opts.Step),
+	).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.UserMfaColumns.LastUsedStep: opts.Step,
+		dbmodels.UserMfaColumns.UpdatedAt:    r.clock(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UseMFAStep.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) DeleteUserMFA(ctx context.Context, sc models.Scope, userID string) error {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteUserMFA.InvalidID: %v", err)
+		return err
+	}
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteUserMFA.BeginTx: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := dbmodels.MfaRecoveryCodes(dbmodels.MfaRecoveryCodeWhere.UserID.EQ(userID)).DeleteAll(ctx, tx); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteUserMFA.MfaRecoveryCodes.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.UserMfas(dbmodels.UserMfaWhere.UserID.EQ(userID)).DeleteAll(ctx, tx); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteUserMFA.UserMfas.DeleteAll: %v", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteUserMFA.Commit: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) ReplaceRecoveryCodes(ctx context.Context, sc models.Scope, opts repository.ReplaceRecoveryCodesOptions) error {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ReplaceRecoveryCodes.InvalidID: %v", err)
+		return err
+	}
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ReplaceRecoveryCodes.BeginTx: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := dbmodels.MfaRecoveryCodes(dbmodels.MfaRecoveryCodeWhere.UserID.EQ(opts.UserID)).DeleteAll(ctx, tx); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ReplaceRecoveryCodes.DeleteAll: %v", err)
+		return err
+	}
+
+	for _, m := range r.buildRecoveryCodeModels(opts) {
+		if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "internal.auth.repository.postgres.ReplaceRecoveryCodes.Insert: %v", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ReplaceRecoveryCodes.Commit: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) UseRecoveryCode(ctx context.Context, sc models.Scope, opts repository.UseRecoveryCodeOptions) error {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UseRecoveryCode.InvalidID: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.MfaRecoveryCodes(
+		dbmodels.MfaRecoveryCodeWhere.UserID.EQ(opts.UserID),
+		dbmodels.MfaRecoveryCodeWhere.CodeHash.EQ(opts.CodeHash),
+		dbmodels.MfaRecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.MfaRecoveryCodeColumns.UsedAt: null.TimeFrom(r.clock()),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UseRecoveryCode.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
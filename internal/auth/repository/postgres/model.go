@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildRefreshTokenModel(opts repository.CreateRefreshTokenOptions) dbmodels.RefreshToken {
+	return dbmodels.RefreshToken{
+		ID:        opts.ID,
+		UserID:    opts.UserID,
+		FamilyID:  opts.FamilyID,
+		TokenHash: opts.TokenHash,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: r.clock(),
+		UpdatedAt: r.clock(),
+	}
+}
+
+func (r implRepository) buildRevokedTokenModel(opts repository.RevokeTokenOptions) dbmodels.RevokedToken {
+	m := dbmodels.RevokedToken{
+		Jti:       opts.JTI,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: r.clock(),
+	}
+	if opts.UserID != "" {
+		m.UserID = null.StringFrom(opts.UserID)
+	}
+
+	return m
+}
+
+func (r implRepository) buildUserTokenRevocationModel(opts repository.RevokeUserTokensOptions) dbmodels.UserTokenRevocation {
+	return dbmodels.UserTokenRevocation{
+		UserID:        opts.UserID,
+		RevokedBefore: opts.RevokedBefore,
+		ExpiresAt:     opts.ExpiresAt,
+		CreatedAt:     r.clock(),
+		UpdatedAt:     r.clock(),
+	}
+}
+
+func (r implRepository) buildSessionModel(opts repository.CreateSessionOptions) dbmodels.Session {
+	now := r.clock()
+	return dbmodels.Session{
+		ID:         opts.ID,
+		UserID:     opts.UserID,
+		UserAgent:  null.NewString(opts.UserAgent, opts.UserAgent != ""),
+		IPAddress:  null.NewString(opts.IPAddress, opts.IPAddress != ""),
+		ExpiresAt:  opts.ExpiresAt,
+		LastUsedAt: now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func (r implRepository) buildEmailVerificationModel(opts repository.CreateEmailVerificationOptions) dbmodels.EmailVerification {
+	now := r.clock()
+	return dbmodels.EmailVerification{
+		ID:        postgres.NewUUID(),
+		UserID:    opts.UserID,
+		OtpHash:   opts.OTPHash,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r implRepository) buildPasswordResetModel(opts repository.CreatePasswordResetOptions) dbmodels.PasswordReset {
+	now := r.clock()
+	return dbmodels.PasswordReset{
+		ID:        postgres.NewUUID(),
+		UserID:    opts.UserID,
+		TokenHash: opts.TokenHash,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r implRepository) buildUserMFAModel(opts repository.UpsertUserMFAOptions) dbmodels.UserMfa {
+	now := r.clock()
+	return dbmodels.UserMfa{
+		UserID:          opts.UserID,
+		SecretEncrypted: opts.SecretEncrypted,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+func (r implRepository) buildRecoveryCodeModels(opts repository.ReplaceRecoveryCodesOptions) []dbmodels.MfaRecoveryCode {
+	now := r.clock()
+	ms := make([]dbmodels.MfaRecoveryCode, len(opts.CodeHashes))
+	for i, h := range opts.CodeHashes {
+		ms[i] = dbmodels.MfaRecoveryCode{
+			ID:        postgres.NewUUID(),
+			UserID:    opts.UserID,
+			CodeHash:  h,
+			CreatedAt: now,
+		}
+	}
+	return ms
+}
+
+func (r implRepository) buildLoginAttemptModel(opts repository.CreateLoginAttemptOptions) dbmodels.LoginAttempt {
+	m := dbmodels.LoginAttempt{
+		ID:        postgres.NewUUID(),
+		Username:  opts.Username,
+		IPAddress: null.NewString(opts.IPAddress, opts.IPAddress != ""),
+		UserAgent: null.NewString(opts.UserAgent, opts.UserAgent != ""),
+		Reason:    opts.Reason,
+		CreatedAt: r.clock(),
+	}
+	if opts.UserID != "" {
+		m.UserID = null.StringFrom(opts.UserID)
+	}
+
+	return m
+}
+
+func (r implRepository) buildOIDCLoginStateModel(opts repository.CreateOIDCLoginStateOptions) dbmodels.OidcLoginState {
+	return dbmodels.OidcLoginState{
+		ID:           postgres.NewUUID(),
+		StateHash:    opts.StateHash,
+		CodeVerifier: opts.CodeVerifier,
+		Nonce:        opts.Nonce,
+		ExpiresAt:    opts.ExpiresAt,
+		CreatedAt:    r.clock(),
+	}
+}
+
+func (r implRepository) buildUserIdentityModel(opts repository.CreateUserIdentityOptions) dbmodels.UserIdentity {
+	now := r.clock()
+	return dbmodels.UserIdentity{
+		ID:          postgres.NewUUID(),
+		UserID:      opts.UserID,
+		Issuer:      opts.Issuer,
+		Subject:     opts.Subject,
+		Email:       null.NewString(opts.Email, opts.Email != ""),
+		LastLoginAt: null.TimeFrom(now),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (r implRepository) buildPersonalAccessTokenModel(opts repository.CreatePersonalAccessTokenOptions) dbmodels.PersonalAccessToken {
+	now := r.clock()
+	return dbmodels.PersonalAccessToken{
+		ID:          postgres.NewUUID(),
+		UserID:      opts.UserID,
+		Name:        opts.Name,
+		TokenHash:   opts.TokenHash,
+		TokenPrefix: opts.TokenPrefix,
+		Scope:       opts.Scope,
+		ExpiresAt:   null.TimeFromPtr(opts.ExpiresAt),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
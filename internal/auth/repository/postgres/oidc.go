@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) CreateOIDCLoginState(ctx context.Context, sc models.Scope, opts repository.CreateOIDCLoginStateOptions) (models.OIDCLoginState, error) {
+	m := r.buildOIDCLoginStateModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreateOIDCLoginState.Insert: %v", err)
+		return models.OIDCLoginState{}, err
+	}
+
+	return models.NewOIDCLoginState(m), nil
+}
+
+func (r implRepository) ConsumeOIDCLoginState(ctx context.Context, sc models.Scope, stateHash string) (models.OIDCLoginState, error) {
+	s, err := dbmodels.OidcLoginStates(dbmodels.OidcLoginStateWhere.StateHash.EQ(stateHash)).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.ConsumeOIDCLoginState.One.NoRows: %v", err)
+			return models.OIDCLoginState{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ConsumeOIDCLoginState.One: %v", err)
+		return models.OIDCLoginState{}, err
+	}
+
+	// Only the caller that deletes the row may use it, the callback can be replayed.
+	n, err := dbmodels.OidcLoginStates(dbmodels.OidcLoginStateWhere.ID.EQ(s.ID)).DeleteAll(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ConsumeOIDCLoginState.DeleteAll: %v", err)
+		return models.OIDCLoginState{}, err
+	}
+	if n == 0 {
+		return models.OIDCLoginState{}, repository.ErrNotFound
+	}
+
+	return models.NewOIDCLoginState(*s), nil
+}
+
+func (r implRepository) DetailUserIdentity(ctx context.Context, sc models.Scope, opts repository.DetailUserIdentityOptions) (models.UserIdentity, error) {
+	i, err := dbmodels.UserIdentities(
+		dbmodels.UserIdentityWhere.Issuer.EQ(opts.Issuer),
+		dbmodels.UserIdentityWhere.Subject.EQ(opts.Subject),
+	).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.DetailUserIdentity.One.NoRows: %v", err)
+			return models.UserIdentity{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailUserIdentity.One: %v", err)
+		return models.UserIdentity{}, err
+	}
+
+	return models.NewUserIdentity(*i), nil
+}
+
+func (r implRepository) CreateUserIdentity(ctx context.Context, sc models.Scope, opts repository.CreateUserIdentityOptions) (models.UserIdentity, error) {
+	m := r.buildUserIdentityModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreateUserIdentity.Insert: %v", err)
+		return models.UserIdentity{}, err
+	}
+
+	return models.NewUserIdentity(m), nil
+}
+
+func (r implRepository) TouchUserIdentity(ctx context.Context, sc models.Scope, opts repository.TouchUserIdentityOptions) error {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.TouchUserIdentity.InvalidID: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	if _, err := dbmodels.UserIdentities(dbmodels.UserIdentityWhere.ID.EQ(opts.ID)).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.UserIdentityColumns.Email:       null.NewString(opts.Email, opts.Email != ""),
+		dbmodels.UserIdentityColumns.LastLoginAt: null.TimeFrom(now),
+		dbmodels.UserIdentityColumns.UpdatedAt:   now,
+	}); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.TouchUserIdentity.UpdateAll: %v", err)
+		return err
+	}
+
+	return nil
+}
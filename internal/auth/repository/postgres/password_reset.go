@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (r implRepository) CreatePasswordReset(ctx context.Context, sc models.Scope, opts repository.CreatePasswordResetOptions) (models.PasswordReset, error) {
+	m := r.buildPasswordResetModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreatePasswordReset.Insert: %v", err)
+		return models.PasswordReset{}, err
+	}
+
+	return models.NewPasswordReset(m), nil
+}
+
+func (r implRepository) GetPasswordReset(ctx context.Context, sc models.Scope, tokenHash string) (models.PasswordReset, error) {
+	pr, err := dbmodels.PasswordResets(dbmodels.PasswordResetWhere.TokenHash.EQ(tokenHash)).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.GetPasswordReset.One.NoRows: %v", err)
+			return models.PasswordReset{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.GetPasswordReset.One: %v", err)
+		return models.PasswordReset{}, err
+	}
+
+	return models.NewPasswordReset(*pr), nil
+}
+
+func (r implRepository) UsePasswordReset(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildUsePasswordResetQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UsePasswordReset.buildUsePasswordResetQuery: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	n, err := dbmodels.PasswordResets(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.PasswordResetColumns.UsedAt:    null.TimeFrom(now),
+		dbmodels.PasswordResetColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UsePasswordReset.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
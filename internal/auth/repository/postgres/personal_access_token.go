@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// touchInterval limits last_used_at writes to one per token and minute, a
+// token used by a busy CI job would otherwise write on every request.
+const touchInterval = time.Minute
+
+const touchPersonalAccessTokenQuery = `
+	UPDATE personal_access_tokens
+	SET last_used_at = $2
+	WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < $3)
+`
+
+func (r implRepository) CreatePersonalAccessToken(ctx context.Context, sc models.Scope, opts repository.CreatePersonalAccessTokenOptions) (models.PersonalAccessToken, error) {
+	m := r.buildPersonalAccessTokenModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreatePersonalAccessToken.Insert: %v", err)
+		return models.PersonalAccessToken{}, err
+	}
+
+	return models.NewPersonalAccessToken(m), nil
+}
+
+func (r implRepository) ListPersonalAccessTokens(ctx context.Context, sc models.Scope, userID string) ([]models.PersonalAccessToken, error) {
+	qr, err := r.buildListPersonalAccessTokensQuery(ctx, userID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ListPersonalAccessTokens.buildListPersonalAccessTokensQuery: %v", err)
+		return nil, err
+	}
+
+	ts, err := dbmodels.PersonalAccessTokens(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ListPersonalAccessTokens.All: %v", err)
+		return nil, err
+	}
+
+	tokens := make([]models.PersonalAccessToken, len(ts))
+	for i, t := range ts {
+		tokens[i] = models.NewPersonalAccessToken(*t)
+	}
+
+	return tokens, nil
+}
+
+func (r implRepository) GetPersonalAccessToken(ctx context.Context, sc models.Scope, tokenHash string) (models.PersonalAccessToken, error) {
+	t, err := dbmodels.PersonalAccessTokens(dbmodels.PersonalAccessTokenWhere.TokenHash.EQ(tokenHash)).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.GetPersonalAccessToken.One.NoRows: %v", err)
+			return models.PersonalAccessToken{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.GetPersonalAccessToken.One: %v", err)
+		return models.PersonalAccessToken{}, err
+	}
+
+	return models.NewPersonalAccessToken(*t), nil
+}
+
+func (r implRepository) RevokePersonalAccessToken(ctx context.Context, sc models.Scope, opts repository.RevokePersonalAccessTokenOptions) error {
+	qr, err := r.buildRevokePersonalAccessTokenQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokePersonalAccessToken.buildRevokePersonalAccessTokenQuery: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	n, err := dbmodels.PersonalAccessTokens(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.PersonalAccessTokenColumns.RevokedAt: null.TimeFrom(now),
+		dbmodels.PersonalAccessTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokePersonalAccessToken.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) RevokeUserPersonalAccessTokens(ctx context.Context, sc models.Scope, userID string) error {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeUserPersonalAccessTokens.InvalidUserID: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	if _, err := dbmodels.PersonalAccessTokens(
+		dbmodels.PersonalAccessTokenWhere.UserID.EQ(userID),
+		dbmodels.PersonalAccessTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.PersonalAccessTokenColumns.RevokedAt: null.TimeFrom(now),
+		dbmodels.PersonalAccessTokenColumns.UpdatedAt: now,
+	}); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeUserPersonalAccessTokens.UpdateAll: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) TouchPersonalAccessToken(ctx context.Context, sc models.Scope, ID string) error {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.TouchPersonalAccessToken.InvalidID: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	if _, err := r.database.ExecContext(ctx, touchPersonalAccessTokenQuery, ID, now, now.Add(-touchInterval)); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.TouchPersonalAccessToken.ExecContext: %v", err)
+		return err
+	}
+
+	return nil
+}
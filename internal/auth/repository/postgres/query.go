@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildRefreshTokenDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildRefreshTokenDetailQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{dbmodels.RefreshTokenWhere.ID.EQ(ID)}, nil
+}
+
+func (r implRepository) buildUseRefreshTokenQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildUseRefreshTokenQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.RefreshTokenWhere.ID.EQ(ID),
+		dbmodels.RefreshTokenWhere.UsedAt.IsNull(),
+		dbmodels.RefreshTokenWhere.RevokedAt.IsNull(),
+	}, nil
+}
+
+func (r implRepository) buildSessionDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildSessionDetailQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{dbmodels.SessionWhere.ID.EQ(ID)}, nil
+}
+
+func (r implRepository) buildListSessionsQuery(ctx context.Context, opts repository.ListSessionsOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildListSessionsQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	qr := []qm.QueryMod{
+		dbmodels.SessionWhere.UserID.EQ(opts.UserID),
+		qm.OrderBy(dbmodels.SessionColumns.LastUsedAt + " DESC"),
+	}
+	if opts.ActiveOnly {
+		qr = append(qr,
+			dbmodels.SessionWhere.RevokedAt.IsNull(),
+			dbmodels.SessionWhere.ExpiresAt.GT(r.clock()),
+		)
+	}
+
+	return qr, nil
+}
+
+// buildRevokeSessionsQuery returns the filter for sessions and the matching
+// filter for their refresh tokens.
+func (r implRepository) buildRevokeSessionsQuery(ctx context.Context, opts repository.RevokeSessionsOptions) ([]qm.QueryMod, []qm.QueryMod, error) {
+	if opts.UserID == "" && len(opts.IDs) == 0 {
+		return nil, nil, repository.ErrNoFilter
+	}
+
+	sqr := []qm.QueryMod{dbmodels.SessionWhere.RevokedAt.IsNull()}
+	tqr := []qm.QueryMod{dbmodels.RefreshTokenWhere.RevokedAt.IsNull()}
+
+	if opts.UserID != "" {
+		if err := postgres.IsUUID(opts.UserID); err != nil {
+			r.l.Errorf(ctx, "internal.auth.repository.postgres.buildRevokeSessionsQuery.InvalidID: %v", err)
+			return nil, nil, err
+		}
+		sqr = append(sqr, dbmodels.SessionWhere.UserID.EQ(opts.UserID))
+		tqr = append(tqr, dbmodels.RefreshTokenWhere.UserID.EQ(opts.UserID))
+	}
+
+	if len(opts.IDs) > 0 {
+		for _, id := range opts.IDs {
+			if err := postgres.IsUUID(id); err != nil {
+				r.l.Errorf(ctx, "internal.auth.repository.postgres.buildRevokeSessionsQuery.InvalidID: %v", err)
+				return nil, nil, err
+			}
+		}
+		sqr = append(sqr, dbmodels.SessionWhere.ID.IN(opts.IDs))
+		tqr = append(tqr, dbmodels.RefreshTokenWhere.FamilyID.IN(opts.IDs))
+	}
+
+	return sqr, tqr, nil
+}
+
+func (r implRepository) buildEmailVerificationDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildEmailVerificationDetailQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{dbmodels.EmailVerificationWhere.ID.EQ(ID)}, nil
+}
+
+func (r implRepository) buildPendingEmailVerificationQuery(ctx context.Context, userID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildPendingEmailVerificationQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.EmailVerificationWhere.UserID.EQ(userID),
+		dbmodels.EmailVerificationWhere.VerifiedAt.IsNull(),
+		qm.OrderBy(dbmodels.EmailVerificationColumns.CreatedAt + " DESC"),
+	}, nil
+}
+
+func (r implRepository) buildUsePasswordResetQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildUsePasswordResetQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.PasswordResetWhere.ID.EQ(ID),
+		dbmodels.PasswordResetWhere.UsedAt.IsNull(),
+	}, nil
+}
+
+func (r implRepository) buildListPersonalAccessTokensQuery(ctx context.Context, userID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(userID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildListPersonalAccessTokensQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.PersonalAccessTokenWhere.UserID.EQ(userID),
+		dbmodels.PersonalAccessTokenWhere.RevokedAt.IsNull(),
+		qm.OrderBy(dbmodels.PersonalAccessTokenColumns.CreatedAt + " DESC"),
+	}, nil
+}
+
+func (r implRepository) buildRevokePersonalAccessTokenQuery(ctx context.Context, opts repository.RevokePersonalAccessTokenOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildRevokePersonalAccessTokenQuery.InvalidID: %v", err)
+		return nil, err
+	}
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.buildRevokePersonalAccessTokenQuery.InvalidUserID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.PersonalAccessTokenWhere.ID.EQ(opts.ID),
+		dbmodels.PersonalAccessTokenWhere.UserID.EQ(opts.UserID),
+		dbmodels.PersonalAccessTokenWhere.RevokedAt.IsNull(),
+	}, nil
+}
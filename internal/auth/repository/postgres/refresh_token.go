@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (r implRepository) CreateRefreshToken(ctx context.Context, sc models.Scope, opts repository.CreateRefreshTokenOptions) (models.RefreshToken, error) {
+	m := r.buildRefreshTokenModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreateRefreshToken.Insert: %v", err)
+		return models.RefreshToken{}, err
+	}
+
+	return models.NewRefreshToken(m), nil
+}
+
+func (r implRepository) DetailRefreshToken(ctx context.Context, sc models.Scope, ID string) (models.RefreshToken, error) {
+	qr, err := r.buildRefreshTokenDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailRefreshToken.buildRefreshTokenDetailQuery: %v", err)
+		return models.RefreshToken{}, err
+	}
+
+	t, err := dbmodels.RefreshTokens(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.DetailRefreshToken.One.NoRows: %v", err)
+			return models.RefreshToken{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailRefreshToken.One: %v", err)
+		return models.RefreshToken{}, err
+	}
+
+	return models.NewRefreshToken(*t), nil
+}
+
+func (r implRepository) UseRefreshToken(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildUseRefreshTokenQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UseRefreshToken.buildUseRefreshTokenQuery: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	n, err := dbmodels.RefreshTokens(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.RefreshTokenColumns.UsedAt:    null.TimeFrom(now),
+		dbmodels.RefreshTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.UseRefreshToken.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+const isTokenRevokedQuery = `
+	SELECT
+		EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)
+		OR EXISTS (SELECT 1 FROM user_token_revocations WHERE user_id = $2::uuid AND revoked_before >= $3)
+		OR EXISTS (SELECT 1 FROM sessions WHERE id = $4::uuid AND revoked_at IS NOT NULL)
+`
+
+func (r implRepository) RevokeToken(ctx context.Context, sc models.Scope, opts repository.RevokeTokenOptions) error {
+	m := r.buildRevokedTokenModel(opts)
+
+	err := m.Upsert(ctx, r.database, false, []string{dbmodels.RevokedTokenColumns.Jti}, boil.None(), boil.Infer())
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeToken.Upsert: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) RevokeUserTokens(ctx context.Context, sc models.Scope, opts repository.RevokeUserTokensOptions) error {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeUserTokens.InvalidID: %v", err)
+		return err
+	}
+
+	m := r.buildUserTokenRevocationModel(opts)
+	err := m.Upsert(ctx, r.database, true, []string{dbmodels.UserTokenRevocationColumns.UserID},
+		boil.Whitelist(
+			dbmodels.UserTokenRevocationColumns.RevokedBefore,
+			dbmodels.UserTokenRevocationColumns.ExpiresAt,
+			dbmodels.UserTokenRevocationColumns.UpdatedAt,
+		), boil.Infer())
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeUserTokens.Upsert: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) IsTokenRevoked(ctx context.Context, sc models.Scope, opts repository.IsTokenRevokedOptions) (bool, error) {
+	var revoked bool
+	err := r.database.QueryRowContext(ctx, isTokenRevokedQuery,
+		opts.JTI,
+		nullUUID(opts.UserID),
+		opts.IssuedAt,
+		nullUUID(opts.SessionID),
+	).Scan(&revoked)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.IsTokenRevoked.QueryRowContext: %v", err)
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (r implRepository) DeleteExpired(ctx context.Context, sc models.Scope) error {
+	now := r.clock()
+
+	if _, err := dbmodels.RevokedTokens(dbmodels.RevokedTokenWhere.ExpiresAt.LT(now)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.RevokedTokens.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.UserTokenRevocations(dbmodels.UserTokenRevocationWhere.ExpiresAt.LT(now)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.UserTokenRevocations.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.RefreshTokens(dbmodels.RefreshTokenWhere.ExpiresAt.LT(now)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.RefreshTokens.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.EmailVerifications(dbmodels.EmailVerificationWhere.ExpiresAt.LT(now)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.EmailVerifications.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.PasswordResets(dbmodels.PasswordResetWhere.ExpiresAt.LT(now)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.PasswordResets.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.OidcLoginStates(dbmodels.OidcLoginStateWhere.ExpiresAt.LT(now)).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.OidcLoginStates.DeleteAll: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.LoginAttempts(dbmodels.LoginAttemptWhere.CreatedAt.LT(now.Add(-loginAttemptRetention))).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DeleteExpired.LoginAttempts.DeleteAll: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// nullUUID maps an empty or malformed ID to NULL so the ::uuid cast never fails.
+func nullUUID(id string) sql.NullString {
+	if postgres.IsUUID(id) != nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id, Valid: true}
+}
@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+const lastLoginsQuery = `
+	SELECT user_id, MAX(created_at)
+	FROM sessions
+	WHERE user_id = ANY($1::uuid[])
+	GROUP BY user_id
+`
+
+func (r implRepository) CreateSession(ctx context.Context, sc models.Scope, opts repository.CreateSessionOptions) (models.Session, error) {
+	m := r.buildSessionModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.CreateSession.Insert: %v", err)
+		return models.Session{}, err
+	}
+
+	return models.NewSession(m), nil
+}
+
+func (r implRepository) DetailSession(ctx context.Context, sc models.Scope, ID string) (models.Session, error) {
+	qr, err := r.buildSessionDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailSession.buildSessionDetailQuery: %v", err)
+		return models.Session{}, err
+	}
+
+	s, err := dbmodels.Sessions(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.auth.repository.postgres.DetailSession.One.NoRows: %v", err)
+			return models.Session{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.DetailSession.One: %v", err)
+		return models.Session{}, err
+	}
+
+	return models.NewSession(*s), nil
+}
+
+func (r implRepository) ListSessions(ctx context.Context, sc models.Scope, opts repository.ListSessionsOptions) ([]models.Session, error) {
+	qr, err := r.buildListSessionsQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ListSessions.buildListSessionsQuery: %v", err)
+		return nil, err
+	}
+
+	ss, err := dbmodels.Sessions(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.ListSessions.All: %v", err)
+		return nil, err
+	}
+
+	dbSessions := util.DerefSlice(ss)
+	sessions := make([]models.Session, len(dbSessions))
+	for i, s := range dbSessions {
+		sessions[i] = models.NewSession(s)
+	}
+
+	return sessions, nil
+}
+
+func (r implRepository) TouchSession(ctx context.Context, sc models.Scope, opts repository.TouchSessionOptions) error {
+	qr, err := r.buildSessionDetailQuery(ctx, opts.ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.TouchSession.buildSessionDetailQuery: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	_, err = dbmodels.Sessions(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.SessionColumns.ExpiresAt:  opts.ExpiresAt,
+		dbmodels.SessionColumns.LastUsedAt: now,
+		dbmodels.SessionColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.TouchSession.UpdateAll: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) RevokeSessions(ctx context.Context, sc models.Scope, opts repository.RevokeSessionsOptions) error {
+	sqr, tqr, err := r.buildRevokeSessionsQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeSessions.buildRevokeSessionsQuery: %v", err)
+		return err
+	}
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeSessions.BeginTx: %v", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	now := r.clock()
+	_, err = dbmodels.Sessions(sqr...).UpdateAll(ctx, tx, dbmodels.M{
+		dbmodels.SessionColumns.RevokedAt: null.TimeFrom(now),
+		dbmodels.SessionColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeSessions.Sessions.UpdateAll: %v", err)
+		return err
+	}
+
+	_, err = dbmodels.RefreshTokens(tqr...).UpdateAll(ctx, tx, dbmodels.M{
+		dbmodels.RefreshTokenColumns.RevokedAt: null.TimeFrom(now),
+		dbmodels.RefreshTokenColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeSessions.RefreshTokens.UpdateAll: %v", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.RevokeSessions.Commit: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) GetLastLogins(ctx context.Context, sc models.Scope, userIDs []string) (map[string]time.Time, error) {
+	result := make(map[string]time.Time, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	for _, id := range userIDs {
+		if err := postgres.IsUUID(id); err != nil {
+			r.l.Errorf(ctx, "internal.auth.repository.postgres.GetLastLogins.InvalidID: %v", err)
+			return nil, err
+		}
+	}
+
+	rows, err := r.database.QueryContext(ctx, lastLoginsQuery, pq.Array(userIDs))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.GetLastLogins.QueryContext: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			userID string
+			at     time.Time
+		)
+		if err := rows.Scan(&userID, &at); err != nil {
+			r.l.Errorf(ctx, "internal.auth.repository.postgres.GetLastLogins.Scan: %v", err)
+			return nil, err
+		}
+		result[userID] = at
+	}
+	if err := rows.Err(); err != nil {
+		r.l.Errorf(ctx, "internal.auth.repository.postgres.GetLastLogins.Rows: %v", err)
+		return nil, err
+	}
+
+	return result, nil
+}
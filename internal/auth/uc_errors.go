@@ -8,4 +8,27 @@ var (
 	ErrInvalidToken       = errors.New("invalid token")
 	ErrTokenExpired       = errors.New("token expired")
 	ErrUnauthorized       = errors.New("unauthorized")
+	ErrTokenReused        = errors.New("refresh token reused")
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrUserExists         = errors.New("user already exists")
+	ErrInvalidOTP         = errors.New("invalid otp")
+	ErrOTPExpired         = errors.New("otp expired")
+	ErrTooManyAttempts    = errors.New("too many attempts")
+	ErrWrongPassword      = errors.New("current password is incorrect")
+	ErrSamePassword       = errors.New("new password must differ from the current one")
+	ErrInvalidResetToken  = errors.New("invalid reset token")
+	ErrMFANotSetUp        = errors.New("two-factor authentication is not set up")
+	ErrMFAAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrInvalidMFACode     = errors.New("invalid two-factor code")
+	ErrMFARequired        = errors.New("two-factor authentication is required for this role")
+	ErrTooManyLogins      = errors.New("too many login attempts")
+	ErrAccountLocked      = errors.New("account temporarily locked")
+	ErrTokenNotFound      = errors.New("personal access token not found")
+	ErrInvalidExpiry      = errors.New("expiry must be in the future")
+	ErrLoginRequired      = errors.New("a login session is required")
+	ErrOIDCDisabled       = errors.New("single sign-on is not configured")
+	ErrInvalidOIDCState   = errors.New("invalid or expired single sign-on state")
+	ErrOIDCFailed         = errors.New("single sign-on failed")
+	ErrOIDCEmailRequired  = errors.New("identity provider returned no verified email")
+	ErrTooManyCodes       = errors.New("too many verification codes requested")
 )
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 )
@@ -9,6 +10,30 @@ import (
 //go:generate mockery --name UseCase
 type UseCase interface {
 	Login(ctx context.Context, sc models.Scope, ip LoginInput) (LoginOutput, error)
+	Register(ctx context.Context, sc models.Scope, ip RegisterInput) (RegisterOutput, error)
+	Verify(ctx context.Context, sc models.Scope, ip VerifyInput) error
+	ResendVerification(ctx context.Context, sc models.Scope, ip ResendVerificationInput) error
+	ChangePassword(ctx context.Context, sc models.Scope, ip ChangePasswordInput) error
+	ForgotPassword(ctx context.Context, sc models.Scope, ip ForgotPasswordInput) error
+	ResetPassword(ctx context.Context, sc models.Scope, ip ResetPasswordInput) error
+	VerifyMFA(ctx context.Context, sc models.Scope, ip VerifyMFAInput) (LoginOutput, error)
+	StartOIDCLogin(ctx context.Context, sc models.Scope) (StartOIDCLoginOutput, error)
+	OIDCCallback(ctx context.Context, sc models.Scope, ip OIDCCallbackInput) (LoginOutput, error)
+	SetupMFAChallenge(ctx context.Context, sc models.Scope, ip MFAChallengeInput) (SetupMFAOutput, error)
+	SetupMFA(ctx context.Context, sc models.Scope) (SetupMFAOutput, error)
+	EnableMFA(ctx context.Context, sc models.Scope, ip EnableMFAInput) (EnableMFAOutput, error)
+	DisableMFA(ctx context.Context, sc models.Scope, ip MFACodeInput) error
+	RegenerateRecoveryCodes(ctx context.Context, sc models.Scope, ip MFACodeInput) (EnableMFAOutput, error)
 	RefreshToken(ctx context.Context, sc models.Scope, ip RefreshTokenInput) (RefreshTokenOutput, error)
-	Logout(ctx context.Context, sc models.Scope) error
+	Logout(ctx context.Context, sc models.Scope, ip LogoutInput) error
+	IsTokenRevoked(ctx context.Context, ip IsTokenRevokedInput) (bool, error)
+	RevokeUserSessions(ctx context.Context, sc models.Scope, userID string) error
+	ListSessions(ctx context.Context, sc models.Scope, ip ListSessionsInput) ([]models.Session, error)
+	RevokeSession(ctx context.Context, sc models.Scope, ID string) error
+	RevokeAllSessions(ctx context.Context, sc models.Scope) error
+	GetLastLogins(ctx context.Context, sc models.Scope, userIDs []string) (map[string]time.Time, error)
+	CreatePersonalAccessToken(ctx context.Context, sc models.Scope, ip CreatePersonalAccessTokenInput) (CreatePersonalAccessTokenOutput, error)
+	ListPersonalAccessTokens(ctx context.Context, sc models.Scope) ([]models.PersonalAccessToken, error)
+	RevokePersonalAccessToken(ctx context.Context, sc models.Scope, ID string) error
+	AuthenticatePersonalAccessToken(ctx context.Context, token string) (AuthenticatePersonalAccessTokenOutput, error)
 }
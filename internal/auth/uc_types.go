@@ -1,16 +1,54 @@
 package auth
 
-import "github.com/nguyentantai21042004/kanban-api/internal/models"
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// TokenConfig is the lifetime of the tokens issued on login and refresh.
+type TokenConfig struct {
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+// LoginProtectionConfig limits how fast passwords can be guessed. At most
+// MaxAttempts logins per username and MaxAttemptsPerIP logins per IP are
+// accepted in AttemptWindow, and MaxFailures consecutive failures lock the
+// account for LockoutDuration.
+type LoginProtectionConfig struct {
+	MaxAttempts      int
+	MaxAttemptsPerIP int
+	AttemptWindow    time.Duration
+	MaxFailures      int
+	LockoutDuration  time.Duration
+}
 
 type LoginInput struct {
-	Username string
-	Password string
+	Username  string
+	Password  string
+	UserAgent string
+	IPAddress string
 }
 
+// MFA statuses returned by Login instead of tokens when a second factor is needed.
+const (
+	MFAStatusPending       = "mfa_pending"
+	MFAStatusSetupRequired = "mfa_setup_required"
+)
+
 type LoginOutput struct {
 	AssToken string
+	RfrToken string
 	User     models.User
 	Role     models.Role
+	// MFAStatus and MFAToken are set instead of the tokens above when the
+	// login has to be completed with VerifyMFA.
+	MFAStatus    string
+	MFAToken     string
+	MFAExpiresAt time.Time
+	// RecoveryCodes is only set when the login also completed a required enrollment.
+	RecoveryCodes []string
 }
 
 type RefreshTokenInput struct {
@@ -21,3 +59,122 @@ type RefreshTokenOutput struct {
 	AssToken string
 	RfrToken string
 }
+
+type LogoutInput struct {
+	TokenID   string
+	SessionID string
+	ExpiresAt time.Time
+}
+
+type ListSessionsInput struct {
+	UserID string
+}
+
+type IsTokenRevokedInput struct {
+	TokenID   string
+	UserID    string
+	SessionID string
+	IssuedAt  time.Time
+}
+
+type RegisterInput struct {
+	Username string
+	Password string
+	FullName string
+}
+
+type RegisterOutput struct {
+	User models.User
+}
+
+type VerifyInput struct {
+	Username string
+	OTP      string
+}
+
+type ResendVerificationInput struct {
+	Username string
+}
+
+type ChangePasswordInput struct {
+	CurrentPassword string
+	NewPassword     string
+}
+
+type ForgotPasswordInput struct {
+	Username string
+}
+
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+type SetupMFAOutput struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type MFAChallengeInput struct {
+	MFAToken string
+}
+
+type EnableMFAInput struct {
+	Code string
+}
+
+type EnableMFAOutput struct {
+	RecoveryCodes []string
+}
+
+// MFACodeInput proves possession of the second factor with either a TOTP
+// code or one of the recovery codes.
+type MFACodeInput struct {
+	Code         string
+	RecoveryCode string
+}
+
+type VerifyMFAInput struct {
+	MFAToken     string
+	Code         string
+	RecoveryCode string
+	UserAgent    string
+	IPAddress    string
+}
+
+type StartOIDCLoginOutput struct {
+	// AuthURL is the page of the identity provider the user is sent to.
+	AuthURL   string
+	ExpiresAt time.Time
+}
+
+// OIDCCallbackInput carries the parameters the identity provider appended to
+// the redirect URL.
+type OIDCCallbackInput struct {
+	Code      string
+	State     string
+	UserAgent string
+	IPAddress string
+}
+
+// PersonalAccessTokenPrefix starts every personal access token, which tells
+// them apart from JWTs and makes leaked tokens easy to scan for.
+const PersonalAccessTokenPrefix = "kpat_"
+
+type CreatePersonalAccessTokenInput struct {
+	Name  string
+	Scope string
+	// ExpiresAt is optional, a nil expiry never expires.
+	ExpiresAt *time.Time
+}
+
+type CreatePersonalAccessTokenOutput struct {
+	Token models.PersonalAccessToken
+	// Secret is the token itself. It is not stored and cannot be shown again.
+	Secret string
+}
+
+type AuthenticatePersonalAccessTokenOutput struct {
+	TokenID string
+	Scope   models.Scope
+}
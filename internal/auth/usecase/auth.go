@@ -5,16 +5,25 @@ import (
 	"sync"
 
 	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
 	"github.com/nguyentantai21042004/kanban-api/pkg/encrypter"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
 )
 
 func (uc *implUseCase) Login(ctx context.Context, sc models.Scope, ip auth.LoginInput) (auth.LoginOutput, error) {
+	if !uc.allowLogin(ctx, ip.Username, ip.IPAddress) {
+		uc.l.Warnf(ctx, "internal.auth.usecase.Login.allowLogin: %v", "rate limited")
+		uc.recordLoginAttempt(ctx, sc, "", ip.Username, ip.IPAddress, ip.UserAgent, models.LoginFailureRateLimited)
+		return auth.LoginOutput{}, auth.ErrTooManyLogins
+	}
+
 	// Get user by email
 	u, err := uc.userUC.GetOne(ctx, sc, user.GetOneInput{Username: ip.Username})
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.auth.usecase.Login.uc.userUC.GetOne: %v", err)
+		uc.recordLoginAttempt(ctx, sc, "", ip.Username, ip.IPAddress, ip.UserAgent, models.LoginFailureUnknownUser)
 		return auth.LoginOutput{}, auth.ErrInvalidCredentials
 	}
 
@@ -24,25 +33,221 @@ func (uc *implUseCase) Login(ctx context.Context, sc models.Scope, ip auth.Login
 		return auth.LoginOutput{}, auth.ErrUnauthorized
 	}
 
+	// A locked account is refused before the password is even checked, so
+	// guessing cannot continue during the lockout.
+	if err := uc.checkLockout(ctx, sc, u.ID); err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.Login.checkLockout: %v", err)
+		if err == auth.ErrAccountLocked {
+			uc.recordLoginAttempt(ctx, sc, u.ID, u.Username, ip.IPAddress, ip.UserAgent, models.LoginFailureLocked)
+		}
+		return auth.LoginOutput{}, err
+	}
+
 	// Verify password
 	if !encrypter.CheckPasswordHash(ip.Password, u.PasswordHash) {
 		uc.l.Warnf(ctx, "internal.auth.usecase.Login.password_mismatch: %v", "password does not match")
-		return auth.LoginOutput{}, auth.ErrInvalidCredentials
+		return auth.LoginOutput{}, uc.failLogin(ctx, sc, u, ip.IPAddress, ip.UserAgent, models.LoginFailureInvalidPassword, auth.ErrInvalidCredentials)
+	}
+
+	o, err := uc.completeLogin(ctx, sc, u, ip.UserAgent, ip.IPAddress)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.Login.completeLogin: %v", err)
+		return auth.LoginOutput{}, err
+	}
+	if o.MFAStatus == "" {
+		uc.clearLoginFailures(ctx, sc, u)
+	}
+
+	return o, nil
+}
+
+func (uc *implUseCase) RefreshToken(ctx context.Context, sc models.Scope, ip auth.RefreshTokenInput) (auth.RefreshTokenOutput, error) {
+	p, err := uc.scopeUC.Verify(ip.RfrToken)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RefreshToken.scopeUC.Verify: %v", err)
+		return auth.RefreshTokenOutput{}, auth.ErrInvalidToken
+	}
+	if p.Type != scope.TokenTypeRefresh || !p.Refresh {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RefreshToken.wrong_type: %v", p.Type)
+		return auth.RefreshTokenOutput{}, auth.ErrInvalidToken
+	}
+
+	rt, err := uc.repo.DetailRefreshToken(ctx, sc, p.Id)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.RefreshToken.repo.DetailRefreshToken: %v", err)
+			return auth.RefreshTokenOutput{}, auth.ErrInvalidToken
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.RefreshToken.repo.DetailRefreshToken: %v", err)
+		return auth.RefreshTokenOutput{}, err
+	}
+	if rt.TokenHash != hashToken(ip.RfrToken) || rt.UserID != p.UserID {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RefreshToken.invalid_token: %v", rt.ID)
+		return auth.RefreshTokenOutput{}, auth.ErrInvalidToken
+	}
+
+	// A refresh token can be exchanged exactly once. Seeing it again means
+	// it leaked, so the whole family is revoked and the user has to log in again.
+	// This is checked before the expiry, a leaked token replayed after it
+	// expired still revokes the family.
+	if rt.UsedAt != nil || rt.RevokedAt != nil {
+		return auth.RefreshTokenOutput{}, uc.revokeReusedFamily(ctx, sc, rt)
+	}
+	if uc.clock().After(rt.ExpiresAt) {
+		return auth.RefreshTokenOutput{}, auth.ErrTokenExpired
+	}
+	if err := uc.repo.UseRefreshToken(ctx, sc, rt.ID); err != nil {
+		if err == repository.ErrNotFound {
+			return auth.RefreshTokenOutput{}, uc.revokeReusedFamily(ctx, sc, rt)
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.RefreshToken.repo.UseRefreshToken: %v", err)
+		return auth.RefreshTokenOutput{}, err
+	}
+
+	uo, err := uc.userUC.Detail(ctx, sc, rt.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RefreshToken.userUC.Detail: %v", err)
+		return auth.RefreshTokenOutput{}, auth.ErrUnauthorized
+	}
+	if !uo.User.IsActive {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RefreshToken.user_inactive: %v", "user is inactive")
+		return auth.RefreshTokenOutput{}, auth.ErrUnauthorized
+	}
+
+	tokens, err := uc.generateTokens(ctx, uo.User.ID, uo.User.Username, rt.FamilyID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RefreshToken.generateTokens: %v", err)
+		return auth.RefreshTokenOutput{}, err
+	}
+
+	if err := uc.repo.TouchSession(ctx, sc, repository.TouchSessionOptions{
+		ID:        rt.FamilyID,
+		ExpiresAt: uc.clock().Add(uc.tokenCfg.RefreshTokenTTL),
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RefreshToken.repo.TouchSession: %v", err)
+		return auth.RefreshTokenOutput{}, err
+	}
+
+	return auth.RefreshTokenOutput{
+		AssToken: tokens.assToken,
+		RfrToken: tokens.rfrToken,
+	}, nil
+}
+
+func (uc *implUseCase) revokeReusedFamily(ctx context.Context, sc models.Scope, rt models.RefreshToken) error {
+	uc.l.Warnf(ctx, "internal.auth.usecase.revokeReusedFamily: refresh token %s reused, revoking family %s", rt.ID, rt.FamilyID)
+	if err := uc.repo.RevokeSessions(ctx, sc, repository.RevokeSessionsOptions{IDs: []string{rt.FamilyID}}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.revokeReusedFamily.repo.RevokeSessions: %v", err)
+		return err
+	}
+	return auth.ErrTokenReused
+}
+
+func (uc *implUseCase) Logout(ctx context.Context, sc models.Scope, ip auth.LogoutInput) error {
+	if ip.TokenID == "" {
+		uc.l.Warnf(ctx, "internal.auth.usecase.Logout: %v", "token has no jti")
+		return auth.ErrInvalidToken
+	}
+
+	if err := uc.repo.RevokeToken(ctx, sc, repository.RevokeTokenOptions{
+		JTI:       ip.TokenID,
+		UserID:    sc.UserID,
+		ExpiresAt: ip.ExpiresAt,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.Logout.repo.RevokeToken: %v", err)
+		return err
 	}
 
+	// Revoking the session also kills its refresh token and every other
+	// access token issued for the same login.
+	if ip.SessionID != "" {
+		if err := uc.repo.RevokeSessions(ctx, sc, repository.RevokeSessionsOptions{IDs: []string{ip.SessionID}}); err != nil {
+			uc.l.Errorf(ctx, "internal.auth.usecase.Logout.repo.RevokeSessions: %v", err)
+			return err
+		}
+	}
+
+	uc.deleteExpired(ctx, sc)
+
+	return nil
+}
+
+func (uc *implUseCase) IsTokenRevoked(ctx context.Context, ip auth.IsTokenRevokedInput) (bool, error) {
+	revoked, err := uc.repo.IsTokenRevoked(ctx, models.Scope{UserID: ip.UserID}, repository.IsTokenRevokedOptions{
+		JTI:       ip.TokenID,
+		UserID:    ip.UserID,
+		SessionID: ip.SessionID,
+		IssuedAt:  ip.IssuedAt,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.IsTokenRevoked.repo.IsTokenRevoked: %v", err)
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+func (uc *implUseCase) RevokeUserSessions(ctx context.Context, sc models.Scope, userID string) error {
+	uo, err := uc.userUC.Detail(ctx, sc, userID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RevokeUserSessions.userUC.Detail: %v", err)
+		return auth.ErrUserNotFound
+	}
+
+	if err := uc.repo.RevokeSessions(ctx, sc, repository.RevokeSessionsOptions{UserID: uo.User.ID}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokeUserSessions.repo.RevokeSessions: %v", err)
+		return err
+	}
+
+	// Access tokens issued up to now stay rejected until the longest-lived
+	// of them would have expired on its own.
+	now := uc.clock()
+	if err := uc.repo.RevokeUserTokens(ctx, sc, repository.RevokeUserTokensOptions{
+		UserID:        uo.User.ID,
+		RevokedBefore: now,
+		ExpiresAt:     now.Add(uc.tokenCfg.AccessTokenTTL),
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokeUserSessions.repo.RevokeUserTokens: %v", err)
+		return err
+	}
+
+	// Personal access tokens reach the account as well, the owner creates
+	// new ones after signing in again.
+	if err := uc.repo.RevokeUserPersonalAccessTokens(ctx, sc, uo.User.ID); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokeUserSessions.repo.RevokeUserPersonalAccessTokens: %v", err)
+		return err
+	}
+
+	uc.deleteExpired(ctx, sc)
+
+	return nil
+}
+
+// deleteExpired is the TTL cleanup of the revocation list. Failures are only
+// logged because the expired rows are harmless.
+func (uc *implUseCase) deleteExpired(ctx context.Context, sc models.Scope) {
+	if err := uc.repo.DeleteExpired(ctx, sc); err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.deleteExpired.repo.DeleteExpired: %v", err)
+	}
+}
+
+// completeLogin finishes the login of an authenticated user: it either starts
+// a session or, when a second factor applies, returns an MFA challenge.
+func (uc *implUseCase) completeLogin(ctx context.Context, sc models.Scope, u models.User, userAgent, ipAddress string) (auth.LoginOutput, error) {
 	var (
-		rl       models.Role
-		assToken string
-		errChan  = make(chan error, 2)
-		wg       sync.WaitGroup
+		rl      models.Role
+		mfa     models.UserMFA
+		errChan = make(chan error, 2)
+		wg      sync.WaitGroup
 	)
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		assToken, err = uc.generateTokens(ctx, u.ID, u.Username)
-		if err != nil {
-			uc.l.Errorf(ctx, "internal.auth.usecase.Login.generateTokens: %v", err)
+		var err error
+		mfa, err = uc.repo.DetailUserMFA(ctx, sc, u.ID)
+		if err != nil && err != repository.ErrNotFound {
+			uc.l.Errorf(ctx, "internal.auth.usecase.completeLogin.repo.DetailUserMFA: %v", err)
 			errChan <- err
 		}
 	}()
@@ -53,7 +258,7 @@ func (uc *implUseCase) Login(ctx context.Context, sc models.Scope, ip auth.Login
 		var err error
 		rl, err = uc.roleUC.Detail(ctx, sc, u.RoleID)
 		if err != nil {
-			uc.l.Errorf(ctx, "internal.auth.usecase.Login.roleUC.Detail: %v", err)
+			uc.l.Errorf(ctx, "internal.auth.usecase.completeLogin.roleUC.Detail: %v", err)
 			errChan <- err
 		}
 	}()
@@ -64,21 +269,39 @@ func (uc *implUseCase) Login(ctx context.Context, sc models.Scope, ip auth.Login
 		return auth.LoginOutput{}, err
 	}
 
+	// The password alone is not enough: hand out a challenge that has to be
+	// completed with VerifyMFA (and an enrollment if the role enforces 2FA).
+	if mfa.Enabled() || rl.MFARequired {
+		status := auth.MFAStatusPending
+		if !mfa.Enabled() {
+			status = auth.MFAStatusSetupRequired
+		}
+
+		token, expiresAt, err := uc.createMFAChallenge(ctx, u)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.auth.usecase.completeLogin.createMFAChallenge: %v", err)
+			return auth.LoginOutput{}, err
+		}
+
+		return auth.LoginOutput{
+			User:         u,
+			Role:         rl,
+			MFAStatus:    status,
+			MFAToken:     token,
+			MFAExpiresAt: expiresAt,
+		}, nil
+	}
+
+	tokens, err := uc.startSession(ctx, u, userAgent, ipAddress)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.completeLogin.startSession: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
 	return auth.LoginOutput{
-		AssToken: assToken,
+		AssToken: tokens.assToken,
+		RfrToken: tokens.rfrToken,
 		User:     u,
 		Role:     rl,
 	}, nil
 }
-
-func (uc *implUseCase) RefreshToken(ctx context.Context, sc models.Scope, ip auth.RefreshTokenInput) (auth.RefreshTokenOutput, error) {
-	// TODO: Implement refresh token validation and generation
-	// For now, return error
-	return auth.RefreshTokenOutput{}, auth.ErrInvalidToken
-}
-
-func (uc *implUseCase) Logout(ctx context.Context, sc models.Scope) error {
-	// TODO: Implement logout (invalidate tokens)
-	// For now, just return success
-	return nil
-}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshToken(t *testing.T) {
+	// The signed tokens are checked against the wall clock, the rows against
+	// the clock of the usecase
+	now := time.Now().Truncate(time.Second)
+	expired := now.Add(25 * time.Hour)
+
+	tcs := map[string]struct {
+		// replay refreshes with the first token once before the checked call
+		replay  bool
+		later   time.Time
+		token   func(tp tokenPair) string
+		wantErr error
+		revoked bool
+	}{
+		"rotates the token": {
+			token: func(tp tokenPair) string { return tp.rfrToken },
+		},
+		"access token": {
+			token:   func(tp tokenPair) string { return tp.assToken },
+			wantErr: auth.ErrInvalidToken,
+		},
+		"malformed token": {
+			token:   func(tp tokenPair) string { return "not-a-token" },
+			wantErr: auth.ErrInvalidToken,
+		},
+		"reused token revokes the family": {
+			replay:  true,
+			token:   func(tp tokenPair) string { return tp.rfrToken },
+			wantErr: auth.ErrTokenReused,
+			revoked: true,
+		},
+		"expired token": {
+			later:   expired,
+			token:   func(tp tokenPair) string { return tp.rfrToken },
+			wantErr: auth.ErrTokenExpired,
+		},
+		"reused token revokes the family after it expired": {
+			replay:  true,
+			later:   expired,
+			token:   func(tp tokenPair) string { return tp.rfrToken },
+			wantErr: auth.ErrTokenReused,
+			revoked: true,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			uc, deps := initUseCase(t, now)
+
+			tp, err := uc.generateTokens(ctx, "user-1", "john@example.com", "family-1")
+			require.NoError(t, err)
+
+			if tc.replay {
+				_, err := uc.RefreshToken(ctx, models.Scope{}, auth.RefreshTokenInput{RfrToken: tp.rfrToken})
+				require.NoError(t, err)
+			}
+			if !tc.later.IsZero() {
+				uc.clock = func() time.Time { return tc.later }
+				deps.repo.now = uc.clock
+			}
+
+			o, err := uc.RefreshToken(ctx, models.Scope{}, auth.RefreshTokenInput{RfrToken: tc.token(tp)})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, tc.revoked, contains(deps.repo.revokedFamilies, "family-1"))
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, o.AssToken)
+			assert.NotEqual(t, tp.rfrToken, o.RfrToken)
+
+			// The old token is spent and the new one belongs to the same family
+			p, err := deps.scopeUC.Verify(tp.rfrToken)
+			require.NoError(t, err)
+			assert.NotNil(t, deps.repo.refreshTokens[p.Id].UsedAt)
+
+			p, err = deps.scopeUC.Verify(o.RfrToken)
+			require.NoError(t, err)
+			rt := deps.repo.refreshTokens[p.Id]
+			assert.Equal(t, "family-1", rt.FamilyID)
+			assert.Nil(t, rt.UsedAt)
+			assert.Empty(t, deps.repo.revokedFamilies)
+		})
+	}
+}
+
+func TestRefreshTokenAfterReuse(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := initUseCase(t, time.Now().Truncate(time.Second))
+
+	tp, err := uc.generateTokens(ctx, "user-1", "john@example.com", "family-1")
+	require.NoError(t, err)
+
+	o, err := uc.RefreshToken(ctx, models.Scope{}, auth.RefreshTokenInput{RfrToken: tp.rfrToken})
+	require.NoError(t, err)
+
+	// The leaked token is replayed, the token of the legitimate client is
+	// revoked along with the rest of the family
+	_, err = uc.RefreshToken(ctx, models.Scope{}, auth.RefreshTokenInput{RfrToken: tp.rfrToken})
+	assert.ErrorIs(t, err, auth.ErrTokenReused)
+
+	_, err = uc.RefreshToken(ctx, models.Scope{}, auth.RefreshTokenInput{RfrToken: o.RfrToken})
+	assert.ErrorIs(t, err, auth.ErrTokenReused)
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+	uc, deps := initUseCase(t, now)
+	sc := models.Scope{UserID: "user-1"}
+
+	err := uc.Logout(ctx, sc, auth.LogoutInput{
+		TokenID:   "jti-1",
+		SessionID: "family-1",
+		ExpiresAt: now.Add(15 * time.Minute),
+	})
+	require.NoError(t, err)
+
+	tcs := map[string]struct {
+		ip   auth.IsTokenRevokedInput
+		want bool
+	}{
+		"logged out token": {
+			ip:   auth.IsTokenRevokedInput{TokenID: "jti-1", UserID: "user-1", SessionID: "family-1"},
+			want: true,
+		},
+		"other token of the session": {
+			ip:   auth.IsTokenRevokedInput{TokenID: "jti-2", UserID: "user-1", SessionID: "family-1"},
+			want: true,
+		},
+		"token of another session": {
+			ip:   auth.IsTokenRevokedInput{TokenID: "jti-3", UserID: "user-1", SessionID: "family-2"},
+			want: false,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			revoked, err := uc.IsTokenRevoked(ctx, tc.ip)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, revoked)
+		})
+	}
+
+	assert.Equal(t, "user-1", deps.repo.revokedTokens["jti-1"].UserID)
+}
+
+func TestLogoutWithoutTokenID(t *testing.T) {
+	uc, deps := initUseCase(t, time.Now())
+
+	err := uc.Logout(context.Background(), models.Scope{UserID: "user-1"}, auth.LogoutInput{})
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+	assert.Empty(t, deps.repo.revokedTokens)
+}
+
+func TestIsTokenRevokedStoreError(t *testing.T) {
+	uc, deps := initUseCase(t, time.Now())
+	deps.repo.revokedErr = errors.New("connection refused")
+
+	_, err := uc.IsTokenRevoked(context.Background(), auth.IsTokenRevokedInput{TokenID: "jti-1"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
+)
+
+// allowLogin applies the sliding window limits of the username and of the
+// IP. A window per pair would let one IP spray passwords over many usernames
+// and many IPs guess the password of one username, so both are kept apart.
+// The limiter failing must not lock everybody out, so errors let the login through.
+func (uc *implUseCase) allowLogin(ctx context.Context, username, ipAddress string) bool {
+	if !uc.allowLoginWindow(ctx, loginIPRateLimitKey(ipAddress), uc.loginCfg.MaxAttemptsPerIP) {
+		return false
+	}
+
+	return uc.allowLoginWindow(ctx, loginUserRateLimitKey(username), uc.loginCfg.MaxAttempts)
+}
+
+func (uc *implUseCase) allowLoginWindow(ctx context.Context, key string, limit int) bool {
+	res, err := uc.limiter.Allow(ctx, key, ratelimit.Policy{
+		Limit:  limit,
+		Window: uc.loginCfg.AttemptWindow,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.allowLoginWindow.limiter.Allow: %v", err)
+		return true
+	}
+
+	return res.Allowed
+}
+
+// checkLockout returns ErrAccountLocked while the user is locked out.
+func (uc *implUseCase) checkLockout(ctx context.Context, sc models.Scope, userID string) error {
+	lo, err := uc.repo.DetailLoginLockout(ctx, sc, userID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return nil
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.checkLockout.repo.DetailLoginLockout: %v", err)
+		return err
+	}
+
+	if lo.IsLocked(uc.clock()) {
+		return auth.ErrAccountLocked
+	}
+
+	return nil
+}
+
+// failLogin counts a wrong password or 2FA code against the user. It returns
+// ErrAccountLocked when this failure locked the account and fallback otherwise.
+func (uc *implUseCase) failLogin(ctx context.Context, sc models.Scope, u models.User, ipAddress, userAgent, reason string, fallback error) error {
+	uc.recordLoginAttempt(ctx, sc, u.ID, u.Username, ipAddress, userAgent, reason)
+
+	lo, err := uc.repo.RegisterLoginFailure(ctx, sc, repository.RegisterLoginFailureOptions{
+		UserID:      u.ID,
+		MaxFailures: uc.loginCfg.MaxFailures,
+		LockedUntil: uc.clock().Add(uc.loginCfg.LockoutDuration),
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.failLogin.repo.RegisterLoginFailure: %v", err)
+		return fallback
+	}
+
+	if lo.IsLocked(uc.clock()) {
+		uc.l.Warnf(ctx, "internal.auth.usecase.failLogin.locked: %v", u.ID)
+		return auth.ErrAccountLocked
+	}
+
+	return fallback
+}
+
+// recordLoginAttempt writes the audit entry of a rejected login. The login
+// result does not depend on it, so errors are only logged.
+func (uc *implUseCase) recordLoginAttempt(ctx context.Context, sc models.Scope, userID, username, ipAddress, userAgent, reason string) {
+	if err := uc.repo.CreateLoginAttempt(ctx, sc, repository.CreateLoginAttemptOptions{
+		UserID:    userID,
+		Username:  username,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
+		Reason:    reason,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.recordLoginAttempt.repo.CreateLoginAttempt: %v", err)
+	}
+}
+
+// clearLoginFailures forgets the failures of the user once a login completed.
+// The window of the IP is kept, otherwise logging into an account of their
+// own would let an attacker keep guessing the passwords of others.
+func (uc *implUseCase) clearLoginFailures(ctx context.Context, sc models.Scope, u models.User) {
+	if err := uc.repo.ResetLoginFailures(ctx, sc, u.ID); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.clearLoginFailures.repo.ResetLoginFailures: %v", err)
+	}
+
+	if err := uc.limiter.Reset(ctx, loginUserRateLimitKey(u.Username)); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.clearLoginFailures.limiter.Reset: %v", err)
+	}
+}
+
+func loginUserRateLimitKey(username string) string {
+	return "login:user:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+func loginIPRateLimitKey(ipAddress string) string {
+	return "login:ip:" + ipAddress
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowLogin(t *testing.T) {
+	type attempt struct {
+		username  string
+		ipAddress string
+	}
+
+	tcs := map[string]struct {
+		before []attempt
+		next   attempt
+		want   bool
+	}{
+		"under both limits": {
+			before: []attempt{{"john@example.com", "10.0.0.1"}},
+			next:   attempt{"john@example.com", "10.0.0.1"},
+			want:   true,
+		},
+		"one username from many IPs": {
+			before: []attempt{
+				{"john@example.com", "10.0.0.1"},
+				{"john@example.com", "10.0.0.2"},
+				{"john@example.com", "10.0.0.3"},
+			},
+			next: attempt{"john@example.com", "10.0.0.4"},
+			want: false,
+		},
+		"many usernames from one IP": {
+			before: []attempt{
+				{"a@example.com", "10.0.0.1"},
+				{"b@example.com", "10.0.0.1"},
+				{"c@example.com", "10.0.0.1"},
+				{"d@example.com", "10.0.0.1"},
+				{"e@example.com", "10.0.0.1"},
+			},
+			next: attempt{"f@example.com", "10.0.0.1"},
+			want: false,
+		},
+		"username in another case": {
+			before: []attempt{
+				{"john@example.com", "10.0.0.1"},
+				{"John@Example.com", "10.0.0.2"},
+				{" JOHN@EXAMPLE.COM", "10.0.0.3"},
+			},
+			next: attempt{"john@example.com", "10.0.0.4"},
+			want: false,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			uc, _ := initUseCase(t, time.Now())
+			uc.loginCfg = auth.LoginProtectionConfig{
+				MaxAttempts:      3,
+				MaxAttemptsPerIP: 5,
+				AttemptWindow:    time.Minute,
+			}
+
+			for _, a := range tc.before {
+				assert.True(t, uc.allowLogin(ctx, a.username, a.ipAddress))
+			}
+
+			assert.Equal(t, tc.want, uc.allowLogin(ctx, tc.next.username, tc.next.ipAddress))
+		})
+	}
+}
+
+func TestClearLoginFailuresKeepsIPWindow(t *testing.T) {
+	ctx := context.Background()
+	uc, deps := initUseCase(t, time.Now())
+	uc.loginCfg = auth.LoginProtectionConfig{
+		MaxAttempts:      2,
+		MaxAttemptsPerIP: 3,
+		AttemptWindow:    time.Minute,
+	}
+
+	assert.True(t, uc.allowLogin(ctx, "john@example.com", "10.0.0.1"))
+	assert.True(t, uc.allowLogin(ctx, "john@example.com", "10.0.0.1"))
+	assert.False(t, uc.allowLogin(ctx, "john@example.com", "10.0.0.1"))
+
+	// A completed login opens the window of the username again, the window
+	// of the IP still counts the attempts
+	uc.clearLoginFailures(ctx, models.Scope{}, deps.users["user-1"])
+	assert.True(t, uc.allowLogin(ctx, "john@example.com", "10.0.0.2"))
+	assert.False(t, uc.allowLogin(ctx, "jane@example.com", "10.0.0.1"))
+}
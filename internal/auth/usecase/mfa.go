@@ -0,0 +1,438 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+	"github.com/nguyentantai21042004/kanban-api/pkg/totp"
+)
+
+const (
+	// mfaIssuer is the account issuer shown in authenticator apps.
+	mfaIssuer = "Kanban"
+	// mfaChallengeTTL is how long the user has to enter the code after the password.
+	mfaChallengeTTL = 5 * time.Minute
+	// recoveryCodeCount is the number of recovery codes issued at once.
+	recoveryCodeCount = 10
+	// recoveryCodeBytes is the entropy of a recovery code (10 base32 characters).
+	recoveryCodeBytes = 6
+)
+
+func (uc *implUseCase) VerifyMFA(ctx context.Context, sc models.Scope, ip auth.VerifyMFAInput) (auth.LoginOutput, error) {
+	u, p, err := uc.parseMFAChallenge(ctx, sc, ip.MFAToken)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.VerifyMFA.parseMFAChallenge: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
+	// Codes are guessed the same way as passwords and share their limits.
+	if !uc.allowLogin(ctx, u.Username, ip.IPAddress) {
+		uc.l.Warnf(ctx, "internal.auth.usecase.VerifyMFA.allowLogin: %v", "rate limited")
+		uc.recordLoginAttempt(ctx, sc, u.ID, u.Username, ip.IPAddress, ip.UserAgent, models.LoginFailureRateLimited)
+		return auth.LoginOutput{}, auth.ErrTooManyLogins
+	}
+	if err := uc.checkLockout(ctx, sc, u.ID); err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.VerifyMFA.checkLockout: %v", err)
+		if err == auth.ErrAccountLocked {
+			uc.recordLoginAttempt(ctx, sc, u.ID, u.Username, ip.IPAddress, ip.UserAgent, models.LoginFailureLocked)
+		}
+		return auth.LoginOutput{}, err
+	}
+
+	mfa, err := uc.repo.DetailUserMFA(ctx, sc, u.ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return auth.LoginOutput{}, auth.ErrMFANotSetUp
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.VerifyMFA.repo.DetailUserMFA: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
+	var recoveryCodes []string
+	if mfa.Enabled() {
+		if err := uc.verifySecondFactor(ctx, sc, mfa, auth.MFACodeInput{Code: ip.Code, RecoveryCode: ip.RecoveryCode}); err != nil {
+			uc.l.Warnf(ctx, "internal.auth.usecase.VerifyMFA.verifySecondFactor: %v", err)
+			if err == auth.ErrInvalidMFACode {
+				err = uc.failLogin(ctx, sc, u, ip.IPAddress, ip.UserAgent, models.LoginFailureInvalidMFACode, err)
+			}
+			return auth.LoginOutput{}, err
+		}
+	} else {
+		// The role enforces 2FA and the user just scanned the secret from
+		// SetupMFAChallenge: the first code also confirms the enrollment.
+		recoveryCodes, err = uc.confirmEnrollment(ctx, sc, mfa, ip.Code)
+		if err != nil {
+			uc.l.Warnf(ctx, "internal.auth.usecase.VerifyMFA.confirmEnrollment: %v", err)
+			if err == auth.ErrInvalidMFACode {
+				err = uc.failLogin(ctx, sc, u, ip.IPAddress, ip.UserAgent, models.LoginFailureInvalidMFACode, err)
+			}
+			return auth.LoginOutput{}, err
+		}
+	}
+
+	// The challenge is single-use.
+	if err := uc.repo.RevokeToken(ctx, sc, repository.RevokeTokenOptions{
+		JTI:       p.Id,
+		UserID:    u.ID,
+		ExpiresAt: time.Unix(p.ExpiresAt, 0),
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.VerifyMFA.repo.RevokeToken: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
+	rl, err := uc.roleUC.Detail(ctx, sc, u.RoleID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.VerifyMFA.roleUC.Detail: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
+	tokens, err := uc.startSession(ctx, u, ip.UserAgent, ip.IPAddress)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.VerifyMFA.startSession: %v", err)
+		return auth.LoginOutput{}, err
+	}
+	uc.clearLoginFailures(ctx, sc, u)
+
+	return auth.LoginOutput{
+		AssToken:      tokens.assToken,
+		RfrToken:      tokens.rfrToken,
+		User:          u,
+		Role:          rl,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (uc *implUseCase) SetupMFAChallenge(ctx context.Context, sc models.Scope, ip auth.MFAChallengeInput) (auth.SetupMFAOutput, error) {
+	u, _, err := uc.parseMFAChallenge(ctx, sc, ip.MFAToken)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.SetupMFAChallenge.parseMFAChallenge: %v", err)
+		return auth.SetupMFAOutput{}, err
+	}
+
+	return uc.setupMFA(ctx, sc, u)
+}
+
+func (uc *implUseCase) SetupMFA(ctx context.Context, sc models.Scope) (auth.SetupMFAOutput, error) {
+	uo, err := uc.userUC.Detail(ctx, sc, sc.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.SetupMFA.userUC.Detail: %v", err)
+		return auth.SetupMFAOutput{}, auth.ErrUserNotFound
+	}
+
+	return uc.setupMFA(ctx, sc, uo.User)
+}
+
+func (uc *implUseCase) EnableMFA(ctx context.Context, sc models.Scope, ip auth.EnableMFAInput) (auth.EnableMFAOutput, error) {
+	mfa, err := uc.repo.DetailUserMFA(ctx, sc, sc.UserID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return auth.EnableMFAOutput{}, auth.ErrMFANotSetUp
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.EnableMFA.repo.DetailUserMFA: %v", err)
+		return auth.EnableMFAOutput{}, err
+	}
+
+	if mfa.Enabled() {
+		return auth.EnableMFAOutput{}, auth.ErrMFAAlreadyEnabled
+	}
+
+	codes, err := uc.confirmEnrollment(ctx, sc, mfa, ip.Code)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.EnableMFA.confirmEnrollment: %v", err)
+		return auth.EnableMFAOutput{}, err
+	}
+
+	return auth.EnableMFAOutput{RecoveryCodes: codes}, nil
+}
+
+func (uc *implUseCase) DisableMFA(ctx context.Context, sc models.Scope, ip auth.MFACodeInput) error {
+	uo, err := uc.userUC.Detail(ctx, sc, sc.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.DisableMFA.userUC.Detail: %v", err)
+		return auth.ErrUserNotFound
+	}
+
+	rl, err := uc.roleUC.Detail(ctx, sc, uo.User.RoleID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.DisableMFA.roleUC.Detail: %v", err)
+		return err
+	}
+
+	if rl.MFARequired {
+		return auth.ErrMFARequired
+	}
+
+	mfa, err := uc.repo.DetailUserMFA(ctx, sc, sc.UserID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return auth.ErrMFANotSetUp
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.DisableMFA.repo.DetailUserMFA: %v", err)
+		return err
+	}
+
+	// A pending enrollment was never confirmed, so there is nothing to prove.
+	if mfa.Enabled() {
+		if err := uc.verifySecondFactor(ctx, sc, mfa, ip); err != nil {
+			uc.l.Warnf(ctx, "internal.auth.usecase.DisableMFA.verifySecondFactor: %v", err)
+			return err
+		}
+	}
+
+	if err := uc.repo.DeleteUserMFA(ctx, sc, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.DisableMFA.repo.DeleteUserMFA: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *implUseCase) RegenerateRecoveryCodes(ctx context.Context, sc models.Scope, ip auth.MFACodeInput) (auth.EnableMFAOutput, error) {
+	mfa, err := uc.repo.DetailUserMFA(ctx, sc, sc.UserID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return auth.EnableMFAOutput{}, auth.ErrMFANotSetUp
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.RegenerateRecoveryCodes.repo.DetailUserMFA: %v", err)
+		return auth.EnableMFAOutput{}, err
+	}
+
+	if !mfa.Enabled() {
+		return auth.EnableMFAOutput{}, auth.ErrMFANotSetUp
+	}
+
+	// Only the authenticator app can mint new recovery codes.
+	if err := uc.verifySecondFactor(ctx, sc, mfa, auth.MFACodeInput{Code: ip.Code}); err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RegenerateRecoveryCodes.verifySecondFactor: %v", err)
+		return auth.EnableMFAOutput{}, err
+	}
+
+	codes, err := uc.issueRecoveryCodes(ctx, sc, sc.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RegenerateRecoveryCodes.issueRecoveryCodes: %v", err)
+		return auth.EnableMFAOutput{}, err
+	}
+
+	return auth.EnableMFAOutput{RecoveryCodes: codes}, nil
+}
+
+// setupMFA starts a new pending enrollment with a fresh secret.
+func (uc *implUseCase) setupMFA(ctx context.Context, sc models.Scope, u models.User) (auth.SetupMFAOutput, error) {
+	mfa, err := uc.repo.DetailUserMFA(ctx, sc, u.ID)
+	if err != nil && err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.auth.usecase.setupMFA.repo.DetailUserMFA: %v", err)
+		return auth.SetupMFAOutput{}, err
+	}
+	if err == nil && mfa.Enabled() {
+		return auth.SetupMFAOutput{}, auth.ErrMFAAlreadyEnabled
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.setupMFA.totp.GenerateSecret: %v", err)
+		return auth.SetupMFAOutput{}, err
+	}
+
+	encrypted, err := uc.encrypt.Encrypt(secret)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.setupMFA.encrypt.Encrypt: %v", err)
+		return auth.SetupMFAOutput{}, err
+	}
+
+	if _, err := uc.repo.UpsertUserMFA(ctx, sc, repository.UpsertUserMFAOptions{
+		UserID:          u.ID,
+		SecretEncrypted: encrypted,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.setupMFA.repo.UpsertUserMFA: %v", err)
+		return auth.SetupMFAOutput{}, err
+	}
+
+	return auth.SetupMFAOutput{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(mfaIssuer, u.Username, secret),
+	}, nil
+}
+
+// confirmEnrollment enables a pending enrollment with its first code and
+// returns the initial recovery codes.
+func (uc *implUseCase) confirmEnrollment(ctx context.Context, sc models.Scope, mfa models.UserMFA, code string) ([]string, error) {
+	step, err := uc.validateTOTP(mfa, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.EnableUserMFA(ctx, sc, repository.UseMFAStepOptions{
+		UserID: mfa.UserID,
+		Step:   step,
+	}); err != nil {
+		if err == repository.ErrNotFound {
+			return nil, auth.ErrMFAAlreadyEnabled
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.confirmEnrollment.repo.EnableUserMFA: %v", err)
+		return nil, err
+	}
+
+	return uc.issueRecoveryCodes(ctx, sc, mfa.UserID)
+}
+
+// verifySecondFactor accepts either a TOTP code that was not used before or an
+// unused recovery code.
+func (uc *implUseCase) verifySecondFactor(ctx context.Context, sc models.Scope, mfa models.UserMFA, ip auth.MFACodeInput) error {
+	if ip.Code != "" {
+		step, err := uc.validateTOTP(mfa, ip.Code)
+		if err != nil {
+			return err
+		}
+
+		if err := uc.repo.UseMFAStep(ctx, sc, repository.UseMFAStepOptions{
+			UserID: mfa.UserID,
+			Step:   step,
+		}); err != nil {
+			if err == repository.ErrNotFound {
+				return auth.ErrInvalidMFACode
+			}
+			uc.l.Errorf(ctx, "internal.auth.usecase.verifySecondFactor.repo.UseMFAStep: %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	if ip.RecoveryCode != "" {
+		if err := uc.repo.UseRecoveryCode(ctx, sc, repository.UseRecoveryCodeOptions{
+			UserID:   mfa.UserID,
+			CodeHash: hashToken(normalizeRecoveryCode(ip.RecoveryCode)),
+		}); err != nil {
+			if err == repository.ErrNotFound {
+				return auth.ErrInvalidMFACode
+			}
+			uc.l.Errorf(ctx, "internal.auth.usecase.verifySecondFactor.repo.UseRecoveryCode: %v", err)
+			return err
+		}
+
+		return nil
+	}
+
+	return auth.ErrInvalidMFACode
+}
+
+func (uc *implUseCase) validateTOTP(mfa models.UserMFA, code string) (int64, error) {
+	secret, err := uc.encrypt.Decrypt(mfa.SecretEncrypted)
+	if err != nil {
+		return 0, err
+	}
+
+	step, ok := totp.Validate(strings.TrimSpace(code), secret, uc.clock())
+	if !ok {
+		return 0, auth.ErrInvalidMFACode
+	}
+
+	return step, nil
+}
+
+func (uc *implUseCase) issueRecoveryCodes(ctx context.Context, sc models.Scope, userID string) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		hashes[i] = hashToken(normalizeRecoveryCode(code))
+	}
+
+	if err := uc.repo.ReplaceRecoveryCodes(ctx, sc, repository.ReplaceRecoveryCodesOptions{
+		UserID:     userID,
+		CodeHashes: hashes,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.issueRecoveryCodes.repo.ReplaceRecoveryCodes: %v", err)
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// createMFAChallenge issues the token that stands in for the access token
+// until the second factor is verified.
+func (uc *implUseCase) createMFAChallenge(ctx context.Context, u models.User) (string, time.Time, error) {
+	now := uc.clock()
+	expiresAt := now.Add(mfaChallengeTTL)
+
+	token, err := uc.scopeUC.CreateToken(scope.Payload{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  "kanban-api",
+			ExpiresAt: expiresAt.Unix(),
+			Id:        postgres.NewUUID(),
+			IssuedAt:  now.Unix(),
+			Issuer:    "kanban-api",
+			NotBefore: now.Unix(),
+			Subject:   u.ID,
+		},
+		UserID:   u.ID,
+		Username: u.Username,
+		Type:     scope.TokenTypeMFAPending,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.createMFAChallenge.scope.CreateToken: %v", err)
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
+}
+
+// parseMFAChallenge checks the challenge token and returns its (still active) user.
+func (uc *implUseCase) parseMFAChallenge(ctx context.Context, sc models.Scope, token string) (models.User, scope.Payload, error) {
+	p, err := uc.scopeUC.Verify(token)
+	if err != nil || p.Type != scope.TokenTypeMFAPending {
+		return models.User{}, scope.Payload{}, auth.ErrInvalidToken
+	}
+
+	revoked, err := uc.repo.IsTokenRevoked(ctx, sc, repository.IsTokenRevokedOptions{
+		JTI:      p.Id,
+		UserID:   p.UserID,
+		IssuedAt: time.Unix(p.IssuedAt, 0),
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.parseMFAChallenge.repo.IsTokenRevoked: %v", err)
+		return models.User{}, scope.Payload{}, err
+	}
+	if revoked {
+		return models.User{}, scope.Payload{}, auth.ErrInvalidToken
+	}
+
+	uo, err := uc.userUC.Detail(ctx, sc, p.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.parseMFAChallenge.userUC.Detail: %v", err)
+		return models.User{}, scope.Payload{}, auth.ErrInvalidToken
+	}
+	if !uo.User.IsActive {
+		return models.User{}, scope.Payload{}, auth.ErrUnauthorized
+	}
+
+	return uo.User, p, nil
+}
+
+// generateRecoveryCode returns a code formatted as xxxxx-xxxxx.
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	s := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))
+	return s[:5] + "-" + s[5:], nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
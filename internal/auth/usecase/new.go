@@ -1,30 +1,51 @@
 package usecase
 
 import (
+	"time"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq/producer"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/role"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
 	"github.com/nguyentantai21042004/kanban-api/pkg/encrypter"
 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/oidc"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
 type implUseCase struct {
-	l       log.Logger
-	encrypt encrypter.Encrypter
-	scopeUC scope.Manager
-	userUC  user.UseCase
-	roleUC  role.UseCase
+	l        log.Logger
+	encrypt  encrypter.Encrypter
+	scopeUC  scope.Manager
+	repo     repository.Repository
+	userUC   user.UseCase
+	roleUC   role.UseCase
+	prod     producer.Producer
+	limiter  ratelimit.Limiter
+	oidc     oidc.Provider
+	tokenCfg auth.TokenConfig
+	loginCfg auth.LoginProtectionConfig
+	clock    func() time.Time
 }
 
 var _ auth.UseCase = &implUseCase{}
 
-func New(l log.Logger, encrypt encrypter.Encrypter, scopeUC scope.Manager, userUC user.UseCase, roleUC role.UseCase) auth.UseCase {
+func New(l log.Logger, encrypt encrypter.Encrypter, scopeUC scope.Manager, repo repository.Repository, userUC user.UseCase, roleUC role.UseCase, prod producer.Producer, limiter ratelimit.Limiter, oidcProvider oidc.Provider, tokenCfg auth.TokenConfig, loginCfg auth.LoginProtectionConfig) auth.UseCase {
 	return &implUseCase{
-		l:       l,
-		encrypt: encrypt,
-		scopeUC: scopeUC,
-		userUC:  userUC,
-		roleUC:  roleUC,
+		l:        l,
+		encrypt:  encrypt,
+		scopeUC:  scopeUC,
+		repo:     repo,
+		userUC:   userUC,
+		roleUC:   roleUC,
+		prod:     prod,
+		limiter:  limiter,
+		oidc:     oidcProvider,
+		tokenCfg: tokenCfg,
+		loginCfg: loginCfg,
+		clock:    util.Now,
 	}
 }
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/oidc"
+)
+
+const (
+	// oidcStateTTL is how long the user has to complete the login at the identity provider.
+	oidcStateTTL = 10 * time.Minute
+	// oidcSecretBytes is the entropy of the state and nonce parameters.
+	oidcSecretBytes = 32
+	// provisionedPasswordBytes is the entropy of the random password given to
+	// provisioned users. Nobody knows it, a local password has to be set with
+	// the forgot-password flow.
+	provisionedPasswordBytes = 32
+)
+
+func (uc *implUseCase) StartOIDCLogin(ctx context.Context, sc models.Scope) (auth.StartOIDCLoginOutput, error) {
+	if uc.oidc == nil {
+		return auth.StartOIDCLoginOutput{}, auth.ErrOIDCDisabled
+	}
+
+	state, err := generateSecret(oidcSecretBytes)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.StartOIDCLogin.generateSecret: %v", err)
+		return auth.StartOIDCLoginOutput{}, err
+	}
+	nonce, err := generateSecret(oidcSecretBytes)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.StartOIDCLogin.generateSecret: %v", err)
+		return auth.StartOIDCLoginOutput{}, err
+	}
+	verifier, err := oidc.GenerateVerifier()
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.StartOIDCLogin.oidc.GenerateVerifier: %v", err)
+		return auth.StartOIDCLoginOutput{}, err
+	}
+
+	authURL, err := uc.oidc.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.StartOIDCLogin.oidc.AuthCodeURL: %v", err)
+		return auth.StartOIDCLoginOutput{}, err
+	}
+
+	expiresAt := uc.clock().Add(oidcStateTTL)
+	if _, err := uc.repo.CreateOIDCLoginState(ctx, sc, repository.CreateOIDCLoginStateOptions{
+		StateHash:    hashToken(state),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		ExpiresAt:    expiresAt,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.StartOIDCLogin.repo.CreateOIDCLoginState: %v", err)
+		return auth.StartOIDCLoginOutput{}, err
+	}
+
+	return auth.StartOIDCLoginOutput{
+		AuthURL:   authURL,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (uc *implUseCase) OIDCCallback(ctx context.Context, sc models.Scope, ip auth.OIDCCallbackInput) (auth.LoginOutput, error) {
+	if uc.oidc == nil {
+		return auth.LoginOutput{}, auth.ErrOIDCDisabled
+	}
+
+	s, err := uc.repo.ConsumeOIDCLoginState(ctx, sc, hashToken(ip.State))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.OIDCCallback.repo.ConsumeOIDCLoginState: %v", err)
+			return auth.LoginOutput{}, auth.ErrInvalidOIDCState
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.OIDCCallback.repo.ConsumeOIDCLoginState: %v", err)
+		return auth.LoginOutput{}, err
+	}
+	if !uc.clock().Before(s.ExpiresAt) {
+		return auth.LoginOutput{}, auth.ErrInvalidOIDCState
+	}
+
+	claims, err := uc.oidc.Exchange(ctx, ip.Code, s.CodeVerifier, s.Nonce)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.OIDCCallback.oidc.Exchange: %v", err)
+		return auth.LoginOutput{}, auth.ErrOIDCFailed
+	}
+
+	u, err := uc.resolveOIDCUser(ctx, sc, claims)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.OIDCCallback.resolveOIDCUser: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
+	if !u.IsActive {
+		uc.l.Warnf(ctx, "internal.auth.usecase.OIDCCallback.user_inactive: %v", u.ID)
+		return auth.LoginOutput{}, auth.ErrUnauthorized
+	}
+
+	o, err := uc.completeLogin(ctx, sc, u, ip.UserAgent, ip.IPAddress)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.OIDCCallback.completeLogin: %v", err)
+		return auth.LoginOutput{}, err
+	}
+
+	return o, nil
+}
+
+// resolveOIDCUser returns the user linked to the identity. An unknown identity
+// is linked to the user with the same username, or to a new user with the
+// default role when there is none.
+func (uc *implUseCase) resolveOIDCUser(ctx context.Context, sc models.Scope, c oidc.Claims) (models.User, error) {
+	email := strings.ToLower(strings.TrimSpace(c.Email))
+
+	i, err := uc.repo.DetailUserIdentity(ctx, sc, repository.DetailUserIdentityOptions{
+		Issuer:  uc.oidc.Issuer(),
+		Subject: c.Subject,
+	})
+	if err == nil {
+		uo, err := uc.userUC.Detail(ctx, sc, i.UserID)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.auth.usecase.resolveOIDCUser.userUC.Detail: %v", err)
+			return models.User{}, err
+		}
+
+		if err := uc.repo.TouchUserIdentity(ctx, sc, repository.TouchUserIdentityOptions{
+			ID:    i.ID,
+			Email: email,
+		}); err != nil {
+			uc.l.Errorf(ctx, "internal.auth.usecase.resolveOIDCUser.repo.TouchUserIdentity: %v", err)
+			return models.User{}, err
+		}
+
+		return uo.User, nil
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.auth.usecase.resolveOIDCUser.repo.DetailUserIdentity: %v", err)
+		return models.User{}, err
+	}
+
+	username := oidcUsername(c)
+	if username == "" {
+		return models.User{}, auth.ErrOIDCEmailRequired
+	}
+
+	u, err := uc.userUC.GetOne(ctx, sc, user.GetOneInput{Username: username})
+	if err != nil {
+		if err != user.ErrUserNotFound {
+			uc.l.Errorf(ctx, "internal.auth.usecase.resolveOIDCUser.userUC.GetOne: %v", err)
+			return models.User{}, err
+		}
+
+		u, err = uc.provisionOIDCUser(ctx, sc, username, c)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.auth.usecase.resolveOIDCUser.provisionOIDCUser: %v", err)
+			return models.User{}, err
+		}
+	}
+
+	if _, err := uc.repo.CreateUserIdentity(ctx, sc, repository.CreateUserIdentityOptions{
+		UserID:  u.ID,
+		Issuer:  uc.oidc.Issuer(),
+		Subject: c.Subject,
+		Email:   email,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.resolveOIDCUser.repo.CreateUserIdentity: %v", err)
+		return models.User{}, err
+	}
+
+	return u, nil
+}
+
+// provisionOIDCUser creates an active user with the default role.
+func (uc *implUseCase) provisionOIDCUser(ctx context.Context, sc models.Scope, username string, c oidc.Claims) (models.User, error) {
+	roleID, err := uc.getDefaultRoleID(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.provisionOIDCUser.getDefaultRoleID: %v", err)
+		return models.User{}, err
+	}
+
+	password, err := generateSecret(provisionedPasswordBytes)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.provisionOIDCUser.generateSecret: %v", err)
+		return models.User{}, err
+	}
+
+	fullName := strings.TrimSpace(c.Name)
+	if fullName == "" {
+		fullName = username
+	}
+
+	o, err := uc.userUC.Create(ctx, sc, user.CreateInput{
+		Username: username,
+		Password: password,
+		FullName: fullName,
+		RoleID:   roleID,
+	})
+	if err != nil {
+		if err == user.ErrUserExists {
+			uc.l.Warnf(ctx, "internal.auth.usecase.provisionOIDCUser.userUC.Create: %v", err)
+			return models.User{}, auth.ErrUserExists
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.provisionOIDCUser.userUC.Create: %v", err)
+		return models.User{}, err
+	}
+
+	return o.User, nil
+}
+
+// oidcUsername maps the claims to a username, which is the email address of
+// the user. Only an email the provider reports as verified is used, any other
+// could take over the account that owns the address. The preferred_username
+// claim is not used either, it is not guaranteed unique.
+func oidcUsername(c oidc.Claims) string {
+	return strings.ToLower(strings.TrimSpace(c.VerifiedEmail()))
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIssuer = "https://idp.example.com"
+
+// fakeOIDC is the identity provider of the tests.
+type fakeOIDC struct {
+	oidc.Provider
+}
+
+func (fakeOIDC) Issuer() string {
+	return testIssuer
+}
+
+func TestResolveOIDCUser(t *testing.T) {
+	verified, unverified := true, false
+
+	tcs := map[string]struct {
+		identities []models.UserIdentity
+		claims     oidc.Claims
+		wantUserID string
+		wantErr    error
+	}{
+		"verified email links the user": {
+			claims:     oidc.Claims{Subject: "sub-1", Email: "John@Example.com", EmailVerified: &verified},
+			wantUserID: "user-1",
+		},
+		"unverified email": {
+			claims:  oidc.Claims{Subject: "sub-1", Email: "john@example.com", EmailVerified: &unverified},
+			wantErr: auth.ErrOIDCEmailRequired,
+		},
+		"missing email_verified": {
+			claims:  oidc.Claims{Subject: "sub-1", Email: "john@example.com"},
+			wantErr: auth.ErrOIDCEmailRequired,
+		},
+		"missing email": {
+			claims:  oidc.Claims{Subject: "sub-1", EmailVerified: &verified},
+			wantErr: auth.ErrOIDCEmailRequired,
+		},
+		"linked identity": {
+			identities: []models.UserIdentity{{ID: "identity-1", UserID: "user-1", Issuer: testIssuer, Subject: "sub-1"}},
+			claims:     oidc.Claims{Subject: "sub-1", Email: "other@example.com", EmailVerified: &unverified},
+			wantUserID: "user-1",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			uc.oidc = fakeOIDC{}
+			deps.repo.identities = tc.identities
+
+			u, err := uc.resolveOIDCUser(context.Background(), models.Scope{}, tc.claims)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				// Nothing is linked to the account that owns the address
+				assert.Equal(t, tc.identities, deps.repo.identities)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantUserID, u.ID)
+			require.NotEmpty(t, deps.repo.identities)
+			assert.Equal(t, tc.wantUserID, deps.repo.identities[len(deps.repo.identities)-1].UserID)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	"github.com/nguyentantai21042004/kanban-api/pkg/encrypter"
+)
+
+const (
+	// passwordResetTTL is how long a reset token sent by email stays valid.
+	passwordResetTTL = 30 * time.Minute
+	// passwordResetTokenBytes is the entropy of a reset token.
+	passwordResetTokenBytes = 32
+)
+
+func (uc *implUseCase) ChangePassword(ctx context.Context, sc models.Scope, ip auth.ChangePasswordInput) error {
+	uo, err := uc.userUC.Detail(ctx, sc, sc.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ChangePassword.userUC.Detail: %v", err)
+		return auth.ErrUserNotFound
+	}
+
+	if !encrypter.CheckPasswordHash(ip.CurrentPassword, uo.User.PasswordHash) {
+		uc.l.Warnf(ctx, "internal.auth.usecase.ChangePassword.password_mismatch: %v", "password does not match")
+		return auth.ErrWrongPassword
+	}
+
+	if ip.CurrentPassword == ip.NewPassword {
+		return auth.ErrSamePassword
+	}
+
+	o, err := uc.userUC.UpdatePassword(ctx, sc, user.UpdatePasswordInput{
+		UserID:   uo.User.ID,
+		Password: ip.NewPassword,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ChangePassword.userUC.UpdatePassword: %v", err)
+		return err
+	}
+
+	uc.notifyPasswordChanged(ctx, o.User)
+
+	return nil
+}
+
+func (uc *implUseCase) ForgotPassword(ctx context.Context, sc models.Scope, ip auth.ForgotPasswordInput) error {
+	// Unknown or inactive accounts get the same answer, so the endpoint
+	// cannot be used to find out which usernames exist.
+	u, err := uc.userUC.GetOne(ctx, sc, user.GetOneInput{Username: ip.Username})
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.ForgotPassword.userUC.GetOne: %v", err)
+			return nil
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.ForgotPassword.userUC.GetOne: %v", err)
+		return err
+	}
+
+	if !u.IsActive {
+		uc.l.Warnf(ctx, "internal.auth.usecase.ForgotPassword.user_inactive: %v", "user is inactive")
+		return nil
+	}
+
+	token, err := generateSecret(passwordResetTokenBytes)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ForgotPassword.generateSecret: %v", err)
+		return err
+	}
+
+	if _, err := uc.repo.CreatePasswordReset(ctx, sc, repository.CreatePasswordResetOptions{
+		UserID:    u.ID,
+		TokenHash: hashToken(token),
+		ExpiresAt: uc.clock().Add(passwordResetTTL),
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ForgotPassword.repo.CreatePasswordReset: %v", err)
+		return err
+	}
+
+	if err := uc.sendEmail(ctx, email.EmailMeta{
+		Recipient:    u.Username,
+		TemplateType: email.PasswordResetTemplate,
+	}, email.PasswordReset{
+		Name:      u.FullName,
+		Email:     u.Username,
+		Token:     token,
+		ExpireMin: strconv.Itoa(int(passwordResetTTL.Minutes())),
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ForgotPassword.sendEmail: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *implUseCase) ResetPassword(ctx context.Context, sc models.Scope, ip auth.ResetPasswordInput) error {
+	pr, err := uc.repo.GetPasswordReset(ctx, sc, hashToken(ip.Token))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.ResetPassword.repo.GetPasswordReset: %v", err)
+			return auth.ErrInvalidResetToken
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.ResetPassword.repo.GetPasswordReset: %v", err)
+		return err
+	}
+
+	if pr.UsedAt != nil {
+		return auth.ErrInvalidResetToken
+	}
+
+	if uc.clock().After(pr.ExpiresAt) {
+		return auth.ErrTokenExpired
+	}
+
+	if err := uc.repo.UsePasswordReset(ctx, sc, pr.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.ResetPassword.repo.UsePasswordReset: %v", err)
+			return auth.ErrInvalidResetToken
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.ResetPassword.repo.UsePasswordReset: %v", err)
+		return err
+	}
+
+	o, err := uc.userUC.UpdatePassword(ctx, sc, user.UpdatePasswordInput{
+		UserID:   pr.UserID,
+		Password: ip.NewPassword,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ResetPassword.userUC.UpdatePassword: %v", err)
+		return err
+	}
+
+	// Whoever knew the old password may still hold a session.
+	if err := uc.RevokeUserSessions(ctx, sc, pr.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ResetPassword.RevokeUserSessions: %v", err)
+		return err
+	}
+
+	uc.notifyPasswordChanged(ctx, o.User)
+
+	return nil
+}
+
+// notifyPasswordChanged tells the owner that the password was changed. The
+// password is already updated at this point, so failures are only logged.
+func (uc *implUseCase) notifyPasswordChanged(ctx context.Context, u models.User) {
+	if err := uc.sendEmail(ctx, email.EmailMeta{
+		Recipient:    u.Username,
+		TemplateType: email.PasswordChangedTemplate,
+	}, email.PasswordChanged{
+		Name:      u.FullName,
+		Email:     u.Username,
+		ChangedAt: uc.clock(),
+	}); err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.notifyPasswordChanged.sendEmail: %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+const (
+	// personalAccessTokenSize is the entropy of a personal access token in bytes.
+	personalAccessTokenSize = 32
+	// personalAccessTokenPrefixLen is how much of the token is kept in clear to
+	// recognise it in listings.
+	personalAccessTokenPrefixLen = 12
+)
+
+func (uc *implUseCase) CreatePersonalAccessToken(ctx context.Context, sc models.Scope, ip auth.CreatePersonalAccessTokenInput) (auth.CreatePersonalAccessTokenOutput, error) {
+	// A leaked token must not be able to mint more tokens.
+	if sc.TokenScope != "" {
+		return auth.CreatePersonalAccessTokenOutput{}, auth.ErrLoginRequired
+	}
+
+	if ip.ExpiresAt != nil && !ip.ExpiresAt.After(uc.clock()) {
+		return auth.CreatePersonalAccessTokenOutput{}, auth.ErrInvalidExpiry
+	}
+
+	secret, err := generateSecret(personalAccessTokenSize)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.CreatePersonalAccessToken.generateSecret: %v", err)
+		return auth.CreatePersonalAccessTokenOutput{}, err
+	}
+	secret = auth.PersonalAccessTokenPrefix + secret
+
+	t, err := uc.repo.CreatePersonalAccessToken(ctx, sc, repository.CreatePersonalAccessTokenOptions{
+		UserID:      sc.UserID,
+		Name:        strings.TrimSpace(ip.Name),
+		TokenHash:   hashToken(secret),
+		TokenPrefix: secret[:personalAccessTokenPrefixLen],
+		Scope:       ip.Scope,
+		ExpiresAt:   ip.ExpiresAt,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.CreatePersonalAccessToken.repo.CreatePersonalAccessToken: %v", err)
+		return auth.CreatePersonalAccessTokenOutput{}, err
+	}
+
+	return auth.CreatePersonalAccessTokenOutput{
+		Token:  t,
+		Secret: secret,
+	}, nil
+}
+
+func (uc *implUseCase) ListPersonalAccessTokens(ctx context.Context, sc models.Scope) ([]models.PersonalAccessToken, error) {
+	ts, err := uc.repo.ListPersonalAccessTokens(ctx, sc, sc.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ListPersonalAccessTokens.repo.ListPersonalAccessTokens: %v", err)
+		return nil, err
+	}
+
+	return ts, nil
+}
+
+func (uc *implUseCase) RevokePersonalAccessToken(ctx context.Context, sc models.Scope, ID string) error {
+	if sc.TokenScope != "" {
+		return auth.ErrLoginRequired
+	}
+
+	if err := uc.repo.RevokePersonalAccessToken(ctx, sc, repository.RevokePersonalAccessTokenOptions{
+		ID:     ID,
+		UserID: sc.UserID,
+	}); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.RevokePersonalAccessToken.repo.RevokePersonalAccessToken: %v", err)
+			return auth.ErrTokenNotFound
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokePersonalAccessToken.repo.RevokePersonalAccessToken: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *implUseCase) AuthenticatePersonalAccessToken(ctx context.Context, token string) (auth.AuthenticatePersonalAccessTokenOutput, error) {
+	if !strings.HasPrefix(token, auth.PersonalAccessTokenPrefix) {
+		return auth.AuthenticatePersonalAccessTokenOutput{}, auth.ErrInvalidToken
+	}
+
+	t, err := uc.repo.GetPersonalAccessToken(ctx, models.Scope{}, hashToken(token))
+	if err != nil {
+		if err == repository.ErrNotFound {
+			return auth.AuthenticatePersonalAccessTokenOutput{}, auth.ErrInvalidToken
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.AuthenticatePersonalAccessToken.repo.GetPersonalAccessToken: %v", err)
+		return auth.AuthenticatePersonalAccessTokenOutput{}, err
+	}
+	if t.RevokedAt != nil {
+		return auth.AuthenticatePersonalAccessTokenOutput{}, auth.ErrInvalidToken
+	}
+	if t.IsExpired(uc.clock()) {
+		return auth.AuthenticatePersonalAccessTokenOutput{}, auth.ErrTokenExpired
+	}
+
+	sc := models.Scope{UserID: t.UserID}
+	uo, err := uc.userUC.Detail(ctx, sc, t.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.AuthenticatePersonalAccessToken.userUC.Detail: %v", err)
+		return auth.AuthenticatePersonalAccessTokenOutput{}, auth.ErrInvalidToken
+	}
+	if !uo.User.IsActive {
+		return auth.AuthenticatePersonalAccessTokenOutput{}, auth.ErrUnauthorized
+	}
+
+	if err := uc.repo.TouchPersonalAccessToken(ctx, sc, t.ID); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.AuthenticatePersonalAccessToken.repo.TouchPersonalAccessToken: %v", err)
+	}
+
+	return auth.AuthenticatePersonalAccessTokenOutput{
+		TokenID: t.ID,
+		Scope: models.Scope{
+			UserID:     uo.User.ID,
+			Username:   uo.User.Username,
+			TokenScope: t.Scope,
+		},
+	}, nil
+}
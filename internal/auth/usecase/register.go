@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	"github.com/nguyentantai21042004/kanban-api/pkg/otp"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
+)
+
+// maxVerificationAttempts is how many wrong codes are accepted before the
+// user has to request a new one.
+const maxVerificationAttempts = 5
+
+// verificationResendPolicy limits the new codes sent to a username. Every
+// code accepts maxVerificationAttempts guesses, so this bounds how fast a
+// code can be guessed.
+var verificationResendPolicy = ratelimit.Policy{
+	Limit:  3,
+	Window: time.Hour,
+}
+
+var errDefaultRoleNotFound = errors.New("default role not found")
+
+func (uc *implUseCase) Register(ctx context.Context, sc models.Scope, ip auth.RegisterInput) (auth.RegisterOutput, error) {
+	roleID, err := uc.getDefaultRoleID(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.Register.getDefaultRoleID: %v", err)
+		return auth.RegisterOutput{}, err
+	}
+
+	o, err := uc.userUC.Register(ctx, sc, user.RegisterInput{
+		Username: ip.Username,
+		Password: ip.Password,
+		FullName: ip.FullName,
+		RoleID:   roleID,
+	})
+	if err != nil {
+		if err == user.ErrUserExists {
+			uc.l.Warnf(ctx, "internal.auth.usecase.Register.userUC.Register: %v", err)
+			return auth.RegisterOutput{}, auth.ErrUserExists
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.Register.userUC.Register: %v", err)
+		return auth.RegisterOutput{}, err
+	}
+
+	if err := uc.sendVerification(ctx, sc, o.User); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.Register.sendVerification: %v", err)
+		return auth.RegisterOutput{}, err
+	}
+
+	return auth.RegisterOutput{User: o.User}, nil
+}
+
+func (uc *implUseCase) Verify(ctx context.Context, sc models.Scope, ip auth.VerifyInput) error {
+	// Unknown and verified accounts get the same answer as a wrong code, so
+	// the endpoint cannot be used to find out which usernames exist
+	u, err := uc.userUC.GetOne(ctx, sc, user.GetOneInput{Username: ip.Username})
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.auth.usecase.Verify.userUC.GetOne: %v", err)
+		return auth.ErrInvalidOTP
+	}
+
+	if u.IsActive {
+		uc.l.Warnf(ctx, "internal.auth.usecase.Verify.user_active: %v", "user is already verified")
+		return auth.ErrInvalidOTP
+	}
+
+	v, err := uc.repo.GetPendingEmailVerification(ctx, sc, u.ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.Verify.repo.GetPendingEmailVerification: %v", err)
+			return auth.ErrInvalidOTP
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.Verify.repo.GetPendingEmailVerification: %v", err)
+		return err
+	}
+
+	if uc.clock().After(v.ExpiresAt) {
+		return auth.ErrOTPExpired
+	}
+
+	if v.Attempts >= maxVerificationAttempts {
+		return auth.ErrTooManyAttempts
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashToken(ip.OTP)), []byte(v.OTPHash)) != 1 {
+		if err := uc.repo.IncreaseEmailVerificationAttempts(ctx, sc, v.ID); err != nil {
+			uc.l.Errorf(ctx, "internal.auth.usecase.Verify.repo.IncreaseEmailVerificationAttempts: %v", err)
+			return err
+		}
+		return auth.ErrInvalidOTP
+	}
+
+	if err := uc.repo.ConfirmEmailVerification(ctx, sc, v.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.Verify.repo.ConfirmEmailVerification: %v", err)
+			return auth.ErrInvalidOTP
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.Verify.repo.ConfirmEmailVerification: %v", err)
+		return err
+	}
+
+	if _, err := uc.userUC.Activate(ctx, sc, u.ID); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.Verify.userUC.Activate: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *implUseCase) ResendVerification(ctx context.Context, sc models.Scope, ip auth.ResendVerificationInput) error {
+	// The limit applies to every username, known or not, so it does not tell
+	// which ones exist either
+	if !uc.allowResendVerification(ctx, ip.Username) {
+		uc.l.Warnf(ctx, "internal.auth.usecase.ResendVerification.allowResendVerification: %v", "rate limited")
+		return auth.ErrTooManyCodes
+	}
+
+	// Unknown or verified accounts get the same answer, so the endpoint
+	// cannot be used to find out which usernames exist.
+	u, err := uc.userUC.GetOne(ctx, sc, user.GetOneInput{Username: ip.Username})
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.ResendVerification.userUC.GetOne: %v", err)
+			return nil
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.ResendVerification.userUC.GetOne: %v", err)
+		return err
+	}
+
+	if u.IsActive {
+		uc.l.Warnf(ctx, "internal.auth.usecase.ResendVerification.user_active: %v", "user is already verified")
+		return nil
+	}
+
+	if err := uc.sendVerification(ctx, sc, u); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ResendVerification.sendVerification: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// allowResendVerification applies verificationResendPolicy to the username.
+// The limiter failing must not block the verification, so errors let the
+// request through.
+func (uc *implUseCase) allowResendVerification(ctx context.Context, username string) bool {
+	res, err := uc.limiter.Allow(ctx, "verification:"+strings.ToLower(strings.TrimSpace(username)), verificationResendPolicy)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.allowResendVerification.limiter.Allow: %v", err)
+		return true
+	}
+
+	return res.Allowed
+}
+
+// getDefaultRoleID returns the role given to self-registered users.
+func (uc *implUseCase) getDefaultRoleID(ctx context.Context, sc models.Scope) (string, error) {
+	rls, err := uc.roleUC.List(ctx, sc, role.ListInput{
+		Filter: role.Filter{Code: models.USER_ROLE},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// The code filter is a pattern match, so pick the exact code here.
+	for _, rl := range rls {
+		if rl.Code == models.USER_ROLE {
+			return rl.ID, nil
+		}
+	}
+
+	return "", errDefaultRoleNotFound
+}
+
+// sendVerification stores a new one-time code for the user and queues the
+// localized verification email.
+func (uc *implUseCase) sendVerification(ctx context.Context, sc models.Scope, u models.User) error {
+	now := uc.clock()
+	code, expiresAt := otp.GenerateOTP(now)
+
+	if _, err := uc.repo.CreateEmailVerification(ctx, sc, repository.CreateEmailVerificationOptions{
+		UserID:    u.ID,
+		OTPHash:   hashToken(code),
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.sendVerification.repo.CreateEmailVerification: %v", err)
+		return err
+	}
+
+	return uc.sendEmail(ctx, email.EmailMeta{
+		Recipient:    u.Username,
+		TemplateType: email.EmailVerificationTemplate,
+	}, email.EmailVerification{
+		Name:         u.FullName,
+		Email:        u.Username,
+		OTP:          code,
+		OTPExpireMin: strconv.Itoa(int(expiresAt.Sub(now).Minutes())),
+	})
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResendVerification(t *testing.T) {
+	tcs := map[string]struct {
+		username string
+		wantSent bool
+	}{
+		"unverified user": {
+			username: "jane@example.com",
+			wantSent: true,
+		},
+		"verified user": {
+			username: "john@example.com",
+		},
+		"unknown user": {
+			username: "nobody@example.com",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := locale.SetLocaleToContext(context.Background(), locale.EN)
+			uc, deps := initUseCase(t, time.Now())
+			deps.users["user-2"] = models.User{ID: "user-2", Username: "jane@example.com"}
+
+			// Every case answers the same, only the unverified user gets a code
+			err := uc.ResendVerification(ctx, models.Scope{}, auth.ResendVerificationInput{Username: tc.username})
+			require.NoError(t, err)
+
+			if tc.wantSent {
+				require.Len(t, deps.prod.sent, 1)
+				assert.Equal(t, tc.username, deps.prod.sent[0].Recipient)
+				require.Len(t, deps.repo.verifications, 1)
+				assert.Equal(t, "user-2", deps.repo.verifications[0].UserID)
+				return
+			}
+			assert.Empty(t, deps.prod.sent)
+			assert.Empty(t, deps.repo.verifications)
+		})
+	}
+}
+
+func TestVerifyDoesNotTellUsernames(t *testing.T) {
+	tcs := map[string]struct {
+		username string
+	}{
+		"verified user": {
+			username: "john@example.com",
+		},
+		"unknown user": {
+			username: "nobody@example.com",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, _ := initUseCase(t, time.Now())
+
+			// Both answer like a wrong code for an unverified user
+			err := uc.Verify(context.Background(), models.Scope{}, auth.VerifyInput{Username: tc.username, OTP: "123456"})
+			assert.ErrorIs(t, err, auth.ErrInvalidOTP)
+		})
+	}
+}
+
+func TestResendVerificationLimit(t *testing.T) {
+	for _, username := range []string{"jane@example.com", "nobody@example.com"} {
+		t.Run(username, func(t *testing.T) {
+			ctx := locale.SetLocaleToContext(context.Background(), locale.EN)
+			uc, deps := initUseCase(t, time.Now())
+			deps.users["user-2"] = models.User{ID: "user-2", Username: "jane@example.com"}
+
+			for i := 0; i < verificationResendPolicy.Limit; i++ {
+				require.NoError(t, uc.ResendVerification(ctx, models.Scope{}, auth.ResendVerificationInput{Username: username}))
+			}
+
+			err := uc.ResendVerification(ctx, models.Scope{}, auth.ResendVerificationInput{Username: username})
+			assert.ErrorIs(t, err, auth.ErrTooManyCodes)
+
+			// The limit is kept per username
+			err = uc.ResendVerification(ctx, models.Scope{}, auth.ResendVerificationInput{Username: "other@example.com"})
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (uc *implUseCase) ListSessions(ctx context.Context, sc models.Scope, ip auth.ListSessionsInput) ([]models.Session, error) {
+	ss, err := uc.repo.ListSessions(ctx, sc, repository.ListSessionsOptions{
+		UserID:     ip.UserID,
+		ActiveOnly: true,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.ListSessions.repo.ListSessions: %v", err)
+		return nil, err
+	}
+
+	return ss, nil
+}
+
+func (uc *implUseCase) RevokeSession(ctx context.Context, sc models.Scope, ID string) error {
+	s, err := uc.repo.DetailSession(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.auth.usecase.RevokeSession.repo.DetailSession: %v", err)
+			return auth.ErrSessionNotFound
+		}
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokeSession.repo.DetailSession: %v", err)
+		return err
+	}
+
+	// Users can only see and revoke their own sessions
+	if s.UserID != sc.UserID {
+		uc.l.Warnf(ctx, "internal.auth.usecase.RevokeSession: session %s does not belong to user %s", s.ID, sc.UserID)
+		return auth.ErrSessionNotFound
+	}
+
+	if err := uc.repo.RevokeSessions(ctx, sc, repository.RevokeSessionsOptions{IDs: []string{s.ID}}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokeSession.repo.RevokeSessions: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *implUseCase) RevokeAllSessions(ctx context.Context, sc models.Scope) error {
+	if err := uc.repo.RevokeSessions(ctx, sc, repository.RevokeSessionsOptions{UserID: sc.UserID}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.RevokeAllSessions.repo.RevokeSessions: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *implUseCase) GetLastLogins(ctx context.Context, sc models.Scope, userIDs []string) (map[string]time.Time, error) {
+	ll, err := uc.repo.GetLastLogins(ctx, sc, userIDs)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.GetLastLogins.repo.GetLastLogins: %v", err)
+		return nil, err
+	}
+
+	return ll, nil
+}
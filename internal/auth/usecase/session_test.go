@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func initSessions(t *testing.T, now time.Time) (*implUseCase, mockDeps) {
+	t.Helper()
+
+	uc, deps := initUseCase(t, now)
+	deps.users["user-2"] = models.User{ID: "user-2", Username: "jane@example.com", IsActive: true}
+	deps.repo.sessions = map[string]models.Session{
+		"session-1": {ID: "session-1", UserID: "user-1", ExpiresAt: now.Add(time.Hour)},
+		"session-2": {ID: "session-2", UserID: "user-1", ExpiresAt: now.Add(time.Hour)},
+		"session-3": {ID: "session-3", UserID: "user-2", ExpiresAt: now.Add(time.Hour)},
+	}
+
+	return uc, deps
+}
+
+func TestListSessions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	uc, deps := initSessions(t, now)
+	deps.repo.sessions["session-2"] = models.Session{ID: "session-2", UserID: "user-1", RevokedAt: &now}
+
+	ss, err := uc.ListSessions(ctx, models.Scope{UserID: "user-1"}, auth.ListSessionsInput{UserID: "user-1"})
+	require.NoError(t, err)
+	require.Len(t, ss, 1)
+	assert.Equal(t, "session-1", ss[0].ID)
+}
+
+func TestRevokeSession(t *testing.T) {
+	tcs := map[string]struct {
+		sc          models.Scope
+		id          string
+		wantErr     error
+		wantRevoked bool
+	}{
+		"own session": {
+			sc:          models.Scope{UserID: "user-1"},
+			id:          "session-1",
+			wantRevoked: true,
+		},
+		"session of another user": {
+			sc:      models.Scope{UserID: "user-2"},
+			id:      "session-1",
+			wantErr: auth.ErrSessionNotFound,
+		},
+		"unknown session": {
+			sc:      models.Scope{UserID: "user-1"},
+			id:      "session-9",
+			wantErr: auth.ErrSessionNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initSessions(t, time.Now())
+
+			err := uc.RevokeSession(context.Background(), tc.sc, tc.id)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.wantRevoked, deps.repo.sessions["session-1"].RevokedAt != nil)
+			assert.Nil(t, deps.repo.sessions["session-2"].RevokedAt)
+		})
+	}
+}
+
+func TestRevokeAllSessions(t *testing.T) {
+	uc, deps := initSessions(t, time.Now())
+
+	require.NoError(t, uc.RevokeAllSessions(context.Background(), models.Scope{UserID: "user-1"}))
+	assert.NotNil(t, deps.repo.sessions["session-1"].RevokedAt)
+	assert.NotNil(t, deps.repo.sessions["session-2"].RevokedAt)
+	assert.Nil(t, deps.repo.sessions["session-3"].RevokedAt)
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	now := time.Now()
+	admin := models.Scope{UserID: "admin-1"}
+
+	t.Run("sessions of another user", func(t *testing.T) {
+		uc, deps := initSessions(t, now)
+
+		require.NoError(t, uc.RevokeUserSessions(context.Background(), admin, "user-2"))
+		assert.NotNil(t, deps.repo.sessions["session-3"].RevokedAt)
+		assert.Nil(t, deps.repo.sessions["session-1"].RevokedAt)
+
+		// The access tokens already issued stay rejected until they expire
+		require.Len(t, deps.repo.userTokens, 1)
+		assert.Equal(t, "user-2", deps.repo.userTokens[0].UserID)
+		assert.Equal(t, now, deps.repo.userTokens[0].RevokedBefore)
+		assert.Equal(t, now.Add(uc.tokenCfg.AccessTokenTTL), deps.repo.userTokens[0].ExpiresAt)
+
+		// The personal access tokens would still reach the account
+		assert.Equal(t, []string{"user-2"}, deps.repo.revokedPATUsers)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		uc, deps := initSessions(t, now)
+
+		err := uc.RevokeUserSessions(context.Background(), admin, "user-9")
+		assert.ErrorIs(t, err, auth.ErrUserNotFound)
+		assert.Empty(t, deps.repo.revokedUsers)
+		assert.Empty(t, deps.repo.userTokens)
+		assert.Empty(t, deps.repo.revokedPATUsers)
+	})
+}
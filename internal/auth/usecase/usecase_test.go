@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq/producer"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/i18n"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+)
+
+// fakeRepo keeps the rows the tests need in memory. Calling a method it does
+// not implement panics through the nil embedded interface.
+type fakeRepo struct {
+	repository.Repository
+
+	now           func() time.Time
+	refreshTokens map[string]models.RefreshToken
+	sessions      map[string]models.Session
+	// revokedFamilies are the sessions revoked by RevokeSessions, by ID
+	revokedFamilies []string
+	revokedUsers    []string
+	revokedTokens   map[string]repository.RevokeTokenOptions
+	userTokens      []repository.RevokeUserTokensOptions
+	// revokedPATUsers are the users whose personal access tokens were revoked
+	revokedPATUsers []string
+	verifications   []repository.CreateEmailVerificationOptions
+	identities      []models.UserIdentity
+	// revokedErr fails the revocation lookups
+	revokedErr error
+}
+
+func newFakeRepo(now func() time.Time) *fakeRepo {
+	return &fakeRepo{
+		now:           now,
+		refreshTokens: make(map[string]models.RefreshToken),
+		sessions:      make(map[string]models.Session),
+		revokedTokens: make(map[string]repository.RevokeTokenOptions),
+	}
+}
+
+func (r *fakeRepo) CreateRefreshToken(ctx context.Context, sc models.Scope, opts repository.CreateRefreshTokenOptions) (models.RefreshToken, error) {
+	rt := models.RefreshToken{
+		ID:        opts.ID,
+		UserID:    opts.UserID,
+		FamilyID:  opts.FamilyID,
+		TokenHash: opts.TokenHash,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: r.now(),
+	}
+	r.refreshTokens[rt.ID] = rt
+	return rt, nil
+}
+
+func (r *fakeRepo) DetailRefreshToken(ctx context.Context, sc models.Scope, ID string) (models.RefreshToken, error) {
+	rt, ok := r.refreshTokens[ID]
+	if !ok {
+		return models.RefreshToken{}, repository.ErrNotFound
+	}
+	return rt, nil
+}
+
+func (r *fakeRepo) UseRefreshToken(ctx context.Context, sc models.Scope, ID string) error {
+	rt, ok := r.refreshTokens[ID]
+	if !ok || rt.UsedAt != nil || rt.RevokedAt != nil {
+		return repository.ErrNotFound
+	}
+	now := r.now()
+	rt.UsedAt = &now
+	r.refreshTokens[ID] = rt
+	return nil
+}
+
+func (r *fakeRepo) DetailSession(ctx context.Context, sc models.Scope, ID string) (models.Session, error) {
+	s, ok := r.sessions[ID]
+	if !ok {
+		return models.Session{}, repository.ErrNotFound
+	}
+	return s, nil
+}
+
+func (r *fakeRepo) ListSessions(ctx context.Context, sc models.Scope, opts repository.ListSessionsOptions) ([]models.Session, error) {
+	var ss []models.Session
+	for _, s := range r.sessions {
+		if s.UserID != opts.UserID || (opts.ActiveOnly && s.RevokedAt != nil) {
+			continue
+		}
+		ss = append(ss, s)
+	}
+	return ss, nil
+}
+
+func (r *fakeRepo) TouchSession(ctx context.Context, sc models.Scope, opts repository.TouchSessionOptions) error {
+	return nil
+}
+
+func (r *fakeRepo) RevokeSessions(ctx context.Context, sc models.Scope, opts repository.RevokeSessionsOptions) error {
+	now := r.now()
+	for id, s := range r.sessions {
+		if contains(opts.IDs, id) || (opts.UserID != "" && s.UserID == opts.UserID) {
+			s.RevokedAt = &now
+			r.sessions[id] = s
+		}
+	}
+	r.revokedFamilies = append(r.revokedFamilies, opts.IDs...)
+	if opts.UserID != "" {
+		r.revokedUsers = append(r.revokedUsers, opts.UserID)
+	}
+	for id, rt := range r.refreshTokens {
+		if contains(opts.IDs, rt.FamilyID) || (opts.UserID != "" && rt.UserID == opts.UserID) {
+			rt.RevokedAt = &now
+			r.refreshTokens[id] = rt
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepo) RevokeToken(ctx context.Context, sc models.Scope, opts repository.RevokeTokenOptions) error {
+	r.revokedTokens[opts.JTI] = opts
+	return nil
+}
+
+func (r *fakeRepo) RevokeUserTokens(ctx context.Context, sc models.Scope, opts repository.RevokeUserTokensOptions) error {
+	r.userTokens = append(r.userTokens, opts)
+	return nil
+}
+
+func (r *fakeRepo) RevokeUserPersonalAccessTokens(ctx context.Context, sc models.Scope, userID string) error {
+	r.revokedPATUsers = append(r.revokedPATUsers, userID)
+	return nil
+}
+
+func (r *fakeRepo) IsTokenRevoked(ctx context.Context, sc models.Scope, opts repository.IsTokenRevokedOptions) (bool, error) {
+	if r.revokedErr != nil {
+		return false, r.revokedErr
+	}
+	if _, ok := r.revokedTokens[opts.JTI]; ok {
+		return true, nil
+	}
+	return contains(r.revokedFamilies, opts.SessionID), nil
+}
+
+func (r *fakeRepo) CreateEmailVerification(ctx context.Context, sc models.Scope, opts repository.CreateEmailVerificationOptions) (models.EmailVerification, error) {
+	r.verifications = append(r.verifications, opts)
+	return models.EmailVerification{
+		UserID:    opts.UserID,
+		OTPHash:   opts.OTPHash,
+		ExpiresAt: opts.ExpiresAt,
+	}, nil
+}
+
+func (r *fakeRepo) ResetLoginFailures(ctx context.Context, sc models.Scope, userID string) error {
+	return nil
+}
+
+func (r *fakeRepo) DetailUserIdentity(ctx context.Context, sc models.Scope, opts repository.DetailUserIdentityOptions) (models.UserIdentity, error) {
+	for _, i := range r.identities {
+		if i.Issuer == opts.Issuer && i.Subject == opts.Subject {
+			return i, nil
+		}
+	}
+	return models.UserIdentity{}, repository.ErrNotFound
+}
+
+func (r *fakeRepo) CreateUserIdentity(ctx context.Context, sc models.Scope, opts repository.CreateUserIdentityOptions) (models.UserIdentity, error) {
+	i := models.UserIdentity{
+		ID:      opts.Subject,
+		UserID:  opts.UserID,
+		Issuer:  opts.Issuer,
+		Subject: opts.Subject,
+		Email:   opts.Email,
+	}
+	r.identities = append(r.identities, i)
+	return i, nil
+}
+
+func (r *fakeRepo) TouchUserIdentity(ctx context.Context, sc models.Scope, opts repository.TouchUserIdentityOptions) error {
+	return nil
+}
+
+func (r *fakeRepo) DeleteExpired(ctx context.Context, sc models.Scope) error {
+	return nil
+}
+
+// fakeUserUC returns the users it holds by ID.
+type fakeUserUC struct {
+	user.UseCase
+
+	users map[string]models.User
+}
+
+func (u fakeUserUC) Detail(ctx context.Context, sc models.Scope, ID string) (user.UserOutput, error) {
+	usr, ok := u.users[ID]
+	if !ok {
+		return user.UserOutput{}, user.ErrUserNotFound
+	}
+	return user.UserOutput{User: usr}, nil
+}
+
+func (u fakeUserUC) GetOne(ctx context.Context, sc models.Scope, ip user.GetOneInput) (models.User, error) {
+	for _, usr := range u.users {
+		if usr.Username == ip.Username {
+			return usr, nil
+		}
+	}
+	return models.User{}, user.ErrUserNotFound
+}
+
+// fakeProducer keeps the emails instead of publishing them.
+type fakeProducer struct {
+	producer.Producer
+
+	sent []rabbitmq.SendEmailMsg
+}
+
+func (p *fakeProducer) PublishSendEmail(ctx context.Context, msg rabbitmq.SendEmailMsg) error {
+	p.sent = append(p.sent, msg)
+	return nil
+}
+
+type mockDeps struct {
+	repo    *fakeRepo
+	scopeUC scope.Manager
+	prod    *fakeProducer
+	users   map[string]models.User
+}
+
+func initUseCase(t *testing.T, mockTime time.Time) (*implUseCase, mockDeps) {
+	t.Helper()
+	// The emails are rendered with the translations
+	i18n.Init()
+
+	clock := func() time.Time { return mockTime }
+	repo := newFakeRepo(clock)
+	scopeUC := scope.New("test-secret")
+	prod := &fakeProducer{}
+	users := map[string]models.User{
+		"user-1": {ID: "user-1", Username: "john@example.com", IsActive: true},
+	}
+
+	uc := &implUseCase{
+		l:       log.InitializeTestZapLogger(),
+		scopeUC: scopeUC,
+		repo:    repo,
+		userUC:  fakeUserUC{users: users},
+		prod:    prod,
+		limiter: ratelimit.NewMemoryLimiter(),
+		tokenCfg: auth.TokenConfig{
+			AccessTokenTTL:  15 * time.Minute,
+			RefreshTokenTTL: 24 * time.Hour,
+		},
+		clock: clock,
+	}
+
+	return uc, mockDeps{
+		repo:    repo,
+		scopeUC: scopeUC,
+		prod:    prod,
+		users:   users,
+	}
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
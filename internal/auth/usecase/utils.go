@@ -2,32 +2,140 @@ package usecase
 
 import (
 	"context"
-	"time"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 
 	"github.com/golang-jwt/jwt"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
 )
 
-// Helper function to generate JWT tokens
-func (uc *implUseCase) generateTokens(ctx context.Context, userID, username string) (string, error) {
+type tokenPair struct {
+	assToken string
+	rfrToken string
+}
+
+// generateTokens issues a short-lived access token and a refresh token that
+// belongs to the given family. The refresh token is persisted so it can be
+// rotated and revoked later.
+func (uc *implUseCase) generateTokens(ctx context.Context, userID, username, familyID string) (tokenPair, error) {
+	now := uc.clock()
+
 	assToken, err := uc.scopeUC.CreateToken(scope.Payload{
 		StandardClaims: jwt.StandardClaims{
-			Audience: "kanban-api",
-			// ExpiresAt: util.Now().Add(accessExpiry).Unix(),
-			IssuedAt: time.Now().Unix(),
-			Issuer:   "kanban-api",
-			// NotBefore: time.Now().Unix(),
-			Subject: userID,
+			Audience:  "kanban-api",
+			ExpiresAt: now.Add(uc.tokenCfg.AccessTokenTTL).Unix(),
+			Id:        postgres.NewUUID(),
+			IssuedAt:  now.Unix(),
+			Issuer:    "kanban-api",
+			NotBefore: now.Unix(),
+			Subject:   userID,
+		},
+		UserID:    userID,
+		Username:  username,
+		Type:      scope.TokenTypeAccess,
+		Refresh:   false,
+		SessionID: familyID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.generateTokens.scope.CreateToken: %v", err)
+		return tokenPair{}, err
+	}
+
+	rfrID := postgres.NewUUID()
+	rfrExpiresAt := now.Add(uc.tokenCfg.RefreshTokenTTL)
+	rfrToken, err := uc.scopeUC.CreateToken(scope.Payload{
+		StandardClaims: jwt.StandardClaims{
+			Audience:  "kanban-api",
+			ExpiresAt: rfrExpiresAt.Unix(),
+			Id:        rfrID,
+			IssuedAt:  now.Unix(),
+			Issuer:    "kanban-api",
+			NotBefore: now.Unix(),
+			Subject:   userID,
 		},
-		UserID:   userID,
-		Username: username,
-		Type:     "access",
-		Refresh:  false,
+		UserID:    userID,
+		Username:  username,
+		Type:      scope.TokenTypeRefresh,
+		Refresh:   true,
+		SessionID: familyID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.generateTokens.scope.CreateToken: %v", err)
+		return tokenPair{}, err
+	}
+
+	_, err = uc.repo.CreateRefreshToken(ctx, models.Scope{UserID: userID, Username: username}, repository.CreateRefreshTokenOptions{
+		ID:        rfrID,
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(rfrToken),
+		ExpiresAt: rfrExpiresAt,
 	})
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.auth.usecase.Login.scope.CreateToken: %v", err)
+		uc.l.Errorf(ctx, "internal.auth.usecase.generateTokens.repo.CreateRefreshToken: %v", err)
+		return tokenPair{}, err
+	}
+
+	return tokenPair{assToken: assToken, rfrToken: rfrToken}, nil
+}
+
+// startSession records a new login session and issues its first token pair.
+func (uc *implUseCase) startSession(ctx context.Context, u models.User, userAgent, ipAddress string) (tokenPair, error) {
+	s, err := uc.repo.CreateSession(ctx, models.Scope{UserID: u.ID, Username: u.Username}, repository.CreateSessionOptions{
+		ID:        postgres.NewUUID(),
+		UserID:    u.ID,
+		UserAgent: userAgent,
+		IPAddress: ipAddress,
+		ExpiresAt: uc.clock().Add(uc.tokenCfg.RefreshTokenTTL),
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.startSession.repo.CreateSession: %v", err)
+		return tokenPair{}, err
+	}
+
+	return uc.generateTokens(ctx, u.ID, u.Username, s.ID)
+}
+
+// hashToken returns the SHA-256 hex digest stored instead of the raw token.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// generateSecret returns a random URL-safe token of n bytes of entropy.
+func generateSecret(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
 		return "", err
 	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// sendEmail renders the template in the locale of the request and queues it
+// for the consumer to deliver.
+func (uc *implUseCase) sendEmail(ctx context.Context, meta email.EmailMeta, data interface{}) error {
+	e, err := email.NewEmail(ctx, meta, data)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.sendEmail.email.NewEmail: %v", err)
+		return err
+	}
+
+	if err := uc.prod.PublishSendEmail(ctx, rabbitmq.SendEmailMsg{
+		Subject:     e.Subject,
+		Recipient:   e.Recipient,
+		Body:        e.Body,
+		CcAddresses: e.CC,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.auth.usecase.sendEmail.prod.PublishSendEmail: %v", err)
+		return err
+	}
 
-	return assToken, nil
+	return nil
 }
@@ -1,15 +1,39 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 )
 
 var (
 	errWrongQuery = pkgErrors.NewHTTPError(10301, "Wrong query")
 	// errWrongBody     = pkgErrors.NewHTTPError(10302, "Wrong body")
-	errNotFound      = pkgErrors.NewHTTPError(10303, "Board not found")
-	errFieldRequired = pkgErrors.NewHTTPError(10304, "Field required")
+	errNotFound           = pkgErrors.NewHTTPError(10303, "Board not found")
+	errFieldRequired      = pkgErrors.NewHTTPError(10304, "Field required")
+	errForbidden          = &pkgErrors.HTTPError{Code: 10305, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errWorkspaceForbidden = &pkgErrors.HTTPError{Code: 10306, Message: "You do not have access to this workspace", StatusCode: http.StatusForbidden}
+	errNoPermission       = &pkgErrors.HTTPError{Code: 10307, Message: "You are not allowed to create boards", StatusCode: http.StatusForbidden}
+	errShareLinkNotFound  = &pkgErrors.HTTPError{Code: 10308, Message: "Share link not found", StatusCode: http.StatusNotFound}
+	errInvalidExpiry      = pkgErrors.NewHTTPError(10309, "Expiry must be in the future")
+	errBoardArchived      = &pkgErrors.HTTPError{Code: 10310, Message: "This board is archived and read-only", StatusCode: http.StatusForbidden}
+	errAlreadyArchived    = pkgErrors.NewHTTPError(10311, "Board is already archived")
+	errNotArchived        = pkgErrors.NewHTTPError(10312, "Board is not archived")
+	errTemplateNotFound   = pkgErrors.NewHTTPError(10313, "Template not found")
+	errInvalidDocument    = pkgErrors.NewHTTPError(10314, "Invalid board document")
+	errUnsupportedVersion = pkgErrors.NewHTTPError(10315, "Unsupported board document version")
+	errImportNotFound     = &pkgErrors.HTTPError{Code: 10316, Message: "Board import not found", StatusCode: http.StatusNotFound}
+	errInvalidSettings    = pkgErrors.NewHTTPError(10317, "Invalid board settings")
+	errWorkspaceRequired  = pkgErrors.NewHTTPError(10318, "Only a board in a workspace can be visible to the workspace")
+	errBackgroundNotFound = &pkgErrors.HTTPError{Code: 10319, Message: "Background image not found", StatusCode: http.StatusNotFound}
+	errBackgroundNotImage = pkgErrors.NewHTTPError(10320, "Background must be an image")
+	errCardKeyPrefixTaken = &pkgErrors.HTTPError{Code: 10321, Message: "Card key prefix is used by another board", StatusCode: http.StatusConflict}
+	errDocumentTooLarge   = &pkgErrors.HTTPError{Code: 10322, Message: "Board document too large", StatusCode: http.StatusRequestEntityTooLarge}
 )
 
 func (h handler) mapErrorCode(err error) error {
@@ -18,6 +42,40 @@ func (h handler) mapErrorCode(err error) error {
 		return errNotFound
 	case boards.ErrFieldRequired:
 		return errFieldRequired
+	case members.ErrForbidden:
+		return errForbidden
+	case members.ErrBoardArchived:
+		return errBoardArchived
+	case workspaces.ErrForbidden:
+		return errWorkspaceForbidden
+	case role.ErrForbidden:
+		return errNoPermission
+	case boards.ErrShareLinkNotFound:
+		return errShareLinkNotFound
+	case boards.ErrInvalidExpiry:
+		return errInvalidExpiry
+	case boards.ErrAlreadyArchived:
+		return errAlreadyArchived
+	case boards.ErrNotArchived:
+		return errNotArchived
+	case templates.ErrNotFound:
+		return errTemplateNotFound
+	case boards.ErrInvalidDocument:
+		return errInvalidDocument
+	case boards.ErrUnsupportedVersion:
+		return errUnsupportedVersion
+	case boards.ErrImportNotFound:
+		return errImportNotFound
+	case boards.ErrInvalidSettings:
+		return errInvalidSettings
+	case boards.ErrWorkspaceRequired:
+		return errWorkspaceRequired
+	case boards.ErrBackgroundNotFound:
+		return errBackgroundNotFound
+	case boards.ErrBackgroundNotAnImage:
+		return errBackgroundNotImage
+	case boards.ErrCardKeyPrefixTaken:
+		return errCardKeyPrefixTaken
 	default:
 		return err
 	}
@@ -25,4 +83,12 @@ func (h handler) mapErrorCode(err error) error {
 
 var NotFound = []error{
 	errNotFound,
+	errForbidden,
+	errWorkspaceForbidden,
+	errNoPermission,
+	errShareLinkNotFound,
+	errBoardArchived,
+	errTemplateNotFound,
+	errImportNotFound,
+	errBackgroundNotFound,
 }
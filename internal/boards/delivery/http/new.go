@@ -10,9 +10,30 @@ import (
 type Handler interface {
 	Get(c *gin.Context)
 	Create(c *gin.Context)
+	Clone(c *gin.Context)
+	Export(c *gin.Context)
+	Import(c *gin.Context)
+	ImportTrello(c *gin.Context)
+	DetailImport(c *gin.Context)
 	Update(c *gin.Context)
 	Detail(c *gin.Context)
+	Full(c *gin.Context)
 	Delete(c *gin.Context)
+	GetTrash(c *gin.Context)
+	Restore(c *gin.Context)
+	Purge(c *gin.Context)
+	Archive(c *gin.Context)
+	Unarchive(c *gin.Context)
+	GetAuditLogs(c *gin.Context)
+	Star(c *gin.Context)
+	Unstar(c *gin.Context)
+	GetRecent(c *gin.Context)
+
+	GetShareLink(c *gin.Context)
+	EnableShareLink(c *gin.Context)
+	UpdateShareLink(c *gin.Context)
+	DisableShareLink(c *gin.Context)
+	DetailShared(c *gin.Context)
 }
 
 type handler struct {
@@ -2,11 +2,13 @@ package http
 
 import (
 	"errors"
+	"time"
 
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
 	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
 type respObj struct {
@@ -15,17 +17,50 @@ type respObj struct {
 }
 
 type boardItem struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	Alias       string  `json:"alias,omitempty"`
-	CreatedBy   respObj `json:"created_by"`
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Alias       string     `json:"alias,omitempty"`
+	WorkspaceID *string    `json:"workspace_id,omitempty"`
+	CreatedBy   respObj    `json:"created_by"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	Settings    settingsItem `json:"settings"`
+	// Starred is only set in the lists of boards
+	Starred *bool `json:"starred,omitempty"`
+}
+
+type settingsItem struct {
+	Visibility      models.BoardVisibility `json:"visibility"`
+	DefaultPriority models.CardPriority    `json:"default_priority"`
+	CardKeyPrefix   string                 `json:"card_key_prefix"`
+	BackgroundColor string                 `json:"background_color"`
+	BackgroundImage string                 `json:"background_image"`
+	CommentsEnabled bool                   `json:"comments_enabled"`
+}
+
+func newSettingsItem(s models.BoardSettings) settingsItem {
+	return settingsItem{
+		Visibility:      s.Visibility,
+		DefaultPriority: s.DefaultPriority,
+		CardKeyPrefix:   s.CardKeyPrefix,
+		BackgroundColor: s.BackgroundColor,
+		BackgroundImage: s.BackgroundImage,
+		CommentsEnabled: s.CommentsEnabled,
+	}
 }
 
 // Get
 type getReq struct {
-	IDs       []string `form:"ids[]"`
-	Keyword   string   `form:"keyword"`
+	IDs         []string `form:"ids[]"`
+	Keyword     string   `form:"keyword"`
+	WorkspaceID string   `form:"workspace_id"`
+	// Archived keeps the archived boards when true, the active ones when false
+	Archived *bool `form:"archived"`
+	// Starred and Recent keep the boards the user starred or viewed recently
+	Starred   bool   `form:"starred"`
+	Recent    bool   `form:"recent"`
+	Sort      string `form:"sort"`
 	PageQuery paginator.PaginateQuery
 }
 
@@ -38,15 +73,30 @@ func (req getReq) validate() error {
 		}
 	}
 
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+
+	if !boards.Sort(req.Sort).IsValid() {
+		return errors.New("invalid sort")
+	}
+
 	return nil
 }
 
 func (req getReq) toInput() boards.GetInput {
 	return boards.GetInput{
 		Filter: boards.Filter{
-			IDs:     req.IDs,
-			Keyword: req.Keyword,
+			IDs:         req.IDs,
+			Keyword:     req.Keyword,
+			WorkspaceID: req.WorkspaceID,
+			Archived:    req.Archived,
 		},
+		Starred:  req.Starred,
+		Recent:   req.Recent,
+		Sort:     boards.Sort(req.Sort),
 		PagQuery: req.PageQuery,
 	}
 }
@@ -57,29 +107,84 @@ type getBoardResp struct {
 }
 
 func (h handler) newGetResp(o boards.GetOutput) getBoardResp {
+	return getBoardResp{
+		Items: h.newGetItems(o),
+		Meta:  o.Pagination.ToResponse(),
+	}
+}
+
+func (h handler) newGetItems(o boards.GetOutput) []boardItem {
 	userMap := make(map[string]models.User)
 	for _, u := range o.Users {
 		userMap[u.ID] = u
 	}
 
+	starred := make(map[string]bool, len(o.Starred))
+	for _, id := range o.Starred {
+		starred[id] = true
+	}
+
 	items := make([]boardItem, len(o.Boards))
 	for i, b := range o.Boards {
 		items[i] = boardItem{
-			ID:    b.ID,
-			Name:  b.Name,
-			Alias: b.Alias,
+			ID:          b.ID,
+			Name:        b.Name,
+			Alias:       b.Alias,
+			WorkspaceID: b.WorkspaceID,
 			CreatedBy: respObj{
 				ID:   userMap[*b.CreatedBy].ID,
 				Name: userMap[*b.CreatedBy].FullName,
 			},
+			ArchivedAt: b.ArchivedAt,
+			DeletedAt:  b.DeletedAt,
+			Settings:   newSettingsItem(b.Settings),
+			Starred:    util.ToPointer(starred[b.ID]),
 		}
 		if b.Description != nil {
 			items[i].Description = *b.Description
 		}
 	}
-	return getBoardResp{
-		Items: items,
-		Meta:  o.Pagination.ToResponse(),
+
+	return items
+}
+
+// Recent
+const (
+	defaultRecentLimit = 10
+	// maxRecentLimit is the number of viewed boards kept for each user
+	maxRecentLimit = 50
+)
+
+type recentReq struct {
+	Limit int `form:"limit"`
+}
+
+func (req recentReq) validate() error {
+	if req.Limit < 0 || req.Limit > maxRecentLimit {
+		return errors.New("invalid limit")
+	}
+
+	return nil
+}
+
+func (req recentReq) toInput() boards.GetRecentInput {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultRecentLimit
+	}
+
+	return boards.GetRecentInput{
+		Limit: limit,
+	}
+}
+
+type recentBoardResp struct {
+	Items []boardItem `json:"items"`
+}
+
+func (h handler) newRecentResp(o boards.GetOutput) recentBoardResp {
+	return recentBoardResp{
+		Items: h.newGetItems(o),
 	}
 }
 
@@ -87,12 +192,31 @@ func (h handler) newGetResp(o boards.GetOutput) getBoardResp {
 type createReq struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	WorkspaceID string `json:"workspace_id"`
+	TemplateID  string `json:"template_id"`
+}
+
+func (req createReq) validate() error {
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+	if req.TemplateID != "" {
+		if err := postgres.IsUUID(req.TemplateID); err != nil {
+			return errors.New("invalid template id")
+		}
+	}
+
+	return nil
 }
 
 func (req createReq) toInput() boards.CreateInput {
 	return boards.CreateInput{
 		Name:        req.Name,
 		Description: req.Description,
+		WorkspaceID: req.WorkspaceID,
+		TemplateID:  req.TemplateID,
 	}
 }
 
@@ -103,13 +227,17 @@ func (h handler) newItem(o boards.DetailOutput) boardItem {
 	}
 
 	item := boardItem{
-		ID:    o.Board.ID,
-		Name:  o.Board.Name,
-		Alias: o.Board.Alias,
+		ID:          o.Board.ID,
+		Name:        o.Board.Name,
+		Alias:       o.Board.Alias,
+		WorkspaceID: o.Board.WorkspaceID,
 		CreatedBy: respObj{
 			ID:   userMap[*o.Board.CreatedBy].ID,
 			Name: userMap[*o.Board.CreatedBy].FullName,
 		},
+		ArchivedAt: o.Board.ArchivedAt,
+		DeletedAt:  o.Board.DeletedAt,
+		Settings:   newSettingsItem(o.Board.Settings),
 	}
 	if o.Board.Description != nil {
 		item.Description = *o.Board.Description
@@ -117,19 +245,226 @@ func (h handler) newItem(o boards.DetailOutput) boardItem {
 	return item
 }
 
+// Clone
+type cloneReq struct {
+	ID              string `json:"-"`
+	Name            string `json:"name"`
+	IncludeCards    bool   `json:"include_cards"`
+	IncludeArchived bool   `json:"include_archived"`
+	KeepAssignees   bool   `json:"keep_assignees"`
+	// KeepDueDates keeps the due and start dates, moved by ShiftDays days
+	KeepDueDates    bool `json:"keep_due_dates"`
+	ShiftDays       int  `json:"shift_days"`
+	CopyAttachments bool `json:"copy_attachments"`
+}
+
+func (req cloneReq) toInput() boards.CloneInput {
+	return boards.CloneInput{
+		ID:              req.ID,
+		Name:            req.Name,
+		IncludeCards:    req.IncludeCards,
+		IncludeArchived: req.IncludeArchived,
+		KeepAssignees:   req.KeepAssignees,
+		KeepDueDates:    req.KeepDueDates,
+		ShiftDays:       req.ShiftDays,
+		CopyAttachments: req.CopyAttachments,
+	}
+}
+
+// cloneResp has no board while the copy is made in the background
+type cloneResp struct {
+	Board   *boardItem `json:"board,omitempty"`
+	Pending bool       `json:"pending"`
+}
+
+func (h handler) newCloneResp(o boards.CloneOutput) cloneResp {
+	resp := cloneResp{Pending: o.Pending}
+	if o.Board != nil {
+		item := h.newItem(boards.DetailOutput{
+			Board: *o.Board,
+			Users: o.Users,
+		})
+		resp.Board = &item
+	}
+
+	return resp
+}
+
+// Import
+type importReq struct {
+	WorkspaceID string `form:"workspace_id"`
+	Document    models.BoardExport `form:"-"`
+}
+
+func (req importReq) validate() error {
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+
+	return nil
+}
+
+func (req importReq) toInput() boards.ImportInput {
+	return boards.ImportInput{
+		Document:    req.Document,
+		WorkspaceID: req.WorkspaceID,
+	}
+}
+
+type importSkipItem struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+type importResp struct {
+	Board   boardItem        `json:"board"`
+	Skipped []importSkipItem `json:"skipped"`
+}
+
+func newImportSkipItems(ss []models.BoardImportSkip) []importSkipItem {
+	items := make([]importSkipItem, len(ss))
+	for i, s := range ss {
+		items[i] = importSkipItem{
+			Kind:   s.Kind,
+			ID:     s.ID,
+			Reason: s.Reason,
+		}
+	}
+
+	return items
+}
+
+func (h handler) newImportResp(o boards.ImportOutput) importResp {
+	return importResp{
+		Board: h.newItem(boards.DetailOutput{
+			Board: o.Board,
+			Users: o.Users,
+		}),
+		Skipped: newImportSkipItems(o.Skipped),
+	}
+}
+
+// Trello import
+// maxTrelloDocumentSize is the largest Trello export read by the import, the
+// export is held in memory until the board is created
+const maxTrelloDocumentSize = 50 << 20
+
+type importTrelloReq struct {
+	WorkspaceID string `form:"workspace_id"`
+	// Data is the board export of Trello, sent as the body
+	Data []byte `form:"-"`
+}
+
+func (req importTrelloReq) validate() error {
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+	if len(req.Data) == 0 {
+		return errors.New("empty document")
+	}
+
+	return nil
+}
+
+func (req importTrelloReq) toInput() boards.ImportTrelloInput {
+	return boards.ImportTrelloInput{
+		Data:        req.Data,
+		WorkspaceID: req.WorkspaceID,
+	}
+}
+
+type boardImportResp struct {
+	ID           string           `json:"id"`
+	Source       string           `json:"source"`
+	Status       string           `json:"status"`
+	WorkspaceID  *string          `json:"workspace_id,omitempty"`
+	BoardID      *string          `json:"board_id,omitempty"`
+	Skipped      []importSkipItem `json:"skipped"`
+	ErrorMessage *string          `json:"error_message,omitempty"`
+	CreatedAt    time.Time        `json:"created_at"`
+	CompletedAt  *time.Time       `json:"completed_at,omitempty"`
+}
+
+func (h handler) newBoardImportResp(imp models.BoardImport) boardImportResp {
+	return boardImportResp{
+		ID:           imp.ID,
+		Source:       string(imp.Source),
+		Status:       string(imp.Status),
+		WorkspaceID:  imp.WorkspaceID,
+		BoardID:      imp.BoardID,
+		Skipped:      newImportSkipItems(imp.Report.Skipped),
+		ErrorMessage: imp.ErrorMessage,
+		CreatedAt:    imp.CreatedAt,
+		CompletedAt:  imp.CompletedAt,
+	}
+}
+
 // Update
 type updateReq struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	// WorkspaceID moves the board, an empty string takes it out of its workspace
+	WorkspaceID *string `json:"workspace_id"`
+	// Settings changes the settings that are set
+	Settings *settingsReq `json:"settings"`
+}
+
+// settingsReq leaves out the settings that are not changed, an empty string
+// removes the prefix, color or image.
+type settingsReq struct {
+	Visibility      *string `json:"visibility"`
+	DefaultPriority *string `json:"default_priority"`
+	CardKeyPrefix   *string `json:"card_key_prefix"`
+	BackgroundColor *string `json:"background_color"`
+	BackgroundImage *string `json:"background_image"`
+	CommentsEnabled *bool   `json:"comments_enabled"`
+}
+
+func (req updateReq) validate() error {
+	if req.WorkspaceID != nil && *req.WorkspaceID != "" {
+		if err := postgres.IsUUID(*req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+
+	if req.Settings != nil && req.Settings.BackgroundImage != nil && *req.Settings.BackgroundImage != "" {
+		if err := postgres.IsUUID(*req.Settings.BackgroundImage); err != nil {
+			return errors.New("invalid background image")
+		}
+	}
+
+	return nil
 }
 
 func (req updateReq) toInput() boards.UpdateInput {
-	return boards.UpdateInput{
+	ip := boards.UpdateInput{
 		ID:          req.ID,
 		Name:        req.Name,
 		Description: req.Description,
+		WorkspaceID: req.WorkspaceID,
 	}
+	if req.Settings != nil {
+		ip.Settings = &boards.SettingsInput{
+			CardKeyPrefix:   req.Settings.CardKeyPrefix,
+			BackgroundColor: req.Settings.BackgroundColor,
+			BackgroundImage: req.Settings.BackgroundImage,
+			CommentsEnabled: req.Settings.CommentsEnabled,
+		}
+		if req.Settings.Visibility != nil {
+			ip.Settings.Visibility = util.ToPointer(models.BoardVisibility(*req.Settings.Visibility))
+		}
+		if req.Settings.DefaultPriority != nil {
+			ip.Settings.DefaultPriority = util.ToPointer(models.CardPriority(*req.Settings.DefaultPriority))
+		}
+	}
+
+	return ip
 }
 
 // Delete
@@ -148,3 +483,378 @@ func (req deleteReq) validate() error {
 
 	return nil
 }
+
+// Trash
+type trashReq struct {
+	PageQuery paginator.PaginateQuery
+}
+
+func (req trashReq) toInput() boards.GetTrashInput {
+	return boards.GetTrashInput{
+		PagQuery: req.PageQuery,
+	}
+}
+
+// Audit logs
+type auditLogsReq struct {
+	BoardID   string `form:"-"`
+	PageQuery paginator.PaginateQuery
+}
+
+func (req auditLogsReq) validate() error {
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		return errors.New("invalid board id")
+	}
+
+	return nil
+}
+
+func (req auditLogsReq) toInput() boards.GetAuditLogsInput {
+	return boards.GetAuditLogsInput{
+		BoardID:  req.BoardID,
+		PagQuery: req.PageQuery,
+	}
+}
+
+type auditLogItem struct {
+	ID        string                  `json:"id"`
+	BoardName string                  `json:"board_name"`
+	Action    models.BoardAuditAction `json:"action"`
+	User      *respObj                `json:"user,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type getAuditLogsResp struct {
+	Items []auditLogItem              `json:"items"`
+	Meta  paginator.PaginatorResponse `json:"meta"`
+}
+
+func (h handler) newGetAuditLogsResp(o boards.GetAuditLogsOutput) getAuditLogsResp {
+	userMap := make(map[string]models.User, len(o.Users))
+	for _, u := range o.Users {
+		userMap[u.ID] = u
+	}
+
+	items := make([]auditLogItem, len(o.Logs))
+	for i, l := range o.Logs {
+		items[i] = auditLogItem{
+			ID:        l.ID,
+			BoardName: l.BoardName,
+			Action:    l.Action,
+			CreatedAt: l.CreatedAt,
+		}
+		if l.UserID != nil {
+			items[i].User = &respObj{
+				ID:   *l.UserID,
+				Name: userMap[*l.UserID].FullName,
+			}
+		}
+	}
+
+	return getAuditLogsResp{
+		Items: items,
+		Meta:  o.Pagination.ToResponse(),
+	}
+}
+
+// Share link
+type shareLinkReq struct {
+	BoardID string `json:"-"`
+	// ExpiresAt is omitted for a link that never expires
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+func (req shareLinkReq) toEnableInput() boards.EnableShareLinkInput {
+	return boards.EnableShareLinkInput{
+		BoardID:   req.BoardID,
+		ExpiresAt: req.ExpiresAt,
+	}
+}
+
+func (req shareLinkReq) toUpdateInput() boards.UpdateShareLinkInput {
+	return boards.UpdateShareLinkInput{
+		BoardID:   req.BoardID,
+		ExpiresAt: req.ExpiresAt,
+	}
+}
+
+type shareLinkResp struct {
+	BoardID   string     `json:"board_id"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	// Token is only returned when the link is enabled or rotated
+	Token string `json:"token,omitempty"`
+}
+
+func (h handler) newShareLinkResp(o boards.ShareLinkOutput) shareLinkResp {
+	return shareLinkResp{
+		BoardID:   o.Link.BoardID,
+		ExpiresAt: o.Link.ExpiresAt,
+		CreatedAt: o.Link.CreatedAt,
+		UpdatedAt: o.Link.UpdatedAt,
+		Token:     o.Token,
+	}
+}
+
+// Shared board, the items leave out every user of the board
+type sharedLabelItem struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type sharedCardItem struct {
+	ID             string                 `json:"id"`
+	ListID         string                 `json:"list_id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description,omitempty"`
+	Position       string                 `json:"position"`
+	Priority       models.CardPriority    `json:"priority"`
+	Labels         []string               `json:"labels,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	Checklist      []models.ChecklistItem `json:"checklist,omitempty"`
+	DueDate        *time.Time             `json:"due_date,omitempty"`
+	StartDate      *time.Time             `json:"start_date,omitempty"`
+	CompletionDate *time.Time             `json:"completion_date,omitempty"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+type sharedListItem struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	Position string           `json:"position"`
+	Cards    []sharedCardItem `json:"cards"`
+}
+
+type sharedBoardResp struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Lists       []sharedListItem  `json:"lists"`
+	Labels      []sharedLabelItem `json:"labels"`
+}
+
+func (h handler) newSharedBoardResp(o boards.BoardWithDetailsOutput) sharedBoardResp {
+	cardsByList := make(map[string][]sharedCardItem, len(o.Lists))
+	for _, c := range o.Cards {
+		cardsByList[c.ListID] = append(cardsByList[c.ListID], sharedCardItem{
+			ID:             c.ID,
+			ListID:         c.ListID,
+			Name:           c.Name,
+			Description:    c.Description,
+			Position:       c.Position,
+			Priority:       c.Priority,
+			Labels:         c.Labels,
+			Tags:           c.Tags,
+			Checklist:      c.Checklist,
+			DueDate:        c.DueDate,
+			StartDate:      c.StartDate,
+			CompletionDate: c.CompletionDate,
+			UpdatedAt:      c.UpdatedAt,
+		})
+	}
+
+	lists := make([]sharedListItem, len(o.Lists))
+	for i, l := range o.Lists {
+		cs := cardsByList[l.ID]
+		if cs == nil {
+			cs = []sharedCardItem{}
+		}
+		lists[i] = sharedListItem{
+			ID:       l.ID,
+			Name:     l.Name,
+			Position: l.Position,
+			Cards:    cs,
+		}
+	}
+
+	labels := make([]sharedLabelItem, len(o.Labels))
+	for i, lb := range o.Labels {
+		labels[i] = sharedLabelItem{
+			ID:    lb.ID,
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+	}
+
+	resp := sharedBoardResp{
+		ID:     o.Board.ID,
+		Name:   o.Board.Name,
+		Lists:  lists,
+		Labels: labels,
+	}
+	if o.Board.Description != nil {
+		resp.Description = *o.Board.Description
+	}
+
+	return resp
+}
+
+// Full board
+type fullReq struct {
+	ID              string `form:"-"`
+	IncludeArchived bool   `form:"include_archived"`
+}
+
+func (req fullReq) validate() error {
+	if err := postgres.IsUUID(req.ID); err != nil {
+		return errors.New("invalid id")
+	}
+
+	return nil
+}
+
+func (req fullReq) toInput() boards.DetailFullInput {
+	return boards.DetailFullInput{
+		ID:              req.ID,
+		IncludeArchived: req.IncludeArchived,
+	}
+}
+
+type fullUserItem struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+type fullMemberItem struct {
+	UserID string           `json:"user_id"`
+	Role   models.BoardRole `json:"role"`
+}
+
+type fullListItem struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Position   string `json:"position"`
+	IsArchived bool   `json:"is_archived"`
+}
+
+type fullCardItem struct {
+	ID             string                 `json:"id"`
+	ListID         string                 `json:"list_id"`
+	Name           string                 `json:"name"`
+	Alias          string                 `json:"alias"`
+	Description    string                 `json:"description,omitempty"`
+	Position       string                 `json:"position"`
+	Priority       models.CardPriority    `json:"priority"`
+	Labels         []string               `json:"labels,omitempty"`
+	Tags           []string               `json:"tags,omitempty"`
+	Checklist      []models.ChecklistItem `json:"checklist,omitempty"`
+	Attachments    []string               `json:"attachments,omitempty"`
+	IsArchived     bool                   `json:"is_archived"`
+	AssignedTo     *string                `json:"assigned_to,omitempty"`
+	EstimatedHours *float64               `json:"estimated_hours,omitempty"`
+	ActualHours    *float64               `json:"actual_hours,omitempty"`
+	DueDate        *time.Time             `json:"due_date,omitempty"`
+	StartDate      *time.Time             `json:"start_date,omitempty"`
+	CompletionDate *time.Time             `json:"completion_date,omitempty"`
+	CreatedBy      *string                `json:"created_by,omitempty"`
+	UpdatedBy      *string                `json:"updated_by,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+// fullBoardResp keeps the items flat, the users are referenced by ID from
+// the members and cards.
+type fullBoardResp struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Alias       string            `json:"alias,omitempty"`
+	Description string            `json:"description,omitempty"`
+	WorkspaceID *string           `json:"workspace_id,omitempty"`
+	CreatedBy   *string           `json:"created_by,omitempty"`
+	Version     int64             `json:"version"`
+	Lists       []fullListItem    `json:"lists"`
+	Cards       []fullCardItem    `json:"cards"`
+	Labels      []sharedLabelItem `json:"labels"`
+	Members     []fullMemberItem  `json:"members"`
+	Users       []fullUserItem    `json:"users"`
+}
+
+func (h handler) newFullBoardResp(o boards.BoardWithDetailsOutput) fullBoardResp {
+	lists := make([]fullListItem, len(o.Lists))
+	for i, l := range o.Lists {
+		lists[i] = fullListItem{
+			ID:         l.ID,
+			Name:       l.Name,
+			Position:   l.Position,
+			IsArchived: l.IsArchived,
+		}
+	}
+
+	cards := make([]fullCardItem, len(o.Cards))
+	for i, c := range o.Cards {
+		cards[i] = fullCardItem{
+			ID:             c.ID,
+			ListID:         c.ListID,
+			Name:           c.Name,
+			Alias:          c.Alias,
+			Description:    c.Description,
+			Position:       c.Position,
+			Priority:       c.Priority,
+			Labels:         c.Labels,
+			Tags:           c.Tags,
+			Checklist:      c.Checklist,
+			Attachments:    c.Attachments,
+			IsArchived:     c.IsArchived,
+			AssignedTo:     c.AssignedTo,
+			EstimatedHours: c.EstimatedHours,
+			ActualHours:    c.ActualHours,
+			DueDate:        c.DueDate,
+			StartDate:      c.StartDate,
+			CompletionDate: c.CompletionDate,
+			CreatedBy:      c.CreatedBy,
+			UpdatedBy:      c.UpdatedBy,
+			CreatedAt:      c.CreatedAt,
+			UpdatedAt:      c.UpdatedAt,
+		}
+	}
+
+	labels := make([]sharedLabelItem, len(o.Labels))
+	for i, lb := range o.Labels {
+		labels[i] = sharedLabelItem{
+			ID:    lb.ID,
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+	}
+
+	members := make([]fullMemberItem, len(o.Members))
+	for i, m := range o.Members {
+		members[i] = fullMemberItem{
+			UserID: m.UserID,
+			Role:   m.Role,
+		}
+	}
+
+	users := make([]fullUserItem, len(o.Users))
+	for i, u := range o.Users {
+		users[i] = fullUserItem{
+			ID:        u.ID,
+			Username:  u.Username,
+			FullName:  u.FullName,
+			AvatarURL: u.AvatarURL,
+		}
+	}
+
+	resp := fullBoardResp{
+		ID:          o.Board.ID,
+		Name:        o.Board.Name,
+		Alias:       o.Board.Alias,
+		WorkspaceID: o.Board.WorkspaceID,
+		CreatedBy:   o.Board.CreatedBy,
+		Version:     o.Board.Version,
+		Lists:       lists,
+		Cards:       cards,
+		Labels:      labels,
+		Members:     members,
+		Users:       users,
+	}
+	if o.Board.Description != nil {
+		resp.Description = *o.Board.Description
+	}
+
+	return resp
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSharedBoardResp(t *testing.T) {
+	userID := "user-1"
+	workspaceID := "workspace-1"
+	o := boards.BoardWithDetailsOutput{
+		Board: models.Board{
+			ID:          "board-1",
+			Name:        "Roadmap",
+			WorkspaceID: &workspaceID,
+			CreatedBy:   &userID,
+			ArchivedBy:  &userID,
+		},
+		Lists: []models.List{{ID: "list-1", Name: "Todo", CreatedBy: &userID}},
+		Cards: []models.Card{{
+			ID:          "card-1",
+			ListID:      "list-1",
+			Name:        "Ship it",
+			CreatedBy:   &userID,
+			UpdatedBy:   &userID,
+			AssignedTo:  &userID,
+			Attachments: []string{"upload-1"},
+		}},
+		Labels: []models.Label{{ID: "label-1", Name: "bug", CreatedBy: &userID, UpdatedBy: &userID}},
+	}
+
+	resp := handler{}.newSharedBoardResp(o)
+	require.Len(t, resp.Lists, 1)
+	require.Len(t, resp.Lists[0].Cards, 1)
+	assert.Equal(t, "card-1", resp.Lists[0].Cards[0].ID)
+
+	// The response is built field by field, whatever the usecase lets through
+	raw, err := json.Marshal(resp)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), userID)
+	assert.NotContains(t, string(raw), workspaceID)
+	assert.NotContains(t, string(raw), "upload-1")
+}
@@ -1,6 +1,9 @@
 package http
 
 import (
+	"errors"
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
@@ -32,6 +35,29 @@ func (h handler) processGetRequest(c *gin.Context) (getReq, models.Scope, error)
 	return req, scope.NewScope(p), nil
 }
 
+func (h handler) processRecentRequest(c *gin.Context) (recentReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processRecentRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return recentReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req recentReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processRecentRequest.c.ShouldBindQuery: %v", err)
+		return recentReq{}, models.Scope{}, errWrongQuery
+	}
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processRecentRequest.req.validate: %v", err)
+		return recentReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
 func (h handler) processCreateRequest(c *gin.Context) (createReq, models.Scope, error) {
 	ctx := c.Request.Context()
 
@@ -47,6 +73,101 @@ func (h handler) processCreateRequest(c *gin.Context) (createReq, models.Scope,
 		return createReq{}, models.Scope{}, errWrongQuery
 	}
 
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processCreateRequest.req.validate: %v", err)
+		return createReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processCloneRequest(c *gin.Context) (cloneReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processCloneRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return cloneReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req cloneReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.l.Errorf(ctx, "internal.boards.delivery.http.processCloneRequest.c.ShouldBindJSON: %v", err)
+			return cloneReq{}, models.Scope{}, errWrongQuery
+		}
+	}
+
+	req.ID = c.Param("id")
+	if err := postgres.IsUUID(req.ID); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processCloneRequest.c.Param: %v", err)
+		return cloneReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processImportRequest(c *gin.Context) (importReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return importReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req importReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportRequest.c.ShouldBindQuery: %v", err)
+		return importReq{}, models.Scope{}, errWrongQuery
+	}
+
+	if err := c.ShouldBindJSON(&req.Document); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportRequest.c.ShouldBindJSON: %v", err)
+		return importReq{}, models.Scope{}, errInvalidDocument
+	}
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportRequest.req.validate: %v", err)
+		return importReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processImportTrelloRequest(c *gin.Context) (importTrelloReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportTrelloRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return importTrelloReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req importTrelloReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportTrelloRequest.c.ShouldBindQuery: %v", err)
+		return importTrelloReq{}, models.Scope{}, errWrongQuery
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxTrelloDocumentSize)
+	data, err := c.GetRawData()
+	if err != nil {
+		var mbe *http.MaxBytesError
+		if errors.As(err, &mbe) {
+			h.l.Warnf(ctx, "internal.boards.delivery.http.processImportTrelloRequest.c.GetRawData: %v", err)
+			return importTrelloReq{}, models.Scope{}, errDocumentTooLarge
+		}
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportTrelloRequest.c.GetRawData: %v", err)
+		return importTrelloReq{}, models.Scope{}, errInvalidDocument
+	}
+	req.Data = data
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processImportTrelloRequest.req.validate: %v", err)
+		return importTrelloReq{}, models.Scope{}, errWrongQuery
+	}
+
 	return req, scope.NewScope(p), nil
 }
 
@@ -65,6 +186,11 @@ func (h handler) processUpdateRequest(c *gin.Context) (updateReq, models.Scope,
 		return updateReq{}, models.Scope{}, errWrongQuery
 	}
 
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processUpdateRequest.req.validate: %v", err)
+		return updateReq{}, models.Scope{}, errWrongQuery
+	}
+
 	return req, scope.NewScope(p), nil
 }
 
@@ -86,6 +212,74 @@ func (h handler) processDetailRequest(c *gin.Context) (string, models.Scope, err
 	return id, scope.NewScope(p), nil
 }
 
+func (h handler) processFullRequest(c *gin.Context) (fullReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processFullRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return fullReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req fullReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processFullRequest.c.ShouldBindQuery: %v", err)
+		return fullReq{}, models.Scope{}, errWrongQuery
+	}
+	req.ID = c.Param("id")
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processFullRequest.req.validate: %v", err)
+		return fullReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processTrashRequest(c *gin.Context) (trashReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processTrashRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return trashReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req trashReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processTrashRequest.c.ShouldBindQuery: %v", err)
+		return trashReq{}, models.Scope{}, errWrongQuery
+	}
+	req.PageQuery.Adjust()
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processAuditLogsRequest(c *gin.Context) (auditLogsReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processAuditLogsRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return auditLogsReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req auditLogsReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processAuditLogsRequest.c.ShouldBindQuery: %v", err)
+		return auditLogsReq{}, models.Scope{}, errWrongQuery
+	}
+	req.BoardID = c.Param("id")
+	req.PageQuery.Adjust()
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processAuditLogsRequest.req.validate: %v", err)
+		return auditLogsReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
 func (h handler) processDeleteRequest(c *gin.Context) (deleteReq, models.Scope, error) {
 	ctx := c.Request.Context()
 
@@ -108,3 +302,42 @@ func (h handler) processDeleteRequest(c *gin.Context) (deleteReq, models.Scope,
 
 	return req, scope.NewScope(p), nil
 }
+
+func (h handler) processShareLinkRequest(c *gin.Context) (shareLinkReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processShareLinkRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return shareLinkReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req shareLinkReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			h.l.Errorf(ctx, "internal.boards.delivery.http.processShareLinkRequest.c.ShouldBindJSON: %v", err)
+			return shareLinkReq{}, models.Scope{}, errWrongQuery
+		}
+	}
+
+	req.BoardID = c.Param("id")
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		h.l.Errorf(ctx, "internal.boards.delivery.http.processShareLinkRequest.c.Param: %v", err)
+		return shareLinkReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+// processSharedRequest reads the token of a share link, the route has no user.
+func (h handler) processSharedRequest(c *gin.Context) (string, error) {
+	ctx := c.Request.Context()
+
+	token := c.Param("token")
+	if token == "" {
+		h.l.Warnf(ctx, "internal.boards.delivery.http.processSharedRequest.c.Param: %v", "token is empty")
+		return "", errWrongQuery
+	}
+
+	return token, nil
+}
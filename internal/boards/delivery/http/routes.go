@@ -1,6 +1,8 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
 )
@@ -11,5 +13,44 @@ func MapBoardRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
 	r.POST("", h.Create)
 	r.PUT("", h.Update)
 	r.GET("/:id", h.Detail)
+	r.GET("/:id/full", h.Full)
+	r.POST("/:id/clone", h.Clone)
+	r.GET("/:id/export", h.Export)
+	r.POST("/import", h.Import)
+	r.POST("/import/trello", h.ImportTrello)
+	r.GET("/imports/:id", h.DetailImport)
 	r.DELETE("", h.Delete)
+
+	r.GET("/trash", h.GetTrash)
+	r.POST("/:id/restore", h.Restore)
+	r.DELETE("/:id/purge", h.Purge)
+	r.POST("/:id/archive", h.Archive)
+	r.POST("/:id/unarchive", h.Unarchive)
+	r.GET("/:id/audit-logs", h.GetAuditLogs)
+	r.POST("/:id/star", h.Star)
+	r.DELETE("/:id/star", h.Unstar)
+
+	r.GET("/:id/share", h.GetShareLink)
+	r.POST("/:id/share", h.EnableShareLink)
+	r.PUT("/:id/share", h.UpdateShareLink)
+	r.DELETE("/:id/share", h.DisableShareLink)
+}
+
+// MapMyBoardRoutes serves the boards of the signed in user.
+func MapMyBoardRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.Use(mw.Auth())
+	r.GET("/recent", h.GetRecent)
+}
+
+// sharedRateLimit slows down guessing share link tokens.
+var sharedRateLimit = middleware.RateLimitPolicy{
+	Name:   "boards_shared",
+	Limit:  60,
+	Window: time.Minute,
+}
+
+// MapSharedBoardRoutes serves the boards opened by a share link, without
+// authentication.
+func MapSharedBoardRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.GET("/:token", mw.RateLimit(sharedRateLimit), h.DetailShared)
 }
@@ -14,5 +14,72 @@ type Repository interface {
 	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.Board, error)
 	Update(ctx context.Context, sc models.Scope, opts UpdateOptions) (models.Board, error)
 	Detail(ctx context.Context, sc models.Scope, id string) (models.Board, error)
+	// Delete moves the boards to the trash.
 	Delete(ctx context.Context, sc models.Scope, ids []string) error
+	// GetVersion returns the current version of a board.
+	GetVersion(ctx context.Context, sc models.Scope, id string) (int64, error)
+	// ListCardKeyPrefixes returns the card key prefixes starting with prefix,
+	// the ones of the boards in the trash included.
+	ListCardKeyPrefixes(ctx context.Context, sc models.Scope, prefix string) ([]string, error)
+
+	// DetailDeleted returns a board in the trash.
+	DetailDeleted(ctx context.Context, sc models.Scope, id string) (models.Board, error)
+	// Archive, Unarchive, Restore and Purge change the lifecycle of a board
+	// and record it in the audit log. They return ErrNotFound when the board
+	// is not in the state the change starts from.
+	Archive(ctx context.Context, sc models.Scope, id string) (models.Board, error)
+	Unarchive(ctx context.Context, sc models.Scope, id string) (models.Board, error)
+	Restore(ctx context.Context, sc models.Scope, id string) (models.Board, error)
+	// Purge deletes a board in the trash for good, with everything on it.
+	Purge(ctx context.Context, sc models.Scope, id string) error
+	// ListAttachmentIDs returns the uploads attached to the cards of a board,
+	// trashed cards included. Links and uploads still attached to the cards
+	// of another board are left out.
+	ListAttachmentIDs(ctx context.Context, sc models.Scope, boardID string) ([]string, error)
+	ListAuditLogs(ctx context.Context, sc models.Scope, opts ListAuditLogsOptions) ([]models.BoardAuditLog, paginator.Paginator, error)
+
+	// ListContent loads the lists, cards and labels of a board.
+	ListContent(ctx context.Context, sc models.Scope, opts ListContentOptions) (Content, error)
+	// CreateWithContent creates a board, its owner and its content in one
+	// transaction. The board ID of the content options is ignored.
+	CreateWithContent(ctx context.Context, sc models.Scope, opts CreateWithContentOptions) (models.Board, Content, error)
+
+	CreateImport(ctx context.Context, sc models.Scope, opts CreateImportOptions) (models.BoardImport, error)
+	// UpdateImport records the progress of an import, the board and report
+	// are set when it completes. It returns ErrNotFound when the import is not
+	// in the status the options start from.
+	UpdateImport(ctx context.Context, sc models.Scope, opts UpdateImportOptions) (models.BoardImport, error)
+	DetailImport(ctx context.Context, sc models.Scope, id string) (models.BoardImport, error)
+	// FailStaleImports marks the imports that stopped making progress as
+	// failed, like the ones running when the server stopped.
+	FailStaleImports(ctx context.Context, sc models.Scope, opts FailStaleImportsOptions) error
+
+	DetailShareLink(ctx context.Context, sc models.Scope, opts DetailShareLinkOptions) (models.BoardShareLink, error)
+	// UpsertShareLink creates the share link of the board, or replaces the
+	// token and expiry of the existing one.
+	UpsertShareLink(ctx context.Context, sc models.Scope, opts UpsertShareLinkOptions) (models.BoardShareLink, error)
+	UpdateShareLinkExpiry(ctx context.Context, sc models.Scope, opts UpdateShareLinkExpiryOptions) (models.BoardShareLink, error)
+	DeleteShareLink(ctx context.Context, sc models.Scope, boardID string) error
+
+	// Star and Unstar change the starred boards of the user of the scope, both
+	// do nothing when there is nothing to change.
+	Star(ctx context.Context, sc models.Scope, boardID string) error
+	Unstar(ctx context.Context, sc models.Scope, boardID string) error
+	// ListStarredIDs returns the boards among boardIDs the user of the scope
+	// starred.
+	ListStarredIDs(ctx context.Context, sc models.Scope, boardIDs []string) ([]string, error)
+	// RecordView records the view of a board by the user of the scope, only
+	// the last views of the user are kept.
+	RecordView(ctx context.Context, sc models.Scope, opts RecordViewOptions) error
+	// ListRecent lists the live boards the user of the scope viewed, the last
+	// viewed first.
+	ListRecent(ctx context.Context, sc models.Scope, opts ListRecentOptions) ([]models.Board, error)
+}
+
+type Content struct {
+	Lists      []models.List
+	Cards      []models.Card
+	Labels     []models.Label
+	Comments   []models.Comment
+	Activities []models.CardActivity
 }
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
@@ -11,7 +13,9 @@ type ListOptions struct {
 }
 
 type GetOptions struct {
-	Filter   boards.Filter
+	Filter boards.Filter
+	// Sort orders the boards for the user of the scope
+	Sort     boards.Sort
 	PagQuery paginator.PaginateQuery
 }
 
@@ -19,6 +23,9 @@ type CreateOptions struct {
 	Name        string
 	Description string
 	Alias       string
+	WorkspaceID string
+	// Settings are the default settings when nil
+	Settings *models.BoardSettings
 }
 
 type UpdateOptions struct {
@@ -26,5 +33,156 @@ type UpdateOptions struct {
 	Name        string
 	Description string
 	Alias       string
-	OldModel    models.Board
+	WorkspaceID *string
+	// Settings are the settings after the update, only the ones that differ
+	// from the settings of OldModel are written
+	Settings models.BoardSettings
+	OldModel models.Board
+}
+
+type ListContentOptions struct {
+	BoardID string
+	// IncludeArchived also loads the archived lists and cards
+	IncludeArchived bool
+	// IncludeHistory also loads the comments and activities of the cards
+	IncludeHistory bool
+}
+
+// DetailShareLinkOptions finds a share link by board or by token hash.
+type DetailShareLinkOptions struct {
+	BoardID   string
+	TokenHash string
+}
+
+type UpsertShareLinkOptions struct {
+	BoardID   string
+	TokenHash string
+	ExpiresAt *time.Time
+}
+
+type UpdateShareLinkExpiryOptions struct {
+	BoardID   string
+	ExpiresAt *time.Time
+}
+
+// CreateWithContentOptions creates a board owned by the user together with
+// its content and its other members.
+type CreateWithContentOptions struct {
+	Board   CreateOptions
+	Content CreateContentOptions
+	// Members other than the user, the user is the owner of the board
+	Members []MemberOptions
+}
+
+type MemberOptions struct {
+	UserID string
+	Role   models.BoardRole
+}
+
+type CreateImportOptions struct {
+	Source      models.BoardImportSource
+	WorkspaceID string
+}
+
+type UpdateImportOptions struct {
+	ID string
+	// FromStatus only updates the import while it is in this status
+	FromStatus   models.BoardImportStatus
+	Status       models.BoardImportStatus
+	BoardID      string
+	Report       *models.BoardImportReport
+	ErrorMessage string
+}
+
+// FailStaleImportsOptions fails the pending and running imports not updated
+// since UpdatedBefore.
+type FailStaleImportsOptions struct {
+	UpdatedBefore time.Time
+	ErrorMessage  string
+}
+
+// ListRecentOptions lists the boards the user of the scope viewed, the last
+// viewed first.
+type ListRecentOptions struct {
+	// MemberID keeps the boards the user is a member of and the public ones,
+	// all the viewed boards are kept when it is empty
+	MemberID string
+	Limit    int
+}
+
+type RecordViewOptions struct {
+	BoardID string
+	// Keep is the number of views of the user kept, the older ones are
+	// deleted
+	Keep int
+}
+
+type ListAuditLogsOptions struct {
+	BoardID  string
+	PagQuery paginator.PaginateQuery
+}
+
+// CreateContentOptions fills a board with lists, their cards and labels.
+type CreateContentOptions struct {
+	BoardID string
+	Lists   []ContentListOptions
+	Labels  []ContentLabelOptions
+}
+
+type ContentListOptions struct {
+	Name       string
+	Position   string
+	IsArchived bool
+	Cards      []ContentCardOptions
+}
+
+type ContentLabelOptions struct {
+	// Key is how the cards refer to the label, its name when empty
+	Key   string
+	Name  string
+	Color string
+}
+
+// ContentCardOptions refers to the labels created along with it by key,
+// other labels are kept as they are.
+type ContentCardOptions struct {
+	Name        string
+	Description string
+	Position    string
+	Priority    models.CardPriority
+	Labels      []string
+	Checklist   []ChecklistItemOptions
+	DueDate     *time.Time
+	StartDate   *time.Time
+	AssignedTo  string
+	Tags        []string
+	Attachments []string
+	IsArchived  bool
+	// CompletionDate and CreatedAt keep the dates of an imported card
+	CompletionDate *time.Time
+	CreatedAt      *time.Time
+	Comments       []ContentCommentOptions
+	Activities     []ContentActivityOptions
+}
+
+// ContentCommentOptions refers to its parent by key, the parent has to come
+// before it. A reply to a missing parent becomes a comment of the card.
+type ContentCommentOptions struct {
+	Key       string
+	ParentKey string
+	UserID    string
+	Content   string
+	CreatedAt time.Time
+}
+
+type ContentActivityOptions struct {
+	ActionType models.CardActionType
+	OldData    map[string]interface{}
+	NewData    map[string]interface{}
+	CreatedAt  time.Time
+}
+
+type ChecklistItemOptions struct {
+	Content     string `json:"content"`
+	IsCompleted bool   `json:"is_completed"`
 }
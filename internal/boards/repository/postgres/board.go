@@ -6,10 +6,12 @@ import (
 	"sync"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/lib/pq"
 	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
@@ -64,7 +66,8 @@ func (r implRepository) Get(ctx context.Context, sc models.Scope, opts repositor
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		bs, err = dbmodels.Boards(qr...).All(ctx, r.database)
+		pageQr := append(qr[:len(qr):len(qr)], r.buildGetOrder(sc.UserID, opts.Sort)...)
+		bs, err = dbmodels.Boards(pageQr...).All(ctx, r.database)
 		if err != nil {
 			r.l.Errorf(ctx, "internal.boards.repository.postgres.Get.All: %v", err)
 			errChan <- err
@@ -108,6 +111,14 @@ func (r implRepository) Create(ctx context.Context, sc models.Scope, opts reposi
 	return models.NewBoard(m), nil
 }
 
+// updateSettingsQuery merges the changed settings into the stored ones in
+// place, the settings changed by someone else since the board was read are
+// kept.
+const updateSettingsQuery = `
+	UPDATE boards SET settings = (settings || $2::jsonb) - $3::text[]
+	WHERE id = $1
+`
+
 func (r implRepository) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.Board, error) {
 	b, col, err := r.buildUpdateModel(ctx, opts)
 	if err != nil {
@@ -115,12 +126,44 @@ func (r implRepository) Update(ctx context.Context, sc models.Scope, opts reposi
 		return models.Board{}, err
 	}
 
-	_, err = b.Update(ctx, r.database, boil.Whitelist(col...))
+	patch, removed, err := r.buildSettingsPatch(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Update.buildSettingsPatch: %v", err)
+		return models.Board{}, err
+	}
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Update.BeginTx: %v", err)
+		return models.Board{}, err
+	}
+	defer tx.Rollback()
+
+	_, err = b.Update(ctx, tx, boil.Whitelist(col...))
 	if err != nil {
 		r.l.Errorf(ctx, "internal.boards.repository.postgres.Update.Update: %v", err)
 		return models.Board{}, err
 	}
 
+	if patch != nil {
+		if _, err := tx.ExecContext(ctx, updateSettingsQuery, b.ID, string(patch), pq.Array(removed)); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.Update.ExecContext: %v", err)
+			return models.Board{}, err
+		}
+	}
+
+	// Only the changed columns are set on the model, the board is read back
+	// whole
+	if err := b.Reload(ctx, tx); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Update.Reload: %v", err)
+		return models.Board{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Update.Commit: %v", err)
+		return models.Board{}, err
+	}
+
 	return models.NewBoard(b), nil
 }
 
@@ -144,18 +187,56 @@ func (r implRepository) Detail(ctx context.Context, sc models.Scope, ID string)
 	return models.NewBoard(*board), nil
 }
 
-func (r implRepository) Delete(ctx context.Context, sc models.Scope, IDs []string) error {
-	qr, err := r.buildDeleteQuery(ctx, IDs)
+// listCardKeyPrefixesQuery reads the prefixes of every board, a board in the
+// trash can be restored with its prefix.
+const listCardKeyPrefixesQuery = `
+	SELECT settings->>'card_key_prefix' FROM boards
+	WHERE settings->>'card_key_prefix' LIKE $1 || '%'
+`
+
+func (r implRepository) ListCardKeyPrefixes(ctx context.Context, sc models.Scope, prefix string) ([]string, error) {
+	rows, err := r.database.QueryContext(ctx, listCardKeyPrefixesQuery, prefix)
 	if err != nil {
-		r.l.Errorf(ctx, "internal.boards.repository.postgres.Delete.buildDeleteQuery: %v", err)
-		return err
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListCardKeyPrefixes.QueryContext: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prefixes []string
+	for rows.Next() {
+		var p string
+		if err := rows.Scan(&p); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListCardKeyPrefixes.Scan: %v", err)
+			return nil, err
+		}
+		prefixes = append(prefixes, p)
+	}
+	if err := rows.Err(); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListCardKeyPrefixes.Err: %v", err)
+		return nil, err
 	}
 
-	_, err = dbmodels.Boards(qr...).DeleteAll(ctx, r.database, true)
-	if err != nil {
-		r.l.Errorf(ctx, "internal.boards.repository.postgres.Delete.DeleteAll: %v", err)
-		return err
+	return prefixes, nil
+}
+
+// getVersionQuery reads the version alone, it runs on every board event.
+const getVersionQuery = `SELECT version FROM boards WHERE id = $1`
+
+func (r implRepository) GetVersion(ctx context.Context, sc models.Scope, ID string) (int64, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.GetVersion.IsUUID: %v", err)
+		return 0, err
+	}
+
+	var v int64
+	if err := r.database.QueryRowContext(ctx, getVersionQuery, ID).Scan(&v); err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.boards.repository.postgres.GetVersion.Scan.NoRows: %v", err)
+			return 0, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.GetVersion.Scan: %v", err)
+		return 0, err
 	}
 
-	return nil
+	return v, nil
 }
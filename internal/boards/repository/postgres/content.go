@@ -0,0 +1,245 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// ListContent runs one query per table, so the cost does not grow with the
+// number of lists.
+func (r implRepository) ListContent(ctx context.Context, sc models.Scope, opts repository.ListContentOptions) (repository.Content, error) {
+	listQr, cardQr, labelQr, err := r.buildListContentQueries(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.buildListContentQueries: %v", err)
+		return repository.Content{}, err
+	}
+
+	var (
+		ls  dbmodels.ListSlice
+		cs  dbmodels.CardSlice
+		lbs dbmodels.LabelSlice
+		cms dbmodels.CommentSlice
+		as  dbmodels.CardActivitySlice
+	)
+
+	errChan := make(chan error, 5)
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var listErr error
+		ls, listErr = dbmodels.Lists(listQr...).All(ctx, r.database)
+		if listErr != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.Lists: %v", listErr)
+			errChan <- listErr
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var cardErr error
+		cs, cardErr = dbmodels.Cards(cardQr...).All(ctx, r.database)
+		if cardErr != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.Cards: %v", cardErr)
+			errChan <- cardErr
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var labelErr error
+		lbs, labelErr = dbmodels.Labels(labelQr...).All(ctx, r.database)
+		if labelErr != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.Labels: %v", labelErr)
+			errChan <- labelErr
+		}
+	}()
+
+	if opts.IncludeHistory {
+		commentQr, activityQr := r.buildCardHistoryQueries(opts.BoardID)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var commentErr error
+			cms, commentErr = dbmodels.Comments(commentQr...).All(ctx, r.database)
+			if commentErr != nil {
+				r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.Comments: %v", commentErr)
+				errChan <- commentErr
+			}
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var activityErr error
+			as, activityErr = dbmodels.CardActivities(activityQr...).All(ctx, r.database)
+			if activityErr != nil {
+				r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.CardActivities: %v", activityErr)
+				errChan <- activityErr
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(errChan)
+	}()
+
+	for err := range errChan {
+		if err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListContent.errChan: %v", err)
+			return repository.Content{}, err
+		}
+	}
+
+	lists := make([]models.List, len(ls))
+	for i, l := range ls {
+		lists[i] = models.NewList(*l)
+	}
+	cards := make([]models.Card, len(cs))
+	for i, c := range cs {
+		cards[i] = models.NewCard(*c)
+	}
+	labels := make([]models.Label, len(lbs))
+	for i, lb := range lbs {
+		labels[i] = models.NewLabel(*lb)
+	}
+
+	comments := make([]models.Comment, len(cms))
+	for i, cm := range cms {
+		comments[i] = models.NewComment(*cm)
+	}
+	activities := make([]models.CardActivity, len(as))
+	for i, a := range as {
+		activities[i] = models.NewCardActivity(*a)
+	}
+
+	return repository.Content{
+		Lists:      lists,
+		Cards:      cards,
+		Labels:     labels,
+		Comments:   comments,
+		Activities: activities,
+	}, nil
+}
+
+func (r implRepository) CreateWithContent(ctx context.Context, sc models.Scope, opts repository.CreateWithContentOptions) (models.Board, repository.Content, error) {
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateWithContent.BeginTx: %v", err)
+		return models.Board{}, repository.Content{}, err
+	}
+	defer tx.Rollback()
+
+	b := r.buildModel(ctx, sc, opts.Board)
+	if err := b.Insert(ctx, tx, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateWithContent.Board.Insert: %v", err)
+		return models.Board{}, repository.Content{}, err
+	}
+
+	m := r.buildOwnerModel(sc, b.ID)
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateWithContent.BoardMember.Insert: %v", err)
+		return models.Board{}, repository.Content{}, err
+	}
+
+	for _, mo := range opts.Members {
+		m := r.buildMemberModel(sc, b.ID, mo)
+		if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateWithContent.Member.Insert: %v", err)
+			return models.Board{}, repository.Content{}, err
+		}
+	}
+
+	contentOpts := opts.Content
+	contentOpts.BoardID = b.ID
+	content, err := r.createContent(ctx, tx, sc, contentOpts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateWithContent.createContent: %v", err)
+		return models.Board{}, repository.Content{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateWithContent.Commit: %v", err)
+		return models.Board{}, repository.Content{}, err
+	}
+
+	return models.NewBoard(b), content, nil
+}
+
+// createContent inserts the labels first, so the cards can refer to them by
+// key.
+func (r implRepository) createContent(ctx context.Context, tx boil.ContextExecutor, sc models.Scope, opts repository.CreateContentOptions) (repository.Content, error) {
+	var content repository.Content
+
+	labelIDs := make(map[string]string, len(opts.Labels))
+	for _, lbOpts := range opts.Labels {
+		lb := r.buildContentLabelModel(sc, opts.BoardID, lbOpts)
+		if err := lb.Insert(ctx, tx, boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.createContent.Label.Insert: %v", err)
+			return repository.Content{}, err
+		}
+		key := lbOpts.Key
+		if key == "" {
+			key = lb.Name
+		}
+		labelIDs[key] = lb.ID
+		content.Labels = append(content.Labels, models.NewLabel(lb))
+	}
+
+	for _, lOpts := range opts.Lists {
+		l := r.buildContentListModel(sc, opts.BoardID, lOpts)
+		if err := l.Insert(ctx, tx, boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.createContent.List.Insert: %v", err)
+			return repository.Content{}, err
+		}
+		content.Lists = append(content.Lists, models.NewList(l))
+
+		for _, cOpts := range lOpts.Cards {
+			c := r.buildContentCardModel(sc, l, cOpts, labelIDs)
+			if err := c.Insert(ctx, tx, boil.Infer()); err != nil {
+				r.l.Errorf(ctx, "internal.boards.repository.postgres.createContent.Card.Insert: %v", err)
+				return repository.Content{}, err
+			}
+			// The number and key of the card are set by a trigger
+			if err := c.Reload(ctx, tx); err != nil {
+				r.l.Errorf(ctx, "internal.boards.repository.postgres.createContent.Card.Reload: %v", err)
+				return repository.Content{}, err
+			}
+			content.Cards = append(content.Cards, models.NewCard(c))
+
+			commentIDs := make(map[string]string, len(cOpts.Comments))
+			for _, cmOpts := range cOpts.Comments {
+				cm := r.buildContentCommentModel(c.ID, cmOpts, commentIDs)
+				if err := cm.Insert(ctx, tx, boil.Infer()); err != nil {
+					r.l.Errorf(ctx, "internal.boards.repository.postgres.createContent.Comment.Insert: %v", err)
+					return repository.Content{}, err
+				}
+				if cmOpts.Key != "" {
+					commentIDs[cmOpts.Key] = cm.ID
+				}
+				content.Comments = append(content.Comments, models.NewComment(cm))
+			}
+
+			for _, aOpts := range cOpts.Activities {
+				a := r.buildContentActivityModel(c.ID, aOpts)
+				if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+					r.l.Errorf(ctx, "internal.boards.repository.postgres.createContent.CardActivity.Insert: %v", err)
+					return repository.Content{}, err
+				}
+				content.Activities = append(content.Activities, models.NewCardActivity(a))
+			}
+		}
+	}
+
+	return content, nil
+}
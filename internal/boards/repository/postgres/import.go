@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) CreateImport(ctx context.Context, sc models.Scope, opts repository.CreateImportOptions) (models.BoardImport, error) {
+	m := r.buildImportModel(sc, opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.CreateImport.Insert: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	return models.NewBoardImport(m), nil
+}
+
+func (r implRepository) UpdateImport(ctx context.Context, sc models.Scope, opts repository.UpdateImportOptions) (models.BoardImport, error) {
+	qr, cols, err := r.buildUpdateImportQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpdateImport.buildUpdateImportQuery: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	n, err := dbmodels.BoardImports(qr...).UpdateAll(ctx, r.database, cols)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpdateImport.UpdateAll: %v", err)
+		return models.BoardImport{}, err
+	}
+	if n == 0 {
+		return models.BoardImport{}, repository.ErrNotFound
+	}
+
+	m, err := dbmodels.FindBoardImport(ctx, r.database, opts.ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpdateImport.FindBoardImport: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	return models.NewBoardImport(*m), nil
+}
+
+func (r implRepository) DetailImport(ctx context.Context, sc models.Scope, id string) (models.BoardImport, error) {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DetailImport.IsUUID: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	m, err := dbmodels.FindBoardImport(ctx, r.database, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.boards.repository.postgres.DetailImport.FindBoardImport.NoRows: %v", err)
+			return models.BoardImport{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DetailImport.FindBoardImport: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	return models.NewBoardImport(*m), nil
+}
+
+func (r implRepository) FailStaleImports(ctx context.Context, sc models.Scope, opts repository.FailStaleImportsOptions) error {
+	now := r.clock()
+	if _, err := dbmodels.BoardImports(
+		dbmodels.BoardImportWhere.Status.IN([]string{string(models.BoardImportPending), string(models.BoardImportRunning)}),
+		dbmodels.BoardImportWhere.UpdatedAt.LT(opts.UpdatedBefore),
+	).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.BoardImportColumns.Status:       string(models.BoardImportFailed),
+		dbmodels.BoardImportColumns.ErrorMessage: null.StringFrom(opts.ErrorMessage),
+		dbmodels.BoardImportColumns.UpdatedAt:    now,
+		dbmodels.BoardImportColumns.CompletedAt:  null.TimeFrom(now),
+	}); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.FailStaleImports.UpdateAll: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,328 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// listAttachmentIDsQuery reads the attachments of every card of the board,
+// trashed ones included, since the cascade of a purge removes them all. Only
+// the entries that are uploads are kept, links are not files, and uploads
+// still attached to the cards of another board stay with that board. The IDs
+// are compared as text so links that are not UUIDs do not fail the cast.
+const listAttachmentIDsQuery = `
+	SELECT DISTINCT u.id::text FROM cards c
+	CROSS JOIN LATERAL jsonb_array_elements_text(c.attachments) AS a(id)
+	JOIN uploads u ON u.id::text = LOWER(a.id) AND u.deleted_at IS NULL
+	WHERE c.board_id = $1 AND jsonb_typeof(c.attachments) = 'array'
+	AND NOT EXISTS (
+		SELECT 1 FROM cards o
+		WHERE o.board_id <> c.board_id AND jsonb_typeof(o.attachments) = 'array'
+		AND o.attachments ? u.id::text
+	)
+`
+
+func (r implRepository) DetailDeleted(ctx context.Context, sc models.Scope, ID string) (models.Board, error) {
+	qr, err := r.buildDetailDeletedQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DetailDeleted.buildDetailDeletedQuery: %v", err)
+		return models.Board{}, err
+	}
+
+	b, err := dbmodels.Boards(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.boards.repository.postgres.DetailDeleted.One.NoRows: %v", err)
+			return models.Board{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DetailDeleted.One: %v", err)
+		return models.Board{}, err
+	}
+
+	return models.NewBoard(*b), nil
+}
+
+func (r implRepository) Archive(ctx context.Context, sc models.Scope, ID string) (models.Board, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Archive.IsUUID: %v", err)
+		return models.Board{}, err
+	}
+
+	now := r.clock()
+	bs, err := r.transition(ctx, sc, models.BoardAuditArchived, []qm.QueryMod{
+		dbmodels.BoardWhere.ID.EQ(ID),
+		qm.Where("archived_at IS NULL"),
+	}, dbmodels.M{
+		dbmodels.BoardColumns.ArchivedAt: null.TimeFrom(now),
+		dbmodels.BoardColumns.ArchivedBy: null.NewString(sc.UserID, sc.UserID != ""),
+		dbmodels.BoardColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Archive.transition: %v", err)
+		return models.Board{}, err
+	}
+
+	return bs[0], nil
+}
+
+func (r implRepository) Unarchive(ctx context.Context, sc models.Scope, ID string) (models.Board, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Unarchive.IsUUID: %v", err)
+		return models.Board{}, err
+	}
+
+	bs, err := r.transition(ctx, sc, models.BoardAuditUnarchived, []qm.QueryMod{
+		dbmodels.BoardWhere.ID.EQ(ID),
+		qm.Where("archived_at IS NOT NULL"),
+	}, dbmodels.M{
+		dbmodels.BoardColumns.ArchivedAt: null.Time{},
+		dbmodels.BoardColumns.ArchivedBy: null.String{},
+		dbmodels.BoardColumns.UpdatedAt:  r.clock(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Unarchive.transition: %v", err)
+		return models.Board{}, err
+	}
+
+	return bs[0], nil
+}
+
+func (r implRepository) Delete(ctx context.Context, sc models.Scope, IDs []string) error {
+	qr, err := r.buildDeleteQuery(ctx, IDs)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Delete.buildDeleteQuery: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	_, err = r.transition(ctx, sc, models.BoardAuditDeleted, qr, dbmodels.M{
+		dbmodels.BoardColumns.DeletedAt: null.TimeFrom(now),
+		dbmodels.BoardColumns.DeletedBy: null.NewString(sc.UserID, sc.UserID != ""),
+		dbmodels.BoardColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Delete.transition: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) Restore(ctx context.Context, sc models.Scope, ID string) (models.Board, error) {
+	qr, err := r.buildDetailDeletedQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Restore.buildDetailDeletedQuery: %v", err)
+		return models.Board{}, err
+	}
+
+	bs, err := r.transition(ctx, sc, models.BoardAuditRestored, qr, dbmodels.M{
+		dbmodels.BoardColumns.DeletedAt: null.Time{},
+		dbmodels.BoardColumns.DeletedBy: null.String{},
+		dbmodels.BoardColumns.UpdatedAt: r.clock(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Restore.transition: %v", err)
+		return models.Board{}, err
+	}
+
+	return bs[0], nil
+}
+
+func (r implRepository) Purge(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildDetailDeletedQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Purge.buildDetailDeletedQuery: %v", err)
+		return err
+	}
+
+	if _, err := r.transition(ctx, sc, models.BoardAuditPurged, qr, nil); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Purge.transition: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// transition applies a lifecycle change to the boards matching the query and
+// writes their audit logs in the same transaction. Without columns the boards
+// are deleted for good, the foreign keys take the lists, cards, labels,
+// comments and activities with them.
+func (r implRepository) transition(ctx context.Context, sc models.Scope, action models.BoardAuditAction, qr []qm.QueryMod, cols dbmodels.M) ([]models.Board, error) {
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.BeginTx: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	bs, err := dbmodels.Boards(append(qr, qm.For("UPDATE"))...).All(ctx, tx)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.All: %v", err)
+		return nil, err
+	}
+	if len(bs) == 0 {
+		r.l.Warnf(ctx, "internal.boards.repository.postgres.transition.NotFound: no board to mark %s", action)
+		return nil, repository.ErrNotFound
+	}
+
+	IDs := make([]string, len(bs))
+	for i, b := range bs {
+		IDs[i] = b.ID
+	}
+	// The boards may have left the query by now, they are found by ID again
+	byID := []qm.QueryMod{qm.WithDeleted(), dbmodels.BoardWhere.ID.IN(IDs)}
+
+	if cols == nil {
+		if _, err := dbmodels.Boards(byID...).DeleteAll(ctx, tx, true); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.DeleteAll: %v", err)
+			return nil, err
+		}
+	} else {
+		if _, err := dbmodels.Boards(byID...).UpdateAll(ctx, tx, cols); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.UpdateAll: %v", err)
+			return nil, err
+		}
+	}
+
+	now := r.clock()
+	for _, b := range bs {
+		l := dbmodels.BoardAuditLog{
+			BoardID:   b.ID,
+			BoardName: b.Name,
+			Action:    string(action),
+			UserID:    null.NewString(sc.UserID, sc.UserID != ""),
+			CreatedAt: now,
+		}
+		if err := l.Insert(ctx, tx, boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.Insert: %v", err)
+			return nil, err
+		}
+	}
+
+	var boards []models.Board
+	if cols != nil {
+		bs, err = dbmodels.Boards(byID...).All(ctx, tx)
+		if err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.All: %v", err)
+			return nil, err
+		}
+		boards = make([]models.Board, len(bs))
+		for i, b := range bs {
+			boards[i] = models.NewBoard(*b)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.transition.Commit: %v", err)
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+func (r implRepository) ListAttachmentIDs(ctx context.Context, sc models.Scope, boardID string) ([]string, error) {
+	if err := postgres.IsUUID(boardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAttachmentIDs.IsUUID: %v", err)
+		return nil, err
+	}
+
+	rows, err := r.database.QueryContext(ctx, listAttachmentIDsQuery, boardID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAttachmentIDs.QueryContext: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var IDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAttachmentIDs.Scan: %v", err)
+			return nil, err
+		}
+		IDs = append(IDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAttachmentIDs.Err: %v", err)
+		return nil, err
+	}
+
+	return IDs, nil
+}
+
+func (r implRepository) ListAuditLogs(ctx context.Context, sc models.Scope, opts repository.ListAuditLogsOptions) ([]models.BoardAuditLog, paginator.Paginator, error) {
+	qr, err := r.buildListAuditLogsQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAuditLogs.buildListAuditLogsQuery: %v", err)
+		return nil, paginator.Paginator{}, err
+	}
+
+	var (
+		total int64
+		ls    dbmodels.BoardAuditLogSlice
+	)
+
+	errChan := make(chan error, 2)
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var countErr error
+		total, countErr = dbmodels.BoardAuditLogs(qr...).Count(ctx, r.database)
+		if countErr != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAuditLogs.Count: %v", countErr)
+			errChan <- countErr
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pageQr := append(qr[:len(qr):len(qr)],
+			qm.OrderBy(dbmodels.BoardAuditLogColumns.CreatedAt+" DESC"),
+			qm.Limit(int(opts.PagQuery.Limit)),
+			qm.Offset(int(opts.PagQuery.Offset())),
+		)
+		var allErr error
+		ls, allErr = dbmodels.BoardAuditLogs(pageQr...).All(ctx, r.database)
+		if allErr != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAuditLogs.All: %v", allErr)
+			errChan <- allErr
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(errChan)
+	}()
+
+	for err := range errChan {
+		if err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListAuditLogs.errChan: %v", err)
+			return nil, paginator.Paginator{}, err
+		}
+	}
+
+	logs := make([]models.BoardAuditLog, len(ls))
+	for i, l := range ls {
+		logs[i] = models.NewBoardAuditLog(*l)
+	}
+
+	return logs, paginator.Paginator{
+		Total:       total,
+		Count:       int64(len(logs)),
+		PerPage:     opts.PagQuery.Limit,
+		CurrentPage: opts.PagQuery.Page,
+	}, nil
+}
@@ -1,7 +1,9 @@
 package postgres
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 
 	"github.com/aarondl/null/v8"
 	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
@@ -19,6 +21,35 @@ func (r implRepository) buildModel(ctx context.Context, sc models.Scope, opts re
 		CreatedAt:   r.clock(),
 		UpdatedAt:   r.clock(),
 	}
+	if opts.WorkspaceID != "" {
+		m.WorkspaceID = null.StringFrom(opts.WorkspaceID)
+	}
+	if opts.Settings != nil {
+		// The settings are plain values, they always marshal
+		m.Settings, _ = json.Marshal(opts.Settings)
+	}
+
+	return m
+}
+
+// buildOwnerModel makes the user the owner of a board it creates.
+func (r implRepository) buildOwnerModel(sc models.Scope, boardID string) dbmodels.BoardMember {
+	now := r.clock()
+
+	return dbmodels.BoardMember{
+		BoardID:   boardID,
+		UserID:    sc.UserID,
+		Role:      string(models.BoardRoleOwner),
+		AddedBy:   null.StringFrom(sc.UserID),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r implRepository) buildMemberModel(sc models.Scope, boardID string, opts repository.MemberOptions) dbmodels.BoardMember {
+	m := r.buildOwnerModel(sc, boardID)
+	m.UserID = opts.UserID
+	m.Role = string(opts.Role)
 
 	return m
 }
@@ -34,6 +65,13 @@ func (r implRepository) buildUpdateModel(ctx context.Context, opts repository.Up
 	cols = append(cols, dbmodels.BoardColumns.Alias)
 	cols = append(cols, dbmodels.BoardColumns.Description)
 
+	if opts.WorkspaceID != nil {
+		if *opts.WorkspaceID != "" {
+			board.WorkspaceID = null.StringFrom(*opts.WorkspaceID)
+		}
+		cols = append(cols, dbmodels.BoardColumns.WorkspaceID)
+	}
+
 	if err := postgres.IsUUID(opts.ID); err != nil {
 		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildUpdateModel.IsUUID: %v", err)
 		return dbmodels.Board{}, nil, err
@@ -45,3 +83,205 @@ func (r implRepository) buildUpdateModel(ctx context.Context, opts repository.Up
 
 	return board, cols, nil
 }
+
+// buildSettingsPatch returns the settings changed by an update: the document
+// merged into the stored settings and the settings removed from them. Only
+// these are written, so a concurrent update of other settings is kept.
+func (r implRepository) buildSettingsPatch(ctx context.Context, opts repository.UpdateOptions) ([]byte, []string, error) {
+	old, err := settingsFields(opts.OldModel.Settings)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildSettingsPatch.settingsFields: %v", err)
+		return nil, nil, err
+	}
+	cur, err := settingsFields(opts.Settings)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildSettingsPatch.settingsFields: %v", err)
+		return nil, nil, err
+	}
+
+	patch := make(map[string]json.RawMessage)
+	for k, v := range cur {
+		if !bytes.Equal(old[k], v) {
+			patch[k] = v
+		}
+	}
+	removed := make([]string, 0)
+	for k := range old {
+		if _, ok := cur[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+	if len(patch) == 0 && len(removed) == 0 {
+		return nil, nil, nil
+	}
+
+	b, err := json.Marshal(patch)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildSettingsPatch.json.Marshal: %v", err)
+		return nil, nil, err
+	}
+
+	return b, removed, nil
+}
+
+// settingsFields splits the settings document into its fields, the empty
+// settings left out of the document are missing.
+func settingsFields(s models.BoardSettings) (map[string]json.RawMessage, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var fs map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fs); err != nil {
+		return nil, err
+	}
+
+	return fs, nil
+}
+
+func (r implRepository) buildContentListModel(sc models.Scope, boardID string, opts repository.ContentListOptions) dbmodels.List {
+	now := r.clock()
+
+	return dbmodels.List{
+		BoardID:    boardID,
+		Name:       opts.Name,
+		Position:   opts.Position,
+		IsArchived: opts.IsArchived,
+		CreatedBy:  null.NewString(sc.UserID, sc.UserID != ""),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+}
+
+func (r implRepository) buildContentLabelModel(sc models.Scope, boardID string, opts repository.ContentLabelOptions) dbmodels.Label {
+	now := r.clock()
+
+	return dbmodels.Label{
+		BoardID:   boardID,
+		Name:      opts.Name,
+		Color:     opts.Color,
+		CreatedBy: null.NewString(sc.UserID, sc.UserID != ""),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// buildContentCardModel places the card in the list, labelIDs translates the
+// label keys of the card to the labels created with it.
+func (r implRepository) buildContentCardModel(sc models.Scope, l dbmodels.List, opts repository.ContentCardOptions, labelIDs map[string]string) dbmodels.Card {
+	now := r.clock()
+
+	priority := opts.Priority
+	if priority == "" {
+		priority = models.CardPriorityMedium
+	}
+
+	m := dbmodels.Card{
+		BoardID:     l.BoardID,
+		ListID:      l.ID,
+		Name:        opts.Name,
+		Description: null.NewString(opts.Description, opts.Description != ""),
+		Position:    opts.Position,
+		Priority:    dbmodels.CardPriority(priority),
+		DueDate:     null.TimeFromPtr(opts.DueDate),
+		StartDate:   null.TimeFromPtr(opts.StartDate),
+		AssignedTo:  null.NewString(opts.AssignedTo, opts.AssignedTo != ""),
+		Tags:        opts.Tags,
+		IsArchived:  opts.IsArchived,
+		CreatedBy:   null.NewString(sc.UserID, sc.UserID != ""),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if opts.CompletionDate != nil {
+		m.CompletionDate = null.TimeFrom(*opts.CompletionDate)
+	}
+	if opts.CreatedAt != nil {
+		m.CreatedAt = *opts.CreatedAt
+	}
+
+	if len(opts.Labels) > 0 {
+		labels := make([]string, len(opts.Labels))
+		for i, key := range opts.Labels {
+			labels[i] = key
+			if id, ok := labelIDs[key]; ok {
+				labels[i] = id
+			}
+		}
+		labelsJSON, _ := json.Marshal(labels)
+		m.Labels = null.JSONFrom(labelsJSON)
+	}
+
+	if len(opts.Checklist) > 0 {
+		checklistJSON, _ := json.Marshal(opts.Checklist)
+		m.Checklist = null.JSONFrom(checklistJSON)
+	}
+
+	if len(opts.Attachments) > 0 {
+		attachmentsJSON, _ := json.Marshal(opts.Attachments)
+		m.Attachments = null.JSONFrom(attachmentsJSON)
+	}
+
+	return m
+}
+
+// buildContentCommentModel places the comment on the card, commentIDs
+// translates the key of its parent to the comment created for it.
+func (r implRepository) buildContentCommentModel(cardID string, opts repository.ContentCommentOptions, commentIDs map[string]string) dbmodels.Comment {
+	m := dbmodels.Comment{
+		CardID:    cardID,
+		UserID:    opts.UserID,
+		Content:   opts.Content,
+		CreatedAt: opts.CreatedAt,
+		UpdatedAt: r.clock(),
+	}
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = m.UpdatedAt
+	}
+	if id, ok := commentIDs[opts.ParentKey]; ok {
+		m.ParentID = null.StringFrom(id)
+	}
+
+	return m
+}
+
+func (r implRepository) buildContentActivityModel(cardID string, opts repository.ContentActivityOptions) dbmodels.CardActivity {
+	m := dbmodels.CardActivity{
+		CardID:     cardID,
+		ActionType: dbmodels.CardActionType(opts.ActionType),
+		CreatedAt:  opts.CreatedAt,
+		UpdatedAt:  r.clock(),
+	}
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = m.UpdatedAt
+	}
+	if opts.OldData != nil {
+		oldJSON, _ := json.Marshal(opts.OldData)
+		m.OldData = null.JSONFrom(oldJSON)
+	}
+	if opts.NewData != nil {
+		newJSON, _ := json.Marshal(opts.NewData)
+		m.NewData = null.JSONFrom(newJSON)
+	}
+
+	return m
+}
+
+func (r implRepository) buildImportModel(sc models.Scope, opts repository.CreateImportOptions) dbmodels.BoardImport {
+	now := r.clock()
+
+	m := dbmodels.BoardImport{
+		Source:    string(opts.Source),
+		Status:    string(models.BoardImportPending),
+		CreatedBy: sc.UserID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if opts.WorkspaceID != "" {
+		m.WorkspaceID = null.StringFrom(opts.WorkspaceID)
+	}
+
+	return m
+}
+
+// buildUpdateImportModel completes the import when it has a final status.
@@ -2,15 +2,22 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 )
 
 func (r implRepository) buildGetQuery(ctx context.Context, fils boards.Filter) ([]qm.QueryMod, error) {
 	qr := postgres.BuildQueryWithSoftDelete()
+	if fils.Trashed {
+		qr = []qm.QueryMod{qm.WithDeleted(), qm.Where("deleted_at IS NOT NULL")}
+	}
 
 	if len(fils.IDs) > 0 {
 		for _, id := range fils.IDs {
@@ -34,9 +41,76 @@ func (r implRepository) buildGetQuery(ctx context.Context, fils boards.Filter) (
 		qr = append(qr, qm.Where("created_by = ?", fils.CreatedBy))
 	}
 
+	if fils.MemberID != "" {
+		if err := postgres.IsUUID(fils.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildGetQuery.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("id IN (SELECT board_id FROM board_access WHERE user_id = ?)", fils.MemberID))
+	}
+
+	if fils.WorkspaceID != "" {
+		if err := postgres.IsUUID(fils.WorkspaceID); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildGetQuery.InvalidWorkspaceID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardWhere.WorkspaceID.EQ(null.StringFrom(fils.WorkspaceID)))
+	}
+
+	if fils.Archived != nil {
+		if *fils.Archived {
+			qr = append(qr, qm.Where("archived_at IS NOT NULL"))
+		} else {
+			qr = append(qr, qm.Where("archived_at IS NULL"))
+		}
+	}
+
+	if fils.OwnerID != "" {
+		if err := postgres.IsUUID(fils.OwnerID); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildGetQuery.InvalidOwnerID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("id IN (SELECT board_id FROM board_access WHERE user_id = ? AND role = ?)", fils.OwnerID, string(models.BoardRoleOwner)))
+	}
+
+	if fils.StarredBy != "" {
+		if err := postgres.IsUUID(fils.StarredBy); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildGetQuery.InvalidStarredBy: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("id IN (SELECT board_id FROM board_stars WHERE user_id = ?)", fils.StarredBy))
+	}
+
+	if fils.ViewedBy != "" {
+		if err := postgres.IsUUID(fils.ViewedBy); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildGetQuery.InvalidViewedBy: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("id IN (SELECT board_id FROM board_views WHERE user_id = ?)", fils.ViewedBy))
+	}
+
 	return qr, nil
 }
 
+// buildGetOrder orders the boards for the user, the boards the user did not
+// star or view come last, newest first.
+func (r implRepository) buildGetOrder(userID string, sort boards.Sort) []qm.QueryMod {
+	switch sort {
+	case boards.SortStarred:
+		return []qm.QueryMod{
+			qm.OrderBy("(SELECT created_at FROM board_stars WHERE board_stars.board_id = boards.id AND board_stars.user_id = ?) DESC NULLS LAST", userID),
+			qm.OrderBy(dbmodels.BoardColumns.CreatedAt + " DESC"),
+		}
+	case boards.SortRecent:
+		return []qm.QueryMod{
+			qm.OrderBy("(SELECT viewed_at FROM board_views WHERE board_views.board_id = boards.id AND board_views.user_id = ?) DESC NULLS LAST", userID),
+			qm.OrderBy(dbmodels.BoardColumns.CreatedAt + " DESC"),
+		}
+	default:
+		return nil
+	}
+}
+
 func (r implRepository) buildDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
 	qr := postgres.BuildQueryWithSoftDelete()
 
@@ -57,8 +131,138 @@ func (r implRepository) buildDeleteQuery(ctx context.Context, IDs []string) ([]q
 			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildDeleteQuery.InvalidID: %v", err)
 			return nil, err
 		}
-		qr = append(qr, qm.Where("id = ?", ID))
+	}
+	qr = append(qr, dbmodels.BoardWhere.ID.IN(IDs))
+
+	return qr, nil
+}
+
+func (r implRepository) buildDetailDeletedQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildDetailDeletedQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		qm.WithDeleted(),
+		qm.Where("deleted_at IS NOT NULL"),
+		dbmodels.BoardWhere.ID.EQ(ID),
+	}, nil
+}
+
+func (r implRepository) buildListAuditLogsQuery(ctx context.Context, opts repository.ListAuditLogsOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildListAuditLogsQuery.InvalidBoardID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.BoardAuditLogWhere.BoardID.EQ(opts.BoardID),
+	}, nil
+}
+
+func (r implRepository) buildListContentQueries(ctx context.Context, opts repository.ListContentOptions) (listQr, cardQr, labelQr []qm.QueryMod, err error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildListContentQueries.InvalidBoardID: %v", err)
+		return nil, nil, nil, err
+	}
+
+	listQr = append(postgres.BuildQueryWithSoftDelete(),
+		dbmodels.ListWhere.BoardID.EQ(opts.BoardID),
+		qm.OrderBy(dbmodels.ListColumns.Position+" ASC"),
+	)
+	cardQr = append(postgres.BuildQueryWithSoftDelete(),
+		dbmodels.CardWhere.BoardID.EQ(opts.BoardID),
+		qm.OrderBy(dbmodels.CardColumns.ListID+" ASC, "+dbmodels.CardColumns.Position+" ASC"),
+	)
+	labelQr = append(postgres.BuildQueryWithSoftDelete(),
+		dbmodels.LabelWhere.BoardID.EQ(opts.BoardID),
+		qm.OrderBy(dbmodels.LabelColumns.CreatedAt+" ASC"),
+	)
+
+	// The cards of an archived list are hidden with the list
+	if !opts.IncludeArchived {
+		listQr = append(listQr, dbmodels.ListWhere.IsArchived.EQ(false))
+		cardQr = append(cardQr,
+			dbmodels.CardWhere.IsArchived.EQ(false),
+			qm.Where("list_id IN (SELECT id FROM lists WHERE is_archived = false AND deleted_at IS NULL)"),
+		)
+	}
+
+	return listQr, cardQr, labelQr, nil
+}
+
+// buildCardHistoryQueries loads the history of every card of the board, in the
+// order it happened. The board ID is checked by the caller.
+func (r implRepository) buildCardHistoryQueries(boardID string) (commentQr, activityQr []qm.QueryMod) {
+	commentQr = append(postgres.BuildQueryWithSoftDelete(),
+		qm.Where("card_id IN (SELECT id FROM cards WHERE board_id = ?)", boardID),
+		qm.OrderBy(dbmodels.CommentColumns.CreatedAt+" ASC"),
+	)
+	activityQr = append(postgres.BuildQueryWithSoftDelete(),
+		qm.Where("card_id IN (SELECT id FROM cards WHERE board_id = ?)", boardID),
+		qm.OrderBy(dbmodels.CardActivityColumns.CreatedAt+" ASC"),
+	)
+
+	return commentQr, activityQr
+}
+
+func (r implRepository) buildDetailShareLinkQuery(ctx context.Context, opts repository.DetailShareLinkOptions) ([]qm.QueryMod, error) {
+	qr := []qm.QueryMod{}
+
+	if opts.BoardID != "" {
+		if err := postgres.IsUUID(opts.BoardID); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildDetailShareLinkQuery.InvalidBoardID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardShareLinkWhere.BoardID.EQ(opts.BoardID))
+	}
+
+	if opts.TokenHash != "" {
+		qr = append(qr, dbmodels.BoardShareLinkWhere.TokenHash.EQ(opts.TokenHash))
+	}
+
+	if len(qr) == 0 {
+		return nil, repository.ErrNotFound
 	}
 
 	return qr, nil
 }
+
+func (r implRepository) buildUpdateImportQuery(ctx context.Context, opts repository.UpdateImportOptions) ([]qm.QueryMod, dbmodels.M, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.buildUpdateImportQuery.IsUUID: %v", err)
+		return nil, nil, err
+	}
+
+	qr := []qm.QueryMod{dbmodels.BoardImportWhere.ID.EQ(opts.ID)}
+	if opts.FromStatus != "" {
+		qr = append(qr, dbmodels.BoardImportWhere.Status.EQ(string(opts.FromStatus)))
+	}
+
+	now := r.clock()
+	cols := dbmodels.M{
+		dbmodels.BoardImportColumns.Status:    string(opts.Status),
+		dbmodels.BoardImportColumns.UpdatedAt: now,
+	}
+
+	if opts.BoardID != "" {
+		cols[dbmodels.BoardImportColumns.BoardID] = null.StringFrom(opts.BoardID)
+	}
+	if opts.Report != nil {
+		reportJSON, err := json.Marshal(opts.Report)
+		if err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.buildUpdateImportQuery.json.Marshal: %v", err)
+			return nil, nil, err
+		}
+		cols[dbmodels.BoardImportColumns.Report] = null.JSONFrom(reportJSON)
+	}
+	if opts.ErrorMessage != "" {
+		cols[dbmodels.BoardImportColumns.ErrorMessage] = null.StringFrom(opts.ErrorMessage)
+	}
+	if opts.Status == models.BoardImportCompleted || opts.Status == models.BoardImportFailed {
+		cols[dbmodels.BoardImportColumns.CompletedAt] = null.TimeFrom(now)
+	}
+
+	return qr, cols, nil
+}
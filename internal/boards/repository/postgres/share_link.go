@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// upsertShareLinkQuery keeps a single link per board, a new token replaces the
+// old one so the links shared before stop working at once.
+const upsertShareLinkQuery = `
+	INSERT INTO board_share_links (board_id, token_hash, expires_at, created_by, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $5)
+	ON CONFLICT (board_id) DO UPDATE SET
+		token_hash = EXCLUDED.token_hash,
+		expires_at = EXCLUDED.expires_at,
+		created_by = EXCLUDED.created_by,
+		updated_at = EXCLUDED.updated_at
+	RETURNING id, created_at
+`
+
+func (r implRepository) DetailShareLink(ctx context.Context, sc models.Scope, opts repository.DetailShareLinkOptions) (models.BoardShareLink, error) {
+	qr, err := r.buildDetailShareLinkQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DetailShareLink.buildDetailShareLinkQuery: %v", err)
+		return models.BoardShareLink{}, err
+	}
+
+	l, err := dbmodels.BoardShareLinks(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.boards.repository.postgres.DetailShareLink.One.NoRows: %v", err)
+			return models.BoardShareLink{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DetailShareLink.One: %v", err)
+		return models.BoardShareLink{}, err
+	}
+
+	return models.NewBoardShareLink(*l), nil
+}
+
+func (r implRepository) UpsertShareLink(ctx context.Context, sc models.Scope, opts repository.UpsertShareLinkOptions) (models.BoardShareLink, error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpsertShareLink.InvalidBoardID: %v", err)
+		return models.BoardShareLink{}, err
+	}
+
+	l := dbmodels.BoardShareLink{
+		BoardID:   opts.BoardID,
+		TokenHash: opts.TokenHash,
+		ExpiresAt: null.TimeFromPtr(opts.ExpiresAt),
+		CreatedBy: null.NewString(sc.UserID, sc.UserID != ""),
+		UpdatedAt: r.clock(),
+	}
+	if err := r.database.QueryRowContext(ctx, upsertShareLinkQuery,
+		l.BoardID, l.TokenHash, l.ExpiresAt, l.CreatedBy, l.UpdatedAt,
+	).Scan(&l.ID, &l.CreatedAt); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpsertShareLink.QueryRowContext: %v", err)
+		return models.BoardShareLink{}, err
+	}
+
+	return models.NewBoardShareLink(l), nil
+}
+
+func (r implRepository) UpdateShareLinkExpiry(ctx context.Context, sc models.Scope, opts repository.UpdateShareLinkExpiryOptions) (models.BoardShareLink, error) {
+	qr, err := r.buildDetailShareLinkQuery(ctx, repository.DetailShareLinkOptions{BoardID: opts.BoardID})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpdateShareLinkExpiry.buildDetailShareLinkQuery: %v", err)
+		return models.BoardShareLink{}, err
+	}
+
+	n, err := dbmodels.BoardShareLinks(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.BoardShareLinkColumns.ExpiresAt: null.TimeFromPtr(opts.ExpiresAt),
+		dbmodels.BoardShareLinkColumns.UpdatedAt: r.clock(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.UpdateShareLinkExpiry.UpdateAll: %v", err)
+		return models.BoardShareLink{}, err
+	}
+	if n == 0 {
+		return models.BoardShareLink{}, repository.ErrNotFound
+	}
+
+	return r.DetailShareLink(ctx, sc, repository.DetailShareLinkOptions{BoardID: opts.BoardID})
+}
+
+func (r implRepository) DeleteShareLink(ctx context.Context, sc models.Scope, boardID string) error {
+	qr, err := r.buildDetailShareLinkQuery(ctx, repository.DetailShareLinkOptions{BoardID: boardID})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DeleteShareLink.buildDetailShareLinkQuery: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.BoardShareLinks(qr...).DeleteAll(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.DeleteShareLink.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
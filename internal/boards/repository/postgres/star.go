@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+const (
+	starBoardQuery = `
+	INSERT INTO board_stars (user_id, board_id, created_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_id, board_id) DO NOTHING
+`
+
+	// recordViewQuery keeps one row per board, a new view moves the board to
+	// the top of the recent boards
+	recordViewQuery = `
+	INSERT INTO board_views (user_id, board_id, viewed_at)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_id, board_id) DO UPDATE SET
+		viewed_at = EXCLUDED.viewed_at
+`
+
+	pruneViewsQuery = `
+	DELETE FROM board_views
+	WHERE user_id = $1 AND board_id NOT IN (
+		SELECT board_id FROM board_views
+		WHERE user_id = $1
+		ORDER BY viewed_at DESC
+		LIMIT $2
+	)
+`
+)
+
+func (r implRepository) Star(ctx context.Context, sc models.Scope, boardID string) error {
+	if err := postgres.IsUUID(boardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Star.InvalidBoardID: %v", err)
+		return err
+	}
+
+	if _, err := r.database.ExecContext(ctx, starBoardQuery, sc.UserID, boardID, r.clock()); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Star.ExecContext: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) Unstar(ctx context.Context, sc models.Scope, boardID string) error {
+	if err := postgres.IsUUID(boardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Unstar.InvalidBoardID: %v", err)
+		return err
+	}
+
+	if _, err := dbmodels.BoardStars(
+		dbmodels.BoardStarWhere.UserID.EQ(sc.UserID),
+		dbmodels.BoardStarWhere.BoardID.EQ(boardID),
+	).DeleteAll(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.Unstar.DeleteAll: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (r implRepository) ListStarredIDs(ctx context.Context, sc models.Scope, boardIDs []string) ([]string, error) {
+	if len(boardIDs) == 0 {
+		return nil, nil
+	}
+
+	ss, err := dbmodels.BoardStars(
+		qm.Select(dbmodels.BoardStarColumns.BoardID),
+		dbmodels.BoardStarWhere.UserID.EQ(sc.UserID),
+		dbmodels.BoardStarWhere.BoardID.IN(boardIDs),
+	).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListStarredIDs.All: %v", err)
+		return nil, err
+	}
+
+	ids := make([]string, len(ss))
+	for i, s := range ss {
+		ids[i] = s.BoardID
+	}
+
+	return ids, nil
+}
+
+func (r implRepository) RecordView(ctx context.Context, sc models.Scope, opts repository.RecordViewOptions) error {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.RecordView.InvalidBoardID: %v", err)
+		return err
+	}
+
+	if _, err := r.database.ExecContext(ctx, recordViewQuery, sc.UserID, opts.BoardID, r.clock()); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.RecordView.ExecContext: %v", err)
+		return err
+	}
+
+	if opts.Keep > 0 {
+		if _, err := r.database.ExecContext(ctx, pruneViewsQuery, sc.UserID, opts.Keep); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.RecordView.ExecContext: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r implRepository) ListRecent(ctx context.Context, sc models.Scope, opts repository.ListRecentOptions) ([]models.Board, error) {
+	if err := postgres.IsUUID(sc.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListRecent.InvalidUserID: %v", err)
+		return nil, err
+	}
+
+	qr := []qm.QueryMod{
+		qm.Select("boards.*"),
+		qm.InnerJoin("board_views ON board_views.board_id = boards.id AND board_views.user_id = ?", sc.UserID),
+		qm.Where("boards.deleted_at IS NULL"),
+	}
+
+	// The public boards are read by every user, the user may have viewed one
+	// without joining it
+	if opts.MemberID != "" {
+		if err := postgres.IsUUID(opts.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.boards.repository.postgres.ListRecent.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("(boards.id IN (SELECT board_id FROM board_access WHERE user_id = ?) OR boards.settings->>'visibility' = ?)",
+			opts.MemberID, string(models.BoardVisibilityPublic)))
+	}
+
+	qr = append(qr, qm.OrderBy("board_views.viewed_at DESC"))
+	if opts.Limit > 0 {
+		qr = append(qr, qm.Limit(opts.Limit))
+	}
+
+	bs, err := dbmodels.Boards(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.boards.repository.postgres.ListRecent.All: %v", err)
+		return nil, err
+	}
+
+	dbBoards := util.DerefSlice(bs)
+	boards := make([]models.Board, len(dbBoards))
+	for i, board := range dbBoards {
+		boards[i] = models.NewBoard(board)
+	}
+
+	return boards, nil
+}
@@ -5,4 +5,20 @@ import "errors"
 var (
 	ErrFieldRequired = errors.New("field required")
 	ErrNotFound      = errors.New("board not found")
+
+	ErrAlreadyArchived = errors.New("board is already archived")
+	ErrNotArchived     = errors.New("board is not archived")
+
+	ErrShareLinkNotFound = errors.New("share link not found")
+	ErrInvalidExpiry     = errors.New("expiry must be in the future")
+
+	ErrInvalidDocument    = errors.New("invalid board document")
+	ErrUnsupportedVersion = errors.New("unsupported board document version")
+	ErrImportNotFound     = errors.New("board import not found")
+
+	ErrInvalidSettings      = errors.New("invalid board settings")
+	ErrWorkspaceRequired    = errors.New("workspace visibility needs a board in a workspace")
+	ErrBackgroundNotFound   = errors.New("background image not found")
+	ErrBackgroundNotAnImage = errors.New("background is not an image")
+	ErrCardKeyPrefixTaken   = errors.New("card key prefix is used by another board")
 )
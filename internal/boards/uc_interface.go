@@ -3,17 +3,65 @@ package boards
 import (
 	"context"
 
-	"github.com/nguyentantai21042004/kanban-api/internal/lists"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
 )
 
 //go:generate mockery --name UseCase
 type UseCase interface {
-	SetList(listUC lists.UseCase)
+	SetTemplate(templateUC templates.UseCase)
 	Get(ctx context.Context, sc models.Scope, ip GetInput) (GetOutput, error)
 	Create(ctx context.Context, sc models.Scope, ip CreateInput) (DetailOutput, error)
+	// Clone copies a board with its lists, labels and cards. Large boards are
+	// copied in the background.
+	Clone(ctx context.Context, sc models.Scope, ip CloneInput) (CloneOutput, error)
+	// Export writes the board with everything on it to a versioned document,
+	// Import recreates a board from it in one transaction.
+	Export(ctx context.Context, sc models.Scope, ID string) (models.BoardExport, error)
+	Import(ctx context.Context, sc models.Scope, ip ImportInput) (ImportOutput, error)
+	// ImportTrello checks a Trello board export and creates the board in the
+	// background. The returned import reports when the board is ready.
+	ImportTrello(ctx context.Context, sc models.Scope, ip ImportTrelloInput) (models.BoardImport, error)
+	// DetailImport returns an import started by the user.
+	DetailImport(ctx context.Context, sc models.Scope, ID string) (models.BoardImport, error)
 	Update(ctx context.Context, sc models.Scope, ip UpdateInput) (DetailOutput, error)
 	Detail(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	// DetailFull returns the board with everything on it. The version of the
+	// board is read first, so the events with a greater version are the ones
+	// the snapshot may miss.
+	DetailFull(ctx context.Context, sc models.Scope, ip DetailFullInput) (BoardWithDetailsOutput, error)
+	// Version returns the current version of a board, without authorization.
+	Version(ctx context.Context, boardID string) (int64, error)
+	// Delete moves the boards to the trash, Restore takes one back out and
+	// Purge deletes it for good with its attachments.
 	Delete(ctx context.Context, sc models.Scope, ids []string) error
+	GetTrash(ctx context.Context, sc models.Scope, ip GetTrashInput) (GetOutput, error)
+	Restore(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	Purge(ctx context.Context, sc models.Scope, ID string) error
+	// Archive makes the board read-only, it stays listed and readable.
+	Archive(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	Unarchive(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	GetAuditLogs(ctx context.Context, sc models.Scope, ip GetAuditLogsInput) (GetAuditLogsOutput, error)
 	Dashboard(ctx context.Context, sc models.Scope, ip DashboardInput) (BoardsDashboardOutput, error)
+
+	// Star adds the board to the starred boards of the user, Unstar takes it
+	// out.
+	Star(ctx context.Context, sc models.Scope, ID string) error
+	Unstar(ctx context.Context, sc models.Scope, ID string) error
+	// GetRecent lists the boards the user viewed last, the last viewed first.
+	GetRecent(ctx context.Context, sc models.Scope, ip GetRecentInput) (GetOutput, error)
+	// RecordView records that the user opened the board. The caller checks
+	// that the user can read it. Detail and DetailFull do not record views,
+	// they also serve the cards, lists and imports reading the board.
+	RecordView(ctx context.Context, sc models.Scope, ID string) error
+
+	GetShareLink(ctx context.Context, sc models.Scope, boardID string) (ShareLinkOutput, error)
+	// EnableShareLink creates the public link of the board, or rotates its
+	// token. The token is only returned here.
+	EnableShareLink(ctx context.Context, sc models.Scope, ip EnableShareLinkInput) (ShareLinkOutput, error)
+	UpdateShareLink(ctx context.Context, sc models.Scope, ip UpdateShareLinkInput) (ShareLinkOutput, error)
+	DisableShareLink(ctx context.Context, sc models.Scope, boardID string) error
+	// DetailShared returns the board a share link token opens, without the
+	// identities of its users. It runs without a signed in user.
+	DetailShared(ctx context.Context, token string) (BoardWithDetailsOutput, error)
 }
@@ -11,26 +11,160 @@ type Filter struct {
 	IDs       []string
 	Keyword   string
 	CreatedBy string
+	// MemberID keeps the rows of the boards the user is a member of
+	MemberID    string
+	WorkspaceID string
+	// Archived keeps the archived boards when true and the active ones when
+	// false
+	Archived *bool
+	// Trashed lists the boards in the trash instead of the live ones
+	Trashed bool
+	// OwnerID keeps the rows of the boards the user owns, directly or through
+	// a workspace
+	OwnerID string
+	// StarredBy keeps the boards the user starred
+	StarredBy string
+	// ViewedBy keeps the boards the user viewed recently
+	ViewedBy string
+}
+
+// Sort orders the listed boards, the boards are not ordered when it is empty.
+type Sort string
+
+const (
+	// SortStarred lists the boards the user starred first, the last starred
+	// first
+	SortStarred Sort = "starred"
+	// SortRecent lists the boards the user viewed first, the last viewed first
+	SortRecent Sort = "recent"
+)
+
+func (s Sort) IsValid() bool {
+	return s == "" || s == SortStarred || s == SortRecent
 }
 
 type GetInput struct {
-	Filter   Filter
+	Filter Filter
+	// Starred and Recent keep the boards the user starred or viewed recently
+	Starred  bool
+	Recent   bool
+	Sort     Sort
 	PagQuery paginator.PaginateQuery
 }
 
+// GetRecentInput lists the boards the user viewed last, at most Limit of
+// them.
+type GetRecentInput struct {
+	Limit int
+}
+
 type CreateInput struct {
 	Name        string
 	Description string
+	WorkspaceID string
+	// TemplateID picks the template the board starts with, the default
+	// template is used when it is empty
+	TemplateID string
 }
 
+// CloneInput copies a board into the same workspace. Without IncludeCards
+// only the lists and labels are copied.
+type CloneInput struct {
+	ID string
+	// Name of the copy, the name of the board followed by "(copy)" when empty
+	Name            string
+	IncludeCards    bool
+	IncludeArchived bool
+	// KeepAssignees keeps the assignees of the cards and the members of the
+	// board with their roles, so the assignees can see their cards
+	KeepAssignees bool
+	// KeepDueDates keeps the due and start dates, moved by ShiftDays days
+	KeepDueDates    bool
+	ShiftDays       int
+	CopyAttachments bool
+}
+
+// CloneOutput has no board when the copy is made in the background, a
+// board_cloned event on the source board announces it.
+type CloneOutput struct {
+	Board   *models.Board
+	Users   []models.User
+	Pending bool
+}
+
+// ImportInput recreates the board of an export document, in a workspace or
+// as a personal board.
+type ImportInput struct {
+	Document    models.BoardExport
+	WorkspaceID string
+}
+
+// ImportOutput lists the items of the document that were left out of the
+// board.
+type ImportOutput struct {
+	Board   models.Board
+	Users   []models.User
+	Skipped []models.BoardImportSkip
+}
+
+// ImportTrelloInput starts the import of a Trello board export, Data is the
+// JSON document exported by Trello.
+type ImportTrelloInput struct {
+	Data        []byte
+	WorkspaceID string
+}
+
+// The kinds of the items skipped by an import
+const (
+	ImportSkipCard       = "card"
+	ImportSkipComment    = "comment"
+	ImportSkipActivity   = "activity"
+	ImportSkipLabel      = "label"
+	ImportSkipAssignee   = "assignee"
+	ImportSkipAttachment = "attachment"
+)
+
 type UpdateInput struct {
 	ID          string
 	Name        string
 	Description string
+	// WorkspaceID moves the board to another workspace when set, an empty
+	// string takes the board out of its workspace
+	WorkspaceID *string
+	// Settings changes the settings of the board when set
+	Settings *SettingsInput
+}
+
+// SettingsInput changes the settings that are set and keeps the others. An
+// empty prefix, color or image removes it.
+type SettingsInput struct {
+	Visibility      *models.BoardVisibility
+	DefaultPriority *models.CardPriority
+	CardKeyPrefix   *string
+	BackgroundColor *string
+	BackgroundImage *string
+	CommentsEnabled *bool
 }
 
 type GetOutput struct {
-	Boards     []models.Board
+	Boards []models.Board
+	Users  []models.User
+	// Starred are the IDs of the listed boards the user starred
+	Starred    []string
+	Pagination paginator.Paginator
+}
+
+type GetTrashInput struct {
+	PagQuery paginator.PaginateQuery
+}
+
+type GetAuditLogsInput struct {
+	BoardID  string
+	PagQuery paginator.PaginateQuery
+}
+
+type GetAuditLogsOutput struct {
+	Logs       []models.BoardAuditLog
 	Users      []models.User
 	Pagination paginator.Paginator
 }
@@ -40,20 +174,45 @@ type DetailOutput struct {
 	Users []models.User
 }
 
+type DetailFullInput struct {
+	ID string
+	// IncludeArchived also loads the archived lists and cards
+	IncludeArchived bool
+}
+
 type BoardWithDetailsOutput struct {
-	Board  models.Board
-	Lists  []models.List
-	Cards  []models.Card
-	Labels []models.Label
+	Board   models.Board
+	Lists   []models.List
+	Cards   []models.Card
+	Labels  []models.Label
+	Members []models.BoardMember
+	Users   []models.User
 }
 
 // Dashboard aggregation for boards
 type DashboardInput struct {
-	From time.Time
-	To   time.Time
+	From        time.Time
+	To          time.Time
+	WorkspaceID string
 }
 
 type BoardsDashboardOutput struct {
 	Total  int64
 	Active int64
 }
+
+type EnableShareLinkInput struct {
+	BoardID string
+	// ExpiresAt is nil for a link that never expires
+	ExpiresAt *time.Time
+}
+
+type UpdateShareLinkInput struct {
+	BoardID   string
+	ExpiresAt *time.Time
+}
+
+type ShareLinkOutput struct {
+	Link  models.BoardShareLink
+	Token string
+}
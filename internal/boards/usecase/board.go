@@ -5,9 +5,11 @@ import (
 
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
-	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
@@ -17,9 +19,16 @@ func (uc implUsecase) broadcastBoardEvent(ctx context.Context, boardID, eventTyp
 		return nil
 	}
 
-	err := uc.wsHub.BroadcastToBoard(ctx, boardID, eventType, data, userID)
+	// The version is read once the change is saved, so it is never lower than
+	// the version the change produced. The event is still sent without it
+	v, err := uc.Version(ctx, boardID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.boards.usecase.broadcastBoardEvent.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Warnf(ctx, "internal.boards.usecase.broadcastBoardEvent.Version: %v", err)
+	}
+
+	err = uc.wsHub.BroadcastToBoardWithVersion(ctx, boardID, eventType, data, userID, v)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.broadcastBoardEvent.wsHub.BroadcastToBoardWithVersion: %v", err)
 		return err
 	}
 
@@ -27,29 +36,30 @@ func (uc implUsecase) broadcastBoardEvent(ctx context.Context, boardID, eventTyp
 }
 
 func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip boards.GetInput) (boards.GetOutput, error) {
-	u, err := uc.userUC.DetailMe(ctx, sc)
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.boards.usecase.Get.userUC.Detail: %v", err)
+		uc.l.Errorf(ctx, "internal.boards.usecase.Get.memberUC.MemberFilter: %v", err)
 		return boards.GetOutput{}, err
 	}
 
-	rl, err := uc.roleUC.Detail(ctx, sc, u.User.RoleID)
-	if err != nil {
-		uc.l.Errorf(ctx, "internal.boards.usecase.Get.roleUC.Detail: %v", err)
-		return boards.GetOutput{}, err
+	f := boards.Filter{
+		IDs:         ip.Filter.IDs,
+		Keyword:     util.BuildAlias(ip.Filter.Keyword),
+		CreatedBy:   ip.Filter.CreatedBy,
+		MemberID:    memberID,
+		WorkspaceID: ip.Filter.WorkspaceID,
+		Archived:    ip.Filter.Archived,
 	}
-
-	// Only admin can see all boards
-	if rl.Code != models.ADMIN_ROLE {
-		ip.Filter.CreatedBy = u.User.ID
+	if ip.Starred {
+		f.StarredBy = sc.UserID
+	}
+	if ip.Recent {
+		f.ViewedBy = sc.UserID
 	}
 
 	b, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
-		Filter: boards.Filter{
-			IDs:       ip.Filter.IDs,
-			Keyword:   util.BuildAlias(ip.Filter.Keyword),
-			CreatedBy: ip.Filter.CreatedBy,
-		},
+		Filter:   f,
+		Sort:     ip.Sort,
 		PagQuery: ip.PagQuery,
 	})
 	if err != nil {
@@ -72,45 +82,70 @@ func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip boards.GetInp
 		return boards.GetOutput{}, err
 	}
 
+	starred, err := uc.listStarredIDs(ctx, sc, b)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Get.listStarredIDs: %v", err)
+		return boards.GetOutput{}, err
+	}
+
 	return boards.GetOutput{
 		Boards:     b,
 		Users:      us,
+		Starred:    starred,
 		Pagination: p,
 	}, nil
 }
 
 func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip boards.CreateInput) (boards.DetailOutput, error) {
-	b, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
-		Name:        ip.Name,
-		Alias:       util.BuildAlias(ip.Name),
-		Description: ip.Description,
-	})
+	if err := uc.roleUC.Authorize(ctx, sc, models.PermissionBoardCreate); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Create.roleUC.Authorize: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	// Any member of a workspace can create boards in it
+	if ip.WorkspaceID != "" {
+		if err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Create.workspaceUC.Authorize: %v", err)
+			return boards.DetailOutput{}, err
+		}
+	}
 
+	// The template is resolved first so a wrong one does not leave an empty
+	// board behind
+	t, err := uc.boardTemplate(ctx, sc, ip.TemplateID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.boards.usecase.Create.repo.Create: %v", err)
+		uc.l.Warnf(ctx, "internal.boards.usecase.Create.boardTemplate: %v", err)
 		return boards.DetailOutput{}, err
 	}
 
-	// Create default lists for the new board
-	defaultLists := []string{
-		"Backlog",          // 1. Backlog
-		"Data Preparation", // 2. Data Preparation
-		"In Progress",      // 3. In Progress
-		"Blocked",          // 4. Blocked
-		"Completed",        // 5. Completed
-	}
-
-	// Create default lists sequentially to ensure proper positioning
-	for i, listName := range defaultLists {
-		_, err := uc.listUC.Create(ctx, sc, lists.CreateInput{
-			BoardID: b.ID,
-			Name:    listName,
-		})
-		if err != nil {
-			uc.l.Errorf(ctx, "internal.boards.usecase.Create.listUC.Create.defaultList[%d]: %v", i, err)
-			// Don't fail the board creation if list creation fails
-			// Just log the error and continue
-		}
+	settings := models.DefaultBoardSettings()
+	settings.CardKeyPrefix, err = uc.newCardKeyPrefix(ctx, sc, ip.Name, "")
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Create.newCardKeyPrefix: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	content, err := uc.templateContent(ctx, t.Content)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Create.templateContent: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	// The board, its owner and the content of the template are created
+	// together, a failure leaves no half filled board behind
+	b, _, err := uc.repo.CreateWithContent(ctx, sc, repository.CreateWithContentOptions{
+		Board: repository.CreateOptions{
+			Name:        ip.Name,
+			Alias:       util.BuildAlias(ip.Name),
+			Description: ip.Description,
+			WorkspaceID: ip.WorkspaceID,
+			Settings:    &settings,
+		},
+		Content: content,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Create.repo.CreateWithContent: %v", err)
+		return boards.DetailOutput{}, err
 	}
 
 	u, err := uc.userUC.Detail(ctx, sc, *b.CreatedBy)
@@ -140,10 +175,42 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip boards.Upd
 		return boards.DetailOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: oldModel.ID, Role: models.BoardRoleAdmin}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Update.memberUC.Authorize: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	oldWorkspaceID := ""
+	if oldModel.WorkspaceID != nil {
+		oldWorkspaceID = *oldModel.WorkspaceID
+	}
+	workspaceID := oldWorkspaceID
+	if ip.WorkspaceID != nil && *ip.WorkspaceID != oldWorkspaceID {
+		if err := uc.authorizeMove(ctx, sc, oldModel.ID, *ip.WorkspaceID); err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Update.authorizeMove: %v", err)
+			return boards.DetailOutput{}, err
+		}
+		workspaceID = *ip.WorkspaceID
+	}
+
+	// The settings are checked even when they are not changed, a move out of
+	// the workspace can make them wrong
+	var sip boards.SettingsInput
+	if ip.Settings != nil {
+		sip = *ip.Settings
+	}
+	settings, err := uc.applySettings(ctx, sc, oldModel.Settings, sip, ip.Name, workspaceID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Update.applySettings: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
 	b, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
 		ID:          ip.ID,
 		Name:        ip.Name,
 		Description: ip.Description,
+		WorkspaceID: ip.WorkspaceID,
+		Settings:    settings,
 		OldModel:    oldModel,
 	})
 	if err != nil {
@@ -162,6 +229,26 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip boards.Upd
 	}, nil
 }
 
+// authorizeMove checks that the user owns the board and can create boards in
+// the workspace the board is moved to.
+func (uc implUsecase) authorizeMove(ctx context.Context, sc models.Scope, boardID, workspaceID string) error {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleOwner}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.authorizeMove.memberUC.Authorize: %v", err)
+		return err
+	}
+
+	if workspaceID == "" {
+		return nil
+	}
+
+	if err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: workspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.authorizeMove.workspaceUC.Authorize: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (boards.DetailOutput, error) {
 	b, err := uc.repo.Detail(ctx, sc, ID)
 	if err != nil {
@@ -173,6 +260,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (b
 		return boards.DetailOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: b.ID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Detail.memberUC.Authorize: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
 	uIDs := []string{*b.CreatedBy}
 	us, err := uc.userUC.List(ctx, sc, user.ListInput{
 		Filter: user.Filter{
@@ -196,17 +288,40 @@ func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ids []string)
 		return boards.ErrFieldRequired
 	}
 
+	// Only the owners can delete a board
+	for _, id := range ids {
+		if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: id, Role: models.BoardRoleOwner, AllowInactive: true}); err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Delete.memberUC.Authorize: %v", err)
+			return err
+		}
+	}
+
 	err := uc.repo.Delete(ctx, sc, ids)
 	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Delete.repo.Delete.NotFound: %v", err)
+			return boards.ErrNotFound
+		}
 		uc.l.Errorf(ctx, "internal.boards.usecase.Delete.repo.Delete: %v", err)
 		return err
 	}
+
+	for _, id := range ids {
+		if err := uc.broadcastBoardEvent(ctx, id, websocket.MSG_BOARD_DELETED, map[string]string{"id": id}, sc.UserID); err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.Delete.broadcastBoardEvent: %v", err)
+		}
+	}
+
 	return nil
 }
 
 // Dashboard: total via repo.Get; active computed in admin via cards dashboard
 func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip boards.DashboardInput) (boards.BoardsDashboardOutput, error) {
-	bs, _, err := uc.repo.List(ctx, sc, repository.ListOptions{})
+	bs, _, err := uc.repo.List(ctx, sc, repository.ListOptions{
+		Filter: boards.Filter{
+			WorkspaceID: ip.WorkspaceID,
+		},
+	})
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.boards.usecase.Dashboard.repo.Get: %v", err)
 		return boards.BoardsDashboardOutput{}, err
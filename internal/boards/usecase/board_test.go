@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	kanban := models.BoardTemplate{
+		ID: "template-1",
+		Content: models.BoardTemplateContent{
+			Lists: []models.TemplateList{
+				{Name: "Todo", Cards: []models.TemplateCard{{Name: "First card"}}},
+				{Name: "Done"},
+			},
+			Labels: []models.TemplateLabel{{Name: "Bug", Color: "#ff0000"}},
+		},
+	}
+	dbErr := errors.New("insert failed")
+
+	tcs := map[string]struct {
+		templateID string
+		defaultID  string
+		createErr  error
+		// wantLists are the lists the board is created with
+		wantLists []string
+		wantErr   error
+	}{
+		"template given": {
+			templateID: "template-1",
+			wantLists:  []string{"Todo", "Done"},
+		},
+		"default template": {
+			defaultID: "template-1",
+			wantLists: []string{"Todo", "Done"},
+		},
+		"no default template": {},
+		"unknown template": {
+			templateID: "template-9",
+			wantErr:    templates.ErrNotFound,
+		},
+		"content of the template cannot be created": {
+			templateID: "template-1",
+			createErr:  dbErr,
+			wantErr:    dbErr,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			uc.templateUC = fakeTemplateUC{
+				templates: map[string]models.BoardTemplate{kanban.ID: kanban},
+				defaultID: tc.defaultID,
+			}
+			deps.repo.createErr = tc.createErr
+
+			// The owner is created with the board, the members usecase is not
+			// asked to add one
+			o, err := uc.Create(context.Background(), models.Scope{UserID: "user-1"}, boards.CreateInput{
+				Name:       "Roadmap",
+				TemplateID: tc.templateID,
+			})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, deps.repo.boards)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "board-new", o.Board.ID)
+
+			require.Len(t, deps.repo.created, 1)
+			created := deps.repo.created[0]
+			assert.Equal(t, "Roadmap", created.Board.Name)
+			require.NotNil(t, created.Board.Settings)
+			assert.Equal(t, "ROAD", created.Board.Settings.CardKeyPrefix)
+
+			var lists []string
+			for _, l := range created.Content.Lists {
+				lists = append(lists, l.Name)
+			}
+			assert.Equal(t, tc.wantLists, lists)
+			if tc.wantLists != nil {
+				require.Len(t, created.Content.Lists[0].Cards, 1)
+				assert.Equal(t, "First card", created.Content.Lists[0].Cards[0].Name)
+				require.Len(t, created.Content.Labels, 1)
+				assert.Equal(t, "Bug", created.Content.Labels[0].Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,328 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/upload"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+// cloneInBackgroundCards is the number of cards above which a board is copied
+// after the request returns.
+const cloneInBackgroundCards = 500
+
+func (uc implUsecase) Clone(ctx context.Context, sc models.Scope, ip boards.CloneInput) (boards.CloneOutput, error) {
+	if ip.ID == "" {
+		return boards.CloneOutput{}, boards.ErrFieldRequired
+	}
+
+	src, err := uc.repo.Detail(ctx, sc, ip.ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Clone.repo.Detail.NotFound: %v", err)
+			return boards.CloneOutput{}, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Clone.repo.Detail: %v", err)
+		return boards.CloneOutput{}, err
+	}
+
+	// Anyone who can see the board and create boards in its workspace can
+	// copy it
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: src.ID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Clone.memberUC.Authorize: %v", err)
+		return boards.CloneOutput{}, err
+	}
+
+	if err := uc.roleUC.Authorize(ctx, sc, models.PermissionBoardCreate); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Clone.roleUC.Authorize: %v", err)
+		return boards.CloneOutput{}, err
+	}
+
+	if src.WorkspaceID != nil {
+		if err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: *src.WorkspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Clone.workspaceUC.Authorize: %v", err)
+			return boards.CloneOutput{}, err
+		}
+	}
+
+	content, err := uc.repo.ListContent(ctx, sc, repository.ListContentOptions{
+		BoardID:         src.ID,
+		IncludeArchived: ip.IncludeArchived,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Clone.repo.ListContent: %v", err)
+		return boards.CloneOutput{}, err
+	}
+	if !ip.IncludeCards {
+		content.Cards = nil
+	}
+
+	if len(content.Cards) > cloneInBackgroundCards {
+		// The copy outlives the request
+		go uc.cloneInBackground(context.WithoutCancel(ctx), sc, ip, src, content)
+
+		return boards.CloneOutput{Pending: true}, nil
+	}
+
+	b, err := uc.clone(ctx, sc, ip, src, content)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Clone.clone: %v", err)
+		return boards.CloneOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, *b.CreatedBy)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Clone.userUC.Detail.NotFound: %v", err)
+			return boards.CloneOutput{}, err
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Clone.userUC.Detail: %v", err)
+		return boards.CloneOutput{}, err
+	}
+
+	return boards.CloneOutput{
+		Board: &b,
+		Users: []models.User{u.User},
+	}, nil
+}
+
+// cloneInBackground copies a board after the request returned, an event on
+// the source board announces the copy or its failure. A panic is reported as
+// a failure.
+func (uc implUsecase) cloneInBackground(ctx context.Context, sc models.Scope, ip boards.CloneInput, src models.Board, content repository.Content) {
+	defer func() {
+		if r := recover(); r != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.cloneInBackground.recover: %v", r)
+			if err := uc.broadcastBoardEvent(ctx, src.ID, websocket.MSG_BOARD_CLONE_FAILED, src, sc.UserID); err != nil {
+				uc.l.Errorf(ctx, "internal.boards.usecase.cloneInBackground.broadcastBoardEvent: %v", err)
+			}
+		}
+	}()
+
+	b, err := uc.clone(ctx, sc, ip, src, content)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.cloneInBackground.clone: %v", err)
+		if err := uc.broadcastBoardEvent(ctx, src.ID, websocket.MSG_BOARD_CLONE_FAILED, src, sc.UserID); err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.cloneInBackground.broadcastBoardEvent: %v", err)
+		}
+		return
+	}
+
+	if err := uc.broadcastBoardEvent(ctx, src.ID, websocket.MSG_BOARD_CLONED, b, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.cloneInBackground.broadcastBoardEvent: %v", err)
+	}
+}
+
+// clone creates the copy of a board. The attachments are copied first, they
+// are removed again when the board cannot be created.
+func (uc implUsecase) clone(ctx context.Context, sc models.Scope, ip boards.CloneInput, src models.Board, content repository.Content) (models.Board, error) {
+	// The copy starts with its creator as its owner. The members of the board
+	// are kept along with the assignees, the assignees could not see their
+	// cards otherwise
+	memberIDs := map[string]bool{sc.UserID: true}
+	var ms []repository.MemberOptions
+	if ip.KeepAssignees {
+		o, err := uc.memberUC.List(ctx, sc, src.ID)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.clone.memberUC.List: %v", err)
+			return models.Board{}, err
+		}
+		for _, m := range o.Members {
+			if m.UserID == sc.UserID {
+				continue
+			}
+			memberIDs[m.UserID] = true
+			ms = append(ms, repository.MemberOptions{UserID: m.UserID, Role: m.Role})
+		}
+	}
+
+	attachmentIDs := map[string]string{}
+	if ip.CopyAttachments && uc.uploadUC != nil {
+		aIDs := make([]string, 0)
+		for _, c := range content.Cards {
+			aIDs = append(aIDs, uploadIDs(c.Attachments)...)
+		}
+		if len(aIDs) > 0 {
+			o, err := uc.uploadUC.Copy(ctx, sc, upload.CopyInput{IDs: util.RemoveDuplicates(aIDs)})
+			if err != nil {
+				uc.l.Errorf(ctx, "internal.boards.usecase.clone.uploadUC.Copy: %v", err)
+				return models.Board{}, err
+			}
+			attachmentIDs = o.IDs
+		}
+	}
+
+	opts, err := uc.cloneContent(ip, content, attachmentIDs, memberIDs)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.clone.cloneContent: %v", err)
+		uc.removeCopies(ctx, sc, attachmentIDs)
+		return models.Board{}, err
+	}
+
+	name := ip.Name
+	if name == "" {
+		name = fmt.Sprintf("%s (copy)", src.Name)
+	}
+	bOpts := repository.CreateOptions{
+		Name:  name,
+		Alias: util.BuildAlias(name),
+	}
+	if src.Description != nil {
+		bOpts.Description = *src.Description
+	}
+	if src.WorkspaceID != nil {
+		bOpts.WorkspaceID = *src.WorkspaceID
+	}
+	// The copy keeps the settings of the board but starts private, and its
+	// cards get keys of their own
+	settings := src.Settings
+	settings.Visibility = models.BoardVisibilityPrivate
+	settings.CardKeyPrefix, err = uc.newCardKeyPrefix(ctx, sc, name, "")
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.clone.newCardKeyPrefix: %v", err)
+		uc.removeCopies(ctx, sc, attachmentIDs)
+		return models.Board{}, err
+	}
+	bOpts.Settings = &settings
+
+	b, _, err := uc.repo.CreateWithContent(ctx, sc, repository.CreateWithContentOptions{
+		Board:   bOpts,
+		Content: opts,
+		Members: ms,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.clone.repo.CreateWithContent: %v", err)
+		uc.removeCopies(ctx, sc, attachmentIDs)
+		return models.Board{}, err
+	}
+
+	return b, nil
+}
+
+// cloneContent lays out the content of a board for its copy, with new
+// positions and the labels of the cards remapped to the copied labels.
+func (uc implUsecase) cloneContent(ip boards.CloneInput, content repository.Content, attachmentIDs map[string]string, memberIDs map[string]bool) (repository.CreateContentOptions, error) {
+	lbs := make([]repository.ContentLabelOptions, len(content.Labels))
+	for i, lb := range content.Labels {
+		lbs[i] = repository.ContentLabelOptions{
+			Key:   lb.ID,
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+	}
+
+	// The cards are sorted by position, grouping keeps their order
+	cards := make(map[string][]models.Card)
+	for _, c := range content.Cards {
+		cards[c.ListID] = append(cards[c.ListID], c)
+	}
+
+	listPsts, err := uc.positions(len(content.Lists))
+	if err != nil {
+		return repository.CreateContentOptions{}, err
+	}
+
+	ls := make([]repository.ContentListOptions, len(content.Lists))
+	for i, l := range content.Lists {
+		cardPsts, err := uc.positions(len(cards[l.ID]))
+		if err != nil {
+			return repository.CreateContentOptions{}, err
+		}
+
+		cs := make([]repository.ContentCardOptions, len(cards[l.ID]))
+		for j, c := range cards[l.ID] {
+			cs[j] = cloneCard(ip, c, cardPsts[j], attachmentIDs, memberIDs)
+		}
+
+		ls[i] = repository.ContentListOptions{
+			Name:       l.Name,
+			Position:   listPsts[i],
+			IsArchived: l.IsArchived,
+			Cards:      cs,
+		}
+	}
+
+	return repository.CreateContentOptions{
+		Lists:  ls,
+		Labels: lbs,
+	}, nil
+}
+
+func cloneCard(ip boards.CloneInput, c models.Card, pst string, attachmentIDs map[string]string, memberIDs map[string]bool) repository.ContentCardOptions {
+	checklist := make([]repository.ChecklistItemOptions, len(c.Checklist))
+	for i, item := range c.Checklist {
+		checklist[i] = repository.ChecklistItemOptions{
+			Content:     item.Content,
+			IsCompleted: item.IsCompleted,
+		}
+	}
+
+	opts := repository.ContentCardOptions{
+		Name:        c.Name,
+		Description: c.Description,
+		Position:    pst,
+		Priority:    c.Priority,
+		Labels:      c.Labels,
+		Checklist:   checklist,
+		Tags:        c.Tags,
+		IsArchived:  c.IsArchived,
+	}
+
+	if ip.KeepAssignees && c.AssignedTo != nil && memberIDs[*c.AssignedTo] {
+		opts.AssignedTo = *c.AssignedTo
+	}
+
+	if ip.KeepDueDates {
+		opts.DueDate = shiftDays(c.DueDate, ip.ShiftDays)
+		opts.StartDate = shiftDays(c.StartDate, ip.ShiftDays)
+	}
+
+	// Uploads are only kept as copies, sharing them with the source board
+	// would let the purge of one board break the other. Attachments that are
+	// not uploads are links, they are kept as they are
+	for _, a := range c.Attachments {
+		if !isUploadID(a) {
+			opts.Attachments = append(opts.Attachments, a)
+			continue
+		}
+		if id, ok := attachmentIDs[a]; ok {
+			opts.Attachments = append(opts.Attachments, id)
+		}
+	}
+
+	return opts
+}
+
+func shiftDays(t *time.Time, days int) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	shifted := t.AddDate(0, 0, days)
+	return &shifted
+}
+
+// removeCopies deletes the attachments copied for a board that could not be
+// created.
+func (uc implUsecase) removeCopies(ctx context.Context, sc models.Scope, attachmentIDs map[string]string) {
+	if len(attachmentIDs) == 0 {
+		return
+	}
+
+	IDs := make([]string, 0, len(attachmentIDs))
+	for _, id := range attachmentIDs {
+		IDs = append(IDs, id)
+	}
+	if err := uc.uploadUC.Delete(ctx, sc, upload.DeleteInput{IDs: IDs}); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.removeCopies.uploadUC.Delete: %v", err)
+	}
+}
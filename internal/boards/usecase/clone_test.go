@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneCard(t *testing.T) {
+	member, other := "user-1", "user-2"
+	uploadID := "0b6f1f3e-8f0a-4c5e-9a57-3d6f7c0e1a2b"
+	copyID := "5c2d7e4a-1b3f-4d6e-8a9c-0f1e2d3c4b5a"
+	link := "https://example.com/spec.pdf"
+	memberIDs := map[string]bool{member: true}
+
+	tcs := map[string]struct {
+		ip              boards.CloneInput
+		assignedTo      *string
+		attachmentIDs   map[string]string
+		wantAssignedTo  string
+		wantAttachments []string
+	}{
+		"assignee who is a member of the copy": {
+			ip:              boards.CloneInput{KeepAssignees: true},
+			assignedTo:      &member,
+			wantAssignedTo:  member,
+			wantAttachments: []string{link},
+		},
+		"assignee who is not a member of the copy": {
+			ip:              boards.CloneInput{KeepAssignees: true},
+			assignedTo:      &other,
+			wantAttachments: []string{link},
+		},
+		"assignees not kept": {
+			assignedTo:      &member,
+			wantAttachments: []string{link},
+		},
+		"copied uploads and links": {
+			ip:              boards.CloneInput{CopyAttachments: true},
+			attachmentIDs:   map[string]string{uploadID: copyID},
+			wantAttachments: []string{copyID, link},
+		},
+		"links without copies": {
+			wantAttachments: []string{link},
+		},
+		"upload that could not be copied": {
+			ip:              boards.CloneInput{CopyAttachments: true},
+			attachmentIDs:   map[string]string{},
+			wantAttachments: []string{link},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			c := models.Card{
+				ID:          "card-1",
+				Name:        "Ship it",
+				AssignedTo:  tc.assignedTo,
+				Attachments: []string{uploadID, link},
+			}
+
+			opts := cloneCard(tc.ip, c, "a0", tc.attachmentIDs, memberIDs)
+			assert.Equal(t, "Ship it", opts.Name)
+			assert.Equal(t, tc.wantAssignedTo, opts.AssignedTo)
+			assert.Equal(t, tc.wantAttachments, opts.Attachments)
+		})
+	}
+}
+
+// panicRepo panics when the copy of the board is created.
+type panicRepo struct {
+	*fakeRepo
+}
+
+func (r panicRepo) CreateWithContent(ctx context.Context, sc models.Scope, opts repository.CreateWithContentOptions) (models.Board, repository.Content, error) {
+	panic("nil map")
+}
+
+func TestCloneInBackgroundPanic(t *testing.T) {
+	uc, deps := initUseCase(t, time.Now())
+	uc.repo = panicRepo{deps.repo}
+	src := models.Board{ID: "board-1", Name: "Roadmap"}
+
+	// The copy runs in its own goroutine, a panic would stop the server
+	assert.NotPanics(t, func() {
+		uc.cloneInBackground(context.Background(), models.Scope{UserID: "user-1"}, boards.CloneInput{ID: src.ID}, src, repository.Content{})
+	})
+}
+
+func TestCloneKeepAssignees(t *testing.T) {
+	member, outsider := "user-2", "user-3"
+
+	tcs := map[string]struct {
+		ip boards.CloneInput
+		// wantAssignees are the assignees of the copied cards, in order
+		wantAssignees []string
+		wantMembers   []repository.MemberOptions
+	}{
+		"assignees kept": {
+			ip:            boards.CloneInput{ID: "board-1", IncludeCards: true, KeepAssignees: true},
+			wantAssignees: []string{"user-1", member, ""},
+			wantMembers:   []repository.MemberOptions{{UserID: member, Role: models.BoardRoleMember}},
+		},
+		"assignees not kept": {
+			ip:            boards.CloneInput{ID: "board-1", IncludeCards: true},
+			wantAssignees: []string{"", "", ""},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			creator := "user-1"
+			deps.repo.boards["board-1"] = models.Board{ID: "board-1", Name: "Roadmap"}
+			deps.repo.content["board-1"] = repository.Content{
+				Lists: []models.List{{ID: "list-1", Name: "Todo"}},
+				Cards: []models.Card{
+					{ID: "card-1", ListID: "list-1", Name: "Plan", AssignedTo: &creator},
+					{ID: "card-2", ListID: "list-1", Name: "Build", AssignedTo: &member},
+					{ID: "card-3", ListID: "list-1", Name: "Ship", AssignedTo: &outsider},
+				},
+			}
+			deps.memberUC.roles["board-1"] = models.BoardRoleAdmin
+			deps.memberUC.members = map[string][]models.BoardMember{
+				"board-1": {
+					{BoardID: "board-1", UserID: creator, Role: models.BoardRoleAdmin},
+					{BoardID: "board-1", UserID: member, Role: models.BoardRoleMember},
+				},
+			}
+
+			_, err := uc.Clone(context.Background(), models.Scope{UserID: creator}, tc.ip)
+			require.NoError(t, err)
+
+			// The creator owns the copy, the other members keep their roles
+			require.Len(t, deps.repo.created, 1)
+			created := deps.repo.created[0]
+			assert.Equal(t, tc.wantMembers, created.Members)
+
+			require.Len(t, created.Content.Lists, 1)
+			var assignees []string
+			for _, c := range created.Content.Lists[0].Cards {
+				assignees = append(assignees, c.AssignedTo)
+			}
+			assert.Equal(t, tc.wantAssignees, assignees)
+		})
+	}
+}
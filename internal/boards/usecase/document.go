@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"sort"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// newBoardExport writes the board to a document, users are referred to by
+// username and attachments by upload ID or link.
+func newBoardExport(now time.Time, b models.Board, content repository.Content, usernames map[string]string, uploads []models.Upload) models.BoardExport {
+	doc := models.BoardExport{
+		Format:     models.BoardExportFormat,
+		Version:    models.BoardExportVersion,
+		ExportedAt: now,
+		Board: models.ExportBoard{
+			Name:      b.Name,
+			CreatedAt: b.CreatedAt,
+		},
+		Lists:       make([]models.ExportList, len(content.Lists)),
+		Labels:      make([]models.ExportLabel, len(content.Labels)),
+		Cards:       make([]models.ExportCard, len(content.Cards)),
+		Comments:    make([]models.ExportComment, len(content.Comments)),
+		Activities:  make([]models.ExportActivity, len(content.Activities)),
+		Attachments: make([]models.ExportAttachment, len(uploads)),
+	}
+	if b.Description != nil {
+		doc.Board.Description = *b.Description
+	}
+	if b.CreatedBy != nil {
+		doc.Board.CreatedBy = usernames[*b.CreatedBy]
+	}
+
+	for i, l := range content.Lists {
+		doc.Lists[i] = models.ExportList{
+			ID:         l.ID,
+			Name:       l.Name,
+			Position:   l.Position,
+			IsArchived: l.IsArchived,
+		}
+	}
+	for i, lb := range content.Labels {
+		doc.Labels[i] = models.ExportLabel{
+			ID:    lb.ID,
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+	}
+	for i, c := range content.Cards {
+		doc.Cards[i] = newExportCard(c, usernames)
+	}
+	for i, cm := range content.Comments {
+		doc.Comments[i] = models.ExportComment{
+			ID:        cm.ID,
+			CardID:    cm.CardID,
+			User:      usernames[cm.UserID],
+			Content:   cm.Content,
+			CreatedAt: cm.CreatedAt,
+		}
+		if cm.ParentID != nil {
+			doc.Comments[i].ParentID = *cm.ParentID
+		}
+	}
+	for i, a := range content.Activities {
+		doc.Activities[i] = models.ExportActivity{
+			CardID:     a.CardID,
+			ActionType: a.ActionType,
+			OldData:    a.OldData,
+			NewData:    a.NewData,
+			CreatedAt:  a.CreatedAt,
+		}
+	}
+	for i, u := range uploads {
+		doc.Attachments[i] = models.ExportAttachment{
+			ID:           u.ID,
+			OriginalName: u.OriginalName,
+			ContentType:  u.ContentType,
+			Size:         u.Size,
+		}
+		if u.URL != nil {
+			doc.Attachments[i].URL = *u.URL
+		}
+	}
+
+	return doc
+}
+
+func newExportCard(c models.Card, usernames map[string]string) models.ExportCard {
+	checklist := make([]models.ExportChecklistItem, len(c.Checklist))
+	for i, item := range c.Checklist {
+		checklist[i] = models.ExportChecklistItem{
+			Content:     item.Content,
+			IsCompleted: item.IsCompleted,
+		}
+	}
+
+	ec := models.ExportCard{
+		ID:             c.ID,
+		ListID:         c.ListID,
+		Name:           c.Name,
+		Description:    c.Description,
+		Position:       c.Position,
+		Priority:       c.Priority,
+		Labels:         c.Labels,
+		Tags:           c.Tags,
+		Checklist:      checklist,
+		Attachments:    c.Attachments,
+		DueDate:        c.DueDate,
+		StartDate:      c.StartDate,
+		CompletionDate: c.CompletionDate,
+		IsArchived:     c.IsArchived,
+		CreatedAt:      c.CreatedAt,
+	}
+	if c.AssignedTo != nil {
+		ec.AssignedTo = usernames[*c.AssignedTo]
+	}
+	if c.CreatedBy != nil {
+		ec.CreatedBy = usernames[*c.CreatedBy]
+	}
+
+	return ec
+}
+
+// validateDocument rejects the documents that cannot be imported as a whole.
+// Items that only refer to something missing are skipped by the import.
+func validateDocument(doc models.BoardExport) error {
+	if doc.Format != models.BoardExportFormat {
+		return boards.ErrInvalidDocument
+	}
+	if doc.Version < 1 || doc.Version > models.BoardExportVersion {
+		return boards.ErrUnsupportedVersion
+	}
+	if doc.Board.Name == "" {
+		return boards.ErrInvalidDocument
+	}
+
+	listIDs := make(map[string]bool, len(doc.Lists))
+	for _, l := range doc.Lists {
+		if l.ID == "" || l.Name == "" || listIDs[l.ID] {
+			return boards.ErrInvalidDocument
+		}
+		listIDs[l.ID] = true
+	}
+
+	labelIDs := make(map[string]bool, len(doc.Labels))
+	for _, lb := range doc.Labels {
+		if lb.ID == "" || lb.Name == "" || labelIDs[lb.ID] {
+			return boards.ErrInvalidDocument
+		}
+		labelIDs[lb.ID] = true
+	}
+
+	cardIDs := make(map[string]bool, len(doc.Cards))
+	for _, c := range doc.Cards {
+		if c.ID == "" || c.Name == "" || cardIDs[c.ID] {
+			return boards.ErrInvalidDocument
+		}
+		if c.Priority != "" && dbmodels.CardPriority(c.Priority).IsValid() != nil {
+			return boards.ErrInvalidDocument
+		}
+		cardIDs[c.ID] = true
+	}
+
+	commentIDs := make(map[string]bool, len(doc.Comments))
+	for _, cm := range doc.Comments {
+		if cm.ID == "" || cm.Content == "" || commentIDs[cm.ID] {
+			return boards.ErrInvalidDocument
+		}
+		commentIDs[cm.ID] = true
+	}
+
+	for _, a := range doc.Activities {
+		if dbmodels.CardActionType(a.ActionType).IsValid() != nil {
+			return boards.ErrInvalidDocument
+		}
+	}
+
+	return nil
+}
+
+// sortExportLists orders the lists by their position in the document.
+func sortExportLists(ls []models.ExportList) []models.ExportList {
+	sorted := append([]models.ExportList(nil), ls...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	return sorted
+}
+
+// sortExportCards orders the cards by their position in the document, the
+// cards of a list keep their order when grouped.
+func sortExportCards(cs []models.ExportCard) []models.ExportCard {
+	sorted := append([]models.ExportCard(nil), cs...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Position < sorted[j].Position
+	})
+
+	return sorted
+}
+
+// sortComments orders the comments as they were written, so a parent comes
+// before its replies.
+func sortComments(cms []models.ExportComment) []models.ExportComment {
+	sorted := append([]models.ExportComment(nil), cms...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	return sorted
+}
+
+func isUploadID(attachment string) bool {
+	return postgres.IsUUID(attachment) == nil
+}
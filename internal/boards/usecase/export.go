@@ -0,0 +1,364 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/upload"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) Export(ctx context.Context, sc models.Scope, ID string) (models.BoardExport, error) {
+	b, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Export.repo.Detail.NotFound: %v", err)
+			return models.BoardExport{}, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Export.repo.Detail: %v", err)
+		return models.BoardExport{}, err
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: b.ID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Export.memberUC.Authorize: %v", err)
+		return models.BoardExport{}, err
+	}
+
+	content, err := uc.repo.ListContent(ctx, sc, repository.ListContentOptions{
+		BoardID:         b.ID,
+		IncludeArchived: true,
+		IncludeHistory:  true,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Export.repo.ListContent: %v", err)
+		return models.BoardExport{}, err
+	}
+
+	usernames, err := uc.exportUsernames(ctx, sc, b, content)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Export.exportUsernames: %v", err)
+		return models.BoardExport{}, err
+	}
+
+	aIDs := make([]string, 0)
+	for _, c := range content.Cards {
+		aIDs = append(aIDs, c.Attachments...)
+	}
+	var uploads []models.Upload
+	if len(aIDs) > 0 && uc.uploadUC != nil {
+		uploads, err = uc.uploadUC.List(ctx, sc, upload.ListInput{IDs: util.RemoveDuplicates(aIDs)})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.Export.uploadUC.List: %v", err)
+			return models.BoardExport{}, err
+		}
+	}
+
+	return newBoardExport(uc.clock(), b, content, usernames, uploads), nil
+}
+
+// exportUsernames returns the usernames of the users the board refers to, by
+// ID.
+func (uc implUsecase) exportUsernames(ctx context.Context, sc models.Scope, b models.Board, content repository.Content) (map[string]string, error) {
+	uIDs := make([]string, 0)
+	if b.CreatedBy != nil {
+		uIDs = append(uIDs, *b.CreatedBy)
+	}
+	for _, c := range content.Cards {
+		if c.AssignedTo != nil {
+			uIDs = append(uIDs, *c.AssignedTo)
+		}
+		if c.CreatedBy != nil {
+			uIDs = append(uIDs, *c.CreatedBy)
+		}
+	}
+	for _, cm := range content.Comments {
+		uIDs = append(uIDs, cm.UserID)
+	}
+
+	usernames := make(map[string]string)
+	if len(uIDs) == 0 {
+		return usernames, nil
+	}
+
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: util.RemoveDuplicates(uIDs),
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.exportUsernames.userUC.List: %v", err)
+		return nil, err
+	}
+	for _, u := range us {
+		usernames[u.ID] = u.Username
+	}
+
+	return usernames, nil
+}
+
+func (uc implUsecase) Import(ctx context.Context, sc models.Scope, ip boards.ImportInput) (boards.ImportOutput, error) {
+	if err := validateDocument(ip.Document); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Import.validateDocument: %v", err)
+		return boards.ImportOutput{}, err
+	}
+
+	if err := uc.roleUC.Authorize(ctx, sc, models.PermissionBoardCreate); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Import.roleUC.Authorize: %v", err)
+		return boards.ImportOutput{}, err
+	}
+
+	if ip.WorkspaceID != "" {
+		if err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Import.workspaceUC.Authorize: %v", err)
+			return boards.ImportOutput{}, err
+		}
+	}
+
+	b, skipped, err := uc.importDocument(ctx, sc, ip.Document, ip.WorkspaceID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Import.importDocument: %v", err)
+		return boards.ImportOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, *b.CreatedBy)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Import.userUC.Detail.NotFound: %v", err)
+			return boards.ImportOutput{}, err
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Import.userUC.Detail: %v", err)
+		return boards.ImportOutput{}, err
+	}
+
+	return boards.ImportOutput{
+		Board:   b,
+		Users:   []models.User{u.User},
+		Skipped: skipped,
+	}, nil
+}
+
+// importDocument creates the board of a valid document, the user is
+// authorized by the caller.
+func (uc implUsecase) importDocument(ctx context.Context, sc models.Scope, doc models.BoardExport, workspaceID string) (models.Board, []models.BoardImportSkip, error) {
+	userIDs, err := uc.importUserIDs(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.importDocument.importUserIDs: %v", err)
+		return models.Board{}, nil, err
+	}
+
+	opts, skipped, err := uc.importContent(doc, userIDs)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.importDocument.importContent: %v", err)
+		return models.Board{}, nil, err
+	}
+
+	settings := models.DefaultBoardSettings()
+	settings.CardKeyPrefix, err = uc.newCardKeyPrefix(ctx, sc, doc.Board.Name, "")
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.importDocument.newCardKeyPrefix: %v", err)
+		return models.Board{}, nil, err
+	}
+
+	b, _, err := uc.repo.CreateWithContent(ctx, sc, repository.CreateWithContentOptions{
+		Board: repository.CreateOptions{
+			Name:        doc.Board.Name,
+			Alias:       util.BuildAlias(doc.Board.Name),
+			Description: doc.Board.Description,
+			WorkspaceID: workspaceID,
+			Settings:    &settings,
+		},
+		Content: opts,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.importDocument.repo.CreateWithContent: %v", err)
+		return models.Board{}, nil, err
+	}
+
+	return b, skipped, nil
+}
+
+// importUserIDs returns the IDs of the users the new board can refer to, by
+// username. The board starts with the importer as its only member, the other
+// users of the document are skipped whether they exist or not, so a document
+// cannot tell which usernames are taken.
+func (uc implUsecase) importUserIDs(ctx context.Context, sc models.Scope) (map[string]string, error) {
+	uo, err := uc.userUC.Detail(ctx, sc, sc.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.importUserIDs.userUC.Detail: %v", err)
+		return nil, err
+	}
+
+	return map[string]string{uo.User.Username: uo.User.ID}, nil
+}
+
+// importContent lays out the content of a document for a new board. The
+// lists and cards keep their order with new positions, the items that refer
+// to something missing are skipped.
+func (uc implUsecase) importContent(doc models.BoardExport, userIDs map[string]string) (repository.CreateContentOptions, []models.BoardImportSkip, error) {
+	skipped := make([]models.BoardImportSkip, 0)
+
+	lbs := make([]repository.ContentLabelOptions, len(doc.Labels))
+	labelIDs := make(map[string]bool, len(doc.Labels))
+	for i, lb := range doc.Labels {
+		lbs[i] = repository.ContentLabelOptions{
+			Key:   lb.ID,
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+		labelIDs[lb.ID] = true
+	}
+
+	comments := make(map[string][]repository.ContentCommentOptions)
+	for _, cm := range sortComments(doc.Comments) {
+		uID, ok := userIDs[cm.User]
+		if !ok {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipComment, ID: cm.ID, Reason: "user " + cm.User + " is not a member of the board"})
+			continue
+		}
+		comments[cm.CardID] = append(comments[cm.CardID], repository.ContentCommentOptions{
+			Key:       cm.ID,
+			ParentKey: cm.ParentID,
+			UserID:    uID,
+			Content:   cm.Content,
+			CreatedAt: cm.CreatedAt,
+		})
+	}
+
+	activities := make(map[string][]repository.ContentActivityOptions)
+	for _, a := range doc.Activities {
+		activities[a.CardID] = append(activities[a.CardID], repository.ContentActivityOptions{
+			ActionType: a.ActionType,
+			OldData:    a.OldData,
+			NewData:    a.NewData,
+			CreatedAt:  a.CreatedAt,
+		})
+	}
+
+	lists := sortExportLists(doc.Lists)
+	listIDs := make(map[string]bool, len(lists))
+	for _, l := range lists {
+		listIDs[l.ID] = true
+	}
+
+	cards := make(map[string][]models.ExportCard)
+	cardIDs := make(map[string]bool, len(doc.Cards))
+	for _, c := range sortExportCards(doc.Cards) {
+		if !listIDs[c.ListID] {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipCard, ID: c.ID, Reason: "unknown list " + c.ListID})
+			continue
+		}
+		cards[c.ListID] = append(cards[c.ListID], c)
+		cardIDs[c.ID] = true
+	}
+
+	// The history of the skipped cards goes with them
+	for _, cm := range doc.Comments {
+		if _, ok := userIDs[cm.User]; ok && !cardIDs[cm.CardID] {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipComment, ID: cm.ID, Reason: "unknown card " + cm.CardID})
+		}
+	}
+	for _, a := range doc.Activities {
+		if !cardIDs[a.CardID] {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipActivity, ID: a.CardID, Reason: "unknown card " + a.CardID})
+		}
+	}
+
+	listPsts, err := uc.positions(len(lists))
+	if err != nil {
+		return repository.CreateContentOptions{}, nil, err
+	}
+
+	ls := make([]repository.ContentListOptions, len(lists))
+	for i, l := range lists {
+		cardPsts, err := uc.positions(len(cards[l.ID]))
+		if err != nil {
+			return repository.CreateContentOptions{}, nil, err
+		}
+
+		cs := make([]repository.ContentCardOptions, len(cards[l.ID]))
+		for j, c := range cards[l.ID] {
+			var cardSkipped []models.BoardImportSkip
+			cs[j], cardSkipped = importCard(c, cardPsts[j], labelIDs, userIDs)
+			cs[j].Comments = comments[c.ID]
+			cs[j].Activities = activities[c.ID]
+			skipped = append(skipped, cardSkipped...)
+		}
+
+		ls[i] = repository.ContentListOptions{
+			Name:       l.Name,
+			Position:   listPsts[i],
+			IsArchived: l.IsArchived,
+			Cards:      cs,
+		}
+	}
+
+	return repository.CreateContentOptions{
+		Lists:  ls,
+		Labels: lbs,
+	}, skipped, nil
+}
+
+func importCard(c models.ExportCard, pst string, labelIDs map[string]bool, userIDs map[string]string) (repository.ContentCardOptions, []models.BoardImportSkip) {
+	var skipped []models.BoardImportSkip
+
+	labels := make([]string, 0, len(c.Labels))
+	for _, l := range c.Labels {
+		if !labelIDs[l] {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipLabel, ID: l, Reason: "unknown label on card " + c.ID})
+			continue
+		}
+		labels = append(labels, l)
+	}
+
+	// The files are not part of the document, only the links are kept
+	attachments := make([]string, 0, len(c.Attachments))
+	for _, a := range c.Attachments {
+		if isUploadID(a) {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipAttachment, ID: a, Reason: "file of card " + c.ID + " is not in the document"})
+			continue
+		}
+		attachments = append(attachments, a)
+	}
+
+	checklist := make([]repository.ChecklistItemOptions, len(c.Checklist))
+	for i, item := range c.Checklist {
+		checklist[i] = repository.ChecklistItemOptions{
+			Content:     item.Content,
+			IsCompleted: item.IsCompleted,
+		}
+	}
+
+	opts := repository.ContentCardOptions{
+		Name:           c.Name,
+		Description:    c.Description,
+		Position:       pst,
+		Priority:       c.Priority,
+		Labels:         labels,
+		Checklist:      checklist,
+		DueDate:        c.DueDate,
+		StartDate:      c.StartDate,
+		CompletionDate: c.CompletionDate,
+		Tags:           c.Tags,
+		Attachments:    attachments,
+		IsArchived:     c.IsArchived,
+	}
+	if !c.CreatedAt.IsZero() {
+		createdAt := c.CreatedAt
+		opts.CreatedAt = &createdAt
+	}
+
+	if c.AssignedTo != "" {
+		if uID, ok := userIDs[c.AssignedTo]; ok {
+			opts.AssignedTo = uID
+		} else {
+			skipped = append(skipped, models.BoardImportSkip{Kind: boards.ImportSkipAssignee, ID: c.ID, Reason: "user " + c.AssignedTo + " is not a member of the board"})
+		}
+	}
+
+	return opts, skipped
+}
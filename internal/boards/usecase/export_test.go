@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportDocument returns a valid document with a list, a label and a card
+// that refers to them, and a comment of "john" on the card.
+func exportDocument() models.BoardExport {
+	return models.BoardExport{
+		Format:  models.BoardExportFormat,
+		Version: models.BoardExportVersion,
+		Board:   models.ExportBoard{Name: "Roadmap"},
+		Lists:   []models.ExportList{{ID: "list-1", Name: "Todo", Position: "a0"}},
+		Labels:  []models.ExportLabel{{ID: "label-1", Name: "bug", Color: "#ff0000"}},
+		Cards: []models.ExportCard{{
+			ID:         "card-1",
+			ListID:     "list-1",
+			Name:       "Ship it",
+			Position:   "a0",
+			Priority:   models.CardPriorityHigh,
+			Labels:     []string{"label-1"},
+			AssignedTo: "john",
+		}},
+		Comments: []models.ExportComment{{ID: "comment-1", CardID: "card-1", User: "john", Content: "Done soon"}},
+	}
+}
+
+func TestValidateDocument(t *testing.T) {
+	tcs := map[string]struct {
+		change  func(doc *models.BoardExport)
+		wantErr error
+	}{
+		"valid document": {
+			change: func(doc *models.BoardExport) {},
+		},
+		"older version": {
+			change: func(doc *models.BoardExport) { doc.Version = 1 },
+		},
+		"other format": {
+			change:  func(doc *models.BoardExport) { doc.Format = "trello" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"missing version": {
+			change:  func(doc *models.BoardExport) { doc.Version = 0 },
+			wantErr: boards.ErrUnsupportedVersion,
+		},
+		"newer version": {
+			change:  func(doc *models.BoardExport) { doc.Version = models.BoardExportVersion + 1 },
+			wantErr: boards.ErrUnsupportedVersion,
+		},
+		"missing board name": {
+			change:  func(doc *models.BoardExport) { doc.Board.Name = "" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"duplicate list": {
+			change:  func(doc *models.BoardExport) { doc.Lists = append(doc.Lists, doc.Lists[0]) },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"label without name": {
+			change:  func(doc *models.BoardExport) { doc.Labels[0].Name = "" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"card without ID": {
+			change:  func(doc *models.BoardExport) { doc.Cards[0].ID = "" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"unknown priority": {
+			change:  func(doc *models.BoardExport) { doc.Cards[0].Priority = "urgent" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"empty comment": {
+			change:  func(doc *models.BoardExport) { doc.Comments[0].Content = "" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"unknown activity": {
+			change: func(doc *models.BoardExport) {
+				doc.Activities = []models.ExportActivity{{CardID: "card-1", ActionType: "teleported"}}
+			},
+			wantErr: boards.ErrInvalidDocument,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			doc := exportDocument()
+			tc.change(&doc)
+
+			err := validateDocument(doc)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	link := "https://example.com/spec.pdf"
+	uploadID := "0b6f1f3e-8f0a-4c5e-9a57-3d6f7c0e1a2b"
+
+	tcs := map[string]struct {
+		change      func(doc *models.BoardExport)
+		workspaceID string
+		roleErr     error
+		wantErr     error
+		wantSkipped []models.BoardImportSkip
+	}{
+		"complete document": {
+			change: func(doc *models.BoardExport) {},
+		},
+		"card on a missing list": {
+			change: func(doc *models.BoardExport) { doc.Cards[0].ListID = "list-2" },
+			wantSkipped: []models.BoardImportSkip{
+				{Kind: boards.ImportSkipCard, ID: "card-1", Reason: "unknown list list-2"},
+				{Kind: boards.ImportSkipComment, ID: "comment-1", Reason: "unknown card card-1"},
+			},
+		},
+		"unknown users": {
+			change: func(doc *models.BoardExport) {
+				doc.Cards[0].AssignedTo = "ghost"
+				doc.Comments[0].User = "ghost"
+			},
+			wantSkipped: []models.BoardImportSkip{
+				{Kind: boards.ImportSkipComment, ID: "comment-1", Reason: "user ghost is not a member of the board"},
+				{Kind: boards.ImportSkipAssignee, ID: "card-1", Reason: "user ghost is not a member of the board"},
+			},
+		},
+		// The users who are not members of the new board are skipped like
+		// unknown ones
+		"users who are not members": {
+			change: func(doc *models.BoardExport) {
+				doc.Cards[0].AssignedTo = "jane"
+				doc.Comments[0].User = "jane"
+			},
+			wantSkipped: []models.BoardImportSkip{
+				{Kind: boards.ImportSkipComment, ID: "comment-1", Reason: "user jane is not a member of the board"},
+				{Kind: boards.ImportSkipAssignee, ID: "card-1", Reason: "user jane is not a member of the board"},
+			},
+		},
+		"missing label": {
+			change: func(doc *models.BoardExport) { doc.Cards[0].Labels = []string{"label-2"} },
+			wantSkipped: []models.BoardImportSkip{
+				{Kind: boards.ImportSkipLabel, ID: "label-2", Reason: "unknown label on card card-1"},
+			},
+		},
+		"uploaded files": {
+			change: func(doc *models.BoardExport) { doc.Cards[0].Attachments = []string{uploadID, link} },
+			wantSkipped: []models.BoardImportSkip{
+				{Kind: boards.ImportSkipAttachment, ID: uploadID, Reason: "file of card card-1 is not in the document"},
+			},
+		},
+		"invalid document": {
+			change:  func(doc *models.BoardExport) { doc.Format = "" },
+			wantErr: boards.ErrInvalidDocument,
+		},
+		"cannot create boards": {
+			change:  func(doc *models.BoardExport) {},
+			roleErr: role.ErrForbidden,
+			wantErr: role.ErrForbidden,
+		},
+		"workspace of another user": {
+			change:      func(doc *models.BoardExport) {},
+			workspaceID: "workspace-1",
+			wantErr:     workspaces.ErrForbidden,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.roleUC.err = tc.roleErr
+			doc := exportDocument()
+			tc.change(&doc)
+
+			o, err := uc.Import(context.Background(), models.Scope{UserID: "user-1"}, boards.ImportInput{
+				Document:    doc,
+				WorkspaceID: tc.workspaceID,
+			})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, deps.repo.created)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Roadmap", o.Board.Name)
+			if tc.wantSkipped == nil {
+				assert.Empty(t, o.Skipped)
+			} else {
+				assert.Equal(t, tc.wantSkipped, o.Skipped)
+			}
+
+			require.Len(t, deps.repo.created, 1)
+			require.NotNil(t, deps.repo.created[0].Board.Settings)
+			assert.Equal(t, "ROAD", deps.repo.created[0].Board.Settings.CardKeyPrefix)
+			content := deps.repo.created[0].Content
+			require.Len(t, content.Lists, 1)
+			for _, c := range content.Lists[0].Cards {
+				assert.NotContains(t, c.Attachments, uploadID)
+				for _, cm := range c.Comments {
+					assert.Equal(t, "user-1", cm.UserID)
+				}
+			}
+		})
+	}
+}
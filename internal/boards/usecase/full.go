@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) DetailFull(ctx context.Context, sc models.Scope, ip boards.DetailFullInput) (boards.BoardWithDetailsOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.ID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.DetailFull.memberUC.Authorize: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+
+	// The board, and its version, is read before the content so a change
+	// landing in between shows up as an event newer than the snapshot
+	b, err := uc.repo.Detail(ctx, sc, ip.ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.DetailFull.repo.Detail.NotFound: %v", err)
+			return boards.BoardWithDetailsOutput{}, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailFull.repo.Detail: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+
+	c, err := uc.repo.ListContent(ctx, sc, repository.ListContentOptions{
+		BoardID:         b.ID,
+		IncludeArchived: ip.IncludeArchived,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailFull.repo.ListContent: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+
+	ms, err := uc.memberUC.List(ctx, sc, b.ID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailFull.memberUC.List: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+
+	// Cards can still point at users who left the board
+	us := ms.Users
+	known := make(map[string]bool, len(us))
+	for _, u := range us {
+		known[u.ID] = true
+	}
+	uIDs := make([]string, 0)
+	if b.CreatedBy != nil && !known[*b.CreatedBy] {
+		uIDs = append(uIDs, *b.CreatedBy)
+	}
+	for _, cd := range c.Cards {
+		for _, id := range []*string{cd.AssignedTo, cd.CreatedBy} {
+			if id != nil && !known[*id] {
+				uIDs = append(uIDs, *id)
+			}
+		}
+	}
+	if len(uIDs) > 0 {
+		others, err := uc.userUC.List(ctx, sc, user.ListInput{
+			Filter: user.Filter{
+				IDs: util.RemoveDuplicates(uIDs),
+			},
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.DetailFull.userUC.List: %v", err)
+			return boards.BoardWithDetailsOutput{}, err
+		}
+		us = append(us, others...)
+	}
+
+	return boards.BoardWithDetailsOutput{
+		Board:   b,
+		Lists:   c.Lists,
+		Cards:   c.Cards,
+		Labels:  c.Labels,
+		Members: ms.Members,
+		Users:   us,
+	}, nil
+}
+
+func (uc implUsecase) Version(ctx context.Context, boardID string) (int64, error) {
+	v, err := uc.repo.GetVersion(ctx, models.Scope{}, boardID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Version.repo.GetVersion.NotFound: %v", err)
+			return 0, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Version.repo.GetVersion: %v", err)
+		return 0, err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+)
+
+const (
+	// importTimeout is the time after which an import that made no progress
+	// is reported as failed.
+	importTimeout = 30 * time.Minute
+	// importHeartbeat is how often a running import records that it is still
+	// alive, well within the import timeout.
+	importHeartbeat = time.Minute
+)
+
+func (uc implUsecase) ImportTrello(ctx context.Context, sc models.Scope, ip boards.ImportTrelloInput) (models.BoardImport, error) {
+	var tb trelloBoard
+	if err := json.Unmarshal(ip.Data, &tb); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.ImportTrello.json.Unmarshal: %v", err)
+		return models.BoardImport{}, boards.ErrInvalidDocument
+	}
+
+	// The document is checked before the import starts, so a wrong file is
+	// reported right away
+	doc := newTrelloDocument(tb, uc.clock())
+	if err := validateDocument(doc); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.ImportTrello.validateDocument: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	if err := uc.roleUC.Authorize(ctx, sc, models.PermissionBoardCreate); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.ImportTrello.roleUC.Authorize: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	if ip.WorkspaceID != "" {
+		if err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.ImportTrello.workspaceUC.Authorize: %v", err)
+			return models.BoardImport{}, err
+		}
+	}
+
+	uc.failStaleImports(ctx, sc)
+
+	imp, err := uc.repo.CreateImport(ctx, sc, repository.CreateImportOptions{
+		Source:      models.BoardImportSourceTrello,
+		WorkspaceID: ip.WorkspaceID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.ImportTrello.repo.CreateImport: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	// The import outlives the request
+	bgCtx := context.WithoutCancel(ctx)
+	go uc.runImport(bgCtx, sc, imp.ID, doc, ip.WorkspaceID)
+
+	return imp, nil
+}
+
+// runImport creates the board of an import and records how it went. A panic
+// fails the import instead of leaving it running.
+func (uc implUsecase) runImport(ctx context.Context, sc models.Scope, importID string, doc models.BoardExport, workspaceID string) {
+	defer func() {
+		if r := recover(); r != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.runImport.recover: %v", r)
+			uc.failImport(ctx, sc, importID, fmt.Sprintf("import stopped: %v", r))
+		}
+	}()
+
+	if _, err := uc.repo.UpdateImport(ctx, sc, repository.UpdateImportOptions{
+		ID:         importID,
+		FromStatus: models.BoardImportPending,
+		Status:     models.BoardImportRunning,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.runImport.repo.UpdateImport: %v", err)
+		return
+	}
+
+	stop := uc.keepImportAlive(ctx, sc, importID, importHeartbeat)
+	defer stop()
+	b, skipped, err := uc.importDocument(ctx, sc, doc, workspaceID)
+	stop()
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.runImport.importDocument: %v", err)
+		uc.failImport(ctx, sc, importID, err.Error())
+		return
+	}
+
+	// An import failed in the meantime keeps its status, the report does not
+	// change its mind
+	if _, err := uc.repo.UpdateImport(ctx, sc, repository.UpdateImportOptions{
+		ID:         importID,
+		FromStatus: models.BoardImportRunning,
+		Status:     models.BoardImportCompleted,
+		BoardID:    b.ID,
+		Report:     &models.BoardImportReport{Skipped: skipped},
+	}); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.runImport.repo.UpdateImport: import %s is no longer running, board %s was created", importID, b.ID)
+			return
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.runImport.repo.UpdateImport: %v", err)
+	}
+}
+
+// keepImportAlive records every interval that the import is still running,
+// until the returned function is called. Only the imports that stopped are
+// timed out. The returned function can be called more than once.
+func (uc implUsecase) keepImportAlive(ctx context.Context, sc models.Scope, importID string, interval time.Duration) func() {
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-t.C:
+				if _, err := uc.repo.UpdateImport(ctx, sc, repository.UpdateImportOptions{
+					ID:         importID,
+					FromStatus: models.BoardImportRunning,
+					Status:     models.BoardImportRunning,
+				}); err != nil {
+					uc.l.Warnf(ctx, "internal.boards.usecase.keepImportAlive.repo.UpdateImport: %v", err)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+		<-stopped
+	}
+}
+
+// failImport records the error a running import stopped with.
+func (uc implUsecase) failImport(ctx context.Context, sc models.Scope, importID, message string) {
+	if _, err := uc.repo.UpdateImport(ctx, sc, repository.UpdateImportOptions{
+		ID:           importID,
+		FromStatus:   models.BoardImportRunning,
+		Status:       models.BoardImportFailed,
+		ErrorMessage: message,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.failImport.repo.UpdateImport: %v", err)
+	}
+}
+
+// failStaleImports fails the imports that made no progress for longer than
+// the import timeout, like the ones running when the server stopped. Failures
+// are only logged, the sweep runs again with the next import.
+func (uc implUsecase) failStaleImports(ctx context.Context, sc models.Scope) {
+	if err := uc.repo.FailStaleImports(ctx, sc, repository.FailStaleImportsOptions{
+		UpdatedBefore: uc.clock().Add(-importTimeout),
+		ErrorMessage:  "import timed out",
+	}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.failStaleImports.repo.FailStaleImports: %v", err)
+	}
+}
+
+func (uc implUsecase) DetailImport(ctx context.Context, sc models.Scope, ID string) (models.BoardImport, error) {
+	uc.failStaleImports(ctx, sc)
+
+	imp, err := uc.repo.DetailImport(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.DetailImport.repo.DetailImport.NotFound: %v", err)
+			return models.BoardImport{}, boards.ErrImportNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailImport.repo.DetailImport: %v", err)
+		return models.BoardImport{}, err
+	}
+
+	// The imports of the other users are not reported
+	if imp.CreatedBy != sc.UserID {
+		uc.l.Warnf(ctx, "internal.boards.usecase.DetailImport.NotCreator: import %s was not started by user %s", ID, sc.UserID)
+		return models.BoardImport{}, boards.ErrImportNotFound
+	}
+
+	return imp, nil
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunImport(t *testing.T) {
+	tcs := map[string]struct {
+		change      func(doc *models.BoardExport)
+		createPanic interface{}
+		// timedOut fails the import while its board is created, like the
+		// sweep of stale imports would
+		timedOut    bool
+		wantStatus  models.BoardImportStatus
+		wantError   string
+		wantSkipped int
+	}{
+		"completed import": {
+			change:     func(doc *models.BoardExport) {},
+			wantStatus: models.BoardImportCompleted,
+		},
+		"completed import with skipped items": {
+			change:      func(doc *models.BoardExport) { doc.Cards[0].AssignedTo = "ghost" },
+			wantStatus:  models.BoardImportCompleted,
+			wantSkipped: 1,
+		},
+		"import that panics": {
+			change:      func(doc *models.BoardExport) {},
+			createPanic: errors.New("nil map"),
+			wantStatus:  models.BoardImportFailed,
+			wantError:   "import stopped: nil map",
+		},
+		"import timed out while it ran": {
+			change:     func(doc *models.BoardExport) {},
+			timedOut:   true,
+			wantStatus: models.BoardImportFailed,
+			wantError:  "import timed out",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.repo.createPanic = tc.createPanic
+			if tc.timedOut {
+				uc.repo = timedOutRepo{deps.repo}
+			}
+			deps.repo.imports["import-1"] = models.BoardImport{ID: "import-1", Status: models.BoardImportPending, CreatedBy: "user-1"}
+			doc := exportDocument()
+			tc.change(&doc)
+
+			require.NotPanics(t, func() {
+				uc.runImport(context.Background(), models.Scope{UserID: "user-1"}, "import-1", doc, "")
+			})
+
+			imp := deps.repo.imports["import-1"]
+			assert.Equal(t, tc.wantStatus, imp.Status)
+			if tc.wantError != "" {
+				require.NotNil(t, imp.ErrorMessage)
+				assert.Equal(t, tc.wantError, *imp.ErrorMessage)
+				assert.Nil(t, imp.BoardID)
+				return
+			}
+			assert.Nil(t, imp.ErrorMessage)
+			require.NotNil(t, imp.BoardID)
+			assert.Equal(t, "board-new", *imp.BoardID)
+			assert.Len(t, imp.Report.Skipped, tc.wantSkipped)
+		})
+	}
+}
+
+// timedOutRepo fails the imports while the board of one is created.
+type timedOutRepo struct {
+	*fakeRepo
+}
+
+func (r timedOutRepo) CreateWithContent(ctx context.Context, sc models.Scope, opts repository.CreateWithContentOptions) (models.Board, repository.Content, error) {
+	if err := r.FailStaleImports(ctx, sc, repository.FailStaleImportsOptions{
+		UpdatedBefore: time.Now().Add(time.Minute),
+		ErrorMessage:  "import timed out",
+	}); err != nil {
+		return models.Board{}, repository.Content{}, err
+	}
+	return r.fakeRepo.CreateWithContent(ctx, sc, opts)
+}
+
+func TestKeepImportAlive(t *testing.T) {
+	now := time.Now()
+
+	tcs := map[string]struct {
+		status     models.BoardImportStatus
+		wantStatus models.BoardImportStatus
+	}{
+		"running import is kept alive": {
+			status:     models.BoardImportRunning,
+			wantStatus: models.BoardImportRunning,
+		},
+		"failed import stays failed": {
+			status:     models.BoardImportFailed,
+			wantStatus: models.BoardImportFailed,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+			deps.repo.imports["import-1"] = models.BoardImport{
+				ID:        "import-1",
+				Status:    tc.status,
+				CreatedBy: "user-1",
+				UpdatedAt: now.Add(-importTimeout - time.Minute),
+			}
+
+			stop := uc.keepImportAlive(context.Background(), models.Scope{UserID: "user-1"}, "import-1", time.Millisecond)
+			time.Sleep(20 * time.Millisecond)
+			stop()
+			stop()
+
+			// An import that is still alive is not timed out
+			imp, err := uc.DetailImport(context.Background(), models.Scope{UserID: "user-1"}, "import-1")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, imp.Status)
+		})
+	}
+}
+
+func TestDetailImport(t *testing.T) {
+	now := time.Now()
+
+	tcs := map[string]struct {
+		status     models.BoardImportStatus
+		updatedAt  time.Time
+		createdBy  string
+		wantErr    error
+		wantStatus models.BoardImportStatus
+	}{
+		"running import": {
+			status:     models.BoardImportRunning,
+			updatedAt:  now.Add(-time.Minute),
+			createdBy:  "user-1",
+			wantStatus: models.BoardImportRunning,
+		},
+		"running import past the timeout": {
+			status:     models.BoardImportRunning,
+			updatedAt:  now.Add(-importTimeout - time.Minute),
+			createdBy:  "user-1",
+			wantStatus: models.BoardImportFailed,
+		},
+		"pending import past the timeout": {
+			status:     models.BoardImportPending,
+			updatedAt:  now.Add(-importTimeout - time.Minute),
+			createdBy:  "user-1",
+			wantStatus: models.BoardImportFailed,
+		},
+		"completed import past the timeout": {
+			status:     models.BoardImportCompleted,
+			updatedAt:  now.Add(-importTimeout - time.Minute),
+			createdBy:  "user-1",
+			wantStatus: models.BoardImportCompleted,
+		},
+		"import of another user": {
+			status:    models.BoardImportRunning,
+			updatedAt: now,
+			createdBy: "user-2",
+			wantErr:   boards.ErrImportNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+			deps.repo.imports["import-1"] = models.BoardImport{
+				ID:        "import-1",
+				Status:    tc.status,
+				CreatedBy: tc.createdBy,
+				UpdatedAt: tc.updatedAt,
+			}
+
+			imp, err := uc.DetailImport(context.Background(), models.Scope{UserID: "user-1"}, "import-1")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, imp.Status)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/upload"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) Archive(ctx context.Context, sc models.Scope, ID string) (boards.DetailOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ID, Role: models.BoardRoleAdmin, AllowInactive: true}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Archive.memberUC.Authorize: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	old, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Archive.repo.Detail.NotFound: %v", err)
+			return boards.DetailOutput{}, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Archive.repo.Detail: %v", err)
+		return boards.DetailOutput{}, err
+	}
+	if old.IsArchived() {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Archive.IsArchived: board %s", ID)
+		return boards.DetailOutput{}, boards.ErrAlreadyArchived
+	}
+
+	b, err := uc.repo.Archive(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Archive.repo.Archive.NotFound: %v", err)
+			return boards.DetailOutput{}, boards.ErrAlreadyArchived
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Archive.repo.Archive: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	if err := uc.broadcastBoardEvent(ctx, b.ID, websocket.MSG_BOARD_ARCHIVED, b, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Archive.broadcastBoardEvent: %v", err)
+	}
+
+	return boards.DetailOutput{Board: b}, nil
+}
+
+func (uc implUsecase) Unarchive(ctx context.Context, sc models.Scope, ID string) (boards.DetailOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ID, Role: models.BoardRoleAdmin, AllowInactive: true}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Unarchive.memberUC.Authorize: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	old, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Unarchive.repo.Detail.NotFound: %v", err)
+			return boards.DetailOutput{}, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Unarchive.repo.Detail: %v", err)
+		return boards.DetailOutput{}, err
+	}
+	if !old.IsArchived() {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Unarchive.IsArchived: board %s", ID)
+		return boards.DetailOutput{}, boards.ErrNotArchived
+	}
+
+	b, err := uc.repo.Unarchive(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Unarchive.repo.Unarchive.NotFound: %v", err)
+			return boards.DetailOutput{}, boards.ErrNotArchived
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Unarchive.repo.Unarchive: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	if err := uc.broadcastBoardEvent(ctx, b.ID, websocket.MSG_BOARD_UNARCHIVED, b, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Unarchive.broadcastBoardEvent: %v", err)
+	}
+
+	return boards.DetailOutput{Board: b}, nil
+}
+
+func (uc implUsecase) GetTrash(ctx context.Context, sc models.Scope, ip boards.GetTrashInput) (boards.GetOutput, error) {
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetTrash.memberUC.MemberFilter: %v", err)
+		return boards.GetOutput{}, err
+	}
+
+	// Only the owners, who can restore and purge them, see the boards in the
+	// trash
+	b, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
+		Filter: boards.Filter{
+			Trashed: true,
+			OwnerID: memberID,
+		},
+		PagQuery: ip.PagQuery,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetTrash.repo.Get: %v", err)
+		return boards.GetOutput{}, err
+	}
+
+	uIDs := make([]string, 0, len(b))
+	for _, b := range b {
+		if b.CreatedBy != nil {
+			uIDs = append(uIDs, *b.CreatedBy)
+		}
+	}
+	var us []models.User
+	if len(uIDs) > 0 {
+		us, err = uc.userUC.List(ctx, sc, user.ListInput{
+			Filter: user.Filter{
+				IDs: util.RemoveDuplicates(uIDs),
+			},
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.GetTrash.userUC.List: %v", err)
+			return boards.GetOutput{}, err
+		}
+	}
+
+	return boards.GetOutput{
+		Boards:     b,
+		Users:      us,
+		Pagination: p,
+	}, nil
+}
+
+func (uc implUsecase) Restore(ctx context.Context, sc models.Scope, ID string) (boards.DetailOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ID, Role: models.BoardRoleOwner, AllowInactive: true}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Restore.memberUC.Authorize: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	b, err := uc.repo.Restore(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Restore.repo.Restore.NotFound: %v", err)
+			return boards.DetailOutput{}, boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Restore.repo.Restore: %v", err)
+		return boards.DetailOutput{}, err
+	}
+
+	if err := uc.broadcastBoardEvent(ctx, b.ID, websocket.MSG_BOARD_RESTORED, b, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Restore.broadcastBoardEvent: %v", err)
+	}
+
+	return boards.DetailOutput{Board: b}, nil
+}
+
+func (uc implUsecase) Purge(ctx context.Context, sc models.Scope, ID string) error {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ID, Role: models.BoardRoleOwner, AllowInactive: true}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Purge.memberUC.Authorize: %v", err)
+		return err
+	}
+
+	// Only boards in the trash can be purged
+	b, err := uc.repo.DetailDeleted(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Purge.repo.DetailDeleted.NotFound: %v", err)
+			return boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Purge.repo.DetailDeleted: %v", err)
+		return err
+	}
+
+	// The attachments are read before the cards go with the board
+	aIDs, err := uc.repo.ListAttachmentIDs(ctx, sc, b.ID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Purge.repo.ListAttachmentIDs: %v", err)
+		return err
+	}
+	aIDs = uploadIDs(aIDs)
+
+	if err := uc.repo.Purge(ctx, sc, b.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Purge.repo.Purge.NotFound: %v", err)
+			return boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Purge.repo.Purge: %v", err)
+		return err
+	}
+
+	// The board is gone already, files left behind are only logged
+	if len(aIDs) > 0 && uc.uploadUC != nil {
+		if err := uc.uploadUC.Delete(ctx, sc, upload.DeleteInput{IDs: aIDs}); err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.Purge.uploadUC.Delete: %v", err)
+		}
+	}
+
+	if err := uc.broadcastBoardEvent(ctx, b.ID, websocket.MSG_BOARD_PURGED, map[string]string{"id": b.ID}, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Purge.broadcastBoardEvent: %v", err)
+	}
+
+	return nil
+}
+
+// uploadIDs keeps the attachments that can be uploads, a single link in the
+// list would fail the delete or the copy of every file.
+func uploadIDs(attachments []string) []string {
+	IDs := make([]string, 0, len(attachments))
+	for _, a := range attachments {
+		if isUploadID(a) {
+			IDs = append(IDs, a)
+		}
+	}
+	return IDs
+}
+
+func (uc implUsecase) GetAuditLogs(ctx context.Context, sc models.Scope, ip boards.GetAuditLogsInput) (boards.GetAuditLogsOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin, AllowInactive: true}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.GetAuditLogs.memberUC.Authorize: %v", err)
+		return boards.GetAuditLogsOutput{}, err
+	}
+
+	ls, p, err := uc.repo.ListAuditLogs(ctx, sc, repository.ListAuditLogsOptions{
+		BoardID:  ip.BoardID,
+		PagQuery: ip.PagQuery,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetAuditLogs.repo.ListAuditLogs: %v", err)
+		return boards.GetAuditLogsOutput{}, err
+	}
+
+	uIDs := make([]string, 0, len(ls))
+	for _, l := range ls {
+		if l.UserID != nil {
+			uIDs = append(uIDs, *l.UserID)
+		}
+	}
+	var us []models.User
+	if len(uIDs) > 0 {
+		us, err = uc.userUC.List(ctx, sc, user.ListInput{
+			Filter: user.Filter{
+				IDs: util.RemoveDuplicates(uIDs),
+			},
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.GetAuditLogs.userUC.List: %v", err)
+			return boards.GetAuditLogsOutput{}, err
+		}
+	}
+
+	return boards.GetAuditLogsOutput{
+		Logs:       ls,
+		Users:      us,
+		Pagination: p,
+	}, nil
+}
@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// lifecycleBoard returns a board in the given state.
+func lifecycleBoard(now time.Time, archived, trashed bool) models.Board {
+	b := models.Board{ID: "board-1", Name: "Roadmap"}
+	if archived {
+		b.ArchivedAt = &now
+	}
+	if trashed {
+		b.DeletedAt = &now
+	}
+	return b
+}
+
+func TestArchive(t *testing.T) {
+	now := time.Now()
+
+	tcs := map[string]struct {
+		role     models.BoardRole
+		archived bool
+		trashed  bool
+		wantErr  error
+	}{
+		"active board": {
+			role: models.BoardRoleAdmin,
+		},
+		"already archived": {
+			role:     models.BoardRoleAdmin,
+			archived: true,
+			wantErr:  boards.ErrAlreadyArchived,
+		},
+		"below admin": {
+			role:    models.BoardRoleMember,
+			wantErr: members.ErrForbidden,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+			deps.repo.boards["board-1"] = lifecycleBoard(now, tc.archived, tc.trashed)
+			deps.memberUC.roles["board-1"] = tc.role
+
+			o, err := uc.Archive(context.Background(), models.Scope{UserID: "user-1"}, "board-1")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, o.Board.IsArchived())
+		})
+	}
+}
+
+func TestUpdateArchivedBoard(t *testing.T) {
+	now := time.Now()
+	uc, deps := initUseCase(t, now)
+	deps.repo.boards["board-1"] = lifecycleBoard(now, true, false)
+	deps.memberUC.roles["board-1"] = models.BoardRoleOwner
+
+	// Archived boards are read-only until they are unarchived
+	_, err := uc.Update(context.Background(), models.Scope{UserID: "user-1"}, boards.UpdateInput{ID: "board-1", Name: "Renamed"})
+	assert.ErrorIs(t, err, members.ErrBoardArchived)
+}
+
+func TestTrash(t *testing.T) {
+	now := time.Now()
+
+	tcs := map[string]struct {
+		role    models.BoardRole
+		trashed bool
+		wantErr error
+	}{
+		"owner": {
+			role: models.BoardRoleOwner,
+		},
+		"admin": {
+			role:    models.BoardRoleAdmin,
+			wantErr: members.ErrForbidden,
+		},
+		"already in the trash": {
+			role:    models.BoardRoleOwner,
+			trashed: true,
+			wantErr: boards.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+			deps.repo.boards["board-1"] = lifecycleBoard(now, false, tc.trashed)
+			deps.memberUC.roles["board-1"] = tc.role
+
+			err := uc.Delete(context.Background(), models.Scope{UserID: "user-1"}, []string{"board-1"})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, deps.repo.boards["board-1"].DeletedAt)
+
+			// The board is only listed in the trash of its owners
+			o, err := uc.GetTrash(context.Background(), models.Scope{UserID: "user-1"}, boards.GetTrashInput{})
+			require.NoError(t, err)
+			assert.Len(t, o.Boards, 1)
+			assert.True(t, deps.repo.trashFilter.Filter.Trashed)
+			assert.Equal(t, "user-1", deps.repo.trashFilter.Filter.OwnerID)
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	now := time.Now()
+
+	tcs := map[string]struct {
+		role    models.BoardRole
+		trashed bool
+		wantErr error
+	}{
+		"trashed board": {
+			role:    models.BoardRoleOwner,
+			trashed: true,
+		},
+		"admin": {
+			role:    models.BoardRoleAdmin,
+			trashed: true,
+			wantErr: members.ErrForbidden,
+		},
+		"board not in the trash": {
+			role:    models.BoardRoleOwner,
+			wantErr: boards.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+			deps.repo.boards["board-1"] = lifecycleBoard(now, false, tc.trashed)
+			deps.memberUC.roles["board-1"] = tc.role
+
+			o, err := uc.Restore(context.Background(), models.Scope{UserID: "user-1"}, "board-1")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Nil(t, o.Board.DeletedAt)
+			assert.Nil(t, deps.repo.boards["board-1"].DeletedAt)
+		})
+	}
+}
+
+func TestPurge(t *testing.T) {
+	now := time.Now()
+	uploadID := "0b6f1f3e-8f0a-4c5e-9a57-3d6f7c0e1a2b"
+
+	tcs := map[string]struct {
+		role        models.BoardRole
+		trashed     bool
+		attachments []string
+		wantErr     error
+		wantDeleted []string
+	}{
+		"trashed board": {
+			role:        models.BoardRoleOwner,
+			trashed:     true,
+			attachments: []string{uploadID},
+			wantDeleted: []string{uploadID},
+		},
+		"links are not deleted": {
+			role:        models.BoardRoleOwner,
+			trashed:     true,
+			attachments: []string{"https://example.com/spec.pdf", uploadID},
+			wantDeleted: []string{uploadID},
+		},
+		"board without attachments": {
+			role:    models.BoardRoleOwner,
+			trashed: true,
+		},
+		"admin": {
+			role:        models.BoardRoleAdmin,
+			trashed:     true,
+			attachments: []string{uploadID},
+			wantErr:     members.ErrForbidden,
+		},
+		"board not in the trash": {
+			role:        models.BoardRoleOwner,
+			attachments: []string{uploadID},
+			wantErr:     boards.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+			deps.repo.boards["board-1"] = lifecycleBoard(now, false, tc.trashed)
+			deps.repo.attachments["board-1"] = tc.attachments
+			deps.memberUC.roles["board-1"] = tc.role
+
+			err := uc.Purge(context.Background(), models.Scope{UserID: "user-1"}, "board-1")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, deps.repo.purged)
+				assert.Empty(t, deps.uploadUC.deleted)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []string{"board-1"}, deps.repo.purged)
+			assert.Equal(t, tc.wantDeleted, deps.uploadUC.deleted)
+		})
+	}
+}
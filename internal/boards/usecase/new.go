@@ -5,38 +5,49 @@ import (
 
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
-	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/upload"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
 	"github.com/nguyentantai21042004/kanban-api/internal/websocket/service"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/position"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
 type implUsecase struct {
-	l      log.Logger
-	repo   repository.Repository
-	wsHub  *service.Hub
-	userUC user.UseCase
-	roleUC role.UseCase
-	listUC lists.UseCase
-	clock  func() time.Time
+	l           log.Logger
+	repo        repository.Repository
+	wsHub       *service.Hub
+	userUC      user.UseCase
+	memberUC    members.UseCase
+	workspaceUC workspaces.UseCase
+	roleUC      role.UseCase
+	uploadUC    upload.UseCase
+	templateUC  templates.UseCase
+	positionUC  position.Usecase
+	clock       func() time.Time
 }
 
 var _ boards.UseCase = &implUsecase{}
 
-func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, userUC user.UseCase, roleUC role.UseCase, listUC lists.UseCase) boards.UseCase {
+func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, userUC user.UseCase, memberUC members.UseCase, workspaceUC workspaces.UseCase, roleUC role.UseCase, uploadUC upload.UseCase, positionUC position.Usecase) boards.UseCase {
 	return &implUsecase{
-		l:      l,
-		repo:   repo,
-		userUC: userUC,
-		roleUC: roleUC,
-		listUC: listUC,
-		wsHub:  wsHub,
-		clock:  util.Now,
+		l:           l,
+		repo:        repo,
+		userUC:      userUC,
+		memberUC:    memberUC,
+		workspaceUC: workspaceUC,
+		roleUC:      roleUC,
+		uploadUC:    uploadUC,
+		positionUC:  positionUC,
+		wsHub:       wsHub,
+		clock:       util.Now,
 	}
 }
 
-func (uc *implUsecase) SetList(listUC lists.UseCase) {
-	uc.listUC = listUC
+func (uc *implUsecase) SetTemplate(templateUC templates.UseCase) {
+	uc.templateUC = templateUC
 }
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/upload"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+var (
+	// cardKeyPrefixPattern keeps the prefixes short enough to read in a key
+	// like PROJ-123
+	cardKeyPrefixPattern   = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+	backgroundColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+	prefixDroppedChars  = regexp.MustCompile(`[^A-Z0-9]`)
+	prefixLeadingDigits = regexp.MustCompile(`^[0-9]+`)
+)
+
+const (
+	// defaultPrefixLen is the number of letters of the board name a default
+	// prefix keeps
+	defaultPrefixLen = 4
+	// fallbackCardKeyPrefix is the default prefix of the boards whose name
+	// does not give one
+	fallbackCardKeyPrefix = "CARD"
+)
+
+// applySettings changes the settings of a board that are set in ip. name and
+// workspaceID are the name of the board and the workspace it is in after the
+// update.
+func (uc implUsecase) applySettings(ctx context.Context, sc models.Scope, settings models.BoardSettings, ip boards.SettingsInput, name, workspaceID string) (models.BoardSettings, error) {
+	if ip.Visibility != nil {
+		if !ip.Visibility.IsValid() {
+			uc.l.Warnf(ctx, "internal.boards.usecase.applySettings.InvalidVisibility: %s", *ip.Visibility)
+			return models.BoardSettings{}, boards.ErrInvalidSettings
+		}
+		settings.Visibility = *ip.Visibility
+	}
+
+	if ip.DefaultPriority != nil {
+		switch *ip.DefaultPriority {
+		case models.CardPriorityLow, models.CardPriorityMedium, models.CardPriorityHigh:
+			settings.DefaultPriority = *ip.DefaultPriority
+		default:
+			uc.l.Warnf(ctx, "internal.boards.usecase.applySettings.InvalidDefaultPriority: %s", *ip.DefaultPriority)
+			return models.BoardSettings{}, boards.ErrInvalidSettings
+		}
+	}
+
+	if ip.CardKeyPrefix != nil {
+		prefix, err := uc.changeCardKeyPrefix(ctx, sc, settings.CardKeyPrefix, *ip.CardKeyPrefix, name)
+		if err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.applySettings.changeCardKeyPrefix: %v", err)
+			return models.BoardSettings{}, err
+		}
+		settings.CardKeyPrefix = prefix
+	}
+
+	if ip.BackgroundColor != nil {
+		color := strings.ToLower(strings.TrimSpace(*ip.BackgroundColor))
+		if color != "" && !backgroundColorPattern.MatchString(color) {
+			uc.l.Warnf(ctx, "internal.boards.usecase.applySettings.InvalidBackgroundColor: %s", color)
+			return models.BoardSettings{}, boards.ErrInvalidSettings
+		}
+		settings.BackgroundColor = color
+	}
+
+	if ip.BackgroundImage != nil && *ip.BackgroundImage != settings.BackgroundImage {
+		if *ip.BackgroundImage != "" {
+			if err := uc.checkBackgroundImage(ctx, sc, *ip.BackgroundImage); err != nil {
+				uc.l.Warnf(ctx, "internal.boards.usecase.applySettings.checkBackgroundImage: %v", err)
+				return models.BoardSettings{}, err
+			}
+		}
+		settings.BackgroundImage = *ip.BackgroundImage
+	}
+
+	if ip.CommentsEnabled != nil {
+		settings.CommentsEnabled = *ip.CommentsEnabled
+	}
+
+	// A board taken out of its workspace has to leave the workspace
+	// visibility at the same time
+	if settings.Visibility == models.BoardVisibilityWorkspace && workspaceID == "" {
+		uc.l.Warnf(ctx, "internal.boards.usecase.applySettings.NoWorkspace")
+		return models.BoardSettings{}, boards.ErrWorkspaceRequired
+	}
+
+	return settings, nil
+}
+
+// changeCardKeyPrefix checks the prefix asked for a board that has the prefix
+// current. An empty prefix gives the board a default one.
+func (uc implUsecase) changeCardKeyPrefix(ctx context.Context, sc models.Scope, current, asked, name string) (string, error) {
+	prefix := strings.ToUpper(strings.TrimSpace(asked))
+	if prefix == "" {
+		p, err := uc.newCardKeyPrefix(ctx, sc, name, current)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.changeCardKeyPrefix.newCardKeyPrefix: %v", err)
+			return "", err
+		}
+		return p, nil
+	}
+
+	if !cardKeyPrefixPattern.MatchString(prefix) {
+		uc.l.Warnf(ctx, "internal.boards.usecase.changeCardKeyPrefix.InvalidCardKeyPrefix: %s", prefix)
+		return "", boards.ErrInvalidSettings
+	}
+	if prefix == current {
+		return prefix, nil
+	}
+
+	taken, err := uc.repo.ListCardKeyPrefixes(ctx, sc, prefix)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.changeCardKeyPrefix.repo.ListCardKeyPrefixes: %v", err)
+		return "", err
+	}
+	for _, t := range taken {
+		if t == prefix {
+			uc.l.Warnf(ctx, "internal.boards.usecase.changeCardKeyPrefix.Taken: %s", prefix)
+			return "", boards.ErrCardKeyPrefixTaken
+		}
+	}
+
+	return prefix, nil
+}
+
+// newCardKeyPrefix builds the default prefix of a board from its name, the
+// first letters and digits of the name followed by a number when another
+// board has them already. current is the prefix the board has, it can be
+// given back to the board. The migration backfilling the prefixes builds
+// them the same way.
+func (uc implUsecase) newCardKeyPrefix(ctx context.Context, sc models.Scope, name, current string) (string, error) {
+	base := prefixDroppedChars.ReplaceAllString(strings.ToUpper(name), "")
+	base = prefixLeadingDigits.ReplaceAllString(base, "")
+	if len(base) > defaultPrefixLen {
+		base = base[:defaultPrefixLen]
+	}
+	if len(base) < 2 {
+		base = fallbackCardKeyPrefix
+	}
+
+	taken, err := uc.repo.ListCardKeyPrefixes(ctx, sc, base)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.newCardKeyPrefix.repo.ListCardKeyPrefixes: %v", err)
+		return "", err
+	}
+	used := make(map[string]bool, len(taken))
+	for _, t := range taken {
+		used[t] = t != current
+	}
+
+	prefix := base
+	for n := 2; used[prefix]; n++ {
+		prefix = base + strconv.Itoa(n)
+	}
+
+	return prefix, nil
+}
+
+// checkBackgroundImage checks that the upload exists and is an image.
+func (uc implUsecase) checkBackgroundImage(ctx context.Context, sc models.Scope, uploadID string) error {
+	if err := postgres.IsUUID(uploadID); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.checkBackgroundImage.InvalidID: %v", err)
+		return boards.ErrBackgroundNotFound
+	}
+
+	us, err := uc.uploadUC.List(ctx, sc, upload.ListInput{IDs: []string{uploadID}})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.checkBackgroundImage.uploadUC.List: %v", err)
+		return err
+	}
+	if len(us) == 0 {
+		uc.l.Warnf(ctx, "internal.boards.usecase.checkBackgroundImage.NotFound: upload %s", uploadID)
+		return boards.ErrBackgroundNotFound
+	}
+
+	if !strings.HasPrefix(us[0].ContentType, "image/") {
+		uc.l.Warnf(ctx, "internal.boards.usecase.checkBackgroundImage.NotAnImage: upload %s is %s", uploadID, us[0].ContentType)
+		return boards.ErrBackgroundNotAnImage
+	}
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	imageUploadID = "6f1c2a4e-3b5d-4c7e-9f10-2a3b4c5d6e7f"
+	fileUploadID  = "7a2d3b5f-4c6e-4d8f-a021-3b4c5d6e7f80"
+)
+
+func TestApplySettings(t *testing.T) {
+	tcs := map[string]struct {
+		old         models.BoardSettings
+		ip          boards.SettingsInput
+		workspaceID string
+		want        models.BoardSettings
+		wantErr     error
+	}{
+		"nothing set keeps the settings": {
+			old:  models.DefaultBoardSettings(),
+			want: models.DefaultBoardSettings(),
+		},
+		"public visibility": {
+			old: models.DefaultBoardSettings(),
+			ip:  boards.SettingsInput{Visibility: util.ToPointer(models.BoardVisibilityPublic)},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPublic,
+				DefaultPriority: models.CardPriorityMedium,
+				CommentsEnabled: true,
+			},
+		},
+		"unknown visibility": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{Visibility: util.ToPointer(models.BoardVisibility("secret"))},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"workspace visibility in a workspace": {
+			old:         models.DefaultBoardSettings(),
+			ip:          boards.SettingsInput{Visibility: util.ToPointer(models.BoardVisibilityWorkspace)},
+			workspaceID: "workspace-1",
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityWorkspace,
+				DefaultPriority: models.CardPriorityMedium,
+				CommentsEnabled: true,
+			},
+		},
+		"workspace visibility without a workspace": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{Visibility: util.ToPointer(models.BoardVisibilityWorkspace)},
+			wantErr: boards.ErrWorkspaceRequired,
+		},
+		"board moved out of its workspace": {
+			old: models.BoardSettings{
+				Visibility:      models.BoardVisibilityWorkspace,
+				DefaultPriority: models.CardPriorityMedium,
+			},
+			wantErr: boards.ErrWorkspaceRequired,
+		},
+		"high default priority": {
+			old: models.DefaultBoardSettings(),
+			ip:  boards.SettingsInput{DefaultPriority: util.ToPointer(models.CardPriorityHigh)},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityHigh,
+				CommentsEnabled: true,
+			},
+		},
+		"unknown default priority": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{DefaultPriority: util.ToPointer(models.CardPriority("urgent"))},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"prefix is upper cased": {
+			old: models.DefaultBoardSettings(),
+			ip:  boards.SettingsInput{CardKeyPrefix: util.ToPointer(" plan ")},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				CardKeyPrefix:   "PLAN",
+				CommentsEnabled: true,
+			},
+		},
+		"prefix of another board": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{CardKeyPrefix: util.ToPointer("proj")},
+			wantErr: boards.ErrCardKeyPrefixTaken,
+		},
+		"prefix kept by the board": {
+			old: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				CardKeyPrefix:   "PROJ",
+			},
+			ip: boards.SettingsInput{CardKeyPrefix: util.ToPointer("PROJ")},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				CardKeyPrefix:   "PROJ",
+			},
+		},
+		"empty prefix gives the default one": {
+			old: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				CardKeyPrefix:   "PLAN",
+			},
+			ip: boards.SettingsInput{CardKeyPrefix: util.ToPointer("")},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				CardKeyPrefix:   "ROAD2",
+			},
+		},
+		"prefix of one letter": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{CardKeyPrefix: util.ToPointer("P")},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"prefix starting with a digit": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{CardKeyPrefix: util.ToPointer("1PROJ")},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"prefix with a dash": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{CardKeyPrefix: util.ToPointer("PR-OJ")},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"prefix too long": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{CardKeyPrefix: util.ToPointer("ABCDEFGHIJK")},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"background color is lower cased": {
+			old: models.DefaultBoardSettings(),
+			ip:  boards.SettingsInput{BackgroundColor: util.ToPointer("#A1B2C3")},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				BackgroundColor: "#a1b2c3",
+				CommentsEnabled: true,
+			},
+		},
+		"background color without the hash": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{BackgroundColor: util.ToPointer("a1b2c3")},
+			wantErr: boards.ErrInvalidSettings,
+		},
+		"background image": {
+			old: models.DefaultBoardSettings(),
+			ip:  boards.SettingsInput{BackgroundImage: util.ToPointer(imageUploadID)},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				BackgroundImage: imageUploadID,
+				CommentsEnabled: true,
+			},
+		},
+		"background image that is not an upload ID": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{BackgroundImage: util.ToPointer("https://example.com/a.png")},
+			wantErr: boards.ErrBackgroundNotFound,
+		},
+		"background image of a missing upload": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{BackgroundImage: util.ToPointer("8b3e4c6a-5d7f-4e9a-b132-4c5d6e7f8091")},
+			wantErr: boards.ErrBackgroundNotFound,
+		},
+		"background image that is not an image": {
+			old:     models.DefaultBoardSettings(),
+			ip:      boards.SettingsInput{BackgroundImage: util.ToPointer(fileUploadID)},
+			wantErr: boards.ErrBackgroundNotAnImage,
+		},
+		"empty background image clears it": {
+			old: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+				BackgroundImage: imageUploadID,
+			},
+			ip: boards.SettingsInput{BackgroundImage: util.ToPointer("")},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+			},
+		},
+		"comments disabled": {
+			old: models.DefaultBoardSettings(),
+			ip:  boards.SettingsInput{CommentsEnabled: util.ToPointer(false)},
+			want: models.BoardSettings{
+				Visibility:      models.BoardVisibilityPrivate,
+				DefaultPriority: models.CardPriorityMedium,
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.repo.boards["board-2"] = models.Board{ID: "board-2", Settings: models.BoardSettings{CardKeyPrefix: "PROJ"}}
+			deps.repo.boards["board-3"] = models.Board{ID: "board-3", Settings: models.BoardSettings{CardKeyPrefix: "ROAD"}}
+			deps.uploadUC.uploads = map[string]models.Upload{
+				imageUploadID: {ID: imageUploadID, ContentType: "image/png"},
+				fileUploadID:  {ID: fileUploadID, ContentType: "application/pdf"},
+			}
+
+			got, err := uc.applySettings(context.Background(), models.Scope{UserID: "user-1"}, tc.old, tc.ip, "Roadmap", tc.workspaceID)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestNewCardKeyPrefix(t *testing.T) {
+	tcs := map[string]struct {
+		name    string
+		taken   []string
+		current string
+		want    string
+	}{
+		"first letters of the name": {
+			name: "Roadmap",
+			want: "ROAD",
+		},
+		"short name": {
+			name: "QA",
+			want: "QA",
+		},
+		"spaces and symbols are dropped": {
+			name: "Q3 - planning",
+			want: "Q3PL",
+		},
+		"leading digits are dropped": {
+			name: "2024 goals",
+			want: "GOAL",
+		},
+		"name without two letters": {
+			name: "#1",
+			want: "CARD",
+		},
+		"taken prefix gets a number": {
+			name:  "Roadmap",
+			taken: []string{"ROAD"},
+			want:  "ROAD2",
+		},
+		"first free number": {
+			name:  "Roadmap",
+			taken: []string{"ROAD", "ROAD2", "ROAD3"},
+			want:  "ROAD4",
+		},
+		"longer prefixes do not count": {
+			name:  "Road",
+			taken: []string{"ROADS"},
+			want:  "ROAD",
+		},
+		"prefix of the board is given back": {
+			name:    "Roadmap",
+			taken:   []string{"ROAD"},
+			current: "ROAD",
+			want:    "ROAD",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			for i, p := range tc.taken {
+				id := fmt.Sprintf("board-%d", i+2)
+				deps.repo.boards[id] = models.Board{ID: id, Settings: models.BoardSettings{CardKeyPrefix: p}}
+			}
+
+			got, err := uc.newCardKeyPrefix(context.Background(), models.Scope{UserID: "user-1"}, tc.name, tc.current)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (uc implUsecase) GetShareLink(ctx context.Context, sc models.Scope, boardID string) (boards.ShareLinkOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleAdmin}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.GetShareLink.memberUC.Authorize: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	l, err := uc.repo.DetailShareLink(ctx, sc, repository.DetailShareLinkOptions{BoardID: boardID})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.GetShareLink.repo.DetailShareLink.NotFound: %v", err)
+			return boards.ShareLinkOutput{}, boards.ErrShareLinkNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetShareLink.repo.DetailShareLink: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	return boards.ShareLinkOutput{
+		Link: l,
+	}, nil
+}
+
+func (uc implUsecase) EnableShareLink(ctx context.Context, sc models.Scope, ip boards.EnableShareLinkInput) (boards.ShareLinkOutput, error) {
+	if ip.BoardID == "" {
+		return boards.ShareLinkOutput{}, boards.ErrFieldRequired
+	}
+	if ip.ExpiresAt != nil && !ip.ExpiresAt.After(uc.clock()) {
+		return boards.ShareLinkOutput{}, boards.ErrInvalidExpiry
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.EnableShareLink.memberUC.Authorize: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	token, err := generateToken(shareTokenBytes)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.EnableShareLink.generateToken: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	l, err := uc.repo.UpsertShareLink(ctx, sc, repository.UpsertShareLinkOptions{
+		BoardID:   ip.BoardID,
+		TokenHash: hashToken(token),
+		ExpiresAt: ip.ExpiresAt,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.EnableShareLink.repo.UpsertShareLink: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	return boards.ShareLinkOutput{
+		Link:  l,
+		Token: token,
+	}, nil
+}
+
+func (uc implUsecase) UpdateShareLink(ctx context.Context, sc models.Scope, ip boards.UpdateShareLinkInput) (boards.ShareLinkOutput, error) {
+	if ip.BoardID == "" {
+		return boards.ShareLinkOutput{}, boards.ErrFieldRequired
+	}
+	if ip.ExpiresAt != nil && !ip.ExpiresAt.After(uc.clock()) {
+		return boards.ShareLinkOutput{}, boards.ErrInvalidExpiry
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.UpdateShareLink.memberUC.Authorize: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	l, err := uc.repo.UpdateShareLinkExpiry(ctx, sc, repository.UpdateShareLinkExpiryOptions{
+		BoardID:   ip.BoardID,
+		ExpiresAt: ip.ExpiresAt,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.UpdateShareLink.repo.UpdateShareLinkExpiry.NotFound: %v", err)
+			return boards.ShareLinkOutput{}, boards.ErrShareLinkNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.UpdateShareLink.repo.UpdateShareLinkExpiry: %v", err)
+		return boards.ShareLinkOutput{}, err
+	}
+
+	return boards.ShareLinkOutput{
+		Link: l,
+	}, nil
+}
+
+func (uc implUsecase) DisableShareLink(ctx context.Context, sc models.Scope, boardID string) error {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleAdmin}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.DisableShareLink.memberUC.Authorize: %v", err)
+		return err
+	}
+
+	if err := uc.repo.DeleteShareLink(ctx, sc, boardID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.DisableShareLink.repo.DeleteShareLink.NotFound: %v", err)
+			return boards.ErrShareLinkNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.DisableShareLink.repo.DeleteShareLink: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) DetailShared(ctx context.Context, token string) (boards.BoardWithDetailsOutput, error) {
+	if token == "" {
+		return boards.BoardWithDetailsOutput{}, boards.ErrShareLinkNotFound
+	}
+
+	// Unknown, rotated, disabled and expired links look the same to the caller
+	sc := models.Scope{}
+	l, err := uc.repo.DetailShareLink(ctx, sc, repository.DetailShareLinkOptions{TokenHash: hashToken(token)})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.DetailShared.repo.DetailShareLink.NotFound: %v", err)
+			return boards.BoardWithDetailsOutput{}, boards.ErrShareLinkNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailShared.repo.DetailShareLink: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+	if !l.IsUsable(uc.clock()) {
+		uc.l.Warnf(ctx, "internal.boards.usecase.DetailShared.IsUsable: share link of board %s expired", l.BoardID)
+		return boards.BoardWithDetailsOutput{}, boards.ErrShareLinkNotFound
+	}
+
+	b, err := uc.repo.Detail(ctx, sc, l.BoardID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.DetailShared.repo.Detail.NotFound: %v", err)
+			return boards.BoardWithDetailsOutput{}, boards.ErrShareLinkNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailShared.repo.Detail: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+	// Archived boards stay readable through their link, trashed ones are
+	// closed like they are to the members
+	if b.DeletedAt != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.DetailShared.Deleted: board %s is in the trash", b.ID)
+		return boards.BoardWithDetailsOutput{}, boards.ErrShareLinkNotFound
+	}
+
+	c, err := uc.repo.ListContent(ctx, sc, repository.ListContentOptions{BoardID: b.ID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.DetailShared.repo.ListContent: %v", err)
+		return boards.BoardWithDetailsOutput{}, err
+	}
+
+	return redactShared(boards.BoardWithDetailsOutput{
+		Board:  b,
+		Lists:  c.Lists,
+		Cards:  c.Cards,
+		Labels: c.Labels,
+	}), nil
+}
+
+// redactShared removes who created, changed and works on the board, and the
+// attachments, which are only served to members.
+func redactShared(o boards.BoardWithDetailsOutput) boards.BoardWithDetailsOutput {
+	o.Board.CreatedBy = nil
+	o.Board.ArchivedBy = nil
+	o.Board.DeletedBy = nil
+	for i := range o.Lists {
+		o.Lists[i].CreatedBy = nil
+	}
+	for i := range o.Cards {
+		o.Cards[i].AssignedTo = nil
+		o.Cards[i].CreatedBy = nil
+		o.Cards[i].UpdatedBy = nil
+		o.Cards[i].Attachments = nil
+	}
+	for i := range o.Labels {
+		o.Labels[i].CreatedBy = nil
+		o.Labels[i].UpdatedBy = nil
+		o.Labels[i].DeletedBy = nil
+	}
+
+	return o
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const sharedToken = "share-token"
+
+// sharedBoard returns a board where every field that can name a user is set
+// to "user-1".
+func sharedBoard(now time.Time) (models.Board, repository.Content) {
+	userID := "user-1"
+	b := models.Board{
+		ID:         "board-1",
+		Name:       "Roadmap",
+		CreatedBy:  &userID,
+		ArchivedBy: &userID,
+		DeletedBy:  &userID,
+	}
+	c := repository.Content{
+		Lists: []models.List{{ID: "list-1", BoardID: "board-1", Name: "Todo", CreatedBy: &userID}},
+		Cards: []models.Card{{
+			ID:          "card-1",
+			BoardID:     "board-1",
+			ListID:      "list-1",
+			Name:        "Ship it",
+			CreatedBy:   &userID,
+			UpdatedBy:   &userID,
+			AssignedTo:  &userID,
+			Attachments: []string{"upload-1"},
+		}},
+		Labels: []models.Label{{ID: "label-1", BoardID: "board-1", Name: "bug", CreatedBy: &userID, UpdatedBy: &userID, DeletedBy: &userID}},
+	}
+	return b, c
+}
+
+func TestDetailShared(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+
+	tcs := map[string]struct {
+		token     string
+		expiresAt *time.Time
+		disabled  bool
+		archived  bool
+		trashed   bool
+		wantErr   error
+	}{
+		"link without expiry": {
+			token: sharedToken,
+		},
+		"link before its expiry": {
+			token:     sharedToken,
+			expiresAt: &future,
+		},
+		"expired link": {
+			token:     sharedToken,
+			expiresAt: &past,
+			wantErr:   boards.ErrShareLinkNotFound,
+		},
+		"disabled link": {
+			token:    sharedToken,
+			disabled: true,
+			wantErr:  boards.ErrShareLinkNotFound,
+		},
+		"unknown token": {
+			token:   "other-token",
+			wantErr: boards.ErrShareLinkNotFound,
+		},
+		"missing token": {
+			wantErr: boards.ErrShareLinkNotFound,
+		},
+		"archived board": {
+			token:    sharedToken,
+			archived: true,
+		},
+		"trashed board": {
+			token:   sharedToken,
+			trashed: true,
+			wantErr: boards.ErrShareLinkNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, now)
+
+			b, c := sharedBoard(now)
+			if tc.archived {
+				b.ArchivedAt = &past
+			}
+			if tc.trashed {
+				b.DeletedAt = &past
+			}
+			deps.repo.boards[b.ID] = b
+			deps.repo.content[b.ID] = c
+			if !tc.disabled {
+				deps.repo.shareLinks[hashToken(sharedToken)] = models.BoardShareLink{
+					ID:        "link-1",
+					BoardID:   b.ID,
+					TokenHash: hashToken(sharedToken),
+					ExpiresAt: tc.expiresAt,
+				}
+			}
+
+			o, err := uc.DetailShared(context.Background(), tc.token)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Roadmap", o.Board.Name)
+			require.Len(t, o.Cards, 1)
+			assert.Nil(t, o.Cards[0].Attachments)
+
+			// Nothing in the output names a user of the board
+			raw, err := json.Marshal(o)
+			require.NoError(t, err)
+			assert.NotContains(t, string(raw), "user-1")
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+// recentBoardsKept is the number of viewed boards kept for each user.
+const recentBoardsKept = 50
+
+func (uc implUsecase) Star(ctx context.Context, sc models.Scope, ID string) error {
+	b, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.boards.usecase.Star.repo.Detail.NotFound: %v", err)
+			return boards.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.Star.repo.Detail: %v", err)
+		return err
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: b.ID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.boards.usecase.Star.memberUC.Authorize: %v", err)
+		return err
+	}
+
+	if err := uc.repo.Star(ctx, sc, b.ID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Star.repo.Star: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Unstar works without access to the board, so a board the user left can
+// still be taken out of the starred boards.
+func (uc implUsecase) Unstar(ctx context.Context, sc models.Scope, ID string) error {
+	if err := uc.repo.Unstar(ctx, sc, ID); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.Unstar.repo.Unstar: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) GetRecent(ctx context.Context, sc models.Scope, ip boards.GetRecentInput) (boards.GetOutput, error) {
+	// The views of the system are not recorded, it has no recent boards
+	if sc.UserID == "" {
+		return boards.GetOutput{}, nil
+	}
+
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetRecent.memberUC.MemberFilter: %v", err)
+		return boards.GetOutput{}, err
+	}
+
+	bs, err := uc.repo.ListRecent(ctx, sc, repository.ListRecentOptions{
+		MemberID: memberID,
+		Limit:    ip.Limit,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetRecent.repo.ListRecent: %v", err)
+		return boards.GetOutput{}, err
+	}
+
+	uIDs := make([]string, len(bs))
+	for i, b := range bs {
+		uIDs[i] = *b.CreatedBy
+	}
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: util.RemoveDuplicates(uIDs),
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetRecent.userUC.List: %v", err)
+		return boards.GetOutput{}, err
+	}
+
+	starred, err := uc.listStarredIDs(ctx, sc, bs)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.GetRecent.listStarredIDs: %v", err)
+		return boards.GetOutput{}, err
+	}
+
+	return boards.GetOutput{
+		Boards:  bs,
+		Users:   us,
+		Starred: starred,
+	}, nil
+}
+
+func (uc implUsecase) RecordView(ctx context.Context, sc models.Scope, ID string) error {
+	// The boards read without a user, by the system, are not recorded
+	if sc.UserID == "" {
+		return nil
+	}
+
+	if err := uc.repo.RecordView(ctx, sc, repository.RecordViewOptions{
+		BoardID: ID,
+		Keep:    recentBoardsKept,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.RecordView.repo.RecordView: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// listStarredIDs returns the boards among bs the user starred.
+func (uc implUsecase) listStarredIDs(ctx context.Context, sc models.Scope, bs []models.Board) ([]string, error) {
+	if sc.UserID == "" || len(bs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, len(bs))
+	for i, b := range bs {
+		ids[i] = b.ID
+	}
+
+	starred, err := uc.repo.ListStarredIDs(ctx, sc, ids)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.listStarredIDs.repo.ListStarredIDs: %v", err)
+		return nil, err
+	}
+
+	return starred, nil
+}
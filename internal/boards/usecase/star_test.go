@@ -0,0 +1,63 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordView(t *testing.T) {
+	creator := "user-1"
+
+	tcs := map[string]struct {
+		sc   models.Scope
+		call func(uc *implUsecase, sc models.Scope) error
+		// wantViews are the boards recorded as viewed
+		wantViews []string
+	}{
+		"board opened by the user": {
+			sc: models.Scope{UserID: "user-1"},
+			call: func(uc *implUsecase, sc models.Scope) error {
+				return uc.RecordView(context.Background(), sc, "board-1")
+			},
+			wantViews: []string{"board-1"},
+		},
+		"board read by the system": {
+			call: func(uc *implUsecase, sc models.Scope) error {
+				return uc.RecordView(context.Background(), sc, "board-1")
+			},
+		},
+		// The cards, lists and imports read their board through Detail, their
+		// changes do not move the board up the recent boards
+		"board read by another module": {
+			sc: models.Scope{UserID: "user-1"},
+			call: func(uc *implUsecase, sc models.Scope) error {
+				_, err := uc.Detail(context.Background(), sc, "board-1")
+				return err
+			},
+		},
+		"full board read by another module": {
+			sc: models.Scope{UserID: "user-1"},
+			call: func(uc *implUsecase, sc models.Scope) error {
+				_, err := uc.DetailFull(context.Background(), sc, boards.DetailFullInput{ID: "board-1"})
+				return err
+			},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.repo.boards["board-1"] = models.Board{ID: "board-1", Name: "Roadmap", CreatedBy: &creator}
+			deps.memberUC.roles["board-1"] = models.BoardRoleMember
+
+			require.NoError(t, tc.call(uc, tc.sc))
+			assert.Equal(t, tc.wantViews, deps.repo.views)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+)
+
+// boardTemplate returns the template a new board starts with. Without a
+// configured default template the board starts empty.
+func (uc implUsecase) boardTemplate(ctx context.Context, sc models.Scope, templateID string) (models.BoardTemplate, error) {
+	if uc.templateUC == nil {
+		return models.BoardTemplate{}, nil
+	}
+
+	if templateID != "" {
+		o, err := uc.templateUC.Detail(ctx, sc, templateID)
+		if err != nil {
+			uc.l.Warnf(ctx, "internal.boards.usecase.boardTemplate.templateUC.Detail: %v", err)
+			return models.BoardTemplate{}, err
+		}
+		return o.Template, nil
+	}
+
+	t, err := uc.templateUC.Default(ctx, sc)
+	if err != nil {
+		if err == templates.ErrNotFound {
+			return models.BoardTemplate{}, nil
+		}
+		uc.l.Errorf(ctx, "internal.boards.usecase.boardTemplate.templateUC.Default: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	return t, nil
+}
+
+// templateContent builds the lists, labels and sample cards of a template for
+// a new board, in the order of the template.
+func (uc implUsecase) templateContent(ctx context.Context, content models.BoardTemplateContent) (repository.CreateContentOptions, error) {
+	if len(content.Lists) == 0 && len(content.Labels) == 0 {
+		return repository.CreateContentOptions{}, nil
+	}
+
+	listPsts, err := uc.positions(len(content.Lists))
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.boards.usecase.templateContent.positions: %v", err)
+		return repository.CreateContentOptions{}, err
+	}
+
+	ls := make([]repository.ContentListOptions, len(content.Lists))
+	for i, l := range content.Lists {
+		cardPsts, err := uc.positions(len(l.Cards))
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.boards.usecase.templateContent.positions: %v", err)
+			return repository.CreateContentOptions{}, err
+		}
+
+		cs := make([]repository.ContentCardOptions, len(l.Cards))
+		for j, c := range l.Cards {
+			checklist := make([]repository.ChecklistItemOptions, len(c.Checklist))
+			for k, item := range c.Checklist {
+				checklist[k] = repository.ChecklistItemOptions{Content: item}
+			}
+			cs[j] = repository.ContentCardOptions{
+				Name:        c.Name,
+				Description: c.Description,
+				Position:    cardPsts[j],
+				Priority:    c.Priority,
+				Labels:      c.Labels,
+				Checklist:   checklist,
+			}
+		}
+
+		ls[i] = repository.ContentListOptions{
+			Name:     l.Name,
+			Position: listPsts[i],
+			Cards:    cs,
+		}
+	}
+
+	lbs := make([]repository.ContentLabelOptions, len(content.Labels))
+	for i, lb := range content.Labels {
+		lbs[i] = repository.ContentLabelOptions{
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+	}
+
+	return repository.CreateContentOptions{
+		Lists:  ls,
+		Labels: lbs,
+	}, nil
+}
+
+// positions returns count ascending positions for the items of an empty list.
+func (uc implUsecase) positions(count int) ([]string, error) {
+	if count == 0 {
+		return nil, nil
+	}
+
+	return uc.positionUC.BatchGeneratePositions(count, "", "")
+}
@@ -0,0 +1,285 @@
+package usecase
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// trelloBoard is the part of a Trello board export the import reads.
+type trelloBoard struct {
+	Name       string            `json:"name"`
+	Desc       string            `json:"desc"`
+	Labels     []trelloLabel     `json:"labels"`
+	Lists      []trelloList      `json:"lists"`
+	Cards      []trelloCard      `json:"cards"`
+	Checklists []trelloChecklist `json:"checklists"`
+	Members    []trelloMember    `json:"members"`
+	Actions    []trelloAction    `json:"actions"`
+}
+
+type trelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID               string             `json:"id"`
+	IDList           string             `json:"idList"`
+	Name             string             `json:"name"`
+	Desc             string             `json:"desc"`
+	Closed           bool               `json:"closed"`
+	Pos              float64            `json:"pos"`
+	Due              *time.Time         `json:"due"`
+	Start            *time.Time         `json:"start"`
+	DueComplete      bool               `json:"dueComplete"`
+	DateLastActivity *time.Time         `json:"dateLastActivity"`
+	IDLabels         []string           `json:"idLabels"`
+	IDMembers        []string           `json:"idMembers"`
+	Attachments      []trelloAttachment `json:"attachments"`
+}
+
+type trelloAttachment struct {
+	URL string `json:"url"`
+}
+
+type trelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Name       string            `json:"name"`
+	Pos        float64           `json:"pos"`
+	CheckItems []trelloCheckItem `json:"checkItems"`
+}
+
+type trelloCheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+type trelloMember struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+}
+
+type trelloAction struct {
+	ID              string           `json:"id"`
+	Type            string           `json:"type"`
+	Date            time.Time        `json:"date"`
+	IDMemberCreator string           `json:"idMemberCreator"`
+	Data            trelloActionData `json:"data"`
+}
+
+type trelloActionData struct {
+	Text string `json:"text"`
+	Card struct {
+		ID string `json:"id"`
+	} `json:"card"`
+}
+
+const (
+	trelloCommentAction = "commentCard"
+	trelloItemComplete  = "complete"
+	// trelloDefaultColor is used for the labels without a color
+	trelloDefaultColor = "#B3BAC5"
+)
+
+// trelloColors are the colors of the Trello labels, the shades of a color
+// ("green_dark") fall back to the color.
+var trelloColors = map[string]string{
+	"green":  "#61BD4F",
+	"yellow": "#F2D600",
+	"orange": "#FF9F1A",
+	"red":    "#EB5A46",
+	"purple": "#C377E0",
+	"blue":   "#0079BF",
+	"sky":    "#00C2E0",
+	"lime":   "#51E898",
+	"pink":   "#FF78CB",
+	"black":  "#344563",
+}
+
+// newTrelloDocument turns a Trello board export into a board document, so it
+// is imported like one. The positions of Trello are replaced by the order of
+// the items, users are referred to by their Trello username.
+func newTrelloDocument(tb trelloBoard, now time.Time) models.BoardExport {
+	doc := models.BoardExport{
+		Format:     models.BoardExportFormat,
+		Version:    models.BoardExportVersion,
+		ExportedAt: now,
+		Board: models.ExportBoard{
+			Name:        tb.Name,
+			Description: tb.Desc,
+		},
+		Lists:       make([]models.ExportList, 0, len(tb.Lists)),
+		Labels:      make([]models.ExportLabel, 0, len(tb.Labels)),
+		Cards:       make([]models.ExportCard, 0, len(tb.Cards)),
+		Comments:    make([]models.ExportComment, 0),
+		Activities:  make([]models.ExportActivity, 0),
+		Attachments: make([]models.ExportAttachment, 0),
+	}
+
+	usernames := make(map[string]string, len(tb.Members))
+	for _, m := range tb.Members {
+		usernames[m.ID] = m.Username
+	}
+
+	for _, lb := range tb.Labels {
+		doc.Labels = append(doc.Labels, models.ExportLabel{
+			ID:    lb.ID,
+			Name:  trelloLabelName(lb),
+			Color: trelloLabelColor(lb.Color),
+		})
+	}
+
+	lists := append([]trelloList(nil), tb.Lists...)
+	sort.SliceStable(lists, func(i, j int) bool {
+		return lists[i].Pos < lists[j].Pos
+	})
+	for i, l := range lists {
+		doc.Lists = append(doc.Lists, models.ExportList{
+			ID:         l.ID,
+			Name:       l.Name,
+			Position:   trelloPosition(i),
+			IsArchived: l.Closed,
+		})
+	}
+
+	checklists := trelloChecklists(tb.Checklists)
+
+	cards := append([]trelloCard(nil), tb.Cards...)
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].Pos < cards[j].Pos
+	})
+	for i, c := range cards {
+		doc.Cards = append(doc.Cards, newTrelloCard(c, trelloPosition(i), checklists[c.ID], usernames))
+	}
+
+	for _, a := range tb.Actions {
+		if a.Type != trelloCommentAction || a.Data.Text == "" {
+			continue
+		}
+		doc.Comments = append(doc.Comments, models.ExportComment{
+			ID:        a.ID,
+			CardID:    a.Data.Card.ID,
+			User:      usernames[a.IDMemberCreator],
+			Content:   a.Data.Text,
+			CreatedAt: a.Date,
+		})
+	}
+
+	return doc
+}
+
+func newTrelloCard(c trelloCard, pst string, checklist []models.ExportChecklistItem, usernames map[string]string) models.ExportCard {
+	name := c.Name
+	if name == "" {
+		name = "Untitled"
+	}
+
+	ec := models.ExportCard{
+		ID:          c.ID,
+		ListID:      c.IDList,
+		Name:        name,
+		Description: c.Desc,
+		Position:    pst,
+		Priority:    models.CardPriorityMedium,
+		Labels:      c.IDLabels,
+		Checklist:   checklist,
+		DueDate:     c.Due,
+		StartDate:   c.Start,
+		IsArchived:  c.Closed,
+	}
+
+	// Cards have one assignee, the first member of the card
+	if len(c.IDMembers) > 0 {
+		ec.AssignedTo = usernames[c.IDMembers[0]]
+	}
+
+	if c.DueComplete && c.DateLastActivity != nil {
+		ec.CompletionDate = c.DateLastActivity
+	}
+
+	// The files stay on Trello, the cards keep their links
+	for _, a := range c.Attachments {
+		if a.URL != "" {
+			ec.Attachments = append(ec.Attachments, a.URL)
+		}
+	}
+
+	return ec
+}
+
+// trelloChecklists returns the checklist items of each card in order. The
+// items of a card with several checklists start with the name of theirs.
+func trelloChecklists(cls []trelloChecklist) map[string][]models.ExportChecklistItem {
+	sorted := append([]trelloChecklist(nil), cls...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Pos < sorted[j].Pos
+	})
+
+	count := make(map[string]int)
+	for _, cl := range sorted {
+		count[cl.IDCard]++
+	}
+
+	items := make(map[string][]models.ExportChecklistItem)
+	for _, cl := range sorted {
+		cis := append([]trelloCheckItem(nil), cl.CheckItems...)
+		sort.SliceStable(cis, func(i, j int) bool {
+			return cis[i].Pos < cis[j].Pos
+		})
+
+		for _, ci := range cis {
+			content := ci.Name
+			if count[cl.IDCard] > 1 {
+				content = cl.Name + ": " + ci.Name
+			}
+			items[cl.IDCard] = append(items[cl.IDCard], models.ExportChecklistItem{
+				Content:     content,
+				IsCompleted: ci.State == trelloItemComplete,
+			})
+		}
+	}
+
+	return items
+}
+
+// trelloLabelName names the labels Trello leaves without a name after their
+// color.
+func trelloLabelName(lb trelloLabel) string {
+	if lb.Name != "" {
+		return lb.Name
+	}
+	if lb.Color != "" {
+		return lb.Color
+	}
+
+	return "Label"
+}
+
+func trelloLabelColor(color string) string {
+	base, _, _ := strings.Cut(color, "_")
+	if hex, ok := trelloColors[base]; ok {
+		return hex
+	}
+
+	return trelloDefaultColor
+}
+
+// trelloPosition keeps the order of the items of the document, the import
+// replaces it with new positions.
+func trelloPosition(i int) string {
+	return fmt.Sprintf("%010d", i)
+}
@@ -0,0 +1,402 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/upload"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/position"
+)
+
+// fakeRepo keeps the boards in memory. Calling a method it does not implement
+// panics through the nil embedded interface.
+type fakeRepo struct {
+	repository.Repository
+
+	boards map[string]models.Board
+	// content are the lists, cards and labels by board
+	content map[string]repository.Content
+	// shareLinks are the share links by token hash
+	shareLinks map[string]models.BoardShareLink
+	// attachments are the attachments of the cards by board
+	attachments map[string][]string
+	purged      []string
+	trashFilter *repository.GetOptions
+	// created are the boards created with their content
+	created []repository.CreateWithContentOptions
+	// createPanic makes CreateWithContent panic with its value
+	createPanic interface{}
+	// createErr fails CreateWithContent
+	createErr error
+	imports   map[string]models.BoardImport
+	// views are the boards viewed, in order
+	views []string
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		boards:      make(map[string]models.Board),
+		content:     make(map[string]repository.Content),
+		shareLinks:  make(map[string]models.BoardShareLink),
+		attachments: make(map[string][]string),
+		imports:     make(map[string]models.BoardImport),
+	}
+}
+
+func (r *fakeRepo) Detail(ctx context.Context, sc models.Scope, id string) (models.Board, error) {
+	b, ok := r.boards[id]
+	if !ok {
+		return models.Board{}, repository.ErrNotFound
+	}
+	return b, nil
+}
+
+func (r *fakeRepo) DetailDeleted(ctx context.Context, sc models.Scope, id string) (models.Board, error) {
+	b, ok := r.boards[id]
+	if !ok || b.DeletedAt == nil {
+		return models.Board{}, repository.ErrNotFound
+	}
+	return b, nil
+}
+
+func (r *fakeRepo) Archive(ctx context.Context, sc models.Scope, id string) (models.Board, error) {
+	b, ok := r.boards[id]
+	if !ok || b.ArchivedAt != nil {
+		return models.Board{}, repository.ErrNotFound
+	}
+	now := time.Now()
+	b.ArchivedAt = &now
+	r.boards[id] = b
+	return b, nil
+}
+
+func (r *fakeRepo) Restore(ctx context.Context, sc models.Scope, id string) (models.Board, error) {
+	b, err := r.DetailDeleted(ctx, sc, id)
+	if err != nil {
+		return models.Board{}, err
+	}
+	b.DeletedAt = nil
+	r.boards[id] = b
+	return b, nil
+}
+
+func (r *fakeRepo) Delete(ctx context.Context, sc models.Scope, ids []string) error {
+	for _, id := range ids {
+		b, ok := r.boards[id]
+		if !ok || b.DeletedAt != nil {
+			return repository.ErrNotFound
+		}
+		now := time.Now()
+		b.DeletedAt = &now
+		r.boards[id] = b
+	}
+	return nil
+}
+
+func (r *fakeRepo) ListAttachmentIDs(ctx context.Context, sc models.Scope, boardID string) ([]string, error) {
+	return r.attachments[boardID], nil
+}
+
+func (r *fakeRepo) Purge(ctx context.Context, sc models.Scope, id string) error {
+	if _, err := r.DetailDeleted(ctx, sc, id); err != nil {
+		return err
+	}
+	delete(r.boards, id)
+	r.purged = append(r.purged, id)
+	return nil
+}
+
+func (r *fakeRepo) Get(ctx context.Context, sc models.Scope, opts repository.GetOptions) ([]models.Board, paginator.Paginator, error) {
+	r.trashFilter = &opts
+	var bs []models.Board
+	for _, b := range r.boards {
+		if (b.DeletedAt != nil) == opts.Filter.Trashed {
+			bs = append(bs, b)
+		}
+	}
+	return bs, paginator.Paginator{Total: int64(len(bs))}, nil
+}
+
+func (r *fakeRepo) ListCardKeyPrefixes(ctx context.Context, sc models.Scope, prefix string) ([]string, error) {
+	var ps []string
+	for _, b := range r.boards {
+		if p := b.Settings.CardKeyPrefix; p != "" && strings.HasPrefix(p, prefix) {
+			ps = append(ps, p)
+		}
+	}
+	return ps, nil
+}
+
+func (r *fakeRepo) CreateWithContent(ctx context.Context, sc models.Scope, opts repository.CreateWithContentOptions) (models.Board, repository.Content, error) {
+	if r.createPanic != nil {
+		panic(r.createPanic)
+	}
+	if r.createErr != nil {
+		return models.Board{}, repository.Content{}, r.createErr
+	}
+	r.created = append(r.created, opts)
+	b := models.Board{
+		ID:        "board-new",
+		Name:      opts.Board.Name,
+		CreatedBy: &sc.UserID,
+	}
+	r.boards[b.ID] = b
+	return b, repository.Content{}, nil
+}
+
+func (r *fakeRepo) UpdateImport(ctx context.Context, sc models.Scope, opts repository.UpdateImportOptions) (models.BoardImport, error) {
+	imp, ok := r.imports[opts.ID]
+	if !ok || (opts.FromStatus != "" && imp.Status != opts.FromStatus) {
+		return models.BoardImport{}, repository.ErrNotFound
+	}
+	imp.Status = opts.Status
+	imp.UpdatedAt = time.Now()
+	if opts.BoardID != "" {
+		imp.BoardID = &opts.BoardID
+	}
+	if opts.Report != nil {
+		imp.Report = *opts.Report
+	}
+	if opts.ErrorMessage != "" {
+		imp.ErrorMessage = &opts.ErrorMessage
+	}
+	r.imports[opts.ID] = imp
+	return imp, nil
+}
+
+func (r *fakeRepo) DetailImport(ctx context.Context, sc models.Scope, id string) (models.BoardImport, error) {
+	imp, ok := r.imports[id]
+	if !ok {
+		return models.BoardImport{}, repository.ErrNotFound
+	}
+	return imp, nil
+}
+
+func (r *fakeRepo) FailStaleImports(ctx context.Context, sc models.Scope, opts repository.FailStaleImportsOptions) error {
+	for id, imp := range r.imports {
+		stale := imp.UpdatedAt.Before(opts.UpdatedBefore)
+		if stale && (imp.Status == models.BoardImportPending || imp.Status == models.BoardImportRunning) {
+			imp.Status = models.BoardImportFailed
+			imp.ErrorMessage = &opts.ErrorMessage
+			r.imports[id] = imp
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepo) RecordView(ctx context.Context, sc models.Scope, opts repository.RecordViewOptions) error {
+	r.views = append(r.views, opts.BoardID)
+	return nil
+}
+
+func (r *fakeRepo) ListContent(ctx context.Context, sc models.Scope, opts repository.ListContentOptions) (repository.Content, error) {
+	return r.content[opts.BoardID], nil
+}
+
+func (r *fakeRepo) DetailShareLink(ctx context.Context, sc models.Scope, opts repository.DetailShareLinkOptions) (models.BoardShareLink, error) {
+	l, ok := r.shareLinks[opts.TokenHash]
+	if !ok {
+		return models.BoardShareLink{}, repository.ErrNotFound
+	}
+	return l, nil
+}
+
+// fakeMemberUC grants the roles it holds by board, and closes the archived
+// and trashed boards of the repository the way the members usecase does.
+type fakeMemberUC struct {
+	members.UseCase
+
+	repo  *fakeRepo
+	roles map[string]models.BoardRole
+	// members are the members of the boards, by board
+	members map[string][]models.BoardMember
+}
+
+func (u fakeMemberUC) Authorize(ctx context.Context, sc models.Scope, ip members.AuthorizeInput) error {
+	r, ok := u.roles[ip.BoardID]
+	if !ok || !r.AtLeast(ip.Role) {
+		return members.ErrForbidden
+	}
+	b := u.repo.boards[ip.BoardID]
+	if ip.AllowInactive {
+		return nil
+	}
+	if b.DeletedAt != nil {
+		return members.ErrForbidden
+	}
+	if b.ArchivedAt != nil && ip.Role != models.BoardRoleObserver {
+		return members.ErrBoardArchived
+	}
+	return nil
+}
+
+func (u fakeMemberUC) List(ctx context.Context, sc models.Scope, boardID string) (members.ListOutput, error) {
+	return members.ListOutput{Members: u.members[boardID]}, nil
+}
+
+func (u fakeMemberUC) MemberFilter(ctx context.Context, sc models.Scope) (string, error) {
+	return sc.UserID, nil
+}
+
+// fakeUploadUC lists the uploads it holds by ID and keeps the IDs of the
+// deleted files.
+type fakeUploadUC struct {
+	upload.UseCase
+
+	uploads map[string]models.Upload
+	deleted []string
+}
+
+func (u *fakeUploadUC) List(ctx context.Context, sc models.Scope, ip upload.ListInput) ([]models.Upload, error) {
+	var us []models.Upload
+	for _, id := range ip.IDs {
+		if up, ok := u.uploads[id]; ok {
+			us = append(us, up)
+		}
+	}
+	return us, nil
+}
+
+func (u *fakeUploadUC) Delete(ctx context.Context, sc models.Scope, ip upload.DeleteInput) error {
+	u.deleted = append(u.deleted, ip.IDs...)
+	return nil
+}
+
+// fakeRoleUC grants every permission unless it holds an error.
+type fakeRoleUC struct {
+	role.UseCase
+
+	err error
+}
+
+func (u *fakeRoleUC) Authorize(ctx context.Context, sc models.Scope, permissions ...string) error {
+	return u.err
+}
+
+// fakeTemplateUC returns the templates it holds by ID.
+type fakeTemplateUC struct {
+	templates.UseCase
+
+	templates map[string]models.BoardTemplate
+	defaultID string
+}
+
+func (u fakeTemplateUC) Detail(ctx context.Context, sc models.Scope, ID string) (templates.DetailOutput, error) {
+	t, ok := u.templates[ID]
+	if !ok {
+		return templates.DetailOutput{}, templates.ErrNotFound
+	}
+	return templates.DetailOutput{Template: t}, nil
+}
+
+func (u fakeTemplateUC) Default(ctx context.Context, sc models.Scope) (models.BoardTemplate, error) {
+	t, ok := u.templates[u.defaultID]
+	if !ok {
+		return models.BoardTemplate{}, templates.ErrNotFound
+	}
+	return t, nil
+}
+
+// fakeWorkspaceUC grants the roles it holds by workspace.
+type fakeWorkspaceUC struct {
+	workspaces.UseCase
+
+	roles map[string]models.WorkspaceRole
+}
+
+func (u fakeWorkspaceUC) Authorize(ctx context.Context, sc models.Scope, ip workspaces.AuthorizeInput) error {
+	r, ok := u.roles[ip.WorkspaceID]
+	if !ok || !r.AtLeast(ip.Role) {
+		return workspaces.ErrForbidden
+	}
+	return nil
+}
+
+// fakeUserUC returns the users it holds by ID.
+type fakeUserUC struct {
+	user.UseCase
+
+	users map[string]models.User
+}
+
+func (u fakeUserUC) List(ctx context.Context, sc models.Scope, ip user.ListInput) ([]models.User, error) {
+	var us []models.User
+	for _, usr := range u.users {
+		if contains(ip.Filter.IDs, usr.ID) || contains(ip.Filter.Usernames, usr.Username) {
+			us = append(us, usr)
+		}
+	}
+	return us, nil
+}
+
+func (u fakeUserUC) Detail(ctx context.Context, sc models.Scope, ID string) (user.UserOutput, error) {
+	usr, ok := u.users[ID]
+	if !ok {
+		return user.UserOutput{}, user.ErrUserNotFound
+	}
+	return user.UserOutput{User: usr}, nil
+}
+
+type mockDeps struct {
+	repo        *fakeRepo
+	memberUC    *fakeMemberUC
+	uploadUC    *fakeUploadUC
+	roleUC      *fakeRoleUC
+	workspaceUC *fakeWorkspaceUC
+	users       map[string]models.User
+}
+
+func initUseCase(t *testing.T, mockTime time.Time) (*implUsecase, mockDeps) {
+	t.Helper()
+
+	repo := newFakeRepo()
+	memberUC := &fakeMemberUC{repo: repo, roles: make(map[string]models.BoardRole)}
+	uploadUC := &fakeUploadUC{}
+	roleUC := &fakeRoleUC{}
+	workspaceUC := &fakeWorkspaceUC{roles: make(map[string]models.WorkspaceRole)}
+	users := map[string]models.User{
+		"user-1": {ID: "user-1", Username: "john"},
+		"user-2": {ID: "user-2", Username: "jane"},
+	}
+
+	uc := &implUsecase{
+		l:           log.InitializeTestZapLogger(),
+		repo:        repo,
+		userUC:      fakeUserUC{users: users},
+		memberUC:    memberUC,
+		workspaceUC: workspaceUC,
+		roleUC:      roleUC,
+		uploadUC:    uploadUC,
+		positionUC:  position.NewPositionManager(),
+		clock:       func() time.Time { return mockTime },
+	}
+
+	return uc, mockDeps{
+		repo:        repo,
+		memberUC:    memberUC,
+		uploadUC:    uploadUC,
+		roleUC:      roleUC,
+		workspaceUC: workspaceUC,
+		users:       users,
+	}
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
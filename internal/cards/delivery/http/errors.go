@@ -1,15 +1,29 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 )
 
 var (
-	errWrongQuery    = pkgErrors.NewHTTPError(10001, "Wrong query")
-	errWrongBody     = pkgErrors.NewHTTPError(10002, "Wrong body")
-	errNotFound      = pkgErrors.NewHTTPError(10003, "Card not found")
-	errFieldRequired = pkgErrors.NewHTTPError(10004, "Field required")
+	errWrongQuery     = pkgErrors.NewHTTPError(10001, "Wrong query")
+	errWrongBody      = pkgErrors.NewHTTPError(10002, "Wrong body")
+	errNotFound       = pkgErrors.NewHTTPError(10003, "Card not found")
+	errFieldRequired  = pkgErrors.NewHTTPError(10004, "Field required")
+	errForbidden      = &pkgErrors.HTTPError{Code: 10005, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errBoardArchived  = &pkgErrors.HTTPError{Code: 10006, Message: "This board is archived and read-only", StatusCode: http.StatusForbidden}
+	errInvalidFormat  = pkgErrors.NewHTTPError(10007, "Invalid export format")
+	errInvalidFile    = pkgErrors.NewHTTPError(10008, "Invalid import file")
+	errInvalidMapping = pkgErrors.NewHTTPError(10009, "Invalid column mapping")
+	errTooManyRows    = pkgErrors.NewHTTPError(10010, "Too many rows in the import file")
+	errListNotFound   = &pkgErrors.HTTPError{Code: 10011, Message: "List not found", StatusCode: http.StatusNotFound}
+	errInvalidKey     = pkgErrors.NewHTTPError(10012, "Invalid card key")
+	errAmbiguousKey   = &pkgErrors.HTTPError{Code: 10013, Message: "The card key matches cards on several boards, pick a board", StatusCode: http.StatusConflict}
+	errTeamForbidden  = &pkgErrors.HTTPError{Code: 10014, Message: "You do not have access to this team", StatusCode: http.StatusForbidden}
 )
 
 func (h handler) mapErrorCode(err error) error {
@@ -18,6 +32,26 @@ func (h handler) mapErrorCode(err error) error {
 		return errNotFound
 	case cards.ErrFieldRequired:
 		return errFieldRequired
+	case members.ErrForbidden:
+		return errForbidden
+	case members.ErrBoardArchived:
+		return errBoardArchived
+	case cards.ErrInvalidFormat:
+		return errInvalidFormat
+	case cards.ErrInvalidFile:
+		return errInvalidFile
+	case cards.ErrInvalidMapping:
+		return errInvalidMapping
+	case cards.ErrTooManyRows:
+		return errTooManyRows
+	case cards.ErrListNotFound:
+		return errListNotFound
+	case cards.ErrInvalidKey:
+		return errInvalidKey
+	case cards.ErrAmbiguousKey:
+		return errAmbiguousKey
+	case teams.ErrForbidden:
+		return errTeamForbidden
 	default:
 		return err
 	}
@@ -25,4 +59,9 @@ func (h handler) mapErrorCode(err error) error {
 
 var NotFound = []error{
 	errNotFound,
+	errForbidden,
+	errBoardArchived,
+	errListNotFound,
+	errAmbiguousKey,
+	errTeamForbidden,
 }
@@ -19,6 +19,7 @@ import (
 // @Param list_id query string false "List ID"
 // @Param board_id query string false "Board ID"
 // @Param keyword query string false "Keyword"
+// @Param assigned_team_id query string false "Keep the cards assigned to any member of the team"
 // @Param page query integer false "Page"
 // @Param limit query integer false "Limit"
 // @Success 200 {object} getCardResp "Success"
@@ -172,6 +173,48 @@ func (h handler) Detail(c *gin.Context) {
 	response.OK(c, h.newItem(o))
 }
 
+// @Summary Get card by key
+// @Description Get a card by its key, like PROJ-123. The key is the card key prefix of the board and the number of the card on the board. board_id picks the board when the key matches cards on several boards the user can read
+// @Tags Card
+// @Accept json
+// @Produce json
+// @Param Access-Control-Allow-Origin header string false "Access-Control-Allow-Origin" default(*)
+// @Param User-Agent header string false "User-Agent" default(Swagger-Codegen/1.0.0/go)
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param key path string true "Card key"
+// @Param board_id query string false "Board ID"
+// @Success 200 {object} cardItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 409 {object} response.Resp "Conflict"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/cards/by-key/{key} [GET]
+func (h handler) DetailByKey(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processDetailByKeyRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.cards.http.DetailByKey.processDetailByKeyRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.DetailByKey(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.cards.http.DetailByKey.uc.DetailByKey: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.cards.http.DetailByKey.uc.DetailByKey: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newItem(o))
+}
+
 // @Summary Delete card
 // @Description Delete a card by ID
 // @Tags Card
@@ -294,6 +337,137 @@ func (h handler) GetActivities(c *gin.Context) {
 	response.OK(c, h.newGetActivitiesResp(o))
 }
 
+// @Summary Export cards
+// @Description Export the cards of a board or of any card filter as a CSV or XLSX file, with their key, name, list, priority, assignee, tags, labels, dates and hours. The file is streamed as the cards are read
+// @Tags Card
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param format query string false "File format, csv or xlsx" default(csv)
+// @Param ids query string false "IDs"
+// @Param list_id query string false "List ID"
+// @Param board_id query string false "Board ID"
+// @Param keyword query string false "Keyword"
+// @Param assigned_team_id query string false "Keep the cards assigned to any member of the team"
+// @Success 200 {file} file "Cards file"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/cards/export [GET]
+func (h handler) Export(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processExportRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.cards.http.Export.processExportRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	ip := req.toInput()
+	w := &exportWriter{c: c, format: ip.Format}
+	if err := h.uc.Export(ctx, sc, ip, w); err != nil {
+		// The file is cut short once it is being sent
+		if w.started {
+			h.l.Errorf(ctx, "internal.cards.http.Export.uc.Export: %v", err)
+			c.Abort()
+			return
+		}
+
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.cards.http.Export.uc.Export: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.cards.http.Export.uc.Export: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+}
+
+// @Summary Preview card import
+// @Description Read the columns and first rows of a CSV file, with the field suggested for each column. The mapping can be changed before the import
+// @Tags Card
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param board_id formData string true "Board ID"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} previewImportResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/cards/import/preview [POST]
+func (h handler) PreviewImport(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processPreviewImportRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.cards.http.PreviewImport.processPreviewImportRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.PreviewImport(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.cards.http.PreviewImport.uc.PreviewImport: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.cards.http.PreviewImport.uc.PreviewImport: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newPreviewImportResp(o))
+}
+
+// @Summary Import cards
+// @Description Create a card for each row of a CSV file. Lists and labels are found by name, assignees by the username of a board member. No card is created when a row is invalid, the errors of the rows are returned instead. A dry run only validates the rows
+// @Tags Card
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param board_id formData string true "Board ID"
+// @Param list_id formData string false "List of the rows without a list"
+// @Param mapping formData string false "JSON object of the column of each field, the suggested mapping when empty"
+// @Param dry_run formData boolean false "Validate the rows without creating the cards"
+// @Param file formData file true "CSV file"
+// @Success 200 {object} importResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/cards/import [POST]
+func (h handler) Import(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processImportRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.cards.http.Import.processImportRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Import(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.cards.http.Import.uc.Import: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.cards.http.Import.uc.Import: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newImportResp(o))
+}
+
 // Enhanced functionality methods
 
 // @Summary Assign card
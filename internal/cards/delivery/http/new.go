@@ -12,9 +12,13 @@ type Handler interface {
 	Create(c *gin.Context)
 	Update(c *gin.Context)
 	Detail(c *gin.Context)
+	DetailByKey(c *gin.Context)
 	Delete(c *gin.Context)
 	Move(c *gin.Context)
 	GetActivities(c *gin.Context)
+	Export(c *gin.Context)
+	PreviewImport(c *gin.Context)
+	Import(c *gin.Context)
 
 	// Enhanced functionality methods
 	Assign(c *gin.Context)
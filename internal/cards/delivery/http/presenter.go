@@ -1,15 +1,20 @@
 package http
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
 	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 	"github.com/nguyentantai21042004/kanban-api/pkg/response"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+	"github.com/nguyentantai21042004/kanban-api/pkg/xlsx"
 )
 
 type respObj struct {
@@ -51,6 +56,7 @@ type getReq struct {
 	BoardID            string   `form:"board_id"`
 	Keyword            string   `form:"keyword"`
 	AssignedTo         string   `form:"assigned_to"`
+	AssignedTeamID     string   `form:"assigned_team_id"`
 	Priority           string   `form:"priority"`
 	Tags               []string `form:"tags[]"`
 	DueDateFrom        string   `form:"due_date_from"`
@@ -70,18 +76,24 @@ func (req getReq) validate() error {
 			}
 		}
 	}
+	if req.AssignedTeamID != "" {
+		if err := postgres.IsUUID(req.AssignedTeamID); err != nil {
+			return errors.New("invalid assigned team id")
+		}
+	}
 
 	return nil
 }
 
 func (req getReq) toInput() cards.GetInput {
 	filter := cards.Filter{
-		IDs:        req.IDs,
-		ListID:     req.ListID,
-		BoardID:    req.BoardID,
-		Keyword:    req.Keyword,
-		AssignedTo: req.AssignedTo,
-		Tags:       req.Tags,
+		IDs:            req.IDs,
+		ListID:         req.ListID,
+		BoardID:        req.BoardID,
+		Keyword:        req.Keyword,
+		AssignedTo:     req.AssignedTo,
+		AssignedTeamID: req.AssignedTeamID,
+		Tags:           req.Tags,
 	}
 
 	// Parse priority if provided
@@ -641,3 +653,215 @@ func (h handler) newGetActivitiesResp(o cards.GetActivitiesOutput) getCardActivi
 		Meta:  paginator.PaginatorResponse{},
 	}
 }
+
+// DetailByKey
+type detailByKeyReq struct {
+	Key     string `form:"-"`
+	BoardID string `form:"board_id"`
+}
+
+func (req detailByKeyReq) validate() error {
+	if req.Key == "" {
+		return errors.New("key is required")
+	}
+	if req.BoardID != "" {
+		if err := postgres.IsUUID(req.BoardID); err != nil {
+			return errors.New("invalid board id")
+		}
+	}
+
+	return nil
+}
+
+func (req detailByKeyReq) toInput() cards.DetailByKeyInput {
+	return cards.DetailByKeyInput{
+		Key:     req.Key,
+		BoardID: req.BoardID,
+	}
+}
+
+// Export
+type exportReq struct {
+	getReq
+	Format string `form:"format"`
+}
+
+func (req exportReq) validate() error {
+	if err := req.getReq.validate(); err != nil {
+		return err
+	}
+	switch cards.ExportFormat(req.Format) {
+	case "", cards.ExportFormatCSV, cards.ExportFormatXLSX:
+	default:
+		return errors.New("invalid format")
+	}
+
+	return nil
+}
+
+func (req exportReq) toInput() cards.ExportInput {
+	format := cards.ExportFormat(req.Format)
+	if format == "" {
+		format = cards.ExportFormatCSV
+	}
+
+	return cards.ExportInput{
+		Filter: req.getReq.toInput().Filter,
+		Format: format,
+	}
+}
+
+// exportWriter sends the headers of the file with its first bytes, so an
+// error returned before them is still sent as JSON.
+type exportWriter struct {
+	c       *gin.Context
+	format  cards.ExportFormat
+	started bool
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+
+		contentType := "text/csv; charset=utf-8"
+		if w.format == cards.ExportFormatXLSX {
+			contentType = xlsx.ContentType
+		}
+		w.c.Header("Content-Type", contentType)
+		w.c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"cards.%s\"", w.format))
+		w.c.Status(http.StatusOK)
+	}
+
+	return w.c.Writer.Write(p)
+}
+
+// Import
+// maxImportFileSize is the largest CSV file read by the imports
+const maxImportFileSize = 10 << 20
+
+var errFileTooLarge = errors.New("import file too large")
+
+type previewImportReq struct {
+	BoardID string `form:"board_id" binding:"required"`
+	// Data is the CSV file, sent as the file field
+	Data []byte `form:"-"`
+}
+
+func (req previewImportReq) validate() error {
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		return errors.New("invalid board_id")
+	}
+
+	return nil
+}
+
+func (req previewImportReq) toInput() cards.PreviewImportInput {
+	return cards.PreviewImportInput{
+		BoardID: req.BoardID,
+		Data:    req.Data,
+	}
+}
+
+type previewImportResp struct {
+	Columns []string          `json:"columns"`
+	Mapping map[string]string `json:"mapping"`
+	Rows    [][]string        `json:"rows"`
+	Total   int               `json:"total"`
+}
+
+func (h handler) newPreviewImportResp(o cards.PreviewImportOutput) previewImportResp {
+	mapping := make(map[string]string, len(o.Mapping))
+	for field, column := range o.Mapping {
+		mapping[string(field)] = column
+	}
+
+	return previewImportResp{
+		Columns: o.Columns,
+		Mapping: mapping,
+		Rows:    o.Rows,
+		Total:   o.Total,
+	}
+}
+
+type importReq struct {
+	BoardID string `form:"board_id" binding:"required"`
+	ListID  string `form:"list_id"`
+	// Mapping is a JSON object of the column of each field, like
+	// {"name": "Title"}
+	Mapping string `form:"mapping"`
+	DryRun  bool   `form:"dry_run"`
+	Data    []byte `form:"-"`
+}
+
+func (req importReq) validate() error {
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		return errors.New("invalid board_id")
+	}
+	if req.ListID != "" {
+		if err := postgres.IsUUID(req.ListID); err != nil {
+			return errors.New("invalid list_id")
+		}
+	}
+	if req.Mapping != "" {
+		var mapping map[string]string
+		if err := json.Unmarshal([]byte(req.Mapping), &mapping); err != nil {
+			return errors.New("invalid mapping")
+		}
+	}
+
+	return nil
+}
+
+func (req importReq) toInput() cards.ImportInput {
+	var mapping map[string]string
+	_ = json.Unmarshal([]byte(req.Mapping), &mapping)
+
+	fields := make(map[cards.ImportField]string, len(mapping))
+	for field, column := range mapping {
+		fields[cards.ImportField(field)] = column
+	}
+
+	return cards.ImportInput{
+		BoardID: req.BoardID,
+		ListID:  req.ListID,
+		Data:    req.Data,
+		Mapping: fields,
+		DryRun:  req.DryRun,
+	}
+}
+
+type importRowErrorItem struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type importResp struct {
+	Total  int                  `json:"total"`
+	DryRun bool                 `json:"dry_run"`
+	Items  []cardItem           `json:"items"`
+	Errors []importRowErrorItem `json:"errors"`
+}
+
+func (h handler) newImportResp(o cards.ImportOutput) importResp {
+	items := make([]cardItem, len(o.Cards))
+	for i, c := range o.Cards {
+		items[i] = h.newItem(cards.DetailOutput{Card: c})
+	}
+
+	errs := make([]importRowErrorItem, len(o.Errors))
+	for i, e := range o.Errors {
+		errs[i] = importRowErrorItem{
+			Row:     e.Row,
+			Field:   string(e.Field),
+			Message: e.Message,
+		}
+	}
+
+	return importResp{
+		Total:  o.Total,
+		DryRun: o.DryRun,
+		Items:  items,
+		Errors: errs,
+	}
+}
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"io"
+
 	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
@@ -91,6 +93,30 @@ func (h handler) processDetailRequest(c *gin.Context) (string, models.Scope, err
 	return id, scope.NewScope(p), nil
 }
 
+func (h handler) processDetailByKeyRequest(c *gin.Context) (detailByKeyReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processDetailByKeyRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return detailByKeyReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req detailByKeyReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processDetailByKeyRequest.c.ShouldBindQuery: %v", err)
+		return detailByKeyReq{}, models.Scope{}, errWrongQuery
+	}
+	req.Key = c.Param("key")
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processDetailByKeyRequest.req.validate: %v", err)
+		return detailByKeyReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
 func (h handler) processDeleteRequest(c *gin.Context) (deleteReq, models.Scope, error) {
 	ctx := c.Request.Context()
 
@@ -342,3 +368,105 @@ func (h handler) processSetCompletionDateRequest(c *gin.Context) (setCompletionD
 
 	return req, scope.NewScope(p), nil
 }
+
+func (h handler) processExportRequest(c *gin.Context) (exportReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processExportRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return exportReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req exportReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processExportRequest.c.ShouldBindQuery: %v", err)
+		return exportReq{}, models.Scope{}, errWrongQuery
+	}
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processExportRequest.req.validate: %v", err)
+		return exportReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processPreviewImportRequest(c *gin.Context) (previewImportReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processPreviewImportRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return previewImportReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req previewImportReq
+	if err := c.ShouldBind(&req); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processPreviewImportRequest.c.ShouldBind: %v", err)
+		return previewImportReq{}, models.Scope{}, errWrongBody
+	}
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processPreviewImportRequest.req.validate: %v", err)
+		return previewImportReq{}, models.Scope{}, errWrongBody
+	}
+
+	data, err := h.readImportFile(c)
+	if err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processPreviewImportRequest.readImportFile: %v", err)
+		return previewImportReq{}, models.Scope{}, errInvalidFile
+	}
+	req.Data = data
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processImportRequest(c *gin.Context) (importReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processImportRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return importReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req importReq
+	if err := c.ShouldBind(&req); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processImportRequest.c.ShouldBind: %v", err)
+		return importReq{}, models.Scope{}, errWrongBody
+	}
+
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processImportRequest.req.validate: %v", err)
+		return importReq{}, models.Scope{}, errWrongBody
+	}
+
+	data, err := h.readImportFile(c)
+	if err != nil {
+		h.l.Errorf(ctx, "internal.cards.delivery.http.processImportRequest.readImportFile: %v", err)
+		return importReq{}, models.Scope{}, errInvalidFile
+	}
+	req.Data = data
+
+	return req, scope.NewScope(p), nil
+}
+
+// readImportFile reads the file field of an import, up to maxImportFileSize.
+func (h handler) readImportFile(c *gin.Context) ([]byte, error) {
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return nil, err
+	}
+	if fh.Size > maxImportFileSize {
+		return nil, errFileTooLarge
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return io.ReadAll(io.LimitReader(f, maxImportFileSize))
+}
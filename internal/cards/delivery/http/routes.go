@@ -11,9 +11,13 @@ func MapCardRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
 	r.POST("", h.Create)
 	r.PUT("", h.Update)
 	r.GET("/:id", h.Detail)
+	r.GET("/by-key/:key", h.DetailByKey)
 	r.DELETE("", h.Delete)
 	r.POST("/move", h.Move)
 	r.GET("/activities", h.GetActivities)
+	r.GET("/export", h.Export)
+	r.POST("/import/preview", h.PreviewImport)
+	r.POST("/import", h.Import)
 
 	// Enhanced functionality routes
 	r.POST("/assign", h.Assign)
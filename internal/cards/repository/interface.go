@@ -19,6 +19,8 @@ type CoreRepository interface {
 	Get(ctx context.Context, sc models.Scope, opts GetOptions) ([]models.Card, paginator.Paginator, error)
 	Move(ctx context.Context, sc models.Scope, opts MoveOptions) (models.Card, error)
 	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.Card, error)
+	// CreateMany creates all the cards or none of them.
+	CreateMany(ctx context.Context, sc models.Scope, opts []CreateOptions) ([]models.Card, error)
 	Update(ctx context.Context, sc models.Scope, opts UpdateOptions) (models.Card, error)
 	Delete(ctx context.Context, sc models.Scope, ids []string) error
 }
@@ -10,6 +10,10 @@ import (
 
 type ListOptions struct {
 	Filter cards.Filter
+	// Limit and Offset read the cards a page at a time, ordered by board,
+	// list and position. All the cards are read when Limit is 0
+	Limit  int64
+	Offset int64
 }
 
 type GetOptions struct {
@@ -31,7 +35,6 @@ type CreateOptions struct {
 	BoardID        string
 	ListID         string
 	Name           string
-	Alias          string
 	Description    string
 	Position       string
 	Priority       models.CardPriority
@@ -40,7 +43,9 @@ type CreateOptions struct {
 	CreatedBy      string
 	AssignedTo     *string
 	EstimatedHours *float64
+	ActualHours    *float64
 	StartDate      *time.Time
+	CompletionDate *time.Time
 	Tags           []string
 	Checklist      []CheckListOptions
 }
@@ -48,7 +53,6 @@ type CreateOptions struct {
 type UpdateOptions struct {
 	ID             string
 	Name           string
-	Alias          string
 	Description    *string
 	Priority       *models.CardPriority
 	Labels         *[]string
@@ -6,6 +6,7 @@ import (
 	"sync"
 
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
@@ -33,6 +34,13 @@ func (r implRepository) List(ctx context.Context, sc models.Scope, opts reposito
 		r.l.Errorf(ctx, "internal.cards.repository.postgres.List.buildListQuery: %v", err)
 		return nil, err
 	}
+	if opts.Limit > 0 {
+		qr = append(qr,
+			qm.OrderBy("board_id, list_id, position, id"),
+			qm.Limit(int(opts.Limit)),
+			qm.Offset(int(opts.Offset)),
+		)
+	}
 
 	cs, err := dbmodels.Cards(qr...).All(ctx, r.database)
 	if err != nil {
@@ -168,16 +176,64 @@ func (r implRepository) Create(ctx context.Context, sc models.Scope, opts reposi
 	}
 	defer tx.Rollback()
 
-	m, err := r.buildModel(ctx, opts)
+	m, err := r.create(ctx, tx, opts)
 	if err != nil {
-		r.l.Errorf(ctx, "internal.cards.repository.postgres.Create.buildModel: %v", err)
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.Create.create: %v", err)
+		return models.Card{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.Create.Commit: %v", err)
 		return models.Card{}, err
 	}
 
+	return models.NewCard(m), nil
+}
+
+func (r implRepository) CreateMany(ctx context.Context, sc models.Scope, opts []repository.CreateOptions) ([]models.Card, error) {
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.CreateMany.BeginTx: %v", err)
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	cs := make([]models.Card, len(opts))
+	for i, o := range opts {
+		m, err := r.create(ctx, tx, o)
+		if err != nil {
+			r.l.Errorf(ctx, "internal.cards.repository.postgres.CreateMany.create: %v", err)
+			return nil, err
+		}
+		cs[i] = models.NewCard(m)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.CreateMany.Commit: %v", err)
+		return nil, err
+	}
+
+	return cs, nil
+}
+
+// create inserts the card with its created activity.
+func (r implRepository) create(ctx context.Context, tx boil.ContextExecutor, opts repository.CreateOptions) (dbmodels.Card, error) {
+	m, err := r.buildModel(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.create.buildModel: %v", err)
+		return dbmodels.Card{}, err
+	}
+
 	err = m.Insert(ctx, tx, boil.Infer())
 	if err != nil {
-		r.l.Errorf(ctx, "internal.cards.repository.postgres.Create.Insert: %v", err)
-		return models.Card{}, err
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.create.Insert: %v", err)
+		return dbmodels.Card{}, err
+	}
+
+	// The number and key of the card are set by a trigger
+	if err := m.Reload(ctx, tx); err != nil {
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.create.Reload: %v", err)
+		return dbmodels.Card{}, err
 	}
 
 	// Create activity record
@@ -193,16 +249,11 @@ func (r implRepository) Create(ctx context.Context, sc models.Scope, opts reposi
 
 	err = activity.Insert(ctx, tx, boil.Infer())
 	if err != nil {
-		r.l.Errorf(ctx, "internal.cards.repository.postgres.Create.InsertActivity: %v", err)
-		return models.Card{}, err
-	}
-
-	if err := tx.Commit(); err != nil {
-		r.l.Errorf(ctx, "internal.cards.repository.postgres.Create.Commit: %v", err)
-		return models.Card{}, err
+		r.l.Errorf(ctx, "internal.cards.repository.postgres.create.InsertActivity: %v", err)
+		return dbmodels.Card{}, err
 	}
 
-	return models.NewCard(m), nil
+	return m, nil
 }
 
 func (r implRepository) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.Card, error) {
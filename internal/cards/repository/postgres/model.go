@@ -34,7 +34,6 @@ func (r implRepository) buildModel(ctx context.Context, opts repository.CreateOp
 		BoardID:     opts.BoardID,
 		ListID:      opts.ListID,
 		Name:        opts.Name,
-		Alias:       null.StringFrom(opts.Alias),
 		Description: null.StringFrom(opts.Description),
 		Position:    opts.Position,
 		Priority:    dbmodels.CardPriority(opts.Priority),
@@ -54,13 +53,21 @@ func (r implRepository) buildModel(ctx context.Context, opts repository.CreateOp
 	}
 
 	if opts.EstimatedHours != nil {
-		m.EstimatedHours = types.NullDecimal{Big: decimal.New(int64(*opts.EstimatedHours), 0)}
+		m.EstimatedHours = types.NullDecimal{Big: new(decimal.Big).SetFloat64(*opts.EstimatedHours)}
+	}
+
+	if opts.ActualHours != nil {
+		m.ActualHours = types.NullDecimal{Big: new(decimal.Big).SetFloat64(*opts.ActualHours)}
 	}
 
 	if opts.StartDate != nil {
 		m.StartDate = null.TimeFromPtr(opts.StartDate)
 	}
 
+	if opts.CompletionDate != nil {
+		m.CompletionDate = null.TimeFromPtr(opts.CompletionDate)
+	}
+
 	if len(opts.Tags) > 0 {
 		m.Tags = opts.Tags
 	}
@@ -83,11 +90,6 @@ func (r implRepository) buildUpdateModel(ctx context.Context, opts repository.Up
 		cols = append(cols, dbmodels.CardColumns.Name)
 		updates["name"] = opts.Name
 	}
-	if opts.Alias != "" {
-		card.Alias = null.StringFrom(opts.Alias)
-		cols = append(cols, dbmodels.CardColumns.Alias)
-		updates["alias"] = opts.Alias
-	}
 	if opts.Description != nil {
 		card.Description = null.StringFrom(*opts.Description)
 		cols = append(cols, dbmodels.CardColumns.Description)
@@ -3,10 +3,12 @@ package postgres
 import (
 	"context"
 
+	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 )
 
@@ -51,8 +53,12 @@ func (r implRepository) buildGetQuery(ctx context.Context, fils cards.Filter) ([
 		qr = append(qr, qm.Where("list_id = ?", fils.ListID))
 	}
 
+	if fils.Key != "" {
+		qr = append(qr, dbmodels.CardWhere.Alias.EQ(null.StringFrom(fils.Key)))
+	}
+
 	if fils.Keyword != "" {
-		qr = append(qr, qm.Where("Name ILIKE ? OR description ILIKE ?", "%"+fils.Keyword+"%", "%"+fils.Keyword+"%"))
+		qr = append(qr, qm.Where("Name ILIKE ? OR description ILIKE ? OR alias ILIKE ?", "%"+fils.Keyword+"%", "%"+fils.Keyword+"%", "%"+fils.Keyword+"%"))
 	}
 
 	if fils.CreatedBy != "" {
@@ -63,6 +69,32 @@ func (r implRepository) buildGetQuery(ctx context.Context, fils cards.Filter) ([
 		qr = append(qr, qm.Where("created_by = ?", fils.CreatedBy))
 	}
 
+	if fils.MemberID != "" {
+		if err := postgres.IsUUID(fils.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.cards.repository.postgres.buildGetQuery.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		// The cards of a board in the trash stay hidden until it is restored
+		qr = append(qr, qm.Where("board_id IN (SELECT ba.board_id FROM board_access ba JOIN boards b ON b.id = ba.board_id WHERE ba.user_id = ? AND b.deleted_at IS NULL)", fils.MemberID))
+	}
+
+	if fils.ReadableBy != "" {
+		if err := postgres.IsUUID(fils.ReadableBy); err != nil {
+			r.l.Errorf(ctx, "internal.cards.repository.postgres.buildGetQuery.InvalidReadableBy: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("board_id IN (SELECT id FROM boards WHERE deleted_at IS NULL AND (settings->>'visibility' = ? OR id IN (SELECT board_id FROM board_access WHERE user_id = ?)))",
+			string(models.BoardVisibilityPublic), fils.ReadableBy))
+	}
+
+	if fils.WorkspaceID != "" {
+		if err := postgres.IsUUID(fils.WorkspaceID); err != nil {
+			r.l.Errorf(ctx, "internal.cards.repository.postgres.buildGetQuery.InvalidWorkspaceID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("board_id IN (SELECT id FROM boards WHERE workspace_id = ?)", fils.WorkspaceID))
+	}
+
 	if fils.AssignedTo != "" {
 		if err := postgres.IsUUID(fils.AssignedTo); err != nil {
 			r.l.Errorf(ctx, "internal.cards.repository.postgres.buildGetQuery.InvalidAssignedTo: %v", err)
@@ -71,6 +103,14 @@ func (r implRepository) buildGetQuery(ctx context.Context, fils cards.Filter) ([
 		qr = append(qr, qm.Where("assigned_to = ?", fils.AssignedTo))
 	}
 
+	if fils.AssignedTeamID != "" {
+		if err := postgres.IsUUID(fils.AssignedTeamID); err != nil {
+			r.l.Errorf(ctx, "internal.cards.repository.postgres.buildGetQuery.InvalidAssignedTeamID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("assigned_to IN (SELECT tm.user_id FROM team_members tm JOIN teams t ON t.id = tm.team_id WHERE tm.team_id = ? AND t.deleted_at IS NULL)", fils.AssignedTeamID))
+	}
+
 	if fils.Priority != "" {
 		qr = append(qr, qm.Where("priority = ?", fils.Priority))
 	}
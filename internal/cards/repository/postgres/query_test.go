@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const userID = "3f2a1b4c-5d6e-4f70-8192-a3b4c5d6e7f8"
+
+// TestBuildGetQueryTrashedBoards checks that the cards of a board moved to the
+// trash are not listed, the cards themselves are not marked deleted.
+func TestBuildGetQueryTrashedBoards(t *testing.T) {
+	tcs := map[string]struct {
+		fils cards.Filter
+		// want is the part of the query leaving the trashed boards out
+		want string
+	}{
+		"boards of a member": {
+			fils: cards.Filter{MemberID: userID},
+			want: "JOIN boards b ON b.id = ba.board_id WHERE ba.user_id = $1 AND b.deleted_at IS NULL",
+		},
+		"boards a user can read": {
+			fils: cards.Filter{ReadableBy: userID},
+			want: "SELECT id FROM boards WHERE deleted_at IS NULL",
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			r := implRepository{l: log.InitializeTestZapLogger()}
+
+			qr, err := r.buildGetQuery(context.Background(), tc.fils)
+			require.NoError(t, err)
+
+			q, args := queries.BuildQuery(dbmodels.Cards(qr...).Query)
+			assert.Contains(t, q, tc.want)
+			assert.Contains(t, args, userID)
+		})
+	}
+}
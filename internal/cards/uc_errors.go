@@ -11,4 +11,10 @@ var (
 	ErrTagNotFound           = errors.New("tag not found")
 	ErrInvalidTimeRange      = errors.New("invalid time range")
 	ErrChecklistItemNotFound = errors.New("checklist item not found")
+	ErrInvalidFormat         = errors.New("invalid export format")
+	ErrInvalidFile           = errors.New("invalid import file")
+	ErrInvalidMapping        = errors.New("invalid column mapping")
+	ErrTooManyRows           = errors.New("too many rows")
+	ErrInvalidKey            = errors.New("invalid card key")
+	ErrAmbiguousKey          = errors.New("card key matches cards on several boards")
 )
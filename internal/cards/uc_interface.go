@@ -2,6 +2,7 @@ package cards
 
 import (
 	"context"
+	"io"
 
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 )
@@ -10,10 +11,15 @@ import (
 type UseCase interface {
 	CoreUseCase
 	EnhancedUseCase
+	SpreadsheetUseCase
 }
 
 type CoreUseCase interface {
 	Detail(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	// DetailByKey returns the card with the key among the boards the user can
+	// read. It returns ErrAmbiguousKey when the key matches cards on several
+	// of them and no board is given.
+	DetailByKey(ctx context.Context, sc models.Scope, ip DetailByKeyInput) (DetailOutput, error)
 	Get(ctx context.Context, sc models.Scope, ip GetInput) (GetOutput, error)
 	Move(ctx context.Context, sc models.Scope, ip MoveInput) error
 	Create(ctx context.Context, sc models.Scope, ip CreateInput) (DetailOutput, error)
@@ -35,3 +41,16 @@ type EnhancedUseCase interface {
 	SetCompletionDate(ctx context.Context, sc models.Scope, ip SetCompletionDateInput) error
 	Dashboard(ctx context.Context, sc models.Scope, ip DashboardInput) (CardsDashboardOutput, error)
 }
+
+type SpreadsheetUseCase interface {
+	// Export writes the cards of the filter to w as a spreadsheet, a page of
+	// cards at a time. Nothing is written to w when an error is returned
+	// before the first page.
+	Export(ctx context.Context, sc models.Scope, ip ExportInput, w io.Writer) error
+	// PreviewImport reads the columns of a CSV file and suggests the fields
+	// they map to, so the mapping can be checked before the import.
+	PreviewImport(ctx context.Context, sc models.Scope, ip PreviewImportInput) (PreviewImportOutput, error)
+	// Import creates a card for each row of a CSV file. No card is created
+	// when a row is invalid, the errors of the rows are returned instead.
+	Import(ctx context.Context, sc models.Scope, ip ImportInput) (ImportOutput, error)
+}
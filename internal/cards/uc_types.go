@@ -8,11 +8,18 @@ import (
 )
 
 type Filter struct {
-	IDs                []string
-	ListID             string
-	BoardID            string
-	Keyword            string
-	CreatedBy          string
+	IDs       []string
+	ListID    string
+	BoardID   string
+	Key       string
+	Keyword   string
+	CreatedBy string
+	// MemberID keeps the rows of the boards the user is a member of that are
+	// not in the trash
+	MemberID string
+	// ReadableBy keeps the rows of the live boards the user can read, the
+	// boards the user is a member of and the public ones
+	ReadableBy         string
 	AssignedTo         string
 	Priority           models.CardPriority
 	Tags               []string
@@ -27,6 +34,9 @@ type Filter struct {
 	UpdatedFrom        *time.Time
 	UpdatedTo          *time.Time
 	UncompletedOnly    bool
+	WorkspaceID        string
+	// AssignedTeamID keeps the cards assigned to any member of the team
+	AssignedTeamID string
 }
 
 type GetInput struct {
@@ -82,6 +92,13 @@ type GetOutput struct {
 	Pagination paginator.Paginator
 }
 
+// DetailByKeyInput finds a card by its key, like PROJ-123. BoardID picks the
+// board when boards share the prefix of the key.
+type DetailByKeyInput struct {
+	Key     string
+	BoardID string
+}
+
 type DetailOutput struct {
 	Card  models.Card
 	List  models.List
@@ -152,8 +169,9 @@ type SetCompletionDateInput struct {
 
 // Dashboard aggregation for cards
 type DashboardInput struct {
-	From time.Time
-	To   time.Time
+	From        time.Time
+	To          time.Time
+	WorkspaceID string
 }
 
 type CardsDashboardOutput struct {
@@ -170,3 +188,75 @@ type ActivityPoint struct {
 	CardsCreated   int64
 	CardsCompleted int64
 }
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV  ExportFormat = "csv"
+	ExportFormatXLSX ExportFormat = "xlsx"
+)
+
+type ExportInput struct {
+	Filter Filter
+	Format ExportFormat
+}
+
+// ImportField is a card field a column of an import file maps to.
+type ImportField string
+
+const (
+	ImportFieldName           ImportField = "name"
+	ImportFieldDescription    ImportField = "description"
+	ImportFieldList           ImportField = "list"
+	ImportFieldPriority       ImportField = "priority"
+	ImportFieldAssignee       ImportField = "assignee"
+	ImportFieldTags           ImportField = "tags"
+	ImportFieldLabels         ImportField = "labels"
+	ImportFieldDueDate        ImportField = "due_date"
+	ImportFieldStartDate      ImportField = "start_date"
+	ImportFieldCompletionDate ImportField = "completion_date"
+	ImportFieldEstimatedHours ImportField = "estimated_hours"
+	ImportFieldActualHours    ImportField = "actual_hours"
+)
+
+type PreviewImportInput struct {
+	BoardID string
+	Data    []byte
+}
+
+type PreviewImportOutput struct {
+	Columns []string
+	// Mapping is the column suggested for each field, by the name of the
+	// column
+	Mapping map[ImportField]string
+	// Rows are the first rows of the file
+	Rows  [][]string
+	Total int
+}
+
+type ImportInput struct {
+	BoardID string
+	// ListID receives the cards of the rows without a list
+	ListID string
+	Data   []byte
+	// Mapping is the column of each field, the suggested mapping is used
+	// when it is empty
+	Mapping map[ImportField]string
+	// DryRun validates the rows without creating the cards
+	DryRun bool
+}
+
+// ImportRowError is a problem with a row of an import file, rows are
+// numbered as in the file, the header being the first one.
+type ImportRowError struct {
+	Row     int
+	Field   ImportField
+	Message string
+}
+
+type ImportOutput struct {
+	Total  int
+	Cards  []models.Card
+	Errors []ImportRowError
+	DryRun bool
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
 	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
 	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
@@ -25,6 +26,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (c
 		return cards.DetailOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: c.BoardID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Detail.memberUC.Authorize: %v", err)
+		return cards.DetailOutput{}, err
+	}
+
 	var (
 		ob boards.DetailOutput
 		ol lists.DetailOutput
@@ -42,9 +48,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (c
 			if err == repository.ErrNotFound {
 				uc.l.Warnf(ctx, "internal.cards.usecase.Detail.boardUC.Detail.NotFound: %v", err)
 				errChan <- repository.ErrNotFound
+				return
 			}
 			uc.l.Errorf(ctx, "internal.cards.usecase.Detail.boardUC.Detail: %v", err)
 			errChan <- err
+			return
 		}
 		errChan <- nil
 	}()
@@ -58,9 +66,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (c
 			if err == lists.ErrNotFound {
 				uc.l.Warnf(ctx, "internal.cards.usecase.Detail.listUC.Detail.NotFound: %v", err)
 				errChan <- lists.ErrNotFound
+				return
 			}
 			uc.l.Errorf(ctx, "internal.cards.usecase.Detail.listUC.Detail: %v", err)
 			errChan <- err
+			return
 		}
 		errChan <- nil
 	}()
@@ -99,22 +109,17 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (c
 }
 
 func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip cards.GetInput) (cards.GetOutput, error) {
-	// Only admin can see all cards
-	me, err := uc.userUC.DetailMe(ctx, sc)
-	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Get.userUC.DetailMe: %v", err)
+	if err := uc.authorizeTeamFilter(ctx, sc, ip.Filter.AssignedTeamID); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Get.authorizeTeamFilter: %v", err)
 		return cards.GetOutput{}, err
 	}
 
-	rl, err := uc.roleUC.Detail(ctx, sc, me.User.RoleID)
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Get.roleUC.Detail: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.Get.memberUC.MemberFilter: %v", err)
 		return cards.GetOutput{}, err
 	}
-
-	if rl.Code != models.ADMIN_ROLE {
-		ip.Filter.CreatedBy = me.User.ID
-	}
+	ip.Filter.MemberID = memberID
 
 	u, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
 		Filter:   ip.Filter,
@@ -158,11 +163,30 @@ func (uc implUsecase) Move(ctx context.Context, sc models.Scope, ip cards.MoveIn
 	}
 
 	// Ensure the card to move exists
-	if _, ok := crdMap[ip.ID]; !ok {
+	crd, ok := crdMap[ip.ID]
+	if !ok {
 		uc.l.Errorf(ctx, "internal.cards.usecase.Move.repo.List.NotFound: %v", repository.ErrNotFound)
 		return repository.ErrNotFound
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: crd.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Move.memberUC.Authorize: %v", err)
+		return err
+	}
+
+	// The target list has to be editable too
+	if ip.ListID != "" && ip.ListID != crd.ListID {
+		ol, err := uc.listUC.Detail(ctx, sc, ip.ListID)
+		if err != nil {
+			uc.l.Warnf(ctx, "internal.cards.usecase.Move.listUC.Detail: %v", err)
+			return err
+		}
+		if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ol.List.BoardID, Role: models.BoardRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.cards.usecase.Move.memberUC.Authorize: %v", err)
+			return err
+		}
+	}
+
 	// Get positions of after/before cards if they exist
 	var afterPst, beforePst string = "", ""
 	if afTrCrd, ok := crdMap[ip.AfterID]; ok {
@@ -197,14 +221,19 @@ func (uc implUsecase) Move(ctx context.Context, sc models.Scope, ip cards.MoveIn
 	}
 
 	// Broadcast the card move event, but don't fail the operation if broadcasting fails
-	if err := uc.wsHub.BroadcastToBoard(ctx, updCard.BoardID, websocket.MSG_CARD_MOVED, updCard, sc.UserID); err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Move.wsHub.BroadcastToBoard: %v", err)
+	if err := uc.broadcastCardEvent(ctx, updCard.BoardID, websocket.MSG_CARD_MOVED, updCard, sc.UserID); err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Move.broadcastCardEvent: %v", err)
 	}
 
 	return nil
 }
 
 func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip cards.CreateInput) (cards.DetailOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Create.memberUC.Authorize: %v", err)
+		return cards.DetailOutput{}, err
+	}
+
 	var (
 		ob      boards.DetailOutput
 		ol      lists.DetailOutput
@@ -221,9 +250,11 @@ func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip cards.Crea
 			if err == repository.ErrNotFound {
 				uc.l.Warnf(ctx, "internal.cards.usecase.Create.boardUC.Detail.NotFound: %v", err)
 				errChan <- repository.ErrNotFound
+				return
 			}
 			uc.l.Errorf(ctx, "internal.cards.usecase.Create.boardUC.Detail: %v", err)
 			errChan <- err
+			return
 		}
 		errChan <- nil
 	}()
@@ -237,9 +268,11 @@ func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip cards.Crea
 			if err == lists.ErrNotFound {
 				uc.l.Warnf(ctx, "internal.cards.usecase.Create.listUC.Detail.NotFound: %v", err)
 				errChan <- lists.ErrNotFound
+				return
 			}
 			uc.l.Errorf(ctx, "internal.cards.usecase.Create.listUC.Detail: %v", err)
 			errChan <- err
+			return
 		}
 		errChan <- nil
 	}()
@@ -269,9 +302,9 @@ func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip cards.Crea
 		return cards.DetailOutput{}, err
 	}
 
-	// Set default priority if not provided
+	// Cards created without a priority get the default one of the board
 	if ip.Priority == "" {
-		ip.Priority = models.CardPriorityMedium
+		ip.Priority = ob.Board.Settings.DefaultPriority
 	}
 
 	checklist := make([]repository.CheckListOptions, len(ip.Checklist))
@@ -286,7 +319,6 @@ func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip cards.Crea
 		BoardID:        ip.BoardID,
 		ListID:         ip.ListID,
 		Name:           ip.Name,
-		Alias:          util.BuildAlias(ip.Name),
 		Description:    ip.Description,
 		Position:       pst,
 		Priority:       ip.Priority,
@@ -304,9 +336,9 @@ func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip cards.Crea
 		return cards.DetailOutput{}, err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, ob.Board.ID, websocket.MSG_CARD_CREATED, b, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, ob.Board.ID, websocket.MSG_CARD_CREATED, b, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Create.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.Create.broadcastCardEvent: %v", err)
 	}
 
 	userIDs := []string{sc.UserID}
@@ -340,6 +372,11 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip cards.Upda
 		return cards.DetailOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: oc.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Update.memberUC.Authorize: %v", err)
+		return cards.DetailOutput{}, err
+	}
+
 	var (
 		ob      boards.DetailOutput
 		ol      lists.DetailOutput
@@ -357,9 +394,11 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip cards.Upda
 			if err == repository.ErrNotFound {
 				uc.l.Warnf(ctx, "internal.cards.usecase.Update.boardUC.Detail.NotFound: %v", err)
 				errChan <- repository.ErrNotFound
+				return
 			}
 			uc.l.Errorf(ctx, "internal.cards.usecase.Update.boardUC.Detail: %v", err)
 			errChan <- err
+			return
 		}
 		errChan <- nil
 	}()
@@ -373,9 +412,11 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip cards.Upda
 			if err == lists.ErrNotFound {
 				uc.l.Warnf(ctx, "internal.cards.usecase.Update.listUC.Detail.NotFound: %v", err)
 				errChan <- lists.ErrNotFound
+				return
 			}
 			uc.l.Errorf(ctx, "internal.cards.usecase.Update.listUC.Detail: %v", err)
 			errChan <- err
+			return
 		}
 		errChan <- nil
 	}()
@@ -392,7 +433,6 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip cards.Upda
 	b, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
 		ID:             ip.ID,
 		Name:           ip.Name,
-		Alias:          util.BuildAlias(ip.Name),
 		Description:    ip.Description,
 		Priority:       ip.Priority,
 		Labels:         ip.Labels,
@@ -411,9 +451,9 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip cards.Upda
 		return cards.DetailOutput{}, err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, oc.BoardID, websocket.MSG_CARD_UPDATED, b, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, oc.BoardID, websocket.MSG_CARD_UPDATED, b, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Update.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.Update.broadcastCardEvent: %v", err)
 	}
 
 	return cards.DetailOutput{
@@ -444,18 +484,24 @@ func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ids []string)
 		return cards.ErrCardNotFound
 	}
 
+	bIDs := make([]string, len(cs))
+	for i, c := range cs {
+		bIDs[i] = c.BoardID
+	}
+	for _, bID := range util.RemoveDuplicates(bIDs) {
+		if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: bID, Role: models.BoardRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.cards.usecase.Delete.memberUC.Authorize: %v", err)
+			return err
+		}
+	}
+
 	err = uc.repo.Delete(ctx, sc, ids)
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.cards.usecase.Delete.repo.Delete: %v", err)
 		return err
 	}
 
-	for _, card := range cs {
-		err = uc.wsHub.BroadcastToBoard(ctx, card.BoardID, websocket.MSG_CARD_DELETED, card, sc.UserID)
-		if err != nil {
-			uc.l.Errorf(ctx, "internal.cards.usecase.Delete.wsHub.BroadcastToBoard: %v", err)
-		}
-	}
+	uc.broadcastCardEvents(ctx, websocket.MSG_CARD_DELETED, cs, sc.UserID)
 
 	return nil
 }
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAssignedTeamFilter(t *testing.T) {
+	sc := models.Scope{UserID: "user-1"}
+
+	tcs := map[string]struct {
+		teamRoles map[string]models.TeamRole
+		filter    cards.Filter
+		wantErr   error
+	}{
+		"no team filter": {
+			filter: cards.Filter{BoardID: "board-1"},
+		},
+		"member of the team": {
+			teamRoles: map[string]models.TeamRole{"team-1": models.TeamRoleMember},
+			filter:    cards.Filter{AssignedTeamID: "team-1"},
+		},
+		"maintainer of the team": {
+			teamRoles: map[string]models.TeamRole{"team-1": models.TeamRoleMaintainer},
+			filter:    cards.Filter{AssignedTeamID: "team-1"},
+		},
+		"not in the team": {
+			teamRoles: map[string]models.TeamRole{"team-2": models.TeamRoleMaintainer},
+			filter:    cards.Filter{AssignedTeamID: "team-1"},
+			wantErr:   teams.ErrForbidden,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			for id, role := range tc.teamRoles {
+				deps.teamUC.roles[id] = role
+			}
+
+			_, err := uc.Get(context.Background(), sc, cards.GetInput{Filter: tc.filter})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				// The cards are never read, so nothing tells who is in the team
+				assert.Empty(t, deps.repo.gets)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, deps.repo.gets, 1)
+			assert.Equal(t, tc.filter.AssignedTeamID, deps.repo.gets[0].Filter.AssignedTeamID)
+			assert.Equal(t, "user-1", deps.repo.gets[0].Filter.MemberID)
+		})
+	}
+}
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/labels"
+	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+	"github.com/nguyentantai21042004/kanban-api/pkg/xlsx"
+)
+
+const (
+	// exportPageSize is the number of cards read and written at a time
+	exportPageSize = 500
+	// valueSeparator joins the tags and labels of a card in a cell
+	valueSeparator = ", "
+)
+
+// exportColumns are the columns of the exported spreadsheets. An exported
+// file imports back, its columns match the import fields.
+var exportColumns = []string{
+	"Key",
+	"Name",
+	"List",
+	"Priority",
+	"Assignee",
+	"Tags",
+	"Labels",
+	"Due date",
+	"Start date",
+	"Completion date",
+	"Estimated hours",
+	"Actual hours",
+}
+
+// exportHourColumns are the indexes of the hours in exportColumns.
+var exportHourColumns = []int{10, 11}
+
+// recordWriter writes the rows of a spreadsheet, like csv.Writer.
+type recordWriter interface {
+	Write(record []string) error
+	Flush()
+	Error() error
+	Close() error
+}
+
+type csvWriter struct {
+	*csv.Writer
+}
+
+func (w csvWriter) Close() error {
+	w.Flush()
+	return w.Error()
+}
+
+func newRecordWriter(format cards.ExportFormat, w io.Writer) (recordWriter, error) {
+	switch format {
+	case cards.ExportFormatCSV:
+		return csvWriter{csv.NewWriter(w)}, nil
+	case cards.ExportFormatXLSX:
+		xw := xlsx.NewWriter(w)
+		xw.SheetName = "Cards"
+		xw.NumberColumns = exportHourColumns
+		return xw, nil
+	default:
+		return nil, cards.ErrInvalidFormat
+	}
+}
+
+// exportNames keeps the names of the lists, labels and users of the written
+// cards, so each of them is read once.
+type exportNames struct {
+	lists     map[string]string
+	labels    map[string]string
+	usernames map[string]string
+}
+
+func (uc implUsecase) Export(ctx context.Context, sc models.Scope, ip cards.ExportInput, w io.Writer) error {
+	if ip.Filter.BoardID != "" {
+		if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.Filter.BoardID, Role: models.BoardRoleObserver}); err != nil {
+			uc.l.Warnf(ctx, "internal.cards.usecase.Export.memberUC.Authorize: %v", err)
+			return err
+		}
+	}
+
+	if err := uc.authorizeTeamFilter(ctx, sc, ip.Filter.AssignedTeamID); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Export.authorizeTeamFilter: %v", err)
+		return err
+	}
+
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Export.memberUC.MemberFilter: %v", err)
+		return err
+	}
+	ip.Filter.MemberID = memberID
+
+	rw, err := newRecordWriter(ip.Format, w)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Export.newRecordWriter: %v", err)
+		return err
+	}
+
+	// The rows are buffered until the first page is flushed
+	if err := rw.Write(exportColumns); err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Export.rw.Write: %v", err)
+		return err
+	}
+
+	names := exportNames{
+		lists:     make(map[string]string),
+		labels:    make(map[string]string),
+		usernames: make(map[string]string),
+	}
+	for offset := int64(0); ; offset += exportPageSize {
+		cs, err := uc.repo.List(ctx, sc, repository.ListOptions{
+			Filter: ip.Filter,
+			Limit:  exportPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.Export.repo.List: %v", err)
+			return err
+		}
+
+		if err := uc.loadExportNames(ctx, sc, cs, names); err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.Export.loadExportNames: %v", err)
+			return err
+		}
+
+		for _, c := range cs {
+			if err := rw.Write(newExportRecord(c, names)); err != nil {
+				uc.l.Errorf(ctx, "internal.cards.usecase.Export.rw.Write: %v", err)
+				return err
+			}
+		}
+
+		rw.Flush()
+		if err := rw.Error(); err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.Export.rw.Flush: %v", err)
+			return err
+		}
+
+		if len(cs) < exportPageSize {
+			break
+		}
+	}
+
+	if err := rw.Close(); err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Export.rw.Close: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// loadExportNames reads the names of the lists, labels and assignees of the
+// cards that are not known yet.
+func (uc implUsecase) loadExportNames(ctx context.Context, sc models.Scope, cs []models.Card, names exportNames) error {
+	var listIDs, labelIDs, userIDs []string
+	for _, c := range cs {
+		if _, ok := names.lists[c.ListID]; !ok {
+			listIDs = append(listIDs, c.ListID)
+		}
+		for _, lb := range c.Labels {
+			if _, ok := names.labels[lb]; !ok {
+				labelIDs = append(labelIDs, lb)
+			}
+		}
+		if c.AssignedTo != nil {
+			if _, ok := names.usernames[*c.AssignedTo]; !ok {
+				userIDs = append(userIDs, *c.AssignedTo)
+			}
+		}
+	}
+
+	if len(listIDs) > 0 {
+		o, err := uc.listUC.Get(ctx, sc, lists.GetInput{
+			Filter: lists.Filter{IDs: util.RemoveDuplicates(listIDs)},
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.loadExportNames.listUC.Get: %v", err)
+			return err
+		}
+		for _, l := range o.Lists {
+			names.lists[l.ID] = l.Name
+		}
+	}
+
+	// Labels are kept by ID on the cards, the ones that are not labels of the
+	// board are left out of the file
+	labelIDs = util.Filter(util.RemoveDuplicates(labelIDs), func(id string) bool {
+		return postgres.IsUUID(id) == nil
+	})
+	if len(labelIDs) > 0 {
+		o, err := uc.labelUC.Get(ctx, sc, labels.GetInput{
+			Filter: labels.Filter{IDs: labelIDs},
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.loadExportNames.labelUC.Get: %v", err)
+			return err
+		}
+		for _, id := range labelIDs {
+			names.labels[id] = ""
+		}
+		for _, lb := range o.Labels {
+			names.labels[lb.ID] = lb.Name
+		}
+	}
+
+	if len(userIDs) > 0 {
+		usrs, err := uc.userUC.List(ctx, sc, user.ListInput{
+			Filter: user.Filter{IDs: util.RemoveDuplicates(userIDs)},
+		})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.loadExportNames.userUC.List: %v", err)
+			return err
+		}
+		for _, u := range usrs {
+			names.usernames[u.ID] = u.Username
+		}
+	}
+
+	return nil
+}
+
+func newExportRecord(c models.Card, names exportNames) []string {
+	labelNames := make([]string, 0, len(c.Labels))
+	for _, lb := range c.Labels {
+		if name := names.labels[lb]; name != "" {
+			labelNames = append(labelNames, name)
+		}
+	}
+
+	var assignee string
+	if c.AssignedTo != nil {
+		assignee = names.usernames[*c.AssignedTo]
+	}
+
+	return []string{
+		c.Alias,
+		c.Name,
+		names.lists[c.ListID],
+		string(c.Priority),
+		assignee,
+		strings.Join(c.Tags, valueSeparator),
+		strings.Join(labelNames, valueSeparator),
+		formatExportTime(c.DueDate),
+		formatExportTime(c.StartDate),
+		formatExportTime(c.CompletionDate),
+		formatExportHours(c.EstimatedHours),
+		formatExportHours(c.ActualHours),
+	}
+}
+
+func formatExportTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return util.DateTimeToStr(t.In(util.GetDefaultTimezone()))
+}
+
+func formatExportHours(h *float64) string {
+	if h == nil {
+		return ""
+	}
+
+	return strconv.FormatFloat(*h, 'f', -1, 64)
+}
@@ -0,0 +1,494 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/labels"
+	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+const (
+	// maxImportRows is the most rows an import file can have
+	maxImportRows = 5000
+	// importPreviewRows is the number of rows returned by the preview
+	importPreviewRows = 5
+)
+
+// importFields are the fields the columns of an import file can map to.
+var importFields = map[cards.ImportField]bool{
+	cards.ImportFieldName:           true,
+	cards.ImportFieldDescription:    true,
+	cards.ImportFieldList:           true,
+	cards.ImportFieldPriority:       true,
+	cards.ImportFieldAssignee:       true,
+	cards.ImportFieldTags:           true,
+	cards.ImportFieldLabels:         true,
+	cards.ImportFieldDueDate:        true,
+	cards.ImportFieldStartDate:      true,
+	cards.ImportFieldCompletionDate: true,
+	cards.ImportFieldEstimatedHours: true,
+	cards.ImportFieldActualHours:    true,
+}
+
+// importColumnAliases are the other column names suggested for a field.
+var importColumnAliases = map[string]cards.ImportField{
+	"title":       cards.ImportFieldName,
+	"assigned_to": cards.ImportFieldAssignee,
+	"due":         cards.ImportFieldDueDate,
+	"start":       cards.ImportFieldStartDate,
+	"estimate":    cards.ImportFieldEstimatedHours,
+}
+
+// importTimeFormats are the date formats read from import files.
+var importTimeFormats = []string{
+	util.DateTimeFormat,
+	util.DateFormat,
+	time.RFC3339,
+}
+
+// importBoard is what the rows of an import refer to on the board, lists
+// and labels by lowercase name and users by username.
+type importBoard struct {
+	boardID         string
+	defaultListID   string
+	defaultPriority models.CardPriority
+	listIDs         map[string]bool
+	lists           map[string]string
+	labels          map[string]string
+	users           map[string]string
+}
+
+func (uc implUsecase) PreviewImport(ctx context.Context, sc models.Scope, ip cards.PreviewImportInput) (cards.PreviewImportOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.PreviewImport.memberUC.Authorize: %v", err)
+		return cards.PreviewImportOutput{}, err
+	}
+
+	columns, rows, err := readImportFile(ip.Data)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.PreviewImport.readImportFile: %v", err)
+		return cards.PreviewImportOutput{}, err
+	}
+
+	preview := rows
+	if len(preview) > importPreviewRows {
+		preview = preview[:importPreviewRows]
+	}
+
+	return cards.PreviewImportOutput{
+		Columns: columns,
+		Mapping: suggestImportMapping(columns),
+		Rows:    preview,
+		Total:   len(rows),
+	}, nil
+}
+
+func (uc implUsecase) Import(ctx context.Context, sc models.Scope, ip cards.ImportInput) (cards.ImportOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Import.memberUC.Authorize: %v", err)
+		return cards.ImportOutput{}, err
+	}
+
+	columns, rows, err := readImportFile(ip.Data)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Import.readImportFile: %v", err)
+		return cards.ImportOutput{}, err
+	}
+	if len(rows) > maxImportRows {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Import.TooManyRows: %d rows", len(rows))
+		return cards.ImportOutput{}, cards.ErrTooManyRows
+	}
+
+	mapping := ip.Mapping
+	if len(mapping) == 0 {
+		mapping = suggestImportMapping(columns)
+	}
+	index, err := importColumnIndex(columns, mapping, ip.ListID != "")
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Import.importColumnIndex: %v", err)
+		return cards.ImportOutput{}, err
+	}
+
+	ib, err := uc.loadImportBoard(ctx, sc, ip.BoardID, importUsernames(rows, index))
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Import.loadImportBoard: %v", err)
+		return cards.ImportOutput{}, err
+	}
+	if ip.ListID != "" {
+		if !ib.listIDs[ip.ListID] {
+			uc.l.Warnf(ctx, "internal.cards.usecase.Import.ListNotFound: list %s is not a list of board %s", ip.ListID, ip.BoardID)
+			return cards.ImportOutput{}, cards.ErrListNotFound
+		}
+		ib.defaultListID = ip.ListID
+	}
+
+	opts := make([]repository.CreateOptions, 0, len(rows))
+	rowErrs := make([]cards.ImportRowError, 0)
+	for i, row := range rows {
+		// Rows are numbered as in the file, the header being the first one
+		o, errs := newImportCard(row, i+2, index, ib)
+		if len(errs) > 0 {
+			rowErrs = append(rowErrs, errs...)
+			continue
+		}
+		o.CreatedBy = sc.UserID
+		opts = append(opts, o)
+	}
+
+	out := cards.ImportOutput{
+		Total:  len(rows),
+		Errors: rowErrs,
+		DryRun: ip.DryRun,
+	}
+	if len(rowErrs) > 0 || ip.DryRun || len(opts) == 0 {
+		return out, nil
+	}
+
+	if err := uc.importPositions(ctx, sc, opts); err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Import.importPositions: %v", err)
+		return cards.ImportOutput{}, err
+	}
+
+	cs, err := uc.repo.CreateMany(ctx, sc, opts)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.Import.repo.CreateMany: %v", err)
+		return cards.ImportOutput{}, err
+	}
+
+	uc.broadcastCardEvents(ctx, websocket.MSG_CARD_CREATED, cs, sc.UserID)
+	out.Cards = cs
+
+	return out, nil
+}
+
+// loadImportBoard reads the lists and labels of the board and its members.
+// Only the members can be assigned, the other usernames are reported the same
+// whether they exist or not, so a file cannot tell which usernames are taken.
+func (uc implUsecase) loadImportBoard(ctx context.Context, sc models.Scope, boardID string, usernames []string) (importBoard, error) {
+	ib := importBoard{
+		boardID: boardID,
+		listIDs: make(map[string]bool),
+		lists:   make(map[string]string),
+		labels:  make(map[string]string),
+		users:   make(map[string]string),
+	}
+
+	ob, err := uc.boardUC.Detail(ctx, sc, boardID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.loadImportBoard.boardUC.Detail: %v", err)
+		return importBoard{}, err
+	}
+	ib.defaultPriority = ob.Board.Settings.DefaultPriority
+
+	ol, err := uc.listUC.Get(ctx, sc, lists.GetInput{
+		Filter: lists.Filter{BoardID: boardID},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.loadImportBoard.listUC.Get: %v", err)
+		return importBoard{}, err
+	}
+	for _, l := range ol.Lists {
+		ib.listIDs[l.ID] = true
+		ib.lists[strings.ToLower(l.Name)] = l.ID
+	}
+
+	olb, err := uc.labelUC.Get(ctx, sc, labels.GetInput{
+		Filter: labels.Filter{BoardID: boardID},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.loadImportBoard.labelUC.Get: %v", err)
+		return importBoard{}, err
+	}
+	for _, lb := range olb.Labels {
+		ib.labels[strings.ToLower(lb.Name)] = lb.ID
+	}
+
+	if len(usernames) > 0 {
+		om, err := uc.memberUC.List(ctx, sc, boardID)
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.loadImportBoard.memberUC.List: %v", err)
+			return importBoard{}, err
+		}
+		for _, u := range om.Users {
+			ib.users[u.Username] = u.ID
+		}
+	}
+
+	return ib, nil
+}
+
+// importPositions places the cards after the cards of their list, in the
+// order of the file.
+func (uc implUsecase) importPositions(ctx context.Context, sc models.Scope, opts []repository.CreateOptions) error {
+	count := make(map[string]int)
+	for _, o := range opts {
+		count[o.ListID]++
+	}
+
+	positions := make(map[string][]string, len(count))
+	for listID, n := range count {
+		mxPst, err := uc.repo.GetPosition(ctx, sc, repository.GetPositionOptions{
+			ListID: listID,
+			ASC:    false,
+		})
+		if err != nil && err != repository.ErrNotFound {
+			uc.l.Errorf(ctx, "internal.cards.usecase.importPositions.repo.GetPosition: %v", err)
+			return err
+		}
+
+		psts, err := uc.positionUC.BatchGeneratePositions(n, mxPst, "")
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.importPositions.positionUC.BatchGeneratePositions: %v", err)
+			return err
+		}
+		positions[listID] = psts
+	}
+
+	for i := range opts {
+		listID := opts[i].ListID
+		opts[i].Position = positions[listID][0]
+		positions[listID] = positions[listID][1:]
+	}
+
+	return nil
+}
+
+// readImportFile returns the header and the rows of a CSV file.
+func readImportFile(data []byte) ([]string, [][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, nil, cards.ErrInvalidFile
+	}
+	if len(records) == 0 {
+		return nil, nil, cards.ErrInvalidFile
+	}
+
+	columns := make([]string, len(records[0]))
+	for i, c := range records[0] {
+		columns[i] = strings.TrimSpace(c)
+	}
+
+	return columns, records[1:], nil
+}
+
+// suggestImportMapping maps the columns named after a field to it, the
+// columns of the exported files are mapped back to their fields.
+func suggestImportMapping(columns []string) map[cards.ImportField]string {
+	mapping := make(map[cards.ImportField]string)
+	for _, c := range columns {
+		key := strings.NewReplacer(" ", "_", "-", "_").Replace(strings.ToLower(c))
+
+		field := cards.ImportField(key)
+		if !importFields[field] {
+			var ok bool
+			if field, ok = importColumnAliases[key]; !ok {
+				continue
+			}
+		}
+		if _, ok := mapping[field]; !ok {
+			mapping[field] = c
+		}
+	}
+
+	return mapping
+}
+
+// importColumnIndex returns the index of the column of each mapped field.
+// The name has to be mapped, and the list unless there is a default list.
+func importColumnIndex(columns []string, mapping map[cards.ImportField]string, hasDefaultList bool) (map[cards.ImportField]int, error) {
+	index := make(map[cards.ImportField]int, len(mapping))
+	for field, column := range mapping {
+		if !importFields[field] {
+			return nil, cards.ErrInvalidMapping
+		}
+		if column == "" {
+			continue
+		}
+
+		i := util.FindIndex(columns, func(c string) bool {
+			return c == column
+		})
+		if i < 0 {
+			return nil, cards.ErrInvalidMapping
+		}
+		index[field] = i
+	}
+
+	if _, ok := index[cards.ImportFieldName]; !ok {
+		return nil, cards.ErrInvalidMapping
+	}
+	if _, ok := index[cards.ImportFieldList]; !ok && !hasDefaultList {
+		return nil, cards.ErrInvalidMapping
+	}
+
+	return index, nil
+}
+
+func importUsernames(rows [][]string, index map[cards.ImportField]int) []string {
+	i, ok := index[cards.ImportFieldAssignee]
+	if !ok {
+		return nil
+	}
+
+	usernames := make([]string, 0)
+	for _, row := range rows {
+		if i < len(row) {
+			if u := strings.TrimSpace(row[i]); u != "" {
+				usernames = append(usernames, u)
+			}
+		}
+	}
+
+	return util.RemoveDuplicates(usernames)
+}
+
+// newImportCard reads the card of a row, with the errors of its values.
+func newImportCard(row []string, rowNum int, index map[cards.ImportField]int, ib importBoard) (repository.CreateOptions, []cards.ImportRowError) {
+	var errs []cards.ImportRowError
+	value := func(f cards.ImportField) string {
+		i, ok := index[f]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+	fail := func(f cards.ImportField, format string, args ...interface{}) {
+		errs = append(errs, cards.ImportRowError{
+			Row:     rowNum,
+			Field:   f,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	o := repository.CreateOptions{
+		BoardID:     ib.boardID,
+		Name:        value(cards.ImportFieldName),
+		Description: value(cards.ImportFieldDescription),
+		Tags:        splitImportValues(value(cards.ImportFieldTags)),
+	}
+	if o.Name == "" {
+		fail(cards.ImportFieldName, "name is required")
+	}
+
+	o.ListID = ib.defaultListID
+	if l := value(cards.ImportFieldList); l != "" {
+		listID, ok := ib.lists[strings.ToLower(l)]
+		if !ok {
+			fail(cards.ImportFieldList, "list %q not found on the board", l)
+		}
+		o.ListID = listID
+	} else if o.ListID == "" {
+		fail(cards.ImportFieldList, "list is required")
+	}
+
+	switch p := models.CardPriority(strings.ToLower(value(cards.ImportFieldPriority))); p {
+	case "":
+		o.Priority = ib.defaultPriority
+	case models.CardPriorityLow, models.CardPriorityMedium, models.CardPriorityHigh:
+		o.Priority = p
+	default:
+		fail(cards.ImportFieldPriority, "priority %q is not low, medium or high", p)
+	}
+
+	if u := value(cards.ImportFieldAssignee); u != "" {
+		userID, ok := ib.users[u]
+		if !ok {
+			fail(cards.ImportFieldAssignee, "user %q is not a member of the board", u)
+		}
+		o.AssignedTo = &userID
+	}
+
+	for _, lb := range splitImportValues(value(cards.ImportFieldLabels)) {
+		labelID, ok := ib.labels[strings.ToLower(lb)]
+		if !ok {
+			fail(cards.ImportFieldLabels, "label %q not found on the board", lb)
+			continue
+		}
+		o.Labels = append(o.Labels, labelID)
+	}
+
+	dates := []struct {
+		field cards.ImportField
+		dst   **time.Time
+	}{
+		{cards.ImportFieldDueDate, &o.DueDate},
+		{cards.ImportFieldStartDate, &o.StartDate},
+		{cards.ImportFieldCompletionDate, &o.CompletionDate},
+	}
+	for _, d := range dates {
+		v := value(d.field)
+		if v == "" {
+			continue
+		}
+		t, err := parseImportTime(v)
+		if err != nil {
+			fail(d.field, "%q is not a date", v)
+			continue
+		}
+		*d.dst = &t
+	}
+
+	hours := []struct {
+		field cards.ImportField
+		dst   **float64
+	}{
+		{cards.ImportFieldEstimatedHours, &o.EstimatedHours},
+		{cards.ImportFieldActualHours, &o.ActualHours},
+	}
+	for _, h := range hours {
+		v := value(h.field)
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 {
+			fail(h.field, "%q is not a number of hours", v)
+			continue
+		}
+		*h.dst = &f
+	}
+
+	return o, errs
+}
+
+func parseImportTime(v string) (time.Time, error) {
+	var err error
+	for _, format := range importTimeFormats {
+		var t time.Time
+		if t, err = time.ParseInLocation(format, v, util.GetDefaultTimezone()); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
+
+// splitImportValues splits the tags or labels of a cell.
+func splitImportValues(v string) []string {
+	values := make([]string, 0)
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const importHeader = "Name,List,Priority,Assignee,Labels,Due Date,Estimated Hours\n"
+
+func TestImport(t *testing.T) {
+	tcs := map[string]struct {
+		data     string
+		listID   string
+		mapping  map[cards.ImportField]string
+		dryRun   bool
+		role     models.BoardRole
+		wantErr  error
+		wantRows []int
+		// wantMessages are the messages of the row errors, when checked
+		wantMessages []string
+		wantCards    int
+	}{
+		"valid rows": {
+			data:      importHeader + "Ship it,Todo,high,john,bug,2026-01-02,3\nWrite notes,done,,,,,\n",
+			role:      models.BoardRoleMember,
+			wantCards: 2,
+		},
+		"dry run": {
+			data:   importHeader + "Ship it,Todo,high,john,bug,2026-01-02,3\nWrite notes,done,,,,,\n",
+			dryRun: true,
+			role:   models.BoardRoleMember,
+		},
+		"invalid rows": {
+			data: importHeader +
+				"Ship it,Todo,urgent,,,,\n" +
+				",Todo,,,,,\n" +
+				"Write notes,Backlog,,,,,\n" +
+				"Fix it,Todo,,ghost,,,\n" +
+				"Test it,Todo,,,feature,,\n" +
+				"Plan it,Todo,,,,tomorrow,\n" +
+				"Size it,Todo,,,,,-1\n" +
+				"Valid,Todo,,,,,\n",
+			role:     models.BoardRoleMember,
+			wantRows: []int{2, 3, 4, 5, 6, 7, 8},
+		},
+		// A user who is not a member is reported like an unknown one
+		"assignees who are not members": {
+			data:     importHeader + "Ship it,Todo,,jane,,,\nFix it,Todo,,ghost,,,\n",
+			role:     models.BoardRoleMember,
+			wantRows: []int{2, 3},
+			wantMessages: []string{
+				`user "jane" is not a member of the board`,
+				`user "ghost" is not a member of the board`,
+			},
+		},
+		"invalid rows in a dry run": {
+			data:     importHeader + "Ship it,Todo,urgent,,,,\nValid,Todo,,,,,\n",
+			dryRun:   true,
+			role:     models.BoardRoleMember,
+			wantRows: []int{2},
+		},
+		"default list": {
+			data:      "Name\nShip it\n",
+			listID:    "list-2",
+			role:      models.BoardRoleMember,
+			wantCards: 1,
+		},
+		"default list of another board": {
+			data:    "Name\nShip it\n",
+			listID:  "list-3",
+			role:    models.BoardRoleMember,
+			wantErr: cards.ErrListNotFound,
+		},
+		"no list": {
+			data:    "Name\nShip it\n",
+			role:    models.BoardRoleMember,
+			wantErr: cards.ErrInvalidMapping,
+		},
+		"mapping to a missing column": {
+			data:    importHeader + "Ship it,Todo,,,,,\n",
+			mapping: map[cards.ImportField]string{cards.ImportFieldName: "Title", cards.ImportFieldList: "List"},
+			role:    models.BoardRoleMember,
+			wantErr: cards.ErrInvalidMapping,
+		},
+		"mapping to an unknown field": {
+			data:    importHeader + "Ship it,Todo,,,,,\n",
+			mapping: map[cards.ImportField]string{cards.ImportFieldName: "Name", cards.ImportFieldList: "List", "color": "Priority"},
+			role:    models.BoardRoleMember,
+			wantErr: cards.ErrInvalidMapping,
+		},
+		"empty file": {
+			role:    models.BoardRoleMember,
+			wantErr: cards.ErrInvalidFile,
+		},
+		"too many rows": {
+			data:    "Name,List\n" + strings.Repeat("Ship it,Todo\n", maxImportRows+1),
+			role:    models.BoardRoleMember,
+			wantErr: cards.ErrTooManyRows,
+		},
+		"observer": {
+			data:    importHeader + "Ship it,Todo,,,,,\n",
+			role:    models.BoardRoleObserver,
+			wantErr: members.ErrForbidden,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.memberUC.roles["board-1"] = tc.role
+
+			o, err := uc.Import(context.Background(), models.Scope{UserID: "user-1"}, cards.ImportInput{
+				BoardID: "board-1",
+				ListID:  tc.listID,
+				Data:    []byte(tc.data),
+				Mapping: tc.mapping,
+				DryRun:  tc.dryRun,
+			})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, deps.repo.created)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.dryRun, o.DryRun)
+
+			rows := make([]int, len(o.Errors))
+			messages := make([]string, len(o.Errors))
+			for i, e := range o.Errors {
+				rows[i] = e.Row
+				messages[i] = e.Message
+			}
+			if tc.wantMessages != nil {
+				assert.Equal(t, tc.wantMessages, messages)
+			}
+			if tc.wantRows == nil {
+				assert.Empty(t, rows)
+			} else {
+				assert.Equal(t, tc.wantRows, rows)
+			}
+
+			// Nothing is written by a dry run or when a row is wrong
+			assert.Len(t, deps.repo.created, tc.wantCards)
+			assert.Len(t, o.Cards, tc.wantCards)
+		})
+	}
+}
+
+func TestImportValues(t *testing.T) {
+	uc, deps := initUseCase(t, time.Now())
+	deps.memberUC.roles["board-1"] = models.BoardRoleMember
+
+	_, err := uc.Import(context.Background(), models.Scope{UserID: "user-1"}, cards.ImportInput{
+		BoardID: "board-1",
+		Data:    []byte(importHeader + "Ship it,TODO,High,john,bug,2026-01-02,1.5\nWrite notes,Todo,,,,,\n"),
+	})
+	require.NoError(t, err)
+	require.Len(t, deps.repo.created, 2)
+
+	c := deps.repo.created[0]
+	assert.Equal(t, "list-1", c.ListID)
+	assert.Equal(t, models.CardPriorityHigh, c.Priority)
+	require.NotNil(t, c.AssignedTo)
+	assert.Equal(t, "user-1", *c.AssignedTo)
+	assert.Equal(t, []string{"label-1"}, c.Labels)
+	require.NotNil(t, c.DueDate)
+	assert.Equal(t, "2026-01-02", c.DueDate.Format("2006-01-02"))
+	require.NotNil(t, c.EstimatedHours)
+	assert.Equal(t, 1.5, *c.EstimatedHours)
+	assert.Equal(t, "user-1", c.CreatedBy)
+
+	// The cards without a priority get the default of the board, and keep
+	// the order of the file
+	assert.Equal(t, models.CardPriorityMedium, deps.repo.created[1].Priority)
+	assert.Less(t, c.Position, deps.repo.created[1].Position)
+}
@@ -0,0 +1,60 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// cardKeyPattern matches the keys given to the cards on insert, the prefix of
+// the board and the number of the card
+var cardKeyPattern = regexp.MustCompile(`^[A-Z0-9]+-[1-9][0-9]*$`)
+
+func (uc implUsecase) DetailByKey(ctx context.Context, sc models.Scope, ip cards.DetailByKeyInput) (cards.DetailOutput, error) {
+	key := strings.ToUpper(strings.TrimSpace(ip.Key))
+	if !cardKeyPattern.MatchString(key) {
+		uc.l.Warnf(ctx, "internal.cards.usecase.DetailByKey.InvalidKey: %s", ip.Key)
+		return cards.DetailOutput{}, cards.ErrInvalidKey
+	}
+
+	readableBy, err := uc.memberUC.MemberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.DetailByKey.memberUC.MemberFilter: %v", err)
+		return cards.DetailOutput{}, err
+	}
+
+	// The cards keyed before the prefixes were made unique can share a key,
+	// only the cards of the boards the user can read are kept
+	found, err := uc.repo.List(ctx, sc, repository.ListOptions{
+		Filter: cards.Filter{
+			Key:        key,
+			BoardID:    ip.BoardID,
+			ReadableBy: readableBy,
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.cards.usecase.DetailByKey.repo.List: %v", err)
+		return cards.DetailOutput{}, err
+	}
+
+	if len(found) == 0 {
+		uc.l.Warnf(ctx, "internal.cards.usecase.DetailByKey.NotFound: %s", key)
+		return cards.DetailOutput{}, cards.ErrCardNotFound
+	}
+	if len(found) > 1 {
+		uc.l.Warnf(ctx, "internal.cards.usecase.DetailByKey.Ambiguous: %s matches %d cards", key, len(found))
+		return cards.DetailOutput{}, cards.ErrAmbiguousKey
+	}
+
+	o, err := uc.Detail(ctx, sc, found[0].ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.DetailByKey.Detail: %v", err)
+		return cards.DetailOutput{}, err
+	}
+
+	return o, nil
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/cards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetailByKey(t *testing.T) {
+	tcs := map[string]struct {
+		key      string
+		boardID  string
+		readable []string
+		admin    bool
+		wantID   string
+		wantErr  error
+		// wantReadableBy is the user the cards are filtered for
+		wantReadableBy string
+	}{
+		"key on a readable board": {
+			key:            "ROAD-2",
+			readable:       []string{"board-2"},
+			wantID:         "card-3",
+			wantReadableBy: "user-1",
+		},
+		"key in lower case with spaces": {
+			key:            " road-2 ",
+			readable:       []string{"board-2"},
+			wantID:         "card-3",
+			wantReadableBy: "user-1",
+		},
+		"key shared with a board the user cannot read": {
+			key:            "ROAD-1",
+			readable:       []string{"board-1"},
+			wantID:         "card-1",
+			wantReadableBy: "user-1",
+		},
+		"key on a board the user cannot read": {
+			key:      "ROAD-2",
+			readable: []string{"board-1"},
+			wantErr:  cards.ErrCardNotFound,
+		},
+		"key shared by readable boards": {
+			key:      "ROAD-1",
+			readable: []string{"board-1", "board-2"},
+			wantErr:  cards.ErrAmbiguousKey,
+		},
+		"key shared by readable boards with the board given": {
+			key:            "ROAD-1",
+			boardID:        "board-2",
+			readable:       []string{"board-1", "board-2"},
+			wantID:         "card-2",
+			wantReadableBy: "user-1",
+		},
+		"admin reads every board": {
+			key:    "ROAD-2",
+			admin:  true,
+			wantID: "card-3",
+		},
+		"unknown key": {
+			key:      "ROAD-9",
+			readable: []string{"board-1", "board-2"},
+			wantErr:  cards.ErrCardNotFound,
+		},
+		"key without a number": {
+			key:     "ROAD",
+			wantErr: cards.ErrInvalidKey,
+		},
+		"key with number zero": {
+			key:     "ROAD-0",
+			wantErr: cards.ErrInvalidKey,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			uc.boardUC = fakeBoardUC{boards: map[string]models.Board{
+				"board-1": {ID: "board-1"},
+				"board-2": {ID: "board-2"},
+			}}
+			uc.listUC = fakeListUC{lists: []models.List{
+				{ID: "list-1", BoardID: "board-1", Name: "Todo"},
+				{ID: "list-3", BoardID: "board-2", Name: "Backlog"},
+			}}
+			// The cards of board-2 were keyed before the prefixes were made
+			// unique, its first card has the key of the first card of board-1
+			deps.repo.cards = []models.Card{
+				{ID: "card-1", BoardID: "board-1", ListID: "list-1", Alias: "ROAD-1"},
+				{ID: "card-2", BoardID: "board-2", ListID: "list-3", Alias: "ROAD-1"},
+				{ID: "card-3", BoardID: "board-2", ListID: "list-3", Alias: "ROAD-2"},
+			}
+			deps.repo.readable = make(map[string]bool)
+			for _, id := range tc.readable {
+				deps.repo.readable[id] = true
+				deps.memberUC.roles[id] = models.BoardRoleObserver
+			}
+			deps.memberUC.admin = tc.admin
+
+			o, err := uc.DetailByKey(context.Background(), models.Scope{UserID: "user-1"}, cards.DetailByKeyInput{
+				Key:     tc.key,
+				BoardID: tc.boardID,
+			})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantID, o.Card.ID)
+			assert.Equal(t, o.Card.BoardID, o.Board.ID)
+
+			// The boards are filtered by the query, one call reads the key
+			require.Len(t, deps.repo.lists, 1)
+			assert.Equal(t, tc.wantReadableBy, deps.repo.lists[0].Filter.ReadableBy)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
 )
@@ -21,6 +22,11 @@ func (uc implUsecase) GetActivities(ctx context.Context, sc models.Scope, ip car
 		return cards.GetActivitiesOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: c.BoardID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.GetActivities.memberUC.Authorize: %v", err)
+		return cards.GetActivitiesOutput{}, err
+	}
+
 	atvs, pag, err := uc.repo.GetActivities(ctx, sc, repository.GetActivitiesOptions{
 		Filter: repository.ActivityFilter{
 			CardID: ip.CardID,
@@ -50,6 +56,11 @@ func (uc implUsecase) Assign(ctx context.Context, sc models.Scope, ip cards.Assi
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Assign.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	usr, err := uc.userUC.Detail(ctx, sc, ip.AssignedTo)
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.cards.usecase.Assign.userUC.List: %v", err)
@@ -72,9 +83,9 @@ func (uc implUsecase) Assign(ctx context.Context, sc models.Scope, ip cards.Assi
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, crd, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, crd, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Assign.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.Assign.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -91,6 +102,11 @@ func (uc implUsecase) Unassign(ctx context.Context, sc models.Scope, ip cards.Un
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.Unassign.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	// Perform unassign operation
 	card, err := uc.repo.Unassign(ctx, sc, repository.UnassignOptions{
 		CardID:   ip.CardID,
@@ -101,9 +117,9 @@ func (uc implUsecase) Unassign(ctx context.Context, sc models.Scope, ip cards.Un
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.Unassign.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.Unassign.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -120,6 +136,11 @@ func (uc implUsecase) AddAttachment(ctx context.Context, sc models.Scope, ip car
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.AddAttachment.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.AddAttachment(ctx, sc, repository.AddAttachmentOptions{
 		CardID:       ip.CardID,
 		AttachmentID: ip.AttachmentID,
@@ -130,9 +151,9 @@ func (uc implUsecase) AddAttachment(ctx context.Context, sc models.Scope, ip car
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.AddAttachment.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.AddAttachment.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -149,6 +170,11 @@ func (uc implUsecase) RemoveAttachment(ctx context.Context, sc models.Scope, ip
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.RemoveAttachment.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.RemoveAttachment(ctx, sc, repository.RemoveAttachmentOptions{
 		CardID:       ip.CardID,
 		AttachmentID: ip.AttachmentID,
@@ -159,9 +185,9 @@ func (uc implUsecase) RemoveAttachment(ctx context.Context, sc models.Scope, ip
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.RemoveAttachment.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.RemoveAttachment.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -178,6 +204,11 @@ func (uc implUsecase) UpdateTimeTracking(ctx context.Context, sc models.Scope, i
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.UpdateTimeTracking.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.UpdateTimeTracking(ctx, sc, repository.UpdateTimeTrackingOptions{
 		CardID:         ip.CardID,
 		EstimatedHours: ip.EstimatedHours,
@@ -190,9 +221,9 @@ func (uc implUsecase) UpdateTimeTracking(ctx context.Context, sc models.Scope, i
 	}
 
 	// Broadcast card time tracking updated event
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.UpdateTimeTracking.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.UpdateTimeTracking.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -209,6 +240,11 @@ func (uc implUsecase) UpdateChecklist(ctx context.Context, sc models.Scope, ip c
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.UpdateChecklist.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.UpdateChecklist(ctx, sc, repository.UpdateChecklistOptions{
 		CardID:    ip.CardID,
 		Checklist: ip.Checklist,
@@ -220,9 +256,9 @@ func (uc implUsecase) UpdateChecklist(ctx context.Context, sc models.Scope, ip c
 	}
 
 	// Broadcast card checklist updated event
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.UpdateChecklist.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.UpdateChecklist.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -239,6 +275,11 @@ func (uc implUsecase) AddTag(ctx context.Context, sc models.Scope, ip cards.AddT
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.AddTag.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.AddTag(ctx, sc, repository.AddTagOptions{
 		CardID:   ip.CardID,
 		Tag:      ip.Tag,
@@ -249,9 +290,9 @@ func (uc implUsecase) AddTag(ctx context.Context, sc models.Scope, ip cards.AddT
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.AddTag.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.AddTag.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -268,6 +309,11 @@ func (uc implUsecase) RemoveTag(ctx context.Context, sc models.Scope, ip cards.R
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.RemoveTag.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.RemoveTag(ctx, sc, repository.RemoveTagOptions{
 		CardID:   ip.CardID,
 		Tag:      ip.Tag,
@@ -278,9 +324,9 @@ func (uc implUsecase) RemoveTag(ctx context.Context, sc models.Scope, ip cards.R
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.RemoveTag.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.RemoveTag.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -297,6 +343,11 @@ func (uc implUsecase) SetStartDate(ctx context.Context, sc models.Scope, ip card
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.SetStartDate.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.SetStartDate(ctx, sc, repository.SetStartDateOptions{
 		CardID:    ip.CardID,
 		StartDate: ip.StartDate,
@@ -307,9 +358,9 @@ func (uc implUsecase) SetStartDate(ctx context.Context, sc models.Scope, ip card
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.SetStartDate.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.SetStartDate.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -326,6 +377,11 @@ func (uc implUsecase) SetCompletionDate(ctx context.Context, sc models.Scope, ip
 		return err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.SetCompletionDate.memberUC.Authorize: %v", err)
+		return err
+	}
+
 	card, err := uc.repo.SetCompletionDate(ctx, sc, repository.SetCompletionDateOptions{
 		CardID:         ip.CardID,
 		CompletionDate: ip.CompletionDate,
@@ -336,9 +392,9 @@ func (uc implUsecase) SetCompletionDate(ctx context.Context, sc models.Scope, ip
 		return err
 	}
 
-	err = uc.wsHub.BroadcastToBoard(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
+	err = uc.broadcastCardEvent(ctx, om.BoardID, websocket.MSG_CARD_UPDATED, card, sc.UserID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.cards.usecase.SetCompletionDate.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Errorf(ctx, "internal.cards.usecase.SetCompletionDate.broadcastCardEvent: %v", err)
 	}
 
 	return nil
@@ -347,7 +403,7 @@ func (uc implUsecase) SetCompletionDate(ctx context.Context, sc models.Scope, ip
 // Dashboard aggregates for cards module
 func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip cards.DashboardInput) (cards.CardsDashboardOutput, error) {
 	// total
-	totalList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{}})
+	totalList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{WorkspaceID: ip.WorkspaceID}})
 	if err != nil {
 		return cards.CardsDashboardOutput{}, err
 	}
@@ -358,6 +414,7 @@ func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip cards.D
 	completedList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{
 		CompletionDateFrom: &completedFrom,
 		CompletionDateTo:   &completedTo,
+		WorkspaceID:        ip.WorkspaceID,
 	}})
 	if err != nil {
 		return cards.CardsDashboardOutput{}, err
@@ -368,6 +425,7 @@ func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip cards.D
 	overdueList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{
 		UncompletedOnly: true,
 		DueDateTo:       &toRef,
+		WorkspaceID:     ip.WorkspaceID,
 	}})
 	if err != nil {
 		return cards.CardsDashboardOutput{}, err
@@ -376,7 +434,7 @@ func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip cards.D
 	// fetch cards updated within period for active users/boards
 	updatedFrom := ip.From
 	updatedTo := ip.To
-	updatedList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{UpdatedFrom: &updatedFrom, UpdatedTo: &updatedTo}})
+	updatedList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{UpdatedFrom: &updatedFrom, UpdatedTo: &updatedTo, WorkspaceID: ip.WorkspaceID}})
 	if err != nil {
 		return cards.CardsDashboardOutput{}, err
 	}
@@ -410,12 +468,12 @@ func (uc implUsecase) Dashboard(ctx context.Context, sc models.Scope, ip cards.D
 		// created
 		from := day
 		to := next.Add(-time.Nanosecond)
-		createdList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{CreatedFrom: &from, CreatedTo: &to}})
+		createdList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{CreatedFrom: &from, CreatedTo: &to, WorkspaceID: ip.WorkspaceID}})
 		if err != nil {
 			return cards.CardsDashboardOutput{}, err
 		}
 		// completed
-		compList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{CompletionDateFrom: &from, CompletionDateTo: &to}})
+		compList, err := uc.repo.List(ctx, sc, repository.ListOptions{Filter: cards.Filter{CompletionDateFrom: &from, CompletionDateTo: &to, WorkspaceID: ip.WorkspaceID}})
 		if err != nil {
 			return cards.CardsDashboardOutput{}, err
 		}
@@ -6,8 +6,10 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
 	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/labels"
 	"github.com/nguyentantai21042004/kanban-api/internal/lists"
-	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
 	"github.com/nguyentantai21042004/kanban-api/internal/websocket/service"
 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
@@ -22,14 +24,16 @@ type implUsecase struct {
 	positionUC position.Usecase
 	boardUC    boards.UseCase
 	listUC     lists.UseCase
+	labelUC    labels.UseCase
 	userUC     user.UseCase
-	roleUC     role.UseCase
+	memberUC   members.UseCase
+	teamUC     teams.UseCase
 	clock      func() time.Time
 }
 
 var _ cards.UseCase = &implUsecase{}
 
-func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, positionUC position.Usecase, boardUC boards.UseCase, listUC lists.UseCase, userUC user.UseCase, roleUC role.UseCase) cards.UseCase {
+func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, positionUC position.Usecase, boardUC boards.UseCase, listUC lists.UseCase, labelUC labels.UseCase, userUC user.UseCase, memberUC members.UseCase, teamUC teams.UseCase) cards.UseCase {
 	return &implUsecase{
 		l:          l,
 		repo:       repo,
@@ -38,7 +42,9 @@ func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, positionU
 		clock:      util.Now,
 		boardUC:    boardUC,
 		listUC:     listUC,
+		labelUC:    labelUC,
 		userUC:     userUC,
-		roleUC:     roleUC,
+		memberUC:   memberUC,
+		teamUC:     teamUC,
 	}
 }
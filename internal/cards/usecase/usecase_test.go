@@ -1,31 +1,259 @@
 package usecase
 
-// import (
-// 	"testing"
-// 	"time"
-
-// 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
-// 	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
-// 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
-// )
-
-// type mockDeps struct {
-// 	mockRepo *repository.MockRepository
-// }
-
-// func initUseCase(t *testing.T, mockTime time.Time) (cards.UseCase, mockDeps) {
-// 	t.Helper()
-// 	l := log.InitializeTestZapLogger()
-
-// 	mockRepo := repository.NewMockRepository(t)
-
-// 	uc := implUsecase{
-// 		l:     l,
-// 		repo:  mockRepo,
-// 		clock: func() time.Time { return mockTime },
-// 	}
-
-// 	return &uc, mockDeps{
-// 		mockRepo: mockRepo,
-// 	}
-// }
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/cards/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/labels"
+	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket/service"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/position"
+)
+
+// fakeRepo keeps the cards in memory. Calling a method it does not implement
+// panics through the nil embedded interface.
+type fakeRepo struct {
+	repository.Repository
+
+	cards []models.Card
+	// readable are the boards the user can read, the cards of the others are
+	// left out by the ReadableBy filter
+	readable map[string]bool
+	// gets are the options of every Get call
+	gets []repository.GetOptions
+	// lists are the options of every List call
+	lists []repository.ListOptions
+	// created are the options of every card created
+	created []repository.CreateOptions
+}
+
+func (r *fakeRepo) Get(ctx context.Context, sc models.Scope, opts repository.GetOptions) ([]models.Card, paginator.Paginator, error) {
+	r.gets = append(r.gets, opts)
+	return r.cards, paginator.Paginator{Total: int64(len(r.cards))}, nil
+}
+
+func (r *fakeRepo) List(ctx context.Context, sc models.Scope, opts repository.ListOptions) ([]models.Card, error) {
+	r.lists = append(r.lists, opts)
+	var cs []models.Card
+	for _, c := range r.cards {
+		if opts.Filter.Key != "" && c.Alias != opts.Filter.Key {
+			continue
+		}
+		if opts.Filter.BoardID != "" && c.BoardID != opts.Filter.BoardID {
+			continue
+		}
+		if opts.Filter.ReadableBy != "" && !r.readable[c.BoardID] {
+			continue
+		}
+		cs = append(cs, c)
+	}
+	return cs, nil
+}
+
+func (r *fakeRepo) Detail(ctx context.Context, sc models.Scope, id string) (models.Card, error) {
+	for _, c := range r.cards {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return models.Card{}, repository.ErrNotFound
+}
+
+func (r *fakeRepo) GetPosition(ctx context.Context, sc models.Scope, opts repository.GetPositionOptions) (string, error) {
+	return "", repository.ErrNotFound
+}
+
+func (r *fakeRepo) CreateMany(ctx context.Context, sc models.Scope, opts []repository.CreateOptions) ([]models.Card, error) {
+	r.created = append(r.created, opts...)
+	cs := make([]models.Card, len(opts))
+	for i, o := range opts {
+		cs[i] = models.Card{BoardID: o.BoardID, ListID: o.ListID, Name: o.Name, Position: o.Position}
+	}
+	return cs, nil
+}
+
+// fakeMemberUC gives the user the roles it holds by board.
+type fakeMemberUC struct {
+	members.UseCase
+
+	roles map[string]models.BoardRole
+	// members are the users who are members of the boards, by board
+	members map[string][]models.User
+	// admin can see every board
+	admin bool
+}
+
+func (u *fakeMemberUC) Authorize(ctx context.Context, sc models.Scope, ip members.AuthorizeInput) error {
+	if u.admin {
+		return nil
+	}
+	role, ok := u.roles[ip.BoardID]
+	if !ok || !role.AtLeast(ip.Role) {
+		return members.ErrForbidden
+	}
+	return nil
+}
+
+func (u *fakeMemberUC) List(ctx context.Context, sc models.Scope, boardID string) (members.ListOutput, error) {
+	return members.ListOutput{Users: u.members[boardID]}, nil
+}
+
+func (u *fakeMemberUC) MemberFilter(ctx context.Context, sc models.Scope) (string, error) {
+	if u.admin {
+		return "", nil
+	}
+	return sc.UserID, nil
+}
+
+// fakeTeamUC gives the user the roles it holds by team.
+type fakeTeamUC struct {
+	teams.UseCase
+
+	roles map[string]models.TeamRole
+}
+
+func (u *fakeTeamUC) Authorize(ctx context.Context, sc models.Scope, ip teams.AuthorizeInput) error {
+	role, ok := u.roles[ip.TeamID]
+	if !ok || !role.AtLeast(ip.Role) {
+		return teams.ErrForbidden
+	}
+	return nil
+}
+
+// fakeBoardUC returns the boards it holds by ID.
+type fakeBoardUC struct {
+	boards.UseCase
+
+	boards map[string]models.Board
+}
+
+func (u fakeBoardUC) Detail(ctx context.Context, sc models.Scope, ID string) (boards.DetailOutput, error) {
+	b, ok := u.boards[ID]
+	if !ok {
+		return boards.DetailOutput{}, boards.ErrNotFound
+	}
+	return boards.DetailOutput{Board: b}, nil
+}
+
+func (u fakeBoardUC) Version(ctx context.Context, boardID string) (int64, error) {
+	return 1, nil
+}
+
+// fakeListUC returns the lists it holds, by board.
+type fakeListUC struct {
+	lists.UseCase
+
+	lists []models.List
+}
+
+func (u fakeListUC) Get(ctx context.Context, sc models.Scope, ip lists.GetInput) (lists.GetOutput, error) {
+	var ls []models.List
+	for _, l := range u.lists {
+		if l.BoardID == ip.Filter.BoardID {
+			ls = append(ls, l)
+		}
+	}
+	return lists.GetOutput{Lists: ls}, nil
+}
+
+func (u fakeListUC) Detail(ctx context.Context, sc models.Scope, ID string) (lists.DetailOutput, error) {
+	for _, l := range u.lists {
+		if l.ID == ID {
+			return lists.DetailOutput{List: l}, nil
+		}
+	}
+	return lists.DetailOutput{}, lists.ErrNotFound
+}
+
+// fakeLabelUC returns the labels it holds, by board.
+type fakeLabelUC struct {
+	labels.UseCase
+
+	labels []models.Label
+}
+
+func (u fakeLabelUC) Get(ctx context.Context, sc models.Scope, ip labels.GetInput) (labels.GetOutput, error) {
+	var lbs []models.Label
+	for _, lb := range u.labels {
+		if lb.BoardID == ip.Filter.BoardID {
+			lbs = append(lbs, lb)
+		}
+	}
+	return labels.GetOutput{Labels: lbs}, nil
+}
+
+// fakeUserUC returns the users it holds.
+type fakeUserUC struct {
+	user.UseCase
+
+	users []models.User
+}
+
+func (u fakeUserUC) List(ctx context.Context, sc models.Scope, ip user.ListInput) ([]models.User, error) {
+	var us []models.User
+	for _, usr := range u.users {
+		for _, name := range ip.Filter.Usernames {
+			if usr.Username == name {
+				us = append(us, usr)
+			}
+		}
+	}
+	return us, nil
+}
+
+type mockDeps struct {
+	repo     *fakeRepo
+	memberUC *fakeMemberUC
+	teamUC   *fakeTeamUC
+}
+
+func initUseCase(t *testing.T, mockTime time.Time) (*implUsecase, mockDeps) {
+	t.Helper()
+
+	repo := &fakeRepo{}
+	memberUC := &fakeMemberUC{
+		roles: make(map[string]models.BoardRole),
+		members: map[string][]models.User{
+			"board-1": {{ID: "user-1", Username: "john"}},
+		},
+	}
+	teamUC := &fakeTeamUC{roles: make(map[string]models.TeamRole)}
+
+	l := log.InitializeTestZapLogger()
+	board := models.Board{ID: "board-1", Settings: models.BoardSettings{DefaultPriority: models.CardPriorityMedium}}
+
+	uc := &implUsecase{
+		l:          l,
+		repo:       repo,
+		wsHub:      service.NewHub(l),
+		positionUC: position.NewPositionManager(),
+		boardUC:    fakeBoardUC{boards: map[string]models.Board{board.ID: board}},
+		listUC: fakeListUC{lists: []models.List{
+			{ID: "list-1", BoardID: "board-1", Name: "Todo"},
+			{ID: "list-2", BoardID: "board-1", Name: "Done"},
+		}},
+		labelUC: fakeLabelUC{labels: []models.Label{{ID: "label-1", BoardID: "board-1", Name: "Bug"}}},
+		userUC: fakeUserUC{users: []models.User{
+			{ID: "user-1", Username: "john"},
+			{ID: "user-2", Username: "jane"},
+		}},
+		memberUC: memberUC,
+		teamUC:   teamUC,
+		clock:    func() time.Time { return mockTime },
+	}
+
+	return uc, mockDeps{
+		repo:     repo,
+		memberUC: memberUC,
+		teamUC:   teamUC,
+	}
+}
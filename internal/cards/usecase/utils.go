@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+)
+
+// broadcastCardEvent sends the event with the version of the board. The
+// version is read once the change is saved, so it is never lower than the
+// version the change produced. The event is still sent without it.
+func (uc implUsecase) broadcastCardEvent(ctx context.Context, boardID, eventType string, data interface{}, userID string) error {
+	v, err := uc.boardUC.Version(ctx, boardID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.broadcastCardEvent.boardUC.Version: %v", err)
+	}
+
+	return uc.wsHub.BroadcastToBoardWithVersion(ctx, boardID, eventType, data, userID, v)
+}
+
+// broadcastCardEvents sends an event for each of the cards of a change. The
+// version of each board is read once, after the whole change.
+func (uc implUsecase) broadcastCardEvents(ctx context.Context, eventType string, cs []models.Card, userID string) {
+	versions := make(map[string]int64)
+	for _, c := range cs {
+		v, ok := versions[c.BoardID]
+		if !ok {
+			var err error
+			v, err = uc.boardUC.Version(ctx, c.BoardID)
+			if err != nil {
+				uc.l.Warnf(ctx, "internal.cards.usecase.broadcastCardEvents.boardUC.Version: %v", err)
+			}
+			versions[c.BoardID] = v
+		}
+
+		if err := uc.wsHub.BroadcastToBoardWithVersion(ctx, c.BoardID, eventType, c, userID, v); err != nil {
+			uc.l.Errorf(ctx, "internal.cards.usecase.broadcastCardEvents.wsHub.BroadcastToBoardWithVersion: %v", err)
+		}
+	}
+}
+
+// authorizeTeamFilter checks the user can see the team the cards are filtered
+// by, otherwise the filter would tell who the members of any team are.
+func (uc implUsecase) authorizeTeamFilter(ctx context.Context, sc models.Scope, teamID string) error {
+	if teamID == "" {
+		return nil
+	}
+
+	if err := uc.teamUC.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: teamID, Role: models.TeamRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.cards.usecase.authorizeTeamFilter.teamUC.Authorize: %v", err)
+		return err
+	}
+
+	return nil
+}
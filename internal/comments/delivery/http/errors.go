@@ -1,7 +1,10 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/comments"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 )
 
@@ -12,6 +15,9 @@ var (
 	errFieldRequired         = pkgErrors.NewHTTPError(10404, "Field required")
 	errCardNotFound          = pkgErrors.NewHTTPError(10405, "Card not found")
 	errParentCommentNotFound = pkgErrors.NewHTTPError(10406, "Parent comment not found")
+	errForbidden             = &pkgErrors.HTTPError{Code: 10407, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errBoardArchived         = &pkgErrors.HTTPError{Code: 10408, Message: "This board is archived and read-only", StatusCode: http.StatusForbidden}
+	errCommentsDisabled      = &pkgErrors.HTTPError{Code: 10409, Message: "Comments are disabled on this board", StatusCode: http.StatusForbidden}
 )
 
 func (h handler) mapErrorCode(err error) error {
@@ -24,6 +30,12 @@ func (h handler) mapErrorCode(err error) error {
 		return errCardNotFound
 	case comments.ErrParentCommentNotFound:
 		return errParentCommentNotFound
+	case members.ErrForbidden:
+		return errForbidden
+	case members.ErrBoardArchived:
+		return errBoardArchived
+	case comments.ErrCommentsDisabled:
+		return errCommentsDisabled
 	default:
 		return err
 	}
@@ -33,4 +45,7 @@ var NotFound = []error{
 	errNotFound,
 	errCardNotFound,
 	errParentCommentNotFound,
+	errForbidden,
+	errBoardArchived,
+	errCommentsDisabled,
 }
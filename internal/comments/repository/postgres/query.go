@@ -50,6 +50,15 @@ func (r implRepository) buildGetQuery(ctx context.Context, fils comments.Filter)
 		qr = append(qr, qm.Where("parent_id = ?", fils.ParentID))
 	}
 
+	if fils.MemberID != "" {
+		if err := postgres.IsUUID(fils.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.comments.repository.postgres.buildGetQuery.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		// A board in the trash hides its comments until it is restored
+		qr = append(qr, qm.Where("card_id IN (SELECT c.id FROM cards c JOIN board_access bm ON bm.board_id = c.board_id JOIN boards b ON b.id = c.board_id WHERE bm.user_id = ? AND b.deleted_at IS NULL)", fils.MemberID))
+	}
+
 	return qr, nil
 }
 
@@ -7,4 +7,5 @@ var (
 	ErrCommentNotFound       = errors.New("comment not found")
 	ErrCardNotFound          = errors.New("card not found")
 	ErrParentCommentNotFound = errors.New("parent comment not found")
+	ErrCommentsDisabled      = errors.New("comments are disabled on this board")
 )
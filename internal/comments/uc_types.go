@@ -11,6 +11,9 @@ type Filter struct {
 	CardID   string
 	UserID   string
 	ParentID string
+	// MemberID keeps the rows of the boards the user is a member of that are
+	// not in the trash
+	MemberID string
 }
 
 type GetInput struct {
@@ -6,11 +6,32 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/cards"
 	"github.com/nguyentantai21042004/kanban-api/internal/comments"
 	"github.com/nguyentantai21042004/kanban-api/internal/comments/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/user"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
+// authorize checks the role of the user on the board of the card.
+// authorize returns the board of the card when the user holds the role on it.
+func (uc implUsecase) authorize(ctx context.Context, sc models.Scope, cardID string, role models.BoardRole) (models.Board, error) {
+	o, err := uc.cardsUC.Detail(ctx, sc, cardID)
+	if err != nil {
+		if err == cards.ErrCardNotFound {
+			uc.l.Warnf(ctx, "internal.comments.usecase.authorize.cardsUC.Detail.CardNotFound: %v", err)
+			return models.Board{}, comments.ErrCardNotFound
+		}
+		uc.l.Warnf(ctx, "internal.comments.usecase.authorize.cardsUC.Detail: %v", err)
+		return models.Board{}, err
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: o.Card.BoardID, Role: role}); err != nil {
+		return models.Board{}, err
+	}
+
+	return o.Board, nil
+}
+
 // broadcastCommentEvent broadcasts comment events to WebSocket clients
 func (uc implUsecase) broadcastCommentEvent(ctx context.Context, cardID, eventType string, data interface{}, userID string) {
 	if uc.wsHub == nil {
@@ -27,6 +48,12 @@ func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip comments.GetI
 		return comments.GetOutput{}, err
 	}
 
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.comments.usecase.Get.memberUC.MemberFilter: %v", err)
+		return comments.GetOutput{}, err
+	}
+
 	c, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
 		Filter: comments.Filter{
 			IDs:      ip.Filter.IDs,
@@ -34,6 +61,7 @@ func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip comments.GetI
 			CardID:   ip.Filter.CardID,
 			UserID:   ip.Filter.UserID,
 			ParentID: ip.Filter.ParentID,
+			MemberID: memberID,
 		},
 		PagQuery: ip.PagQuery,
 	})
@@ -65,16 +93,16 @@ func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip comments.GetI
 }
 
 func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip comments.CreateInput) (comments.DetailOutput, error) {
-	// Verify card exists
-	_, err := uc.cardsUC.Detail(ctx, sc, ip.CardID)
+	// Verify card exists and the user can comment on its board
+	b, err := uc.authorize(ctx, sc, ip.CardID, models.BoardRoleMember)
 	if err != nil {
-		if err == cards.ErrCardNotFound {
-			uc.l.Warnf(ctx, "internal.comments.usecase.Create.cardsUC.Detail.CardNotFound: %v", err)
-			return comments.DetailOutput{}, comments.ErrCardNotFound
-		}
-		uc.l.Errorf(ctx, "internal.comments.usecase.Create.cardsUC.Detail: %v", err)
+		uc.l.Warnf(ctx, "internal.comments.usecase.Create.authorize: %v", err)
 		return comments.DetailOutput{}, err
 	}
+	if !b.Settings.CommentsEnabled {
+		uc.l.Warnf(ctx, "internal.comments.usecase.Create.CommentsDisabled: board %s", b.ID)
+		return comments.DetailOutput{}, comments.ErrCommentsDisabled
+	}
 
 	// Verify parent comment exists if provided
 	if ip.ParentID != nil {
@@ -130,6 +158,21 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip comments.U
 		return comments.DetailOutput{}, err
 	}
 
+	// Only the author can edit a comment
+	if oldModel.UserID != sc.UserID {
+		uc.l.Warnf(ctx, "internal.comments.usecase.Update.NotAuthor: %v", oldModel.ID)
+		return comments.DetailOutput{}, members.ErrForbidden
+	}
+	b, err := uc.authorize(ctx, sc, oldModel.CardID, models.BoardRoleMember)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.comments.usecase.Update.authorize: %v", err)
+		return comments.DetailOutput{}, err
+	}
+	if !b.Settings.CommentsEnabled {
+		uc.l.Warnf(ctx, "internal.comments.usecase.Update.CommentsDisabled: board %s", b.ID)
+		return comments.DetailOutput{}, comments.ErrCommentsDisabled
+	}
+
 	c, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
 		ID:       ip.ID,
 		Content:  ip.Content,
@@ -158,6 +201,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (c
 		uc.l.Errorf(ctx, "internal.comments.usecase.Detail.repo.Detail: %v", err)
 		return comments.DetailOutput{}, err
 	}
+
+	if _, err := uc.authorize(ctx, sc, c.CardID, models.BoardRoleObserver); err != nil {
+		uc.l.Warnf(ctx, "internal.comments.usecase.Detail.authorize: %v", err)
+		return comments.DetailOutput{}, err
+	}
 	return comments.DetailOutput{
 		Comment: c,
 	}, nil
@@ -169,6 +217,28 @@ func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ids []string)
 		return comments.ErrFieldRequired
 	}
 
+	// Authors can delete their comments, board admins any comment
+	for _, id := range ids {
+		c, err := uc.repo.Detail(ctx, sc, id)
+		if err != nil {
+			if err == repository.ErrNotFound {
+				uc.l.Warnf(ctx, "internal.comments.usecase.Delete.repo.Detail.NotFound: %v", err)
+				return comments.ErrCommentNotFound
+			}
+			uc.l.Errorf(ctx, "internal.comments.usecase.Delete.repo.Detail: %v", err)
+			return err
+		}
+
+		role := models.BoardRoleAdmin
+		if c.UserID == sc.UserID {
+			role = models.BoardRoleMember
+		}
+		if _, err := uc.authorize(ctx, sc, c.CardID, role); err != nil {
+			uc.l.Warnf(ctx, "internal.comments.usecase.Delete.authorize: %v", err)
+			return err
+		}
+	}
+
 	err := uc.repo.Delete(ctx, sc, ids)
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.comments.usecase.Delete.repo.Delete: %v", err)
@@ -21,5 +21,10 @@ func (srv Consumer) Run() error {
 		return err
 	}
 
+	srv.l.Infof(context.Background(), "Consumer is running")
+
+	forever := make(chan bool)
+	<-forever
+
 	return nil
 }
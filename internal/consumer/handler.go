@@ -1,5 +1,15 @@
 package consumer
 
+import (
+	authConsumer "github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq/consumer"
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
+)
+
 func (srv Consumer) mapHandlers() error {
+	sender := email.NewSMTPSender(srv.smtp)
+
+	authC := authConsumer.NewConsumer(srv.l, srv.amqpConn, sender)
+	authC.Consume()
+
 	return nil
 }
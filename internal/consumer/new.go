@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/nguyentantai21042004/kanban-api/pkg/email"
 	pkgCrt "github.com/nguyentantai21042004/kanban-api/pkg/encrypter"
 	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
 	"github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
@@ -19,6 +20,7 @@ type Consumer struct {
 	internalKey  string
 	postgresDB   *sql.DB
 	redisClient  *redis.Client
+	smtp         email.SMTPConfig
 }
 
 type ConsumerConfig struct {
@@ -29,6 +31,7 @@ type ConsumerConfig struct {
 	InternalKey  string
 	PostgresDB   *sql.DB
 	RedisClient  *redis.Client
+	SMTP         email.SMTPConfig
 }
 
 type TeleCredentials struct {
@@ -51,6 +54,7 @@ func New(l pkgLog.Logger, cfg ConsumerConfig) (*Consumer, error) {
 		internalKey:  cfg.InternalKey,
 		postgresDB:   cfg.PostgresDB,
 		redisClient:  cfg.RedisClient,
+		smtp:         cfg.SMTP,
 	}
 
 	if err := h.validate(); err != nil {
@@ -73,6 +77,7 @@ func (s Consumer) validate() error {
 		{s.internalKey, "internalKey is required"},
 		{s.postgresDB, "postgresDB is required"},
 		{s.redisClient, "redisClient is required"},
+		{s.smtp.Host, "smtp host is required"},
 	}
 
 	for _, dep := range requiredDeps {
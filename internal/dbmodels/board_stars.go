@@ -0,0 +1,1347 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// BoardStar is an object representing the database table.
+type BoardStar struct {
+	UserID  string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	BoardID string `boil:"board_id" json:"board_id" toml:"board_id" yaml:"board_id"`
+	// When the user starred the board
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *boardStarR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L boardStarL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BoardStarColumns = struct {
+	UserID    string
+	BoardID   string
+	CreatedAt string
+}{
+	UserID:    "user_id",
+	BoardID:   "board_id",
+	CreatedAt: "created_at",
+}
+
+var BoardStarTableColumns = struct {
+	UserID    string
+	BoardID   string
+	CreatedAt string
+}{
+	UserID:    "board_stars.user_id",
+	BoardID:   "board_stars.board_id",
+	CreatedAt: "board_stars.created_at",
+}
+
+// Generated where
+
+var BoardStarWhere = struct {
+	UserID    whereHelperstring
+	BoardID   whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	UserID:    whereHelperstring{field: "\"board_stars\".\"user_id\""},
+	BoardID:   whereHelperstring{field: "\"board_stars\".\"board_id\""},
+	CreatedAt: whereHelpertime_Time{field: "\"board_stars\".\"created_at\""},
+}
+
+// BoardStarRels is where relationship names are stored.
+var BoardStarRels = struct {
+	Board string
+	User  string
+}{
+	Board: "Board",
+	User:  "User",
+}
+
+// boardStarR is where relationships are stored.
+type boardStarR struct {
+	Board *Board `boil:"Board" json:"Board" toml:"Board" yaml:"Board"`
+	User  *User  `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*boardStarR) NewStruct() *boardStarR {
+	return &boardStarR{}
+}
+
+func (o *BoardStar) GetBoard() *Board {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoard()
+}
+
+func (r *boardStarR) GetBoard() *Board {
+	if r == nil {
+		return nil
+	}
+
+	return r.Board
+}
+
+func (o *BoardStar) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *boardStarR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// boardStarL is where Load methods for each relationship are stored.
+type boardStarL struct{}
+
+var (
+	boardStarAllColumns            = []string{"user_id", "board_id", "created_at"}
+	boardStarColumnsWithoutDefault = []string{"user_id", "board_id"}
+	boardStarColumnsWithDefault    = []string{"created_at"}
+	boardStarPrimaryKeyColumns     = []string{"user_id", "board_id"}
+	boardStarGeneratedColumns      = []string{}
+)
+
+type (
+	// BoardStarSlice is an alias for a slice of pointers to BoardStar.
+	// This should almost always be used instead of []BoardStar.
+	BoardStarSlice []*BoardStar
+	// BoardStarHook is the signature for custom BoardStar hook methods
+	BoardStarHook func(context.Context, boil.ContextExecutor, *BoardStar) error
+
+	boardStarQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	boardStarType                 = reflect.TypeOf(&BoardStar{})
+	boardStarMapping              = queries.MakeStructMapping(boardStarType)
+	boardStarPrimaryKeyMapping, _ = queries.BindMapping(boardStarType, boardStarMapping, boardStarPrimaryKeyColumns)
+	boardStarInsertCacheMut       sync.RWMutex
+	boardStarInsertCache          = make(map[string]insertCache)
+	boardStarUpdateCacheMut       sync.RWMutex
+	boardStarUpdateCache          = make(map[string]updateCache)
+	boardStarUpsertCacheMut       sync.RWMutex
+	boardStarUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var boardStarAfterSelectMu sync.Mutex
+var boardStarAfterSelectHooks []BoardStarHook
+
+var boardStarBeforeInsertMu sync.Mutex
+var boardStarBeforeInsertHooks []BoardStarHook
+var boardStarAfterInsertMu sync.Mutex
+var boardStarAfterInsertHooks []BoardStarHook
+
+var boardStarBeforeUpdateMu sync.Mutex
+var boardStarBeforeUpdateHooks []BoardStarHook
+var boardStarAfterUpdateMu sync.Mutex
+var boardStarAfterUpdateHooks []BoardStarHook
+
+var boardStarBeforeDeleteMu sync.Mutex
+var boardStarBeforeDeleteHooks []BoardStarHook
+var boardStarAfterDeleteMu sync.Mutex
+var boardStarAfterDeleteHooks []BoardStarHook
+
+var boardStarBeforeUpsertMu sync.Mutex
+var boardStarBeforeUpsertHooks []BoardStarHook
+var boardStarAfterUpsertMu sync.Mutex
+var boardStarAfterUpsertHooks []BoardStarHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BoardStar) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BoardStar) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BoardStar) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BoardStar) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BoardStar) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BoardStar) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BoardStar) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BoardStar) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BoardStar) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardStarAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBoardStarHook registers your hook function for all future operations.
+func AddBoardStarHook(hookPoint boil.HookPoint, boardStarHook BoardStarHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		boardStarAfterSelectMu.Lock()
+		boardStarAfterSelectHooks = append(boardStarAfterSelectHooks, boardStarHook)
+		boardStarAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		boardStarBeforeInsertMu.Lock()
+		boardStarBeforeInsertHooks = append(boardStarBeforeInsertHooks, boardStarHook)
+		boardStarBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		boardStarAfterInsertMu.Lock()
+		boardStarAfterInsertHooks = append(boardStarAfterInsertHooks, boardStarHook)
+		boardStarAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		boardStarBeforeUpdateMu.Lock()
+		boardStarBeforeUpdateHooks = append(boardStarBeforeUpdateHooks, boardStarHook)
+		boardStarBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		boardStarAfterUpdateMu.Lock()
+		boardStarAfterUpdateHooks = append(boardStarAfterUpdateHooks, boardStarHook)
+		boardStarAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		boardStarBeforeDeleteMu.Lock()
+		boardStarBeforeDeleteHooks = append(boardStarBeforeDeleteHooks, boardStarHook)
+		boardStarBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		boardStarAfterDeleteMu.Lock()
+		boardStarAfterDeleteHooks = append(boardStarAfterDeleteHooks, boardStarHook)
+		boardStarAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		boardStarBeforeUpsertMu.Lock()
+		boardStarBeforeUpsertHooks = append(boardStarBeforeUpsertHooks, boardStarHook)
+		boardStarBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		boardStarAfterUpsertMu.Lock()
+		boardStarAfterUpsertHooks = append(boardStarAfterUpsertHooks, boardStarHook)
+		boardStarAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single boardStar record from the query.
+func (q boardStarQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BoardStar, error) {
+	o := &BoardStar{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for board_stars")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BoardStar records from the query.
+func (q boardStarQuery) All(ctx context.Context, exec boil.ContextExecutor) (BoardStarSlice, error) {
+	var o []*BoardStar
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to BoardStar slice")
+	}
+
+	if len(boardStarAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BoardStar records in the query.
+func (q boardStarQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count board_stars rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q boardStarQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if board_stars exists")
+	}
+
+	return count > 0, nil
+}
+
+// Board pointed to by the foreign key.
+func (o *BoardStar) Board(mods ...qm.QueryMod) boardQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BoardID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Boards(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *BoardStar) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadBoard allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardStarL) LoadBoard(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardStar interface{}, mods queries.Applicator) error {
+	var slice []*BoardStar
+	var object *BoardStar
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardStar.(*BoardStar)
+		if !ok {
+			object = new(BoardStar)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardStar)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardStar))
+			}
+		}
+	} else {
+		s, ok := maybeBoardStar.(*[]*BoardStar)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardStar)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardStar))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardStarR{}
+		}
+		args[object.BoardID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardStarR{}
+			}
+
+			args[obj.BoardID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`boards`),
+		qm.WhereIn(`boards.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`boards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Board")
+	}
+
+	var resultSlice []*Board
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Board")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+	}
+
+	if len(boardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Board = foreign
+		if foreign.R == nil {
+			foreign.R = &boardR{}
+		}
+		foreign.R.BoardStars = append(foreign.R.BoardStars, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BoardID == foreign.ID {
+				local.R.Board = foreign
+				if foreign.R == nil {
+					foreign.R = &boardR{}
+				}
+				foreign.R.BoardStars = append(foreign.R.BoardStars, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardStarL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardStar interface{}, mods queries.Applicator) error {
+	var slice []*BoardStar
+	var object *BoardStar
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardStar.(*BoardStar)
+		if !ok {
+			object = new(BoardStar)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardStar)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardStar))
+			}
+		}
+	} else {
+		s, ok := maybeBoardStar.(*[]*BoardStar)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardStar)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardStar))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardStarR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardStarR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BoardStars = append(foreign.R.BoardStars, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BoardStars = append(foreign.R.BoardStars, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBoard of the boardStar to the related item.
+// Sets o.R.Board to related.
+// Adds o to related.R.BoardStars.
+func (o *BoardStar) SetBoard(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Board) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_stars\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardStarPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.BoardID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BoardID = related.ID
+	if o.R == nil {
+		o.R = &boardStarR{
+			Board: related,
+		}
+	} else {
+		o.R.Board = related
+	}
+
+	if related.R == nil {
+		related.R = &boardR{
+			BoardStars: BoardStarSlice{o},
+		}
+	} else {
+		related.R.BoardStars = append(related.R.BoardStars, o)
+	}
+
+	return nil
+}
+
+// SetUser of the boardStar to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.BoardStars.
+func (o *BoardStar) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_stars\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardStarPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.BoardID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &boardStarR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BoardStars: BoardStarSlice{o},
+		}
+	} else {
+		related.R.BoardStars = append(related.R.BoardStars, o)
+	}
+
+	return nil
+}
+
+// BoardStars retrieves all the records using an executor.
+func BoardStars(mods ...qm.QueryMod) boardStarQuery {
+	mods = append(mods, qm.From("\"board_stars\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"board_stars\".*"})
+	}
+
+	return boardStarQuery{q}
+}
+
+// FindBoardStar retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBoardStar(ctx context.Context, exec boil.ContextExecutor, userID string, boardID string, selectCols ...string) (*BoardStar, error) {
+	boardStarObj := &BoardStar{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"board_stars\" where \"user_id\"=$1 AND \"board_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, boardID)
+
+	err := q.Bind(ctx, exec, boardStarObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from board_stars")
+	}
+
+	if err = boardStarObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return boardStarObj, err
+	}
+
+	return boardStarObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BoardStar) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_stars provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardStarColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	boardStarInsertCacheMut.RLock()
+	cache, cached := boardStarInsertCache[key]
+	boardStarInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			boardStarAllColumns,
+			boardStarColumnsWithDefault,
+			boardStarColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(boardStarType, boardStarMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(boardStarType, boardStarMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"board_stars\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"board_stars\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into board_stars")
+	}
+
+	if !cached {
+		boardStarInsertCacheMut.Lock()
+		boardStarInsertCache[key] = cache
+		boardStarInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BoardStar.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BoardStar) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	boardStarUpdateCacheMut.RLock()
+	cache, cached := boardStarUpdateCache[key]
+	boardStarUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			boardStarAllColumns,
+			boardStarPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update board_stars, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"board_stars\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, boardStarPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(boardStarType, boardStarMapping, append(wl, boardStarPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update board_stars row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for board_stars")
+	}
+
+	if !cached {
+		boardStarUpdateCacheMut.Lock()
+		boardStarUpdateCache[key] = cache
+		boardStarUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q boardStarQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for board_stars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for board_stars")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BoardStarSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardStarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"board_stars\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, boardStarPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in boardStar slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all boardStar")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BoardStar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_stars provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardStarColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	boardStarUpsertCacheMut.RLock()
+	cache, cached := boardStarUpsertCache[key]
+	boardStarUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			boardStarAllColumns,
+			boardStarColumnsWithDefault,
+			boardStarColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			boardStarAllColumns,
+			boardStarPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert board_stars, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(boardStarAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(boardStarPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert board_stars, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(boardStarPrimaryKeyColumns))
+			copy(conflict, boardStarPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"board_stars\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(boardStarType, boardStarMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(boardStarType, boardStarMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert board_stars")
+	}
+
+	if !cached {
+		boardStarUpsertCacheMut.Lock()
+		boardStarUpsertCache[key] = cache
+		boardStarUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BoardStar record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BoardStar) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no BoardStar provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), boardStarPrimaryKeyMapping)
+	sql := "DELETE FROM \"board_stars\" WHERE \"user_id\"=$1 AND \"board_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from board_stars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for board_stars")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q boardStarQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no boardStarQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from board_stars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_stars")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BoardStarSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(boardStarBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardStarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"board_stars\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardStarPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from boardStar slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_stars")
+	}
+
+	if len(boardStarAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BoardStar) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBoardStar(ctx, exec, o.UserID, o.BoardID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BoardStarSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BoardStarSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardStarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"board_stars\".* FROM \"board_stars\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardStarPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in BoardStarSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BoardStarExists checks if the BoardStar row exists.
+func BoardStarExists(ctx context.Context, exec boil.ContextExecutor, userID string, boardID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"board_stars\" where \"user_id\"=$1 AND \"board_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, boardID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, boardID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if board_stars exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BoardStar row exists.
+func (o *BoardStar) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BoardStarExists(ctx, exec, o.UserID, o.BoardID)
+}
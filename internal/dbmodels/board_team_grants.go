@@ -0,0 +1,1622 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// BoardTeamGrant is an object representing the database table.
+type BoardTeamGrant struct {
+	ID      string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BoardID string `boil:"board_id" json:"board_id" toml:"board_id" yaml:"board_id"`
+	TeamID  string `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	// admin, member or observer; every team member holds this role on the board
+	Role string `boil:"role" json:"role" toml:"role" yaml:"role"`
+	// User who granted the team access
+	AddedBy   null.String `boil:"added_by" json:"added_by,omitempty" toml:"added_by" yaml:"added_by,omitempty"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *boardTeamGrantR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L boardTeamGrantL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BoardTeamGrantColumns = struct {
+	ID        string
+	BoardID   string
+	TeamID    string
+	Role      string
+	AddedBy   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	BoardID:   "board_id",
+	TeamID:    "team_id",
+	Role:      "role",
+	AddedBy:   "added_by",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var BoardTeamGrantTableColumns = struct {
+	ID        string
+	BoardID   string
+	TeamID    string
+	Role      string
+	AddedBy   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "board_team_grants.id",
+	BoardID:   "board_team_grants.board_id",
+	TeamID:    "board_team_grants.team_id",
+	Role:      "board_team_grants.role",
+	AddedBy:   "board_team_grants.added_by",
+	CreatedAt: "board_team_grants.created_at",
+	UpdatedAt: "board_team_grants.updated_at",
+}
+
+// Generated where
+
+var BoardTeamGrantWhere = struct {
+	ID        whereHelperstring
+	BoardID   whereHelperstring
+	TeamID    whereHelperstring
+	Role      whereHelperstring
+	AddedBy   whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"board_team_grants\".\"id\""},
+	BoardID:   whereHelperstring{field: "\"board_team_grants\".\"board_id\""},
+	TeamID:    whereHelperstring{field: "\"board_team_grants\".\"team_id\""},
+	Role:      whereHelperstring{field: "\"board_team_grants\".\"role\""},
+	AddedBy:   whereHelpernull_String{field: "\"board_team_grants\".\"added_by\""},
+	CreatedAt: whereHelpertime_Time{field: "\"board_team_grants\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"board_team_grants\".\"updated_at\""},
+}
+
+// BoardTeamGrantRels is where relationship names are stored.
+var BoardTeamGrantRels = struct {
+	AddedByUser string
+	Board       string
+	Team        string
+}{
+	AddedByUser: "AddedByUser",
+	Board:       "Board",
+	Team:        "Team",
+}
+
+// boardTeamGrantR is where relationships are stored.
+type boardTeamGrantR struct {
+	AddedByUser *User  `boil:"AddedByUser" json:"AddedByUser" toml:"AddedByUser" yaml:"AddedByUser"`
+	Board       *Board `boil:"Board" json:"Board" toml:"Board" yaml:"Board"`
+	Team        *Team  `boil:"Team" json:"Team" toml:"Team" yaml:"Team"`
+}
+
+// NewStruct creates a new relationship struct
+func (*boardTeamGrantR) NewStruct() *boardTeamGrantR {
+	return &boardTeamGrantR{}
+}
+
+func (o *BoardTeamGrant) GetAddedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAddedByUser()
+}
+
+func (r *boardTeamGrantR) GetAddedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.AddedByUser
+}
+
+func (o *BoardTeamGrant) GetBoard() *Board {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoard()
+}
+
+func (r *boardTeamGrantR) GetBoard() *Board {
+	if r == nil {
+		return nil
+	}
+
+	return r.Board
+}
+
+func (o *BoardTeamGrant) GetTeam() *Team {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTeam()
+}
+
+func (r *boardTeamGrantR) GetTeam() *Team {
+	if r == nil {
+		return nil
+	}
+
+	return r.Team
+}
+
+// boardTeamGrantL is where Load methods for each relationship are stored.
+type boardTeamGrantL struct{}
+
+var (
+	boardTeamGrantAllColumns            = []string{"id", "board_id", "team_id", "role", "added_by", "created_at", "updated_at"}
+	boardTeamGrantColumnsWithoutDefault = []string{"board_id", "team_id", "role"}
+	boardTeamGrantColumnsWithDefault    = []string{"id", "added_by", "created_at", "updated_at"}
+	boardTeamGrantPrimaryKeyColumns     = []string{"id"}
+	boardTeamGrantGeneratedColumns      = []string{}
+)
+
+type (
+	// BoardTeamGrantSlice is an alias for a slice of pointers to BoardTeamGrant.
+	// This should almost always be used instead of []BoardTeamGrant.
+	BoardTeamGrantSlice []*BoardTeamGrant
+	// BoardTeamGrantHook is the signature for custom BoardTeamGrant hook methods
+	BoardTeamGrantHook func(context.Context, boil.ContextExecutor, *BoardTeamGrant) error
+
+	boardTeamGrantQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	boardTeamGrantType                 = reflect.TypeOf(&BoardTeamGrant{})
+	boardTeamGrantMapping              = queries.MakeStructMapping(boardTeamGrantType)
+	boardTeamGrantPrimaryKeyMapping, _ = queries.BindMapping(boardTeamGrantType, boardTeamGrantMapping, boardTeamGrantPrimaryKeyColumns)
+	boardTeamGrantInsertCacheMut       sync.RWMutex
+	boardTeamGrantInsertCache          = make(map[string]insertCache)
+	boardTeamGrantUpdateCacheMut       sync.RWMutex
+	boardTeamGrantUpdateCache          = make(map[string]updateCache)
+	boardTeamGrantUpsertCacheMut       sync.RWMutex
+	boardTeamGrantUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var boardTeamGrantAfterSelectMu sync.Mutex
+var boardTeamGrantAfterSelectHooks []BoardTeamGrantHook
+
+var boardTeamGrantBeforeInsertMu sync.Mutex
+var boardTeamGrantBeforeInsertHooks []BoardTeamGrantHook
+var boardTeamGrantAfterInsertMu sync.Mutex
+var boardTeamGrantAfterInsertHooks []BoardTeamGrantHook
+
+var boardTeamGrantBeforeUpdateMu sync.Mutex
+var boardTeamGrantBeforeUpdateHooks []BoardTeamGrantHook
+var boardTeamGrantAfterUpdateMu sync.Mutex
+var boardTeamGrantAfterUpdateHooks []BoardTeamGrantHook
+
+var boardTeamGrantBeforeDeleteMu sync.Mutex
+var boardTeamGrantBeforeDeleteHooks []BoardTeamGrantHook
+var boardTeamGrantAfterDeleteMu sync.Mutex
+var boardTeamGrantAfterDeleteHooks []BoardTeamGrantHook
+
+var boardTeamGrantBeforeUpsertMu sync.Mutex
+var boardTeamGrantBeforeUpsertHooks []BoardTeamGrantHook
+var boardTeamGrantAfterUpsertMu sync.Mutex
+var boardTeamGrantAfterUpsertHooks []BoardTeamGrantHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BoardTeamGrant) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BoardTeamGrant) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BoardTeamGrant) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BoardTeamGrant) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BoardTeamGrant) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BoardTeamGrant) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BoardTeamGrant) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BoardTeamGrant) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BoardTeamGrant) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTeamGrantAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBoardTeamGrantHook registers your hook function for all future operations.
+func AddBoardTeamGrantHook(hookPoint boil.HookPoint, boardTeamGrantHook BoardTeamGrantHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		boardTeamGrantAfterSelectMu.Lock()
+		boardTeamGrantAfterSelectHooks = append(boardTeamGrantAfterSelectHooks, boardTeamGrantHook)
+		boardTeamGrantAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		boardTeamGrantBeforeInsertMu.Lock()
+		boardTeamGrantBeforeInsertHooks = append(boardTeamGrantBeforeInsertHooks, boardTeamGrantHook)
+		boardTeamGrantBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		boardTeamGrantAfterInsertMu.Lock()
+		boardTeamGrantAfterInsertHooks = append(boardTeamGrantAfterInsertHooks, boardTeamGrantHook)
+		boardTeamGrantAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		boardTeamGrantBeforeUpdateMu.Lock()
+		boardTeamGrantBeforeUpdateHooks = append(boardTeamGrantBeforeUpdateHooks, boardTeamGrantHook)
+		boardTeamGrantBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		boardTeamGrantAfterUpdateMu.Lock()
+		boardTeamGrantAfterUpdateHooks = append(boardTeamGrantAfterUpdateHooks, boardTeamGrantHook)
+		boardTeamGrantAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		boardTeamGrantBeforeDeleteMu.Lock()
+		boardTeamGrantBeforeDeleteHooks = append(boardTeamGrantBeforeDeleteHooks, boardTeamGrantHook)
+		boardTeamGrantBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		boardTeamGrantAfterDeleteMu.Lock()
+		boardTeamGrantAfterDeleteHooks = append(boardTeamGrantAfterDeleteHooks, boardTeamGrantHook)
+		boardTeamGrantAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		boardTeamGrantBeforeUpsertMu.Lock()
+		boardTeamGrantBeforeUpsertHooks = append(boardTeamGrantBeforeUpsertHooks, boardTeamGrantHook)
+		boardTeamGrantBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		boardTeamGrantAfterUpsertMu.Lock()
+		boardTeamGrantAfterUpsertHooks = append(boardTeamGrantAfterUpsertHooks, boardTeamGrantHook)
+		boardTeamGrantAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single boardTeamGrant record from the query.
+func (q boardTeamGrantQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BoardTeamGrant, error) {
+	o := &BoardTeamGrant{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for board_team_grants")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BoardTeamGrant records from the query.
+func (q boardTeamGrantQuery) All(ctx context.Context, exec boil.ContextExecutor) (BoardTeamGrantSlice, error) {
+	var o []*BoardTeamGrant
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to BoardTeamGrant slice")
+	}
+
+	if len(boardTeamGrantAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BoardTeamGrant records in the query.
+func (q boardTeamGrantQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count board_team_grants rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q boardTeamGrantQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if board_team_grants exists")
+	}
+
+	return count > 0, nil
+}
+
+// AddedByUser pointed to by the foreign key.
+func (o *BoardTeamGrant) AddedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AddedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Board pointed to by the foreign key.
+func (o *BoardTeamGrant) Board(mods ...qm.QueryMod) boardQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BoardID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Boards(queryMods...)
+}
+
+// Team pointed to by the foreign key.
+func (o *BoardTeamGrant) Team(mods ...qm.QueryMod) teamQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeamID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Teams(queryMods...)
+}
+
+// LoadAddedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardTeamGrantL) LoadAddedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardTeamGrant interface{}, mods queries.Applicator) error {
+	var slice []*BoardTeamGrant
+	var object *BoardTeamGrant
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardTeamGrant.(*BoardTeamGrant)
+		if !ok {
+			object = new(BoardTeamGrant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardTeamGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardTeamGrant))
+			}
+		}
+	} else {
+		s, ok := maybeBoardTeamGrant.(*[]*BoardTeamGrant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardTeamGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardTeamGrant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardTeamGrantR{}
+		}
+		if !queries.IsNil(object.AddedBy) {
+			args[object.AddedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardTeamGrantR{}
+			}
+
+			if !queries.IsNil(obj.AddedBy) {
+				args[obj.AddedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AddedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AddedByBoardTeamGrants = append(foreign.R.AddedByBoardTeamGrants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AddedBy, foreign.ID) {
+				local.R.AddedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AddedByBoardTeamGrants = append(foreign.R.AddedByBoardTeamGrants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoard allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardTeamGrantL) LoadBoard(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardTeamGrant interface{}, mods queries.Applicator) error {
+	var slice []*BoardTeamGrant
+	var object *BoardTeamGrant
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardTeamGrant.(*BoardTeamGrant)
+		if !ok {
+			object = new(BoardTeamGrant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardTeamGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardTeamGrant))
+			}
+		}
+	} else {
+		s, ok := maybeBoardTeamGrant.(*[]*BoardTeamGrant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardTeamGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardTeamGrant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardTeamGrantR{}
+		}
+		args[object.BoardID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardTeamGrantR{}
+			}
+
+			args[obj.BoardID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`boards`),
+		qm.WhereIn(`boards.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`boards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Board")
+	}
+
+	var resultSlice []*Board
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Board")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+	}
+
+	if len(boardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Board = foreign
+		if foreign.R == nil {
+			foreign.R = &boardR{}
+		}
+		foreign.R.BoardTeamGrants = append(foreign.R.BoardTeamGrants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BoardID == foreign.ID {
+				local.R.Board = foreign
+				if foreign.R == nil {
+					foreign.R = &boardR{}
+				}
+				foreign.R.BoardTeamGrants = append(foreign.R.BoardTeamGrants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeam allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardTeamGrantL) LoadTeam(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardTeamGrant interface{}, mods queries.Applicator) error {
+	var slice []*BoardTeamGrant
+	var object *BoardTeamGrant
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardTeamGrant.(*BoardTeamGrant)
+		if !ok {
+			object = new(BoardTeamGrant)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardTeamGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardTeamGrant))
+			}
+		}
+	} else {
+		s, ok := maybeBoardTeamGrant.(*[]*BoardTeamGrant)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardTeamGrant)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardTeamGrant))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardTeamGrantR{}
+		}
+		args[object.TeamID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardTeamGrantR{}
+			}
+
+			args[obj.TeamID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`teams`),
+		qm.WhereIn(`teams.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`teams.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Team")
+	}
+
+	var resultSlice []*Team
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Team")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for teams")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for teams")
+	}
+
+	if len(teamAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Team = foreign
+		if foreign.R == nil {
+			foreign.R = &teamR{}
+		}
+		foreign.R.BoardTeamGrants = append(foreign.R.BoardTeamGrants, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TeamID == foreign.ID {
+				local.R.Team = foreign
+				if foreign.R == nil {
+					foreign.R = &teamR{}
+				}
+				foreign.R.BoardTeamGrants = append(foreign.R.BoardTeamGrants, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAddedByUser of the boardTeamGrant to the related item.
+// Sets o.R.AddedByUser to related.
+// Adds o to related.R.AddedByBoardTeamGrants.
+func (o *BoardTeamGrant) SetAddedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_team_grants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+		strmangle.WhereClause("\"", "\"", 2, boardTeamGrantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AddedBy, related.ID)
+	if o.R == nil {
+		o.R = &boardTeamGrantR{
+			AddedByUser: related,
+		}
+	} else {
+		o.R.AddedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AddedByBoardTeamGrants: BoardTeamGrantSlice{o},
+		}
+	} else {
+		related.R.AddedByBoardTeamGrants = append(related.R.AddedByBoardTeamGrants, o)
+	}
+
+	return nil
+}
+
+// RemoveAddedByUser relationship.
+// Sets o.R.AddedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *BoardTeamGrant) RemoveAddedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AddedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AddedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AddedByBoardTeamGrants {
+		if queries.Equal(o.AddedBy, ri.AddedBy) {
+			continue
+		}
+
+		ln := len(related.R.AddedByBoardTeamGrants)
+		if ln > 1 && i < ln-1 {
+			related.R.AddedByBoardTeamGrants[i] = related.R.AddedByBoardTeamGrants[ln-1]
+		}
+		related.R.AddedByBoardTeamGrants = related.R.AddedByBoardTeamGrants[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetBoard of the boardTeamGrant to the related item.
+// Sets o.R.Board to related.
+// Adds o to related.R.BoardTeamGrants.
+func (o *BoardTeamGrant) SetBoard(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Board) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_team_grants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardTeamGrantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BoardID = related.ID
+	if o.R == nil {
+		o.R = &boardTeamGrantR{
+			Board: related,
+		}
+	} else {
+		o.R.Board = related
+	}
+
+	if related.R == nil {
+		related.R = &boardR{
+			BoardTeamGrants: BoardTeamGrantSlice{o},
+		}
+	} else {
+		related.R.BoardTeamGrants = append(related.R.BoardTeamGrants, o)
+	}
+
+	return nil
+}
+
+// SetTeam of the boardTeamGrant to the related item.
+// Sets o.R.Team to related.
+// Adds o to related.R.BoardTeamGrants.
+func (o *BoardTeamGrant) SetTeam(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Team) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_team_grants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"team_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardTeamGrantPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TeamID = related.ID
+	if o.R == nil {
+		o.R = &boardTeamGrantR{
+			Team: related,
+		}
+	} else {
+		o.R.Team = related
+	}
+
+	if related.R == nil {
+		related.R = &teamR{
+			BoardTeamGrants: BoardTeamGrantSlice{o},
+		}
+	} else {
+		related.R.BoardTeamGrants = append(related.R.BoardTeamGrants, o)
+	}
+
+	return nil
+}
+
+// BoardTeamGrants retrieves all the records using an executor.
+func BoardTeamGrants(mods ...qm.QueryMod) boardTeamGrantQuery {
+	mods = append(mods, qm.From("\"board_team_grants\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"board_team_grants\".*"})
+	}
+
+	return boardTeamGrantQuery{q}
+}
+
+// FindBoardTeamGrant retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBoardTeamGrant(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BoardTeamGrant, error) {
+	boardTeamGrantObj := &BoardTeamGrant{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"board_team_grants\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, boardTeamGrantObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from board_team_grants")
+	}
+
+	if err = boardTeamGrantObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return boardTeamGrantObj, err
+	}
+
+	return boardTeamGrantObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BoardTeamGrant) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_team_grants provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardTeamGrantColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	boardTeamGrantInsertCacheMut.RLock()
+	cache, cached := boardTeamGrantInsertCache[key]
+	boardTeamGrantInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			boardTeamGrantAllColumns,
+			boardTeamGrantColumnsWithDefault,
+			boardTeamGrantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(boardTeamGrantType, boardTeamGrantMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(boardTeamGrantType, boardTeamGrantMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"board_team_grants\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"board_team_grants\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into board_team_grants")
+	}
+
+	if !cached {
+		boardTeamGrantInsertCacheMut.Lock()
+		boardTeamGrantInsertCache[key] = cache
+		boardTeamGrantInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BoardTeamGrant.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BoardTeamGrant) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	boardTeamGrantUpdateCacheMut.RLock()
+	cache, cached := boardTeamGrantUpdateCache[key]
+	boardTeamGrantUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			boardTeamGrantAllColumns,
+			boardTeamGrantPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update board_team_grants, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"board_team_grants\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, boardTeamGrantPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(boardTeamGrantType, boardTeamGrantMapping, append(wl, boardTeamGrantPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update board_team_grants row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for board_team_grants")
+	}
+
+	if !cached {
+		boardTeamGrantUpdateCacheMut.Lock()
+		boardTeamGrantUpdateCache[key] = cache
+		boardTeamGrantUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q boardTeamGrantQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for board_team_grants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for board_team_grants")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BoardTeamGrantSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTeamGrantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"board_team_grants\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, boardTeamGrantPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in boardTeamGrant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all boardTeamGrant")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BoardTeamGrant) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_team_grants provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardTeamGrantColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	boardTeamGrantUpsertCacheMut.RLock()
+	cache, cached := boardTeamGrantUpsertCache[key]
+	boardTeamGrantUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			boardTeamGrantAllColumns,
+			boardTeamGrantColumnsWithDefault,
+			boardTeamGrantColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			boardTeamGrantAllColumns,
+			boardTeamGrantPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert board_team_grants, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(boardTeamGrantAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(boardTeamGrantPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert board_team_grants, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(boardTeamGrantPrimaryKeyColumns))
+			copy(conflict, boardTeamGrantPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"board_team_grants\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(boardTeamGrantType, boardTeamGrantMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(boardTeamGrantType, boardTeamGrantMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert board_team_grants")
+	}
+
+	if !cached {
+		boardTeamGrantUpsertCacheMut.Lock()
+		boardTeamGrantUpsertCache[key] = cache
+		boardTeamGrantUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BoardTeamGrant record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BoardTeamGrant) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no BoardTeamGrant provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), boardTeamGrantPrimaryKeyMapping)
+	sql := "DELETE FROM \"board_team_grants\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from board_team_grants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for board_team_grants")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q boardTeamGrantQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no boardTeamGrantQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from board_team_grants")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_team_grants")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BoardTeamGrantSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(boardTeamGrantBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTeamGrantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"board_team_grants\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardTeamGrantPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from boardTeamGrant slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_team_grants")
+	}
+
+	if len(boardTeamGrantAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BoardTeamGrant) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBoardTeamGrant(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BoardTeamGrantSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BoardTeamGrantSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTeamGrantPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"board_team_grants\".* FROM \"board_team_grants\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardTeamGrantPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in BoardTeamGrantSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BoardTeamGrantExists checks if the BoardTeamGrant row exists.
+func BoardTeamGrantExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"board_team_grants\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if board_team_grants exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BoardTeamGrant row exists.
+func (o *BoardTeamGrant) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BoardTeamGrantExists(ctx, exec, o.ID)
+}
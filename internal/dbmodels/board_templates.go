@@ -0,0 +1,1563 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// BoardTemplate is an object representing the database table.
+type BoardTemplate struct {
+	ID string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	// Stable name of a built-in template, BOARD_DEFAULT_TEMPLATE refers to it
+	Key         null.String `boil:"key" json:"key,omitempty" toml:"key" yaml:"key,omitempty"`
+	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	// Lists with their sample cards and checklists, and labels; cards refer to labels by name
+	Content types.JSON `boil:"content" json:"content" toml:"content" yaml:"content"`
+	// Built-in templates are shipped with the application and cannot be changed
+	IsBuiltin bool `boil:"is_builtin" json:"is_builtin" toml:"is_builtin" yaml:"is_builtin"`
+	// Workspace the template is shared with; NULL keeps it to its creator
+	WorkspaceID null.String `boil:"workspace_id" json:"workspace_id,omitempty" toml:"workspace_id" yaml:"workspace_id,omitempty"`
+	CreatedBy   null.String `boil:"created_by" json:"created_by,omitempty" toml:"created_by" yaml:"created_by,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *boardTemplateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L boardTemplateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BoardTemplateColumns = struct {
+	ID          string
+	Key         string
+	Name        string
+	Description string
+	Content     string
+	IsBuiltin   string
+	WorkspaceID string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+	DeletedAt   string
+}{
+	ID:          "id",
+	Key:         "key",
+	Name:        "name",
+	Description: "description",
+	Content:     "content",
+	IsBuiltin:   "is_builtin",
+	WorkspaceID: "workspace_id",
+	CreatedBy:   "created_by",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+	DeletedAt:   "deleted_at",
+}
+
+var BoardTemplateTableColumns = struct {
+	ID          string
+	Key         string
+	Name        string
+	Description string
+	Content     string
+	IsBuiltin   string
+	WorkspaceID string
+	CreatedBy   string
+	CreatedAt   string
+	UpdatedAt   string
+	DeletedAt   string
+}{
+	ID:          "board_templates.id",
+	Key:         "board_templates.key",
+	Name:        "board_templates.name",
+	Description: "board_templates.description",
+	Content:     "board_templates.content",
+	IsBuiltin:   "board_templates.is_builtin",
+	WorkspaceID: "board_templates.workspace_id",
+	CreatedBy:   "board_templates.created_by",
+	CreatedAt:   "board_templates.created_at",
+	UpdatedAt:   "board_templates.updated_at",
+	DeletedAt:   "board_templates.deleted_at",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var BoardTemplateWhere = struct {
+	ID          whereHelperstring
+	Key         whereHelpernull_String
+	Name        whereHelperstring
+	Description whereHelpernull_String
+	Content     whereHelpertypes_JSON
+	IsBuiltin   whereHelperbool
+	WorkspaceID whereHelpernull_String
+	CreatedBy   whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+	DeletedAt   whereHelpernull_Time
+}{
+	ID:          whereHelperstring{field: "\"board_templates\".\"id\""},
+	Key:         whereHelpernull_String{field: "\"board_templates\".\"key\""},
+	Name:        whereHelperstring{field: "\"board_templates\".\"name\""},
+	Description: whereHelpernull_String{field: "\"board_templates\".\"description\""},
+	Content:     whereHelpertypes_JSON{field: "\"board_templates\".\"content\""},
+	IsBuiltin:   whereHelperbool{field: "\"board_templates\".\"is_builtin\""},
+	WorkspaceID: whereHelpernull_String{field: "\"board_templates\".\"workspace_id\""},
+	CreatedBy:   whereHelpernull_String{field: "\"board_templates\".\"created_by\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"board_templates\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"board_templates\".\"updated_at\""},
+	DeletedAt:   whereHelpernull_Time{field: "\"board_templates\".\"deleted_at\""},
+}
+
+// BoardTemplateRels is where relationship names are stored.
+var BoardTemplateRels = struct {
+	CreatedByUser string
+	Workspace     string
+}{
+	CreatedByUser: "CreatedByUser",
+	Workspace:     "Workspace",
+}
+
+// boardTemplateR is where relationships are stored.
+type boardTemplateR struct {
+	CreatedByUser *User      `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	Workspace     *Workspace `boil:"Workspace" json:"Workspace" toml:"Workspace" yaml:"Workspace"`
+}
+
+// NewStruct creates a new relationship struct
+func (*boardTemplateR) NewStruct() *boardTemplateR {
+	return &boardTemplateR{}
+}
+
+func (o *BoardTemplate) GetCreatedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByUser()
+}
+
+func (r *boardTemplateR) GetCreatedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByUser
+}
+
+func (o *BoardTemplate) GetWorkspace() *Workspace {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWorkspace()
+}
+
+func (r *boardTemplateR) GetWorkspace() *Workspace {
+	if r == nil {
+		return nil
+	}
+
+	return r.Workspace
+}
+
+// boardTemplateL is where Load methods for each relationship are stored.
+type boardTemplateL struct{}
+
+var (
+	boardTemplateAllColumns            = []string{"id", "key", "name", "description", "content", "is_builtin", "workspace_id", "created_by", "created_at", "updated_at", "deleted_at"}
+	boardTemplateColumnsWithoutDefault = []string{"name"}
+	boardTemplateColumnsWithDefault    = []string{"id", "key", "description", "content", "is_builtin", "workspace_id", "created_by", "created_at", "updated_at", "deleted_at"}
+	boardTemplatePrimaryKeyColumns     = []string{"id"}
+	boardTemplateGeneratedColumns      = []string{}
+)
+
+type (
+	// BoardTemplateSlice is an alias for a slice of pointers to BoardTemplate.
+	// This should almost always be used instead of []BoardTemplate.
+	BoardTemplateSlice []*BoardTemplate
+	// BoardTemplateHook is the signature for custom BoardTemplate hook methods
+	BoardTemplateHook func(context.Context, boil.ContextExecutor, *BoardTemplate) error
+
+	boardTemplateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	boardTemplateType                 = reflect.TypeOf(&BoardTemplate{})
+	boardTemplateMapping              = queries.MakeStructMapping(boardTemplateType)
+	boardTemplatePrimaryKeyMapping, _ = queries.BindMapping(boardTemplateType, boardTemplateMapping, boardTemplatePrimaryKeyColumns)
+	boardTemplateInsertCacheMut       sync.RWMutex
+	boardTemplateInsertCache          = make(map[string]insertCache)
+	boardTemplateUpdateCacheMut       sync.RWMutex
+	boardTemplateUpdateCache          = make(map[string]updateCache)
+	boardTemplateUpsertCacheMut       sync.RWMutex
+	boardTemplateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var boardTemplateAfterSelectMu sync.Mutex
+var boardTemplateAfterSelectHooks []BoardTemplateHook
+
+var boardTemplateBeforeInsertMu sync.Mutex
+var boardTemplateBeforeInsertHooks []BoardTemplateHook
+var boardTemplateAfterInsertMu sync.Mutex
+var boardTemplateAfterInsertHooks []BoardTemplateHook
+
+var boardTemplateBeforeUpdateMu sync.Mutex
+var boardTemplateBeforeUpdateHooks []BoardTemplateHook
+var boardTemplateAfterUpdateMu sync.Mutex
+var boardTemplateAfterUpdateHooks []BoardTemplateHook
+
+var boardTemplateBeforeDeleteMu sync.Mutex
+var boardTemplateBeforeDeleteHooks []BoardTemplateHook
+var boardTemplateAfterDeleteMu sync.Mutex
+var boardTemplateAfterDeleteHooks []BoardTemplateHook
+
+var boardTemplateBeforeUpsertMu sync.Mutex
+var boardTemplateBeforeUpsertHooks []BoardTemplateHook
+var boardTemplateAfterUpsertMu sync.Mutex
+var boardTemplateAfterUpsertHooks []BoardTemplateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BoardTemplate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BoardTemplate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BoardTemplate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BoardTemplate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BoardTemplate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BoardTemplate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BoardTemplate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BoardTemplate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BoardTemplate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardTemplateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBoardTemplateHook registers your hook function for all future operations.
+func AddBoardTemplateHook(hookPoint boil.HookPoint, boardTemplateHook BoardTemplateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		boardTemplateAfterSelectMu.Lock()
+		boardTemplateAfterSelectHooks = append(boardTemplateAfterSelectHooks, boardTemplateHook)
+		boardTemplateAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		boardTemplateBeforeInsertMu.Lock()
+		boardTemplateBeforeInsertHooks = append(boardTemplateBeforeInsertHooks, boardTemplateHook)
+		boardTemplateBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		boardTemplateAfterInsertMu.Lock()
+		boardTemplateAfterInsertHooks = append(boardTemplateAfterInsertHooks, boardTemplateHook)
+		boardTemplateAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		boardTemplateBeforeUpdateMu.Lock()
+		boardTemplateBeforeUpdateHooks = append(boardTemplateBeforeUpdateHooks, boardTemplateHook)
+		boardTemplateBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		boardTemplateAfterUpdateMu.Lock()
+		boardTemplateAfterUpdateHooks = append(boardTemplateAfterUpdateHooks, boardTemplateHook)
+		boardTemplateAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		boardTemplateBeforeDeleteMu.Lock()
+		boardTemplateBeforeDeleteHooks = append(boardTemplateBeforeDeleteHooks, boardTemplateHook)
+		boardTemplateBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		boardTemplateAfterDeleteMu.Lock()
+		boardTemplateAfterDeleteHooks = append(boardTemplateAfterDeleteHooks, boardTemplateHook)
+		boardTemplateAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		boardTemplateBeforeUpsertMu.Lock()
+		boardTemplateBeforeUpsertHooks = append(boardTemplateBeforeUpsertHooks, boardTemplateHook)
+		boardTemplateBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		boardTemplateAfterUpsertMu.Lock()
+		boardTemplateAfterUpsertHooks = append(boardTemplateAfterUpsertHooks, boardTemplateHook)
+		boardTemplateAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single boardTemplate record from the query.
+func (q boardTemplateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BoardTemplate, error) {
+	o := &BoardTemplate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for board_templates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BoardTemplate records from the query.
+func (q boardTemplateQuery) All(ctx context.Context, exec boil.ContextExecutor) (BoardTemplateSlice, error) {
+	var o []*BoardTemplate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to BoardTemplate slice")
+	}
+
+	if len(boardTemplateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BoardTemplate records in the query.
+func (q boardTemplateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count board_templates rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q boardTemplateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if board_templates exists")
+	}
+
+	return count > 0, nil
+}
+
+// CreatedByUser pointed to by the foreign key.
+func (o *BoardTemplate) CreatedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Workspace pointed to by the foreign key.
+func (o *BoardTemplate) Workspace(mods ...qm.QueryMod) workspaceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WorkspaceID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Workspaces(queryMods...)
+}
+
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardTemplateL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardTemplate interface{}, mods queries.Applicator) error {
+	var slice []*BoardTemplate
+	var object *BoardTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardTemplate.(*BoardTemplate)
+		if !ok {
+			object = new(BoardTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeBoardTemplate.(*[]*BoardTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardTemplateR{}
+		}
+		if !queries.IsNil(object.CreatedBy) {
+			args[object.CreatedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardTemplateR{}
+			}
+
+			if !queries.IsNil(obj.CreatedBy) {
+				args[obj.CreatedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByBoardTemplates = append(foreign.R.CreatedByBoardTemplates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedBy, foreign.ID) {
+				local.R.CreatedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByBoardTemplates = append(foreign.R.CreatedByBoardTemplates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkspace allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardTemplateL) LoadWorkspace(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardTemplate interface{}, mods queries.Applicator) error {
+	var slice []*BoardTemplate
+	var object *BoardTemplate
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardTemplate.(*BoardTemplate)
+		if !ok {
+			object = new(BoardTemplate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardTemplate))
+			}
+		}
+	} else {
+		s, ok := maybeBoardTemplate.(*[]*BoardTemplate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardTemplate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardTemplate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardTemplateR{}
+		}
+		if !queries.IsNil(object.WorkspaceID) {
+			args[object.WorkspaceID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardTemplateR{}
+			}
+
+			if !queries.IsNil(obj.WorkspaceID) {
+				args[obj.WorkspaceID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`workspaces`),
+		qm.WhereIn(`workspaces.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`workspaces.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Workspace")
+	}
+
+	var resultSlice []*Workspace
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Workspace")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for workspaces")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workspaces")
+	}
+
+	if len(workspaceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Workspace = foreign
+		if foreign.R == nil {
+			foreign.R = &workspaceR{}
+		}
+		foreign.R.BoardTemplates = append(foreign.R.BoardTemplates, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.WorkspaceID, foreign.ID) {
+				local.R.Workspace = foreign
+				if foreign.R == nil {
+					foreign.R = &workspaceR{}
+				}
+				foreign.R.BoardTemplates = append(foreign.R.BoardTemplates, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatedByUser of the boardTemplate to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByBoardTemplates.
+func (o *BoardTemplate) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_templates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.WhereClause("\"", "\"", 2, boardTemplatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedBy, related.ID)
+	if o.R == nil {
+		o.R = &boardTemplateR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByBoardTemplates: BoardTemplateSlice{o},
+		}
+	} else {
+		related.R.CreatedByBoardTemplates = append(related.R.CreatedByBoardTemplates, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedByUser relationship.
+// Sets o.R.CreatedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *BoardTemplate) RemoveCreatedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByBoardTemplates {
+		if queries.Equal(o.CreatedBy, ri.CreatedBy) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByBoardTemplates)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByBoardTemplates[i] = related.R.CreatedByBoardTemplates[ln-1]
+		}
+		related.R.CreatedByBoardTemplates = related.R.CreatedByBoardTemplates[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetWorkspace of the boardTemplate to the related item.
+// Sets o.R.Workspace to related.
+// Adds o to related.R.BoardTemplates.
+func (o *BoardTemplate) SetWorkspace(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Workspace) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_templates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"workspace_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardTemplatePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.WorkspaceID, related.ID)
+	if o.R == nil {
+		o.R = &boardTemplateR{
+			Workspace: related,
+		}
+	} else {
+		o.R.Workspace = related
+	}
+
+	if related.R == nil {
+		related.R = &workspaceR{
+			BoardTemplates: BoardTemplateSlice{o},
+		}
+	} else {
+		related.R.BoardTemplates = append(related.R.BoardTemplates, o)
+	}
+
+	return nil
+}
+
+// RemoveWorkspace relationship.
+// Sets o.R.Workspace to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *BoardTemplate) RemoveWorkspace(ctx context.Context, exec boil.ContextExecutor, related *Workspace) error {
+	var err error
+
+	queries.SetScanner(&o.WorkspaceID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("workspace_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Workspace = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.BoardTemplates {
+		if queries.Equal(o.WorkspaceID, ri.WorkspaceID) {
+			continue
+		}
+
+		ln := len(related.R.BoardTemplates)
+		if ln > 1 && i < ln-1 {
+			related.R.BoardTemplates[i] = related.R.BoardTemplates[ln-1]
+		}
+		related.R.BoardTemplates = related.R.BoardTemplates[:ln-1]
+		break
+	}
+	return nil
+}
+
+// BoardTemplates retrieves all the records using an executor.
+func BoardTemplates(mods ...qm.QueryMod) boardTemplateQuery {
+	mods = append(mods, qm.From("\"board_templates\""), qmhelper.WhereIsNull("\"board_templates\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"board_templates\".*"})
+	}
+
+	return boardTemplateQuery{q}
+}
+
+// FindBoardTemplate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBoardTemplate(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BoardTemplate, error) {
+	boardTemplateObj := &BoardTemplate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"board_templates\" where \"id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, boardTemplateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from board_templates")
+	}
+
+	if err = boardTemplateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return boardTemplateObj, err
+	}
+
+	return boardTemplateObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BoardTemplate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_templates provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardTemplateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	boardTemplateInsertCacheMut.RLock()
+	cache, cached := boardTemplateInsertCache[key]
+	boardTemplateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			boardTemplateAllColumns,
+			boardTemplateColumnsWithDefault,
+			boardTemplateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(boardTemplateType, boardTemplateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(boardTemplateType, boardTemplateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"board_templates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"board_templates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into board_templates")
+	}
+
+	if !cached {
+		boardTemplateInsertCacheMut.Lock()
+		boardTemplateInsertCache[key] = cache
+		boardTemplateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BoardTemplate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BoardTemplate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	boardTemplateUpdateCacheMut.RLock()
+	cache, cached := boardTemplateUpdateCache[key]
+	boardTemplateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			boardTemplateAllColumns,
+			boardTemplatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update board_templates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"board_templates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, boardTemplatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(boardTemplateType, boardTemplateMapping, append(wl, boardTemplatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update board_templates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for board_templates")
+	}
+
+	if !cached {
+		boardTemplateUpdateCacheMut.Lock()
+		boardTemplateUpdateCache[key] = cache
+		boardTemplateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q boardTemplateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for board_templates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for board_templates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BoardTemplateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTemplatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"board_templates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, boardTemplatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in boardTemplate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all boardTemplate")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BoardTemplate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_templates provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardTemplateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	boardTemplateUpsertCacheMut.RLock()
+	cache, cached := boardTemplateUpsertCache[key]
+	boardTemplateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			boardTemplateAllColumns,
+			boardTemplateColumnsWithDefault,
+			boardTemplateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			boardTemplateAllColumns,
+			boardTemplatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert board_templates, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(boardTemplateAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(boardTemplatePrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert board_templates, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(boardTemplatePrimaryKeyColumns))
+			copy(conflict, boardTemplatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"board_templates\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(boardTemplateType, boardTemplateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(boardTemplateType, boardTemplateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert board_templates")
+	}
+
+	if !cached {
+		boardTemplateUpsertCacheMut.Lock()
+		boardTemplateUpsertCache[key] = cache
+		boardTemplateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BoardTemplate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BoardTemplate) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no BoardTemplate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), boardTemplatePrimaryKeyMapping)
+		sql = "DELETE FROM \"board_templates\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"board_templates\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(boardTemplateType, boardTemplateMapping, append(wl, boardTemplatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from board_templates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for board_templates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q boardTemplateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no boardTemplateQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from board_templates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_templates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BoardTemplateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(boardTemplateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTemplatePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"board_templates\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardTemplatePrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTemplatePrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"board_templates\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, boardTemplatePrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from boardTemplate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_templates")
+	}
+
+	if len(boardTemplateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BoardTemplate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBoardTemplate(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BoardTemplateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BoardTemplateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardTemplatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"board_templates\".* FROM \"board_templates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardTemplatePrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in BoardTemplateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BoardTemplateExists checks if the BoardTemplate row exists.
+func BoardTemplateExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"board_templates\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if board_templates exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BoardTemplate row exists.
+func (o *BoardTemplate) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BoardTemplateExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1333 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// BoardView is an object representing the database table.
+type BoardView struct {
+	UserID  string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	BoardID string `boil:"board_id" json:"board_id" toml:"board_id" yaml:"board_id"`
+	// When the user last opened the board or its live updates
+	ViewedAt time.Time `boil:"viewed_at" json:"viewed_at" toml:"viewed_at" yaml:"viewed_at"`
+
+	R *boardViewR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L boardViewL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BoardViewColumns = struct {
+	UserID   string
+	BoardID  string
+	ViewedAt string
+}{
+	UserID:   "user_id",
+	BoardID:  "board_id",
+	ViewedAt: "viewed_at",
+}
+
+var BoardViewTableColumns = struct {
+	UserID   string
+	BoardID  string
+	ViewedAt string
+}{
+	UserID:   "board_views.user_id",
+	BoardID:  "board_views.board_id",
+	ViewedAt: "board_views.viewed_at",
+}
+
+// Generated where
+
+var BoardViewWhere = struct {
+	UserID   whereHelperstring
+	BoardID  whereHelperstring
+	ViewedAt whereHelpertime_Time
+}{
+	UserID:   whereHelperstring{field: "\"board_views\".\"user_id\""},
+	BoardID:  whereHelperstring{field: "\"board_views\".\"board_id\""},
+	ViewedAt: whereHelpertime_Time{field: "\"board_views\".\"viewed_at\""},
+}
+
+// BoardViewRels is where relationship names are stored.
+var BoardViewRels = struct {
+	Board string
+	User  string
+}{
+	Board: "Board",
+	User:  "User",
+}
+
+// boardViewR is where relationships are stored.
+type boardViewR struct {
+	Board *Board `boil:"Board" json:"Board" toml:"Board" yaml:"Board"`
+	User  *User  `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*boardViewR) NewStruct() *boardViewR {
+	return &boardViewR{}
+}
+
+func (o *BoardView) GetBoard() *Board {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoard()
+}
+
+func (r *boardViewR) GetBoard() *Board {
+	if r == nil {
+		return nil
+	}
+
+	return r.Board
+}
+
+func (o *BoardView) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *boardViewR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// boardViewL is where Load methods for each relationship are stored.
+type boardViewL struct{}
+
+var (
+	boardViewAllColumns            = []string{"user_id", "board_id", "viewed_at"}
+	boardViewColumnsWithoutDefault = []string{"user_id", "board_id"}
+	boardViewColumnsWithDefault    = []string{"viewed_at"}
+	boardViewPrimaryKeyColumns     = []string{"user_id", "board_id"}
+	boardViewGeneratedColumns      = []string{}
+)
+
+type (
+	// BoardViewSlice is an alias for a slice of pointers to BoardView.
+	// This should almost always be used instead of []BoardView.
+	BoardViewSlice []*BoardView
+	// BoardViewHook is the signature for custom BoardView hook methods
+	BoardViewHook func(context.Context, boil.ContextExecutor, *BoardView) error
+
+	boardViewQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	boardViewType                 = reflect.TypeOf(&BoardView{})
+	boardViewMapping              = queries.MakeStructMapping(boardViewType)
+	boardViewPrimaryKeyMapping, _ = queries.BindMapping(boardViewType, boardViewMapping, boardViewPrimaryKeyColumns)
+	boardViewInsertCacheMut       sync.RWMutex
+	boardViewInsertCache          = make(map[string]insertCache)
+	boardViewUpdateCacheMut       sync.RWMutex
+	boardViewUpdateCache          = make(map[string]updateCache)
+	boardViewUpsertCacheMut       sync.RWMutex
+	boardViewUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var boardViewAfterSelectMu sync.Mutex
+var boardViewAfterSelectHooks []BoardViewHook
+
+var boardViewBeforeInsertMu sync.Mutex
+var boardViewBeforeInsertHooks []BoardViewHook
+var boardViewAfterInsertMu sync.Mutex
+var boardViewAfterInsertHooks []BoardViewHook
+
+var boardViewBeforeUpdateMu sync.Mutex
+var boardViewBeforeUpdateHooks []BoardViewHook
+var boardViewAfterUpdateMu sync.Mutex
+var boardViewAfterUpdateHooks []BoardViewHook
+
+var boardViewBeforeDeleteMu sync.Mutex
+var boardViewBeforeDeleteHooks []BoardViewHook
+var boardViewAfterDeleteMu sync.Mutex
+var boardViewAfterDeleteHooks []BoardViewHook
+
+var boardViewBeforeUpsertMu sync.Mutex
+var boardViewBeforeUpsertHooks []BoardViewHook
+var boardViewAfterUpsertMu sync.Mutex
+var boardViewAfterUpsertHooks []BoardViewHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BoardView) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BoardView) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BoardView) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BoardView) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BoardView) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BoardView) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BoardView) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BoardView) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BoardView) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range boardViewAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBoardViewHook registers your hook function for all future operations.
+func AddBoardViewHook(hookPoint boil.HookPoint, boardViewHook BoardViewHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		boardViewAfterSelectMu.Lock()
+		boardViewAfterSelectHooks = append(boardViewAfterSelectHooks, boardViewHook)
+		boardViewAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		boardViewBeforeInsertMu.Lock()
+		boardViewBeforeInsertHooks = append(boardViewBeforeInsertHooks, boardViewHook)
+		boardViewBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		boardViewAfterInsertMu.Lock()
+		boardViewAfterInsertHooks = append(boardViewAfterInsertHooks, boardViewHook)
+		boardViewAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		boardViewBeforeUpdateMu.Lock()
+		boardViewBeforeUpdateHooks = append(boardViewBeforeUpdateHooks, boardViewHook)
+		boardViewBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		boardViewAfterUpdateMu.Lock()
+		boardViewAfterUpdateHooks = append(boardViewAfterUpdateHooks, boardViewHook)
+		boardViewAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		boardViewBeforeDeleteMu.Lock()
+		boardViewBeforeDeleteHooks = append(boardViewBeforeDeleteHooks, boardViewHook)
+		boardViewBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		boardViewAfterDeleteMu.Lock()
+		boardViewAfterDeleteHooks = append(boardViewAfterDeleteHooks, boardViewHook)
+		boardViewAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		boardViewBeforeUpsertMu.Lock()
+		boardViewBeforeUpsertHooks = append(boardViewBeforeUpsertHooks, boardViewHook)
+		boardViewBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		boardViewAfterUpsertMu.Lock()
+		boardViewAfterUpsertHooks = append(boardViewAfterUpsertHooks, boardViewHook)
+		boardViewAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single boardView record from the query.
+func (q boardViewQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BoardView, error) {
+	o := &BoardView{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for board_views")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BoardView records from the query.
+func (q boardViewQuery) All(ctx context.Context, exec boil.ContextExecutor) (BoardViewSlice, error) {
+	var o []*BoardView
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to BoardView slice")
+	}
+
+	if len(boardViewAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BoardView records in the query.
+func (q boardViewQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count board_views rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q boardViewQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if board_views exists")
+	}
+
+	return count > 0, nil
+}
+
+// Board pointed to by the foreign key.
+func (o *BoardView) Board(mods ...qm.QueryMod) boardQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BoardID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Boards(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *BoardView) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadBoard allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardViewL) LoadBoard(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardView interface{}, mods queries.Applicator) error {
+	var slice []*BoardView
+	var object *BoardView
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardView.(*BoardView)
+		if !ok {
+			object = new(BoardView)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardView)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardView))
+			}
+		}
+	} else {
+		s, ok := maybeBoardView.(*[]*BoardView)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardView)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardView))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardViewR{}
+		}
+		args[object.BoardID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardViewR{}
+			}
+
+			args[obj.BoardID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`boards`),
+		qm.WhereIn(`boards.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`boards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Board")
+	}
+
+	var resultSlice []*Board
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Board")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+	}
+
+	if len(boardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Board = foreign
+		if foreign.R == nil {
+			foreign.R = &boardR{}
+		}
+		foreign.R.BoardViews = append(foreign.R.BoardViews, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BoardID == foreign.ID {
+				local.R.Board = foreign
+				if foreign.R == nil {
+					foreign.R = &boardR{}
+				}
+				foreign.R.BoardViews = append(foreign.R.BoardViews, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardViewL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoardView interface{}, mods queries.Applicator) error {
+	var slice []*BoardView
+	var object *BoardView
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoardView.(*BoardView)
+		if !ok {
+			object = new(BoardView)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoardView)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoardView))
+			}
+		}
+	} else {
+		s, ok := maybeBoardView.(*[]*BoardView)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoardView)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoardView))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardViewR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardViewR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.BoardViews = append(foreign.R.BoardViews, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.BoardViews = append(foreign.R.BoardViews, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBoard of the boardView to the related item.
+// Sets o.R.Board to related.
+// Adds o to related.R.BoardViews.
+func (o *BoardView) SetBoard(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Board) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_views\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardViewPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.BoardID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BoardID = related.ID
+	if o.R == nil {
+		o.R = &boardViewR{
+			Board: related,
+		}
+	} else {
+		o.R.Board = related
+	}
+
+	if related.R == nil {
+		related.R = &boardR{
+			BoardViews: BoardViewSlice{o},
+		}
+	} else {
+		related.R.BoardViews = append(related.R.BoardViews, o)
+	}
+
+	return nil
+}
+
+// SetUser of the boardView to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.BoardViews.
+func (o *BoardView) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"board_views\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, boardViewPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID, o.BoardID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &boardViewR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			BoardViews: BoardViewSlice{o},
+		}
+	} else {
+		related.R.BoardViews = append(related.R.BoardViews, o)
+	}
+
+	return nil
+}
+
+// BoardViews retrieves all the records using an executor.
+func BoardViews(mods ...qm.QueryMod) boardViewQuery {
+	mods = append(mods, qm.From("\"board_views\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"board_views\".*"})
+	}
+
+	return boardViewQuery{q}
+}
+
+// FindBoardView retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBoardView(ctx context.Context, exec boil.ContextExecutor, userID string, boardID string, selectCols ...string) (*BoardView, error) {
+	boardViewObj := &BoardView{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"board_views\" where \"user_id\"=$1 AND \"board_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, boardID)
+
+	err := q.Bind(ctx, exec, boardViewObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from board_views")
+	}
+
+	if err = boardViewObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return boardViewObj, err
+	}
+
+	return boardViewObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BoardView) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_views provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardViewColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	boardViewInsertCacheMut.RLock()
+	cache, cached := boardViewInsertCache[key]
+	boardViewInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			boardViewAllColumns,
+			boardViewColumnsWithDefault,
+			boardViewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(boardViewType, boardViewMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(boardViewType, boardViewMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"board_views\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"board_views\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into board_views")
+	}
+
+	if !cached {
+		boardViewInsertCacheMut.Lock()
+		boardViewInsertCache[key] = cache
+		boardViewInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BoardView.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BoardView) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	boardViewUpdateCacheMut.RLock()
+	cache, cached := boardViewUpdateCache[key]
+	boardViewUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			boardViewAllColumns,
+			boardViewPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update board_views, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"board_views\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, boardViewPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(boardViewType, boardViewMapping, append(wl, boardViewPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update board_views row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for board_views")
+	}
+
+	if !cached {
+		boardViewUpdateCacheMut.Lock()
+		boardViewUpdateCache[key] = cache
+		boardViewUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q boardViewQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for board_views")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for board_views")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BoardViewSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardViewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"board_views\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, boardViewPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in boardView slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all boardView")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *BoardView) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no board_views provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(boardViewColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	boardViewUpsertCacheMut.RLock()
+	cache, cached := boardViewUpsertCache[key]
+	boardViewUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			boardViewAllColumns,
+			boardViewColumnsWithDefault,
+			boardViewColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			boardViewAllColumns,
+			boardViewPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert board_views, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(boardViewAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(boardViewPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert board_views, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(boardViewPrimaryKeyColumns))
+			copy(conflict, boardViewPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"board_views\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(boardViewType, boardViewMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(boardViewType, boardViewMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert board_views")
+	}
+
+	if !cached {
+		boardViewUpsertCacheMut.Lock()
+		boardViewUpsertCache[key] = cache
+		boardViewUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single BoardView record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BoardView) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no BoardView provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), boardViewPrimaryKeyMapping)
+	sql := "DELETE FROM \"board_views\" WHERE \"user_id\"=$1 AND \"board_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from board_views")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for board_views")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q boardViewQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no boardViewQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from board_views")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_views")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BoardViewSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(boardViewBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardViewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"board_views\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardViewPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from boardView slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for board_views")
+	}
+
+	if len(boardViewAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BoardView) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBoardView(ctx, exec, o.UserID, o.BoardID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BoardViewSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BoardViewSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), boardViewPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"board_views\".* FROM \"board_views\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, boardViewPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in BoardViewSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BoardViewExists checks if the BoardView row exists.
+func BoardViewExists(ctx context.Context, exec boil.ContextExecutor, userID string, boardID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"board_views\" where \"user_id\"=$1 AND \"board_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, boardID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, boardID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if board_views exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the BoardView row exists.
+func (o *BoardView) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BoardViewExists(ctx, exec, o.UserID, o.BoardID)
+}
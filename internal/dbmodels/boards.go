@@ -18,6 +18,7 @@ import (
 	"github.com/aarondl/sqlboiler/v4/queries"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/sqlboiler/v4/types"
 	"github.com/aarondl/strmangle"
 	"github.com/friendsofgo/errors"
 )
@@ -32,6 +33,20 @@ type Board struct {
 	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Workspace that owns the board; NULL for a personal board
+	WorkspaceID null.String `boil:"workspace_id" json:"workspace_id,omitempty" toml:"workspace_id" yaml:"workspace_id,omitempty"`
+	// Incremented by triggers on every change to the board, its lists, cards, labels and members
+	Version int64 `boil:"version" json:"version" toml:"version" yaml:"version"`
+	// When the board was archived; archived boards stay visible but read-only
+	ArchivedAt null.Time `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	// User who archived the board
+	ArchivedBy null.String `boil:"archived_by" json:"archived_by,omitempty" toml:"archived_by" yaml:"archived_by,omitempty"`
+	// User who moved the board to the trash
+	DeletedBy null.String `boil:"deleted_by" json:"deleted_by,omitempty" toml:"deleted_by" yaml:"deleted_by,omitempty"`
+	// Board settings: visibility (private, workspace or public), default_priority of new cards, card_key_prefix, background_color, background_image (upload ID) and comments_enabled
+	Settings types.JSON `boil:"settings" json:"settings" toml:"settings" yaml:"settings"`
+	// Last number given to a card of the board, incremented by a trigger on every new card
+	CardCounter int64 `boil:"card_counter" json:"card_counter" toml:"card_counter" yaml:"card_counter"`
 
 	R *boardR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L boardL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +61,13 @@ var BoardColumns = struct {
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
+	WorkspaceID string
+	Version     string
+	ArchivedAt  string
+	ArchivedBy  string
+	DeletedBy   string
+	Settings    string
+	CardCounter string
 }{
 	ID:          "id",
 	Name:        "name",
@@ -55,6 +77,13 @@ var BoardColumns = struct {
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
 	DeletedAt:   "deleted_at",
+	WorkspaceID: "workspace_id",
+	Version:     "version",
+	ArchivedAt:  "archived_at",
+	ArchivedBy:  "archived_by",
+	DeletedBy:   "deleted_by",
+	Settings:    "settings",
+	CardCounter: "card_counter",
 }
 
 var BoardTableColumns = struct {
@@ -66,6 +95,13 @@ var BoardTableColumns = struct {
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
+	WorkspaceID string
+	Version     string
+	ArchivedAt  string
+	ArchivedBy  string
+	DeletedBy   string
+	Settings    string
+	CardCounter string
 }{
 	ID:          "boards.id",
 	Name:        "boards.name",
@@ -75,87 +111,33 @@ var BoardTableColumns = struct {
 	CreatedAt:   "boards.created_at",
 	UpdatedAt:   "boards.updated_at",
 	DeletedAt:   "boards.deleted_at",
+	WorkspaceID: "boards.workspace_id",
+	Version:     "boards.version",
+	ArchivedAt:  "boards.archived_at",
+	ArchivedBy:  "boards.archived_by",
+	DeletedBy:   "boards.deleted_by",
+	Settings:    "boards.settings",
+	CardCounter: "boards.card_counter",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod      { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod    { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod   { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod   { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) SIMILAR(x string) qm.QueryMod { return qm.Where(w.field+" SIMILAR TO ?", x) }
-func (w whereHelperstring) NSIMILAR(x string) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
-}
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
+type whereHelperint64 struct{ field string }
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
-}
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
-}
-func (w whereHelpernull_String) SIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" SIMILAR TO ?", x)
-}
-func (w whereHelpernull_String) NSIMILAR(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT SIMILAR TO ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -163,54 +145,6 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-type whereHelpernull_Time struct{ field string }
-
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var BoardWhere = struct {
 	ID          whereHelperstring
 	Name        whereHelperstring
@@ -220,6 +154,13 @@ var BoardWhere = struct {
 	CreatedAt   whereHelpertime_Time
 	UpdatedAt   whereHelpertime_Time
 	DeletedAt   whereHelpernull_Time
+	WorkspaceID whereHelpernull_String
+	Version     whereHelperint64
+	ArchivedAt  whereHelpernull_Time
+	ArchivedBy  whereHelpernull_String
+	DeletedBy   whereHelpernull_String
+	Settings    whereHelpertypes_JSON
+	CardCounter whereHelperint64
 }{
 	ID:          whereHelperstring{field: "\"boards\".\"id\""},
 	Name:        whereHelperstring{field: "\"boards\".\"name\""},
@@ -229,11 +170,28 @@ var BoardWhere = struct {
 	CreatedAt:   whereHelpertime_Time{field: "\"boards\".\"created_at\""},
 	UpdatedAt:   whereHelpertime_Time{field: "\"boards\".\"updated_at\""},
 	DeletedAt:   whereHelpernull_Time{field: "\"boards\".\"deleted_at\""},
+	WorkspaceID: whereHelpernull_String{field: "\"boards\".\"workspace_id\""},
+	Version:     whereHelperint64{field: "\"boards\".\"version\""},
+	ArchivedAt:  whereHelpernull_Time{field: "\"boards\".\"archived_at\""},
+	ArchivedBy:  whereHelpernull_String{field: "\"boards\".\"archived_by\""},
+	DeletedBy:   whereHelpernull_String{field: "\"boards\".\"deleted_by\""},
+	Settings:    whereHelpertypes_JSON{field: "\"boards\".\"settings\""},
+	CardCounter: whereHelperint64{field: "\"boards\".\"card_counter\""},
 }
 
 // BoardRels is where relationship names are stored.
 var BoardRels = struct {
+	ArchivedByUser         string
 	CreatedByUser          string
+	DeletedByUser          string
+	Workspace              string
+	BoardShareLink         string
+	BoardImports           string
+	BoardInvitations       string
+	BoardMembers           string
+	BoardStars             string
+	BoardTeamGrants        string
+	BoardViews             string
 	Cards                  string
 	Labels                 string
 	Lists                  string
@@ -242,7 +200,17 @@ var BoardRels = struct {
 	RebalanceEvents        string
 	RebalanceJobs          string
 }{
+	ArchivedByUser:         "ArchivedByUser",
 	CreatedByUser:          "CreatedByUser",
+	DeletedByUser:          "DeletedByUser",
+	Workspace:              "Workspace",
+	BoardShareLink:         "BoardShareLink",
+	BoardImports:           "BoardImports",
+	BoardInvitations:       "BoardInvitations",
+	BoardMembers:           "BoardMembers",
+	BoardStars:             "BoardStars",
+	BoardTeamGrants:        "BoardTeamGrants",
+	BoardViews:             "BoardViews",
 	Cards:                  "Cards",
 	Labels:                 "Labels",
 	Lists:                  "Lists",
@@ -254,7 +222,17 @@ var BoardRels = struct {
 
 // boardR is where relationships are stored.
 type boardR struct {
+	ArchivedByUser         *User                      `boil:"ArchivedByUser" json:"ArchivedByUser" toml:"ArchivedByUser" yaml:"ArchivedByUser"`
 	CreatedByUser          *User                      `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	DeletedByUser          *User                      `boil:"DeletedByUser" json:"DeletedByUser" toml:"DeletedByUser" yaml:"DeletedByUser"`
+	Workspace              *Workspace                 `boil:"Workspace" json:"Workspace" toml:"Workspace" yaml:"Workspace"`
+	BoardShareLink         *BoardShareLink            `boil:"BoardShareLink" json:"BoardShareLink" toml:"BoardShareLink" yaml:"BoardShareLink"`
+	BoardImports           BoardImportSlice           `boil:"BoardImports" json:"BoardImports" toml:"BoardImports" yaml:"BoardImports"`
+	BoardInvitations       BoardInvitationSlice       `boil:"BoardInvitations" json:"BoardInvitations" toml:"BoardInvitations" yaml:"BoardInvitations"`
+	BoardMembers           BoardMemberSlice           `boil:"BoardMembers" json:"BoardMembers" toml:"BoardMembers" yaml:"BoardMembers"`
+	BoardStars             BoardStarSlice             `boil:"BoardStars" json:"BoardStars" toml:"BoardStars" yaml:"BoardStars"`
+	BoardTeamGrants        BoardTeamGrantSlice        `boil:"BoardTeamGrants" json:"BoardTeamGrants" toml:"BoardTeamGrants" yaml:"BoardTeamGrants"`
+	BoardViews             BoardViewSlice             `boil:"BoardViews" json:"BoardViews" toml:"BoardViews" yaml:"BoardViews"`
 	Cards                  CardSlice                  `boil:"Cards" json:"Cards" toml:"Cards" yaml:"Cards"`
 	Labels                 LabelSlice                 `boil:"Labels" json:"Labels" toml:"Labels" yaml:"Labels"`
 	Lists                  ListSlice                  `boil:"Lists" json:"Lists" toml:"Lists" yaml:"Lists"`
@@ -269,6 +247,22 @@ func (*boardR) NewStruct() *boardR {
 	return &boardR{}
 }
 
+func (o *Board) GetArchivedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetArchivedByUser()
+}
+
+func (r *boardR) GetArchivedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.ArchivedByUser
+}
+
 func (o *Board) GetCreatedByUser() *User {
 	if o == nil {
 		return nil
@@ -285,6 +279,150 @@ func (r *boardR) GetCreatedByUser() *User {
 	return r.CreatedByUser
 }
 
+func (o *Board) GetDeletedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDeletedByUser()
+}
+
+func (r *boardR) GetDeletedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.DeletedByUser
+}
+
+func (o *Board) GetWorkspace() *Workspace {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWorkspace()
+}
+
+func (r *boardR) GetWorkspace() *Workspace {
+	if r == nil {
+		return nil
+	}
+
+	return r.Workspace
+}
+
+func (o *Board) GetBoardShareLink() *BoardShareLink {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardShareLink()
+}
+
+func (r *boardR) GetBoardShareLink() *BoardShareLink {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardShareLink
+}
+
+func (o *Board) GetBoardImports() BoardImportSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardImports()
+}
+
+func (r *boardR) GetBoardImports() BoardImportSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardImports
+}
+
+func (o *Board) GetBoardInvitations() BoardInvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardInvitations()
+}
+
+func (r *boardR) GetBoardInvitations() BoardInvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardInvitations
+}
+
+func (o *Board) GetBoardMembers() BoardMemberSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardMembers()
+}
+
+func (r *boardR) GetBoardMembers() BoardMemberSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardMembers
+}
+
+func (o *Board) GetBoardStars() BoardStarSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardStars()
+}
+
+func (r *boardR) GetBoardStars() BoardStarSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardStars
+}
+
+func (o *Board) GetBoardTeamGrants() BoardTeamGrantSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardTeamGrants()
+}
+
+func (r *boardR) GetBoardTeamGrants() BoardTeamGrantSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardTeamGrants
+}
+
+func (o *Board) GetBoardViews() BoardViewSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetBoardViews()
+}
+
+func (r *boardR) GetBoardViews() BoardViewSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.BoardViews
+}
+
 func (o *Board) GetCards() CardSlice {
 	if o == nil {
 		return nil
@@ -401,9 +539,9 @@ func (r *boardR) GetRebalanceJobs() RebalanceJobSlice {
 type boardL struct{}
 
 var (
-	boardAllColumns            = []string{"id", "name", "alias", "description", "created_by", "created_at", "updated_at", "deleted_at"}
+	boardAllColumns            = []string{"id", "name", "alias", "description", "created_by", "created_at", "updated_at", "deleted_at", "workspace_id", "version", "archived_at", "archived_by", "deleted_by", "settings", "card_counter"}
 	boardColumnsWithoutDefault = []string{"name"}
-	boardColumnsWithDefault    = []string{"id", "alias", "description", "created_by", "created_at", "updated_at", "deleted_at"}
+	boardColumnsWithDefault    = []string{"id", "alias", "description", "created_by", "created_at", "updated_at", "deleted_at", "workspace_id", "version", "archived_at", "archived_by", "deleted_by", "settings", "card_counter"}
 	boardPrimaryKeyColumns     = []string{"id"}
 	boardGeneratedColumns      = []string{}
 )
@@ -713,6 +851,17 @@ func (q boardQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// ArchivedByUser pointed to by the foreign key.
+func (o *Board) ArchivedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ArchivedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // CreatedByUser pointed to by the foreign key.
 func (o *Board) CreatedByUser(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -724,6 +873,123 @@ func (o *Board) CreatedByUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// DeletedByUser pointed to by the foreign key.
+func (o *Board) DeletedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.DeletedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Workspace pointed to by the foreign key.
+func (o *Board) Workspace(mods ...qm.QueryMod) workspaceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WorkspaceID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Workspaces(queryMods...)
+}
+
+// BoardShareLink pointed to by the foreign key.
+func (o *Board) BoardShareLink(mods ...qm.QueryMod) boardShareLinkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"board_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return BoardShareLinks(queryMods...)
+}
+
+// BoardImports retrieves all the board_import's BoardImports with an executor.
+func (o *Board) BoardImports(mods ...qm.QueryMod) boardImportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_imports\".\"board_id\"=?", o.ID),
+	)
+
+	return BoardImports(queryMods...)
+}
+
+// BoardInvitations retrieves all the board_invitation's BoardInvitations with an executor.
+func (o *Board) BoardInvitations(mods ...qm.QueryMod) boardInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_invitations\".\"board_id\"=?", o.ID),
+	)
+
+	return BoardInvitations(queryMods...)
+}
+
+// BoardMembers retrieves all the board_member's BoardMembers with an executor.
+func (o *Board) BoardMembers(mods ...qm.QueryMod) boardMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_members\".\"board_id\"=?", o.ID),
+	)
+
+	return BoardMembers(queryMods...)
+}
+
+// BoardStars retrieves all the board_star's BoardStars with an executor.
+func (o *Board) BoardStars(mods ...qm.QueryMod) boardStarQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_stars\".\"board_id\"=?", o.ID),
+	)
+
+	return BoardStars(queryMods...)
+}
+
+// BoardTeamGrants retrieves all the board_team_grant's BoardTeamGrants with an executor.
+func (o *Board) BoardTeamGrants(mods ...qm.QueryMod) boardTeamGrantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_team_grants\".\"board_id\"=?", o.ID),
+	)
+
+	return BoardTeamGrants(queryMods...)
+}
+
+// BoardViews retrieves all the board_view's BoardViews with an executor.
+func (o *Board) BoardViews(mods ...qm.QueryMod) boardViewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_views\".\"board_id\"=?", o.ID),
+	)
+
+	return BoardViews(queryMods...)
+}
+
 // Cards retrieves all the card's Cards with an executor.
 func (o *Board) Cards(mods ...qm.QueryMod) cardQuery {
 	var queryMods []qm.QueryMod
@@ -822,9 +1088,9 @@ func (o *Board) RebalanceJobs(mods ...qm.QueryMod) rebalanceJobQuery {
 	return RebalanceJobs(queryMods...)
 }
 
-// LoadCreatedByUser allows an eager lookup of values, cached into the
+// LoadArchivedByUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (boardL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+func (boardL) LoadArchivedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -855,8 +1121,8 @@ func (boardL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, sin
 		if object.R == nil {
 			object.R = &boardR{}
 		}
-		if !queries.IsNil(object.CreatedBy) {
-			args[object.CreatedBy] = struct{}{}
+		if !queries.IsNil(object.ArchivedBy) {
+			args[object.ArchivedBy] = struct{}{}
 		}
 
 	} else {
@@ -865,8 +1131,8 @@ func (boardL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, sin
 				obj.R = &boardR{}
 			}
 
-			if !queries.IsNil(obj.CreatedBy) {
-				args[obj.CreatedBy] = struct{}{}
+			if !queries.IsNil(obj.ArchivedBy) {
+				args[obj.ArchivedBy] = struct{}{}
 			}
 
 		}
@@ -923,22 +1189,22 @@ func (boardL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, sin
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.CreatedByUser = foreign
+		object.R.ArchivedByUser = foreign
 		if foreign.R == nil {
 			foreign.R = &userR{}
 		}
-		foreign.R.CreatedByBoards = append(foreign.R.CreatedByBoards, object)
+		foreign.R.ArchivedByBoards = append(foreign.R.ArchivedByBoards, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if queries.Equal(local.CreatedBy, foreign.ID) {
-				local.R.CreatedByUser = foreign
+			if queries.Equal(local.ArchivedBy, foreign.ID) {
+				local.R.ArchivedByUser = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
 				}
-				foreign.R.CreatedByBoards = append(foreign.R.CreatedByBoards, local)
+				foreign.R.ArchivedByBoards = append(foreign.R.ArchivedByBoards, local)
 				break
 			}
 		}
@@ -947,9 +1213,9 @@ func (boardL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// LoadCards allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -980,13 +1246,20 @@ func (boardL) LoadCards(ctx context.Context, e boil.ContextExecutor, singular bo
 		if object.R == nil {
 			object.R = &boardR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.CreatedBy) {
+			args[object.CreatedBy] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &boardR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.CreatedBy) {
+				args[obj.CreatedBy] = struct{}{}
+			}
+
 		}
 	}
 
@@ -1002,9 +1275,9 @@ func (boardL) LoadCards(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`cards`),
-		qm.WhereIn(`cards.board_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`cards.deleted_at`),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1012,47 +1285,51 @@ func (boardL) LoadCards(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load cards")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*Card
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on cards")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(cardAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Cards = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &cardR{}
-			}
-			foreign.R.Board = object
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
 		}
+		foreign.R.CreatedByBoards = append(foreign.R.CreatedByBoards, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.BoardID {
-				local.R.Cards = append(local.R.Cards, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.CreatedBy, foreign.ID) {
+				local.R.CreatedByUser = foreign
 				if foreign.R == nil {
-					foreign.R = &cardR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Board = local
+				foreign.R.CreatedByBoards = append(foreign.R.CreatedByBoards, local)
 				break
 			}
 		}
@@ -1061,9 +1338,9 @@ func (boardL) LoadCards(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadLabels allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+// LoadDeletedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardL) LoadDeletedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -1094,13 +1371,20 @@ func (boardL) LoadLabels(ctx context.Context, e boil.ContextExecutor, singular b
 		if object.R == nil {
 			object.R = &boardR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.DeletedBy) {
+			args[object.DeletedBy] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &boardR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.DeletedBy) {
+				args[obj.DeletedBy] = struct{}{}
+			}
+
 		}
 	}
 
@@ -1116,9 +1400,9 @@ func (boardL) LoadLabels(ctx context.Context, e boil.ContextExecutor, singular b
 	}
 
 	query := NewQuery(
-		qm.From(`labels`),
-		qm.WhereIn(`labels.board_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`labels.deleted_at`),
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1126,47 +1410,51 @@ func (boardL) LoadLabels(ctx context.Context, e boil.ContextExecutor, singular b
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load labels")
+		return errors.Wrap(err, "failed to eager load User")
 	}
 
-	var resultSlice []*Label
+	var resultSlice []*User
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on labels")
+		return errors.Wrap(err, "failed to close results of eager load for users")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
 	}
 
-	if len(labelAfterSelectHooks) != 0 {
+	if len(userAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Labels = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &labelR{}
-			}
-			foreign.R.Board = object
+		foreign := resultSlice[0]
+		object.R.DeletedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
 		}
+		foreign.R.DeletedByBoards = append(foreign.R.DeletedByBoards, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.BoardID {
-				local.R.Labels = append(local.R.Labels, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.DeletedBy, foreign.ID) {
+				local.R.DeletedByUser = foreign
 				if foreign.R == nil {
-					foreign.R = &labelR{}
+					foreign.R = &userR{}
 				}
-				foreign.R.Board = local
+				foreign.R.DeletedByBoards = append(foreign.R.DeletedByBoards, local)
 				break
 			}
 		}
@@ -1175,9 +1463,9 @@ func (boardL) LoadLabels(ctx context.Context, e boil.ContextExecutor, singular b
 	return nil
 }
 
-// LoadLists allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+// LoadWorkspace allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (boardL) LoadWorkspace(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -1208,13 +1496,20 @@ func (boardL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 		if object.R == nil {
 			object.R = &boardR{}
 		}
-		args[object.ID] = struct{}{}
+		if !queries.IsNil(object.WorkspaceID) {
+			args[object.WorkspaceID] = struct{}{}
+		}
+
 	} else {
 		for _, obj := range slice {
 			if obj.R == nil {
 				obj.R = &boardR{}
 			}
-			args[obj.ID] = struct{}{}
+
+			if !queries.IsNil(obj.WorkspaceID) {
+				args[obj.WorkspaceID] = struct{}{}
+			}
+
 		}
 	}
 
@@ -1230,9 +1525,9 @@ func (boardL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`lists`),
-		qm.WhereIn(`lists.board_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`lists.deleted_at`),
+		qm.From(`workspaces`),
+		qm.WhereIn(`workspaces.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`workspaces.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1240,47 +1535,51 @@ func (boardL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load lists")
+		return errors.Wrap(err, "failed to eager load Workspace")
 	}
 
-	var resultSlice []*List
+	var resultSlice []*Workspace
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice lists")
+		return errors.Wrap(err, "failed to bind eager loaded slice Workspace")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on lists")
+		return errors.Wrap(err, "failed to close results of eager load for workspaces")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workspaces")
 	}
 
-	if len(listAfterSelectHooks) != 0 {
+	if len(workspaceAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.Lists = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &listR{}
-			}
-			foreign.R.Board = object
+		foreign := resultSlice[0]
+		object.R.Workspace = foreign
+		if foreign.R == nil {
+			foreign.R = &workspaceR{}
 		}
+		foreign.R.Boards = append(foreign.R.Boards, object)
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.BoardID {
-				local.R.Lists = append(local.R.Lists, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.WorkspaceID, foreign.ID) {
+				local.R.Workspace = foreign
 				if foreign.R == nil {
-					foreign.R = &listR{}
+					foreign.R = &workspaceR{}
 				}
-				foreign.R.Board = local
+				foreign.R.Boards = append(foreign.R.Boards, local)
 				break
 			}
 		}
@@ -1289,9 +1588,9 @@ func (boardL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadPositionStatistics allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadPositionStatistics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+// LoadBoardShareLink allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (boardL) LoadBoardShareLink(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -1328,6 +1627,7 @@ func (boardL) LoadPositionStatistics(ctx context.Context, e boil.ContextExecutor
 			if obj.R == nil {
 				obj.R = &boardR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -1344,8 +1644,8 @@ func (boardL) LoadPositionStatistics(ctx context.Context, e boil.ContextExecutor
 	}
 
 	query := NewQuery(
-		qm.From(`position_statistics`),
-		qm.WhereIn(`position_statistics.board_id in ?`, argsSlice...),
+		qm.From(`board_share_links`),
+		qm.WhereIn(`board_share_links.board_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1353,45 +1653,48 @@ func (boardL) LoadPositionStatistics(ctx context.Context, e boil.ContextExecutor
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load position_statistics")
+		return errors.Wrap(err, "failed to eager load BoardShareLink")
 	}
 
-	var resultSlice []*PositionStatistic
+	var resultSlice []*BoardShareLink
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice position_statistics")
+		return errors.Wrap(err, "failed to bind eager loaded slice BoardShareLink")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on position_statistics")
+		return errors.Wrap(err, "failed to close results of eager load for board_share_links")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for position_statistics")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_share_links")
 	}
 
-	if len(positionStatisticAfterSelectHooks) != 0 {
+	if len(boardShareLinkAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.PositionStatistics = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &positionStatisticR{}
-			}
-			foreign.R.Board = object
+		foreign := resultSlice[0]
+		object.R.BoardShareLink = foreign
+		if foreign.R == nil {
+			foreign.R = &boardShareLinkR{}
 		}
-		return nil
+		foreign.R.Board = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.BoardID) {
-				local.R.PositionStatistics = append(local.R.PositionStatistics, foreign)
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.BoardID {
+				local.R.BoardShareLink = foreign
 				if foreign.R == nil {
-					foreign.R = &positionStatisticR{}
+					foreign.R = &boardShareLinkR{}
 				}
 				foreign.R.Board = local
 				break
@@ -1402,9 +1705,9 @@ func (boardL) LoadPositionStatistics(ctx context.Context, e boil.ContextExecutor
 	return nil
 }
 
-// LoadPositionValidationLogs allows an eager lookup of values, cached into the
+// LoadBoardImports allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+func (boardL) LoadBoardImports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -1457,8 +1760,8 @@ func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExec
 	}
 
 	query := NewQuery(
-		qm.From(`position_validation_log`),
-		qm.WhereIn(`position_validation_log.board_id in ?`, argsSlice...),
+		qm.From(`board_imports`),
+		qm.WhereIn(`board_imports.board_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1466,22 +1769,22 @@ func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExec
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load position_validation_log")
+		return errors.Wrap(err, "failed to eager load board_imports")
 	}
 
-	var resultSlice []*PositionValidationLog
+	var resultSlice []*BoardImport
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice position_validation_log")
+		return errors.Wrap(err, "failed to bind eager loaded slice board_imports")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on position_validation_log")
+		return errors.Wrap(err, "failed to close results in eager load on board_imports")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for position_validation_log")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_imports")
 	}
 
-	if len(positionValidationLogAfterSelectHooks) != 0 {
+	if len(boardImportAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1489,10 +1792,10 @@ func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExec
 		}
 	}
 	if singular {
-		object.R.PositionValidationLogs = resultSlice
+		object.R.BoardImports = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &positionValidationLogR{}
+				foreign.R = &boardImportR{}
 			}
 			foreign.R.Board = object
 		}
@@ -1502,9 +1805,9 @@ func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExec
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if queries.Equal(local.ID, foreign.BoardID) {
-				local.R.PositionValidationLogs = append(local.R.PositionValidationLogs, foreign)
+				local.R.BoardImports = append(local.R.BoardImports, foreign)
 				if foreign.R == nil {
-					foreign.R = &positionValidationLogR{}
+					foreign.R = &boardImportR{}
 				}
 				foreign.R.Board = local
 				break
@@ -1515,9 +1818,9 @@ func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
-// LoadRebalanceEvents allows an eager lookup of values, cached into the
+// LoadBoardInvitations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+func (boardL) LoadBoardInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -1570,8 +1873,8 @@ func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`rebalance_events`),
-		qm.WhereIn(`rebalance_events.board_id in ?`, argsSlice...),
+		qm.From(`board_invitations`),
+		qm.WhereIn(`board_invitations.board_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1579,22 +1882,22 @@ func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load rebalance_events")
+		return errors.Wrap(err, "failed to eager load board_invitations")
 	}
 
-	var resultSlice []*RebalanceEvent
+	var resultSlice []*BoardInvitation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice rebalance_events")
+		return errors.Wrap(err, "failed to bind eager loaded slice board_invitations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on rebalance_events")
+		return errors.Wrap(err, "failed to close results in eager load on board_invitations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rebalance_events")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_invitations")
 	}
 
-	if len(rebalanceEventAfterSelectHooks) != 0 {
+	if len(boardInvitationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1602,10 +1905,10 @@ func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, s
 		}
 	}
 	if singular {
-		object.R.RebalanceEvents = resultSlice
+		object.R.BoardInvitations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &rebalanceEventR{}
+				foreign.R = &boardInvitationR{}
 			}
 			foreign.R.Board = object
 		}
@@ -1614,10 +1917,10 @@ func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, s
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.BoardID) {
-				local.R.RebalanceEvents = append(local.R.RebalanceEvents, foreign)
+			if local.ID == foreign.BoardID {
+				local.R.BoardInvitations = append(local.R.BoardInvitations, foreign)
 				if foreign.R == nil {
-					foreign.R = &rebalanceEventR{}
+					foreign.R = &boardInvitationR{}
 				}
 				foreign.R.Board = local
 				break
@@ -1628,9 +1931,9 @@ func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
-// LoadRebalanceJobs allows an eager lookup of values, cached into the
+// LoadBoardMembers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+func (boardL) LoadBoardMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
 	var slice []*Board
 	var object *Board
 
@@ -1683,8 +1986,8 @@ func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`rebalance_jobs`),
-		qm.WhereIn(`rebalance_jobs.board_id in ?`, argsSlice...),
+		qm.From(`board_members`),
+		qm.WhereIn(`board_members.board_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1692,22 +1995,22 @@ func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load rebalance_jobs")
+		return errors.Wrap(err, "failed to eager load board_members")
 	}
 
-	var resultSlice []*RebalanceJob
+	var resultSlice []*BoardMember
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice rebalance_jobs")
+		return errors.Wrap(err, "failed to bind eager loaded slice board_members")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on rebalance_jobs")
+		return errors.Wrap(err, "failed to close results in eager load on board_members")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rebalance_jobs")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_members")
 	}
 
-	if len(rebalanceJobAfterSelectHooks) != 0 {
+	if len(boardMemberAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1715,10 +2018,10 @@ func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.RebalanceJobs = resultSlice
+		object.R.BoardMembers = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &rebalanceJobR{}
+				foreign.R = &boardMemberR{}
 			}
 			foreign.R.Board = object
 		}
@@ -1727,10 +2030,10 @@ func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, sin
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.BoardID) {
-				local.R.RebalanceJobs = append(local.R.RebalanceJobs, foreign)
+			if local.ID == foreign.BoardID {
+				local.R.BoardMembers = append(local.R.BoardMembers, foreign)
 				if foreign.R == nil {
-					foreign.R = &rebalanceJobR{}
+					foreign.R = &boardMemberR{}
 				}
 				foreign.R.Board = local
 				break
@@ -1741,82 +2044,1897 @@ func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, sin
 	return nil
 }
 
-// SetCreatedByUser of the board to the related item.
-// Sets o.R.CreatedByUser to related.
-// Adds o to related.R.CreatedByBoards.
-func (o *Board) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
-
-	updateQuery := fmt.Sprintf(
+// LoadBoardStars allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadBoardStars(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_stars`),
+		qm.WhereIn(`board_stars.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_stars")
+	}
+
+	var resultSlice []*BoardStar
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_stars")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_stars")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_stars")
+	}
+
+	if len(boardStarAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardStars = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardStarR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BoardID {
+				local.R.BoardStars = append(local.R.BoardStars, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardStarR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoardTeamGrants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadBoardTeamGrants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_team_grants`),
+		qm.WhereIn(`board_team_grants.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_team_grants")
+	}
+
+	var resultSlice []*BoardTeamGrant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_team_grants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_team_grants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_team_grants")
+	}
+
+	if len(boardTeamGrantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardTeamGrants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardTeamGrantR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BoardID {
+				local.R.BoardTeamGrants = append(local.R.BoardTeamGrants, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardTeamGrantR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoardViews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadBoardViews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_views`),
+		qm.WhereIn(`board_views.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_views")
+	}
+
+	var resultSlice []*BoardView
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_views")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_views")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_views")
+	}
+
+	if len(boardViewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardViews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardViewR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BoardID {
+				local.R.BoardViews = append(local.R.BoardViews, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardViewR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`cards`),
+		qm.WhereIn(`cards.board_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`cards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load cards")
+	}
+
+	var resultSlice []*Card
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on cards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+	}
+
+	if len(cardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Cards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &cardR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BoardID {
+				local.R.Cards = append(local.R.Cards, foreign)
+				if foreign.R == nil {
+					foreign.R = &cardR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLabels allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`labels`),
+		qm.WhereIn(`labels.board_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`labels.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load labels")
+	}
+
+	var resultSlice []*Label
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on labels")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+	}
+
+	if len(labelAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Labels = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &labelR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BoardID {
+				local.R.Labels = append(local.R.Labels, foreign)
+				if foreign.R == nil {
+					foreign.R = &labelR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.board_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`lists.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load lists")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice lists")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Lists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BoardID {
+				local.R.Lists = append(local.R.Lists, foreign)
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPositionStatistics allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadPositionStatistics(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`position_statistics`),
+		qm.WhereIn(`position_statistics.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load position_statistics")
+	}
+
+	var resultSlice []*PositionStatistic
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice position_statistics")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on position_statistics")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for position_statistics")
+	}
+
+	if len(positionStatisticAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PositionStatistics = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &positionStatisticR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BoardID) {
+				local.R.PositionStatistics = append(local.R.PositionStatistics, foreign)
+				if foreign.R == nil {
+					foreign.R = &positionStatisticR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPositionValidationLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadPositionValidationLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`position_validation_log`),
+		qm.WhereIn(`position_validation_log.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load position_validation_log")
+	}
+
+	var resultSlice []*PositionValidationLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice position_validation_log")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on position_validation_log")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for position_validation_log")
+	}
+
+	if len(positionValidationLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PositionValidationLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &positionValidationLogR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BoardID) {
+				local.R.PositionValidationLogs = append(local.R.PositionValidationLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &positionValidationLogR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRebalanceEvents allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadRebalanceEvents(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`rebalance_events`),
+		qm.WhereIn(`rebalance_events.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rebalance_events")
+	}
+
+	var resultSlice []*RebalanceEvent
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rebalance_events")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rebalance_events")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rebalance_events")
+	}
+
+	if len(rebalanceEventAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RebalanceEvents = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rebalanceEventR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BoardID) {
+				local.R.RebalanceEvents = append(local.R.RebalanceEvents, foreign)
+				if foreign.R == nil {
+					foreign.R = &rebalanceEventR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRebalanceJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (boardL) LoadRebalanceJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBoard interface{}, mods queries.Applicator) error {
+	var slice []*Board
+	var object *Board
+
+	if singular {
+		var ok bool
+		object, ok = maybeBoard.(*Board)
+		if !ok {
+			object = new(Board)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBoard))
+			}
+		}
+	} else {
+		s, ok := maybeBoard.(*[]*Board)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBoard)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBoard))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &boardR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &boardR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`rebalance_jobs`),
+		qm.WhereIn(`rebalance_jobs.board_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rebalance_jobs")
+	}
+
+	var resultSlice []*RebalanceJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rebalance_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rebalance_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rebalance_jobs")
+	}
+
+	if len(rebalanceJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RebalanceJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rebalanceJobR{}
+			}
+			foreign.R.Board = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.BoardID) {
+				local.R.RebalanceJobs = append(local.R.RebalanceJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &rebalanceJobR{}
+				}
+				foreign.R.Board = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArchivedByUser of the board to the related item.
+// Sets o.R.ArchivedByUser to related.
+// Adds o to related.R.ArchivedByBoards.
+func (o *Board) SetArchivedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"boards\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by"}),
+		strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ArchivedBy, related.ID)
+	if o.R == nil {
+		o.R = &boardR{
+			ArchivedByUser: related,
+		}
+	} else {
+		o.R.ArchivedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ArchivedByBoards: BoardSlice{o},
+		}
+	} else {
+		related.R.ArchivedByBoards = append(related.R.ArchivedByBoards, o)
+	}
+
+	return nil
+}
+
+// RemoveArchivedByUser relationship.
+// Sets o.R.ArchivedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Board) RemoveArchivedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ArchivedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("archived_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ArchivedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ArchivedByBoards {
+		if queries.Equal(o.ArchivedBy, ri.ArchivedBy) {
+			continue
+		}
+
+		ln := len(related.R.ArchivedByBoards)
+		if ln > 1 && i < ln-1 {
+			related.R.ArchivedByBoards[i] = related.R.ArchivedByBoards[ln-1]
+		}
+		related.R.ArchivedByBoards = related.R.ArchivedByBoards[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCreatedByUser of the board to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByBoards.
+func (o *Board) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"boards\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.CreatedBy, related.ID)
+	if o.R == nil {
+		o.R = &boardR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByBoards: BoardSlice{o},
+		}
+	} else {
+		related.R.CreatedByBoards = append(related.R.CreatedByBoards, o)
+	}
+
+	return nil
+}
+
+// RemoveCreatedByUser relationship.
+// Sets o.R.CreatedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Board) RemoveCreatedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.CreatedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.CreatedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.CreatedByBoards {
+		if queries.Equal(o.CreatedBy, ri.CreatedBy) {
+			continue
+		}
+
+		ln := len(related.R.CreatedByBoards)
+		if ln > 1 && i < ln-1 {
+			related.R.CreatedByBoards[i] = related.R.CreatedByBoards[ln-1]
+		}
+		related.R.CreatedByBoards = related.R.CreatedByBoards[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetDeletedByUser of the board to the related item.
+// Sets o.R.DeletedByUser to related.
+// Adds o to related.R.DeletedByBoards.
+func (o *Board) SetDeletedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
 		"UPDATE \"boards\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.SetParamNames("\"", "\"", 1, []string{"deleted_by"}),
+		strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.DeletedBy, related.ID)
+	if o.R == nil {
+		o.R = &boardR{
+			DeletedByUser: related,
+		}
+	} else {
+		o.R.DeletedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			DeletedByBoards: BoardSlice{o},
+		}
+	} else {
+		related.R.DeletedByBoards = append(related.R.DeletedByBoards, o)
+	}
+
+	return nil
+}
+
+// RemoveDeletedByUser relationship.
+// Sets o.R.DeletedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Board) RemoveDeletedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.DeletedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("deleted_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.DeletedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.DeletedByBoards {
+		if queries.Equal(o.DeletedBy, ri.DeletedBy) {
+			continue
+		}
+
+		ln := len(related.R.DeletedByBoards)
+		if ln > 1 && i < ln-1 {
+			related.R.DeletedByBoards[i] = related.R.DeletedByBoards[ln-1]
+		}
+		related.R.DeletedByBoards = related.R.DeletedByBoards[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetWorkspace of the board to the related item.
+// Sets o.R.Workspace to related.
+// Adds o to related.R.Boards.
+func (o *Board) SetWorkspace(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Workspace) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"boards\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"workspace_id"}),
 		strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
 	)
 	values := []interface{}{related.ID, o.ID}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.WorkspaceID, related.ID)
+	if o.R == nil {
+		o.R = &boardR{
+			Workspace: related,
+		}
+	} else {
+		o.R.Workspace = related
+	}
+
+	if related.R == nil {
+		related.R = &workspaceR{
+			Boards: BoardSlice{o},
+		}
+	} else {
+		related.R.Boards = append(related.R.Boards, o)
+	}
+
+	return nil
+}
+
+// RemoveWorkspace relationship.
+// Sets o.R.Workspace to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Board) RemoveWorkspace(ctx context.Context, exec boil.ContextExecutor, related *Workspace) error {
+	var err error
+
+	queries.SetScanner(&o.WorkspaceID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("workspace_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Workspace = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Boards {
+		if queries.Equal(o.WorkspaceID, ri.WorkspaceID) {
+			continue
+		}
+
+		ln := len(related.R.Boards)
+		if ln > 1 && i < ln-1 {
+			related.R.Boards[i] = related.R.Boards[ln-1]
+		}
+		related.R.Boards = related.R.Boards[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetBoardShareLink of the board to the related item.
+// Sets o.R.BoardShareLink to related.
+// Adds o to related.R.Board.
+func (o *Board) SetBoardShareLink(ctx context.Context, exec boil.ContextExecutor, insert bool, related *BoardShareLink) error {
+	var err error
+
+	if insert {
+		related.BoardID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"board_share_links\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+			strmangle.WhereClause("\"", "\"", 2, boardShareLinkPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.BoardID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &boardR{
+			BoardShareLink: related,
+		}
+	} else {
+		o.R.BoardShareLink = related
+	}
+
+	if related.R == nil {
+		related.R = &boardShareLinkR{
+			Board: o,
+		}
+	} else {
+		related.R.Board = o
+	}
+	return nil
+}
+
+// AddBoardImports adds the given related objects to the existing relationships
+// of the board, optionally inserting them as new records.
+// Appends related to o.R.BoardImports.
+// Sets related.R.Board appropriately.
+func (o *Board) AddBoardImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardImport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.BoardID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_imports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardImportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.BoardID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &boardR{
+			BoardImports: related,
+		}
+	} else {
+		o.R.BoardImports = append(o.R.BoardImports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardImportR{
+				Board: o,
+			}
+		} else {
+			rel.R.Board = o
+		}
+	}
+	return nil
+}
+
+// SetBoardImports removes all previously related items of the
+// board replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Board's BoardImports accordingly.
+// Replaces o.R.BoardImports with related.
+// Sets related.R.Board's BoardImports accordingly.
+func (o *Board) SetBoardImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardImport) error {
+	query := "update \"board_imports\" set \"board_id\" = null where \"board_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.BoardImports {
+			queries.SetScanner(&rel.BoardID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Board = nil
+		}
+		o.R.BoardImports = nil
+	}
+
+	return o.AddBoardImports(ctx, exec, insert, related...)
+}
+
+// RemoveBoardImports relationships from objects passed in.
+// Removes related items from R.BoardImports (uses pointer comparison, removal does not keep order)
+// Sets related.R.Board.
+func (o *Board) RemoveBoardImports(ctx context.Context, exec boil.ContextExecutor, related ...*BoardImport) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.BoardID, nil)
+		if rel.R != nil {
+			rel.R.Board = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("board_id")); err != nil {
+			return err
+		}
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.BoardImports {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.BoardImports)
+			if ln > 1 && i < ln-1 {
+				o.R.BoardImports[i] = o.R.BoardImports[ln-1]
+			}
+			o.R.BoardImports = o.R.BoardImports[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddBoardInvitations adds the given related objects to the existing relationships
+// of the board, optionally inserting them as new records.
+// Appends related to o.R.BoardInvitations.
+// Sets related.R.Board appropriately.
+func (o *Board) AddBoardInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BoardID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BoardID = o.ID
+		}
 	}
 
-	queries.Assign(&o.CreatedBy, related.ID)
 	if o.R == nil {
 		o.R = &boardR{
-			CreatedByUser: related,
+			BoardInvitations: related,
 		}
 	} else {
-		o.R.CreatedByUser = related
+		o.R.BoardInvitations = append(o.R.BoardInvitations, related...)
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			CreatedByBoards: BoardSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardInvitationR{
+				Board: o,
+			}
+		} else {
+			rel.R.Board = o
+		}
+	}
+	return nil
+}
+
+// AddBoardMembers adds the given related objects to the existing relationships
+// of the board, optionally inserting them as new records.
+// Appends related to o.R.BoardMembers.
+// Sets related.R.Board appropriately.
+func (o *Board) AddBoardMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BoardID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BoardID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &boardR{
+			BoardMembers: related,
 		}
 	} else {
-		related.R.CreatedByBoards = append(related.R.CreatedByBoards, o)
+		o.R.BoardMembers = append(o.R.BoardMembers, related...)
 	}
 
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardMemberR{
+				Board: o,
+			}
+		} else {
+			rel.R.Board = o
+		}
+	}
 	return nil
 }
 
-// RemoveCreatedByUser relationship.
-// Sets o.R.CreatedByUser to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *Board) RemoveCreatedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+// AddBoardStars adds the given related objects to the existing relationships
+// of the board, optionally inserting them as new records.
+// Appends related to o.R.BoardStars.
+// Sets related.R.Board appropriately.
+func (o *Board) AddBoardStars(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardStar) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BoardID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_stars\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardStarPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.BoardID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BoardID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &boardR{
+			BoardStars: related,
+		}
+	} else {
+		o.R.BoardStars = append(o.R.BoardStars, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardStarR{
+				Board: o,
+			}
+		} else {
+			rel.R.Board = o
+		}
+	}
+	return nil
+}
+
+// AddBoardTeamGrants adds the given related objects to the existing relationships
+// of the board, optionally inserting them as new records.
+// Appends related to o.R.BoardTeamGrants.
+// Sets related.R.Board appropriately.
+func (o *Board) AddBoardTeamGrants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardTeamGrant) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BoardID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_team_grants\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardTeamGrantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.CreatedBy, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BoardID = o.ID
+		}
 	}
 
-	if o.R != nil {
-		o.R.CreatedByUser = nil
+	if o.R == nil {
+		o.R = &boardR{
+			BoardTeamGrants: related,
+		}
+	} else {
+		o.R.BoardTeamGrants = append(o.R.BoardTeamGrants, related...)
 	}
-	if related == nil || related.R == nil {
-		return nil
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardTeamGrantR{
+				Board: o,
+			}
+		} else {
+			rel.R.Board = o
+		}
 	}
+	return nil
+}
 
-	for i, ri := range related.R.CreatedByBoards {
-		if queries.Equal(o.CreatedBy, ri.CreatedBy) {
-			continue
+// AddBoardViews adds the given related objects to the existing relationships
+// of the board, optionally inserting them as new records.
+// Appends related to o.R.BoardViews.
+// Sets related.R.Board appropriately.
+func (o *Board) AddBoardViews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardView) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BoardID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_views\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"board_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardViewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.BoardID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BoardID = o.ID
 		}
+	}
 
-		ln := len(related.R.CreatedByBoards)
-		if ln > 1 && i < ln-1 {
-			related.R.CreatedByBoards[i] = related.R.CreatedByBoards[ln-1]
+	if o.R == nil {
+		o.R = &boardR{
+			BoardViews: related,
+		}
+	} else {
+		o.R.BoardViews = append(o.R.BoardViews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardViewR{
+				Board: o,
+			}
+		} else {
+			rel.R.Board = o
 		}
-		related.R.CreatedByBoards = related.R.CreatedByBoards[:ln-1]
-		break
 	}
 	return nil
 }
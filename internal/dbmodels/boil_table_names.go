@@ -4,33 +4,89 @@
 package dbmodels
 
 var TableNames = struct {
+	BoardAuditLogs        string
+	BoardImports          string
+	BoardInvitations      string
+	BoardMembers          string
+	BoardShareLinks       string
+	BoardStars            string
+	BoardTeamGrants       string
+	BoardTemplates        string
+	BoardViews            string
 	Boards                string
 	CardActivities        string
 	Cards                 string
 	Comments              string
+	EmailVerifications    string
 	Labels                string
 	Lists                 string
+	LoginAttempts         string
+	LoginLockouts         string
+	MfaRecoveryCodes      string
 	MigrationProgress     string
+	OidcLoginStates       string
+	PasswordResets        string
+	Permissions           string
+	PersonalAccessTokens  string
 	PositionStatistics    string
 	PositionValidationLog string
 	RebalanceEvents       string
 	RebalanceJobs         string
+	RefreshTokens         string
+	RevokedTokens         string
+	RolePermissions       string
 	Roles                 string
+	Sessions              string
+	TeamMembers           string
+	Teams                 string
 	Uploads               string
+	UserIdentities        string
+	UserMfa               string
+	UserTokenRevocations  string
 	Users                 string
+	WorkspaceMembers      string
+	Workspaces            string
 }{
+	BoardAuditLogs:        "board_audit_logs",
+	BoardImports:          "board_imports",
+	BoardInvitations:      "board_invitations",
+	BoardMembers:          "board_members",
+	BoardShareLinks:       "board_share_links",
+	BoardStars:            "board_stars",
+	BoardTeamGrants:       "board_team_grants",
+	BoardTemplates:        "board_templates",
+	BoardViews:            "board_views",
 	Boards:                "boards",
 	CardActivities:        "card_activities",
 	Cards:                 "cards",
 	Comments:              "comments",
+	EmailVerifications:    "email_verifications",
 	Labels:                "labels",
 	Lists:                 "lists",
+	LoginAttempts:         "login_attempts",
+	LoginLockouts:         "login_lockouts",
+	MfaRecoveryCodes:      "mfa_recovery_codes",
 	MigrationProgress:     "migration_progress",
+	OidcLoginStates:       "oidc_login_states",
+	PasswordResets:        "password_resets",
+	Permissions:           "permissions",
+	PersonalAccessTokens:  "personal_access_tokens",
 	PositionStatistics:    "position_statistics",
 	PositionValidationLog: "position_validation_log",
 	RebalanceEvents:       "rebalance_events",
 	RebalanceJobs:         "rebalance_jobs",
+	RefreshTokens:         "refresh_tokens",
+	RevokedTokens:         "revoked_tokens",
+	RolePermissions:       "role_permissions",
 	Roles:                 "roles",
+	Sessions:              "sessions",
+	TeamMembers:           "team_members",
+	Teams:                 "teams",
 	Uploads:               "uploads",
+	UserIdentities:        "user_identities",
+	UserMfa:               "user_mfa",
+	UserTokenRevocations:  "user_token_revocations",
 	Users:                 "users",
+	WorkspaceMembers:      "workspace_members",
+	Workspaces:            "workspaces",
 }
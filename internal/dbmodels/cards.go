@@ -30,7 +30,7 @@ type Card struct {
 	// Board this card belongs to - provides direct reference without joining through lists
 	BoardID string `boil:"board_id" json:"board_id" toml:"board_id" yaml:"board_id"`
 	Name    string `boil:"name" json:"name" toml:"name" yaml:"name"`
-	// Short, memorable identifier for the card (e.g., PROJ-123, BUG-001)
+	// Key of the card, the card key prefix of the board and the number of the card (e.g., PROJ-123, BUG-001). Set on insert and never changed
 	Alias       null.String `boil:"alias" json:"alias,omitempty" toml:"alias" yaml:"alias,omitempty"`
 	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
 	// Card position using fractional indexing - supports large values up to 99999999999999.999999
@@ -63,6 +63,8 @@ type Card struct {
 	CreatedAt  time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt  time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
 	DeletedAt  null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Sequential number of the card on its board, set by a trigger on insert
+	Number int64 `boil:"number" json:"number" toml:"number" yaml:"number"`
 
 	R *cardR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L cardL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -94,6 +96,7 @@ var CardColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	DeletedAt      string
+	Number         string
 }{
 	ID:             "id",
 	ListID:         "list_id",
@@ -120,6 +123,7 @@ var CardColumns = struct {
 	CreatedAt:      "created_at",
 	UpdatedAt:      "updated_at",
 	DeletedAt:      "deleted_at",
+	Number:         "number",
 }
 
 var CardTableColumns = struct {
@@ -148,6 +152,7 @@ var CardTableColumns = struct {
 	CreatedAt      string
 	UpdatedAt      string
 	DeletedAt      string
+	Number         string
 }{
 	ID:             "cards.id",
 	ListID:         "cards.list_id",
@@ -174,6 +179,7 @@ var CardTableColumns = struct {
 	CreatedAt:      "cards.created_at",
 	UpdatedAt:      "cards.updated_at",
 	DeletedAt:      "cards.deleted_at",
+	Number:         "cards.number",
 }
 
 // Generated where
@@ -265,15 +271,6 @@ func (w whereHelpertypes_NullDecimal) IsNotNull() qm.QueryMod {
 	return qmhelper.WhereIsNotNull(w.field)
 }
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
 var CardWhere = struct {
 	ID             whereHelperstring
 	ListID         whereHelperstring
@@ -300,6 +297,7 @@ var CardWhere = struct {
 	CreatedAt      whereHelpertime_Time
 	UpdatedAt      whereHelpertime_Time
 	DeletedAt      whereHelpernull_Time
+	Number         whereHelperint64
 }{
 	ID:             whereHelperstring{field: "\"cards\".\"id\""},
 	ListID:         whereHelperstring{field: "\"cards\".\"list_id\""},
@@ -326,6 +324,7 @@ var CardWhere = struct {
 	CreatedAt:      whereHelpertime_Time{field: "\"cards\".\"created_at\""},
 	UpdatedAt:      whereHelpertime_Time{field: "\"cards\".\"updated_at\""},
 	DeletedAt:      whereHelpernull_Time{field: "\"cards\".\"deleted_at\""},
+	Number:         whereHelperint64{field: "\"cards\".\"number\""},
 }
 
 // CardRels is where relationship names are stored.
@@ -479,8 +478,8 @@ func (r *cardR) GetComments() CommentSlice {
 type cardL struct{}
 
 var (
-	cardAllColumns            = []string{"id", "list_id", "board_id", "name", "alias", "description", "position", "due_date", "start_date", "completion_date", "last_activity_at", "priority", "labels", "tags", "assigned_to", "created_by", "updated_by", "estimated_hours", "actual_hours", "attachments", "checklist", "is_archived", "created_at", "updated_at", "deleted_at"}
-	cardColumnsWithoutDefault = []string{"list_id", "board_id", "name", "position"}
+	cardAllColumns            = []string{"id", "list_id", "board_id", "name", "alias", "description", "position", "due_date", "start_date", "completion_date", "last_activity_at", "priority", "labels", "tags", "assigned_to", "created_by", "updated_by", "estimated_hours", "actual_hours", "attachments", "checklist", "is_archived", "created_at", "updated_at", "deleted_at", "number"}
+	cardColumnsWithoutDefault = []string{"list_id", "board_id", "name", "position", "number"}
 	cardColumnsWithDefault    = []string{"id", "alias", "description", "due_date", "start_date", "completion_date", "last_activity_at", "priority", "labels", "tags", "assigned_to", "created_by", "updated_by", "estimated_hours", "actual_hours", "attachments", "checklist", "is_archived", "created_at", "updated_at", "deleted_at"}
 	cardPrimaryKeyColumns     = []string{"id"}
 	cardGeneratedColumns      = []string{}
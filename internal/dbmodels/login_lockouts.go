@@ -0,0 +1,1175 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// LoginLockout is an object representing the database table.
+type LoginLockout struct {
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// Failures since the last successful login or the last lockout
+	FailedAttempts int `boil:"failed_attempts" json:"failed_attempts" toml:"failed_attempts" yaml:"failed_attempts"`
+	// Logins are refused until this time
+	LockedUntil null.Time `boil:"locked_until" json:"locked_until,omitempty" toml:"locked_until" yaml:"locked_until,omitempty"`
+	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *loginLockoutR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L loginLockoutL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LoginLockoutColumns = struct {
+	UserID         string
+	FailedAttempts string
+	LockedUntil    string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	UserID:         "user_id",
+	FailedAttempts: "failed_attempts",
+	LockedUntil:    "locked_until",
+	CreatedAt:      "created_at",
+	UpdatedAt:      "updated_at",
+}
+
+var LoginLockoutTableColumns = struct {
+	UserID         string
+	FailedAttempts string
+	LockedUntil    string
+	CreatedAt      string
+	UpdatedAt      string
+}{
+	UserID:         "login_lockouts.user_id",
+	FailedAttempts: "login_lockouts.failed_attempts",
+	LockedUntil:    "login_lockouts.locked_until",
+	CreatedAt:      "login_lockouts.created_at",
+	UpdatedAt:      "login_lockouts.updated_at",
+}
+
+// Generated where
+
+var LoginLockoutWhere = struct {
+	UserID         whereHelperstring
+	FailedAttempts whereHelperint
+	LockedUntil    whereHelpernull_Time
+	CreatedAt      whereHelpertime_Time
+	UpdatedAt      whereHelpertime_Time
+}{
+	UserID:         whereHelperstring{field: "\"login_lockouts\".\"user_id\""},
+	FailedAttempts: whereHelperint{field: "\"login_lockouts\".\"failed_attempts\""},
+	LockedUntil:    whereHelpernull_Time{field: "\"login_lockouts\".\"locked_until\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"login_lockouts\".\"created_at\""},
+	UpdatedAt:      whereHelpertime_Time{field: "\"login_lockouts\".\"updated_at\""},
+}
+
+// LoginLockoutRels is where relationship names are stored.
+var LoginLockoutRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// loginLockoutR is where relationships are stored.
+type loginLockoutR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*loginLockoutR) NewStruct() *loginLockoutR {
+	return &loginLockoutR{}
+}
+
+func (o *LoginLockout) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *loginLockoutR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// loginLockoutL is where Load methods for each relationship are stored.
+type loginLockoutL struct{}
+
+var (
+	loginLockoutAllColumns            = []string{"user_id", "failed_attempts", "locked_until", "created_at", "updated_at"}
+	loginLockoutColumnsWithoutDefault = []string{"user_id"}
+	loginLockoutColumnsWithDefault    = []string{"failed_attempts", "locked_until", "created_at", "updated_at"}
+	loginLockoutPrimaryKeyColumns     = []string{"user_id"}
+	loginLockoutGeneratedColumns      = []string{}
+)
+
+type (
+	// LoginLockoutSlice is an alias for a slice of pointers to LoginLockout.
+	// This should almost always be used instead of []LoginLockout.
+	LoginLockoutSlice []*LoginLockout
+	// LoginLockoutHook is the signature for custom LoginLockout hook methods
+	LoginLockoutHook func(context.Context, boil.ContextExecutor, *LoginLockout) error
+
+	loginLockoutQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	loginLockoutType                 = reflect.TypeOf(&LoginLockout{})
+	loginLockoutMapping              = queries.MakeStructMapping(loginLockoutType)
+	loginLockoutPrimaryKeyMapping, _ = queries.BindMapping(loginLockoutType, loginLockoutMapping, loginLockoutPrimaryKeyColumns)
+	loginLockoutInsertCacheMut       sync.RWMutex
+	loginLockoutInsertCache          = make(map[string]insertCache)
+	loginLockoutUpdateCacheMut       sync.RWMutex
+	loginLockoutUpdateCache          = make(map[string]updateCache)
+	loginLockoutUpsertCacheMut       sync.RWMutex
+	loginLockoutUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var loginLockoutAfterSelectMu sync.Mutex
+var loginLockoutAfterSelectHooks []LoginLockoutHook
+
+var loginLockoutBeforeInsertMu sync.Mutex
+var loginLockoutBeforeInsertHooks []LoginLockoutHook
+var loginLockoutAfterInsertMu sync.Mutex
+var loginLockoutAfterInsertHooks []LoginLockoutHook
+
+var loginLockoutBeforeUpdateMu sync.Mutex
+var loginLockoutBeforeUpdateHooks []LoginLockoutHook
+var loginLockoutAfterUpdateMu sync.Mutex
+var loginLockoutAfterUpdateHooks []LoginLockoutHook
+
+var loginLockoutBeforeDeleteMu sync.Mutex
+var loginLockoutBeforeDeleteHooks []LoginLockoutHook
+var loginLockoutAfterDeleteMu sync.Mutex
+var loginLockoutAfterDeleteHooks []LoginLockoutHook
+
+var loginLockoutBeforeUpsertMu sync.Mutex
+var loginLockoutBeforeUpsertHooks []LoginLockoutHook
+var loginLockoutAfterUpsertMu sync.Mutex
+var loginLockoutAfterUpsertHooks []LoginLockoutHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LoginLockout) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LoginLockout) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LoginLockout) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LoginLockout) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LoginLockout) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LoginLockout) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LoginLockout) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LoginLockout) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LoginLockout) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range loginLockoutAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLoginLockoutHook registers your hook function for all future operations.
+func AddLoginLockoutHook(hookPoint boil.HookPoint, loginLockoutHook LoginLockoutHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		loginLockoutAfterSelectMu.Lock()
+		loginLockoutAfterSelectHooks = append(loginLockoutAfterSelectHooks, loginLockoutHook)
+		loginLockoutAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		loginLockoutBeforeInsertMu.Lock()
+		loginLockoutBeforeInsertHooks = append(loginLockoutBeforeInsertHooks, loginLockoutHook)
+		loginLockoutBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		loginLockoutAfterInsertMu.Lock()
+		loginLockoutAfterInsertHooks = append(loginLockoutAfterInsertHooks, loginLockoutHook)
+		loginLockoutAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		loginLockoutBeforeUpdateMu.Lock()
+		loginLockoutBeforeUpdateHooks = append(loginLockoutBeforeUpdateHooks, loginLockoutHook)
+		loginLockoutBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		loginLockoutAfterUpdateMu.Lock()
+		loginLockoutAfterUpdateHooks = append(loginLockoutAfterUpdateHooks, loginLockoutHook)
+		loginLockoutAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		loginLockoutBeforeDeleteMu.Lock()
+		loginLockoutBeforeDeleteHooks = append(loginLockoutBeforeDeleteHooks, loginLockoutHook)
+		loginLockoutBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		loginLockoutAfterDeleteMu.Lock()
+		loginLockoutAfterDeleteHooks = append(loginLockoutAfterDeleteHooks, loginLockoutHook)
+		loginLockoutAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		loginLockoutBeforeUpsertMu.Lock()
+		loginLockoutBeforeUpsertHooks = append(loginLockoutBeforeUpsertHooks, loginLockoutHook)
+		loginLockoutBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		loginLockoutAfterUpsertMu.Lock()
+		loginLockoutAfterUpsertHooks = append(loginLockoutAfterUpsertHooks, loginLockoutHook)
+		loginLockoutAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single loginLockout record from the query.
+func (q loginLockoutQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LoginLockout, error) {
+	o := &LoginLockout{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for login_lockouts")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all LoginLockout records from the query.
+func (q loginLockoutQuery) All(ctx context.Context, exec boil.ContextExecutor) (LoginLockoutSlice, error) {
+	var o []*LoginLockout
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to LoginLockout slice")
+	}
+
+	if len(loginLockoutAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all LoginLockout records in the query.
+func (q loginLockoutQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count login_lockouts rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q loginLockoutQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if login_lockouts exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *LoginLockout) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (loginLockoutL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLoginLockout interface{}, mods queries.Applicator) error {
+	var slice []*LoginLockout
+	var object *LoginLockout
+
+	if singular {
+		var ok bool
+		object, ok = maybeLoginLockout.(*LoginLockout)
+		if !ok {
+			object = new(LoginLockout)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLoginLockout)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLoginLockout))
+			}
+		}
+	} else {
+		s, ok := maybeLoginLockout.(*[]*LoginLockout)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLoginLockout)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLoginLockout))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &loginLockoutR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &loginLockoutR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.LoginLockout = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.LoginLockout = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the loginLockout to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.LoginLockout.
+func (o *LoginLockout) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"login_lockouts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, loginLockoutPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &loginLockoutR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			LoginLockout: o,
+		}
+	} else {
+		related.R.LoginLockout = o
+	}
+
+	return nil
+}
+
+// LoginLockouts retrieves all the records using an executor.
+func LoginLockouts(mods ...qm.QueryMod) loginLockoutQuery {
+	mods = append(mods, qm.From("\"login_lockouts\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"login_lockouts\".*"})
+	}
+
+	return loginLockoutQuery{q}
+}
+
+// FindLoginLockout retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLoginLockout(ctx context.Context, exec boil.ContextExecutor, userID string, selectCols ...string) (*LoginLockout, error) {
+	loginLockoutObj := &LoginLockout{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"login_lockouts\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, loginLockoutObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from login_lockouts")
+	}
+
+	if err = loginLockoutObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return loginLockoutObj, err
+	}
+
+	return loginLockoutObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LoginLockout) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no login_lockouts provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(loginLockoutColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	loginLockoutInsertCacheMut.RLock()
+	cache, cached := loginLockoutInsertCache[key]
+	loginLockoutInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			loginLockoutAllColumns,
+			loginLockoutColumnsWithDefault,
+			loginLockoutColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(loginLockoutType, loginLockoutMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(loginLockoutType, loginLockoutMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"login_lockouts\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"login_lockouts\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into login_lockouts")
+	}
+
+	if !cached {
+		loginLockoutInsertCacheMut.Lock()
+		loginLockoutInsertCache[key] = cache
+		loginLockoutInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the LoginLockout.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LoginLockout) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	loginLockoutUpdateCacheMut.RLock()
+	cache, cached := loginLockoutUpdateCache[key]
+	loginLockoutUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			loginLockoutAllColumns,
+			loginLockoutPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update login_lockouts, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"login_lockouts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, loginLockoutPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(loginLockoutType, loginLockoutMapping, append(wl, loginLockoutPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update login_lockouts row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for login_lockouts")
+	}
+
+	if !cached {
+		loginLockoutUpdateCacheMut.Lock()
+		loginLockoutUpdateCache[key] = cache
+		loginLockoutUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q loginLockoutQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for login_lockouts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for login_lockouts")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LoginLockoutSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), loginLockoutPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"login_lockouts\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, loginLockoutPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in loginLockout slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all loginLockout")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LoginLockout) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no login_lockouts provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(loginLockoutColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	loginLockoutUpsertCacheMut.RLock()
+	cache, cached := loginLockoutUpsertCache[key]
+	loginLockoutUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			loginLockoutAllColumns,
+			loginLockoutColumnsWithDefault,
+			loginLockoutColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			loginLockoutAllColumns,
+			loginLockoutPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert login_lockouts, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(loginLockoutAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(loginLockoutPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert login_lockouts, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(loginLockoutPrimaryKeyColumns))
+			copy(conflict, loginLockoutPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"login_lockouts\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(loginLockoutType, loginLockoutMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(loginLockoutType, loginLockoutMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert login_lockouts")
+	}
+
+	if !cached {
+		loginLockoutUpsertCacheMut.Lock()
+		loginLockoutUpsertCache[key] = cache
+		loginLockoutUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single LoginLockout record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LoginLockout) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no LoginLockout provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), loginLockoutPrimaryKeyMapping)
+	sql := "DELETE FROM \"login_lockouts\" WHERE \"user_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from login_lockouts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for login_lockouts")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q loginLockoutQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no loginLockoutQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from login_lockouts")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for login_lockouts")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LoginLockoutSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(loginLockoutBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), loginLockoutPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"login_lockouts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, loginLockoutPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from loginLockout slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for login_lockouts")
+	}
+
+	if len(loginLockoutAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LoginLockout) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLoginLockout(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LoginLockoutSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LoginLockoutSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), loginLockoutPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"login_lockouts\".* FROM \"login_lockouts\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, loginLockoutPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in LoginLockoutSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LoginLockoutExists checks if the LoginLockout row exists.
+func LoginLockoutExists(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"login_lockouts\" where \"user_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if login_lockouts exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LoginLockout row exists.
+func (o *LoginLockout) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LoginLockoutExists(ctx, exec, o.UserID)
+}
@@ -0,0 +1,1164 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// MfaRecoveryCode is an object representing the database table.
+type MfaRecoveryCode struct {
+	ID     string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// SHA-256 hex digest of the recovery code
+	CodeHash  string    `boil:"code_hash" json:"code_hash" toml:"code_hash" yaml:"code_hash"`
+	UsedAt    null.Time `boil:"used_at" json:"used_at,omitempty" toml:"used_at" yaml:"used_at,omitempty"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *mfaRecoveryCodeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L mfaRecoveryCodeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var MfaRecoveryCodeColumns = struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	UsedAt    string
+	CreatedAt string
+}{
+	ID:        "id",
+	UserID:    "user_id",
+	CodeHash:  "code_hash",
+	UsedAt:    "used_at",
+	CreatedAt: "created_at",
+}
+
+var MfaRecoveryCodeTableColumns = struct {
+	ID        string
+	UserID    string
+	CodeHash  string
+	UsedAt    string
+	CreatedAt string
+}{
+	ID:        "mfa_recovery_codes.id",
+	UserID:    "mfa_recovery_codes.user_id",
+	CodeHash:  "mfa_recovery_codes.code_hash",
+	UsedAt:    "mfa_recovery_codes.used_at",
+	CreatedAt: "mfa_recovery_codes.created_at",
+}
+
+// Generated where
+
+var MfaRecoveryCodeWhere = struct {
+	ID        whereHelperstring
+	UserID    whereHelperstring
+	CodeHash  whereHelperstring
+	UsedAt    whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"mfa_recovery_codes\".\"id\""},
+	UserID:    whereHelperstring{field: "\"mfa_recovery_codes\".\"user_id\""},
+	CodeHash:  whereHelperstring{field: "\"mfa_recovery_codes\".\"code_hash\""},
+	UsedAt:    whereHelpernull_Time{field: "\"mfa_recovery_codes\".\"used_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"mfa_recovery_codes\".\"created_at\""},
+}
+
+// MfaRecoveryCodeRels is where relationship names are stored.
+var MfaRecoveryCodeRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// mfaRecoveryCodeR is where relationships are stored.
+type mfaRecoveryCodeR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*mfaRecoveryCodeR) NewStruct() *mfaRecoveryCodeR {
+	return &mfaRecoveryCodeR{}
+}
+
+func (o *MfaRecoveryCode) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *mfaRecoveryCodeR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// mfaRecoveryCodeL is where Load methods for each relationship are stored.
+type mfaRecoveryCodeL struct{}
+
+var (
+	mfaRecoveryCodeAllColumns            = []string{"id", "user_id", "code_hash", "used_at", "created_at"}
+	mfaRecoveryCodeColumnsWithoutDefault = []string{"user_id", "code_hash"}
+	mfaRecoveryCodeColumnsWithDefault    = []string{"id", "used_at", "created_at"}
+	mfaRecoveryCodePrimaryKeyColumns     = []string{"id"}
+	mfaRecoveryCodeGeneratedColumns      = []string{}
+)
+
+type (
+	// MfaRecoveryCodeSlice is an alias for a slice of pointers to MfaRecoveryCode.
+	// This should almost always be used instead of []MfaRecoveryCode.
+	MfaRecoveryCodeSlice []*MfaRecoveryCode
+	// MfaRecoveryCodeHook is the signature for custom MfaRecoveryCode hook methods
+	MfaRecoveryCodeHook func(context.Context, boil.ContextExecutor, *MfaRecoveryCode) error
+
+	mfaRecoveryCodeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	mfaRecoveryCodeType                 = reflect.TypeOf(&MfaRecoveryCode{})
+	mfaRecoveryCodeMapping              = queries.MakeStructMapping(mfaRecoveryCodeType)
+	mfaRecoveryCodePrimaryKeyMapping, _ = queries.BindMapping(mfaRecoveryCodeType, mfaRecoveryCodeMapping, mfaRecoveryCodePrimaryKeyColumns)
+	mfaRecoveryCodeInsertCacheMut       sync.RWMutex
+	mfaRecoveryCodeInsertCache          = make(map[string]insertCache)
+	mfaRecoveryCodeUpdateCacheMut       sync.RWMutex
+	mfaRecoveryCodeUpdateCache          = make(map[string]updateCache)
+	mfaRecoveryCodeUpsertCacheMut       sync.RWMutex
+	mfaRecoveryCodeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var mfaRecoveryCodeAfterSelectMu sync.Mutex
+var mfaRecoveryCodeAfterSelectHooks []MfaRecoveryCodeHook
+
+var mfaRecoveryCodeBeforeInsertMu sync.Mutex
+var mfaRecoveryCodeBeforeInsertHooks []MfaRecoveryCodeHook
+var mfaRecoveryCodeAfterInsertMu sync.Mutex
+var mfaRecoveryCodeAfterInsertHooks []MfaRecoveryCodeHook
+
+var mfaRecoveryCodeBeforeUpdateMu sync.Mutex
+var mfaRecoveryCodeBeforeUpdateHooks []MfaRecoveryCodeHook
+var mfaRecoveryCodeAfterUpdateMu sync.Mutex
+var mfaRecoveryCodeAfterUpdateHooks []MfaRecoveryCodeHook
+
+var mfaRecoveryCodeBeforeDeleteMu sync.Mutex
+var mfaRecoveryCodeBeforeDeleteHooks []MfaRecoveryCodeHook
+var mfaRecoveryCodeAfterDeleteMu sync.Mutex
+var mfaRecoveryCodeAfterDeleteHooks []MfaRecoveryCodeHook
+
+var mfaRecoveryCodeBeforeUpsertMu sync.Mutex
+var mfaRecoveryCodeBeforeUpsertHooks []MfaRecoveryCodeHook
+var mfaRecoveryCodeAfterUpsertMu sync.Mutex
+var mfaRecoveryCodeAfterUpsertHooks []MfaRecoveryCodeHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *MfaRecoveryCode) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *MfaRecoveryCode) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *MfaRecoveryCode) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *MfaRecoveryCode) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *MfaRecoveryCode) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *MfaRecoveryCode) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *MfaRecoveryCode) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *MfaRecoveryCode) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *MfaRecoveryCode) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range mfaRecoveryCodeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddMfaRecoveryCodeHook registers your hook function for all future operations.
+func AddMfaRecoveryCodeHook(hookPoint boil.HookPoint, mfaRecoveryCodeHook MfaRecoveryCodeHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		mfaRecoveryCodeAfterSelectMu.Lock()
+		mfaRecoveryCodeAfterSelectHooks = append(mfaRecoveryCodeAfterSelectHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		mfaRecoveryCodeBeforeInsertMu.Lock()
+		mfaRecoveryCodeBeforeInsertHooks = append(mfaRecoveryCodeBeforeInsertHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		mfaRecoveryCodeAfterInsertMu.Lock()
+		mfaRecoveryCodeAfterInsertHooks = append(mfaRecoveryCodeAfterInsertHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		mfaRecoveryCodeBeforeUpdateMu.Lock()
+		mfaRecoveryCodeBeforeUpdateHooks = append(mfaRecoveryCodeBeforeUpdateHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		mfaRecoveryCodeAfterUpdateMu.Lock()
+		mfaRecoveryCodeAfterUpdateHooks = append(mfaRecoveryCodeAfterUpdateHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		mfaRecoveryCodeBeforeDeleteMu.Lock()
+		mfaRecoveryCodeBeforeDeleteHooks = append(mfaRecoveryCodeBeforeDeleteHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		mfaRecoveryCodeAfterDeleteMu.Lock()
+		mfaRecoveryCodeAfterDeleteHooks = append(mfaRecoveryCodeAfterDeleteHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		mfaRecoveryCodeBeforeUpsertMu.Lock()
+		mfaRecoveryCodeBeforeUpsertHooks = append(mfaRecoveryCodeBeforeUpsertHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		mfaRecoveryCodeAfterUpsertMu.Lock()
+		mfaRecoveryCodeAfterUpsertHooks = append(mfaRecoveryCodeAfterUpsertHooks, mfaRecoveryCodeHook)
+		mfaRecoveryCodeAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single mfaRecoveryCode record from the query.
+func (q mfaRecoveryCodeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*MfaRecoveryCode, error) {
+	o := &MfaRecoveryCode{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for mfa_recovery_codes")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all MfaRecoveryCode records from the query.
+func (q mfaRecoveryCodeQuery) All(ctx context.Context, exec boil.ContextExecutor) (MfaRecoveryCodeSlice, error) {
+	var o []*MfaRecoveryCode
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to MfaRecoveryCode slice")
+	}
+
+	if len(mfaRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all MfaRecoveryCode records in the query.
+func (q mfaRecoveryCodeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count mfa_recovery_codes rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q mfaRecoveryCodeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if mfa_recovery_codes exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *MfaRecoveryCode) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (mfaRecoveryCodeL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeMfaRecoveryCode interface{}, mods queries.Applicator) error {
+	var slice []*MfaRecoveryCode
+	var object *MfaRecoveryCode
+
+	if singular {
+		var ok bool
+		object, ok = maybeMfaRecoveryCode.(*MfaRecoveryCode)
+		if !ok {
+			object = new(MfaRecoveryCode)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeMfaRecoveryCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeMfaRecoveryCode))
+			}
+		}
+	} else {
+		s, ok := maybeMfaRecoveryCode.(*[]*MfaRecoveryCode)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeMfaRecoveryCode)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeMfaRecoveryCode))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &mfaRecoveryCodeR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &mfaRecoveryCodeR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.MfaRecoveryCodes = append(foreign.R.MfaRecoveryCodes, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.MfaRecoveryCodes = append(foreign.R.MfaRecoveryCodes, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the mfaRecoveryCode to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.MfaRecoveryCodes.
+func (o *MfaRecoveryCode) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"mfa_recovery_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, mfaRecoveryCodePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &mfaRecoveryCodeR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			MfaRecoveryCodes: MfaRecoveryCodeSlice{o},
+		}
+	} else {
+		related.R.MfaRecoveryCodes = append(related.R.MfaRecoveryCodes, o)
+	}
+
+	return nil
+}
+
+// MfaRecoveryCodes retrieves all the records using an executor.
+func MfaRecoveryCodes(mods ...qm.QueryMod) mfaRecoveryCodeQuery {
+	mods = append(mods, qm.From("\"mfa_recovery_codes\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"mfa_recovery_codes\".*"})
+	}
+
+	return mfaRecoveryCodeQuery{q}
+}
+
+// FindMfaRecoveryCode retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindMfaRecoveryCode(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*MfaRecoveryCode, error) {
+	mfaRecoveryCodeObj := &MfaRecoveryCode{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"mfa_recovery_codes\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, mfaRecoveryCodeObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from mfa_recovery_codes")
+	}
+
+	if err = mfaRecoveryCodeObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return mfaRecoveryCodeObj, err
+	}
+
+	return mfaRecoveryCodeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *MfaRecoveryCode) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no mfa_recovery_codes provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mfaRecoveryCodeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	mfaRecoveryCodeInsertCacheMut.RLock()
+	cache, cached := mfaRecoveryCodeInsertCache[key]
+	mfaRecoveryCodeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			mfaRecoveryCodeAllColumns,
+			mfaRecoveryCodeColumnsWithDefault,
+			mfaRecoveryCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(mfaRecoveryCodeType, mfaRecoveryCodeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(mfaRecoveryCodeType, mfaRecoveryCodeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"mfa_recovery_codes\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"mfa_recovery_codes\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into mfa_recovery_codes")
+	}
+
+	if !cached {
+		mfaRecoveryCodeInsertCacheMut.Lock()
+		mfaRecoveryCodeInsertCache[key] = cache
+		mfaRecoveryCodeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the MfaRecoveryCode.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *MfaRecoveryCode) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	mfaRecoveryCodeUpdateCacheMut.RLock()
+	cache, cached := mfaRecoveryCodeUpdateCache[key]
+	mfaRecoveryCodeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			mfaRecoveryCodeAllColumns,
+			mfaRecoveryCodePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update mfa_recovery_codes, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"mfa_recovery_codes\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, mfaRecoveryCodePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(mfaRecoveryCodeType, mfaRecoveryCodeMapping, append(wl, mfaRecoveryCodePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update mfa_recovery_codes row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for mfa_recovery_codes")
+	}
+
+	if !cached {
+		mfaRecoveryCodeUpdateCacheMut.Lock()
+		mfaRecoveryCodeUpdateCache[key] = cache
+		mfaRecoveryCodeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q mfaRecoveryCodeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for mfa_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for mfa_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o MfaRecoveryCodeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mfaRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"mfa_recovery_codes\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, mfaRecoveryCodePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in mfaRecoveryCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all mfaRecoveryCode")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *MfaRecoveryCode) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no mfa_recovery_codes provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(mfaRecoveryCodeColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	mfaRecoveryCodeUpsertCacheMut.RLock()
+	cache, cached := mfaRecoveryCodeUpsertCache[key]
+	mfaRecoveryCodeUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			mfaRecoveryCodeAllColumns,
+			mfaRecoveryCodeColumnsWithDefault,
+			mfaRecoveryCodeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			mfaRecoveryCodeAllColumns,
+			mfaRecoveryCodePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert mfa_recovery_codes, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(mfaRecoveryCodeAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(mfaRecoveryCodePrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert mfa_recovery_codes, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(mfaRecoveryCodePrimaryKeyColumns))
+			copy(conflict, mfaRecoveryCodePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"mfa_recovery_codes\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(mfaRecoveryCodeType, mfaRecoveryCodeMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(mfaRecoveryCodeType, mfaRecoveryCodeMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert mfa_recovery_codes")
+	}
+
+	if !cached {
+		mfaRecoveryCodeUpsertCacheMut.Lock()
+		mfaRecoveryCodeUpsertCache[key] = cache
+		mfaRecoveryCodeUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single MfaRecoveryCode record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *MfaRecoveryCode) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no MfaRecoveryCode provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), mfaRecoveryCodePrimaryKeyMapping)
+	sql := "DELETE FROM \"mfa_recovery_codes\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from mfa_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for mfa_recovery_codes")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q mfaRecoveryCodeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no mfaRecoveryCodeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from mfa_recovery_codes")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for mfa_recovery_codes")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o MfaRecoveryCodeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(mfaRecoveryCodeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mfaRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"mfa_recovery_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mfaRecoveryCodePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from mfaRecoveryCode slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for mfa_recovery_codes")
+	}
+
+	if len(mfaRecoveryCodeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *MfaRecoveryCode) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindMfaRecoveryCode(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *MfaRecoveryCodeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := MfaRecoveryCodeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), mfaRecoveryCodePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"mfa_recovery_codes\".* FROM \"mfa_recovery_codes\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, mfaRecoveryCodePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in MfaRecoveryCodeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// MfaRecoveryCodeExists checks if the MfaRecoveryCode row exists.
+func MfaRecoveryCodeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"mfa_recovery_codes\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if mfa_recovery_codes exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the MfaRecoveryCode row exists.
+func (o *MfaRecoveryCode) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return MfaRecoveryCodeExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1345 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// RolePermission is an object representing the database table.
+type RolePermission struct {
+	RoleID         string    `boil:"role_id" json:"role_id" toml:"role_id" yaml:"role_id"`
+	PermissionCode string    `boil:"permission_code" json:"permission_code" toml:"permission_code" yaml:"permission_code"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *rolePermissionR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L rolePermissionL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var RolePermissionColumns = struct {
+	RoleID         string
+	PermissionCode string
+	CreatedAt      string
+}{
+	RoleID:         "role_id",
+	PermissionCode: "permission_code",
+	CreatedAt:      "created_at",
+}
+
+var RolePermissionTableColumns = struct {
+	RoleID         string
+	PermissionCode string
+	CreatedAt      string
+}{
+	RoleID:         "role_permissions.role_id",
+	PermissionCode: "role_permissions.permission_code",
+	CreatedAt:      "role_permissions.created_at",
+}
+
+// Generated where
+
+var RolePermissionWhere = struct {
+	RoleID         whereHelperstring
+	PermissionCode whereHelperstring
+	CreatedAt      whereHelpertime_Time
+}{
+	RoleID:         whereHelperstring{field: "\"role_permissions\".\"role_id\""},
+	PermissionCode: whereHelperstring{field: "\"role_permissions\".\"permission_code\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"role_permissions\".\"created_at\""},
+}
+
+// RolePermissionRels is where relationship names are stored.
+var RolePermissionRels = struct {
+	PermissionCodePermission string
+	Role                     string
+}{
+	PermissionCodePermission: "PermissionCodePermission",
+	Role:                     "Role",
+}
+
+// rolePermissionR is where relationships are stored.
+type rolePermissionR struct {
+	PermissionCodePermission *Permission `boil:"PermissionCodePermission" json:"PermissionCodePermission" toml:"PermissionCodePermission" yaml:"PermissionCodePermission"`
+	Role                     *Role       `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+}
+
+// NewStruct creates a new relationship struct
+func (*rolePermissionR) NewStruct() *rolePermissionR {
+	return &rolePermissionR{}
+}
+
+func (o *RolePermission) GetPermissionCodePermission() *Permission {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPermissionCodePermission()
+}
+
+func (r *rolePermissionR) GetPermissionCodePermission() *Permission {
+	if r == nil {
+		return nil
+	}
+
+	return r.PermissionCodePermission
+}
+
+func (o *RolePermission) GetRole() *Role {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRole()
+}
+
+func (r *rolePermissionR) GetRole() *Role {
+	if r == nil {
+		return nil
+	}
+
+	return r.Role
+}
+
+// rolePermissionL is where Load methods for each relationship are stored.
+type rolePermissionL struct{}
+
+var (
+	rolePermissionAllColumns            = []string{"role_id", "permission_code", "created_at"}
+	rolePermissionColumnsWithoutDefault = []string{"role_id", "permission_code"}
+	rolePermissionColumnsWithDefault    = []string{"created_at"}
+	rolePermissionPrimaryKeyColumns     = []string{"role_id", "permission_code"}
+	rolePermissionGeneratedColumns      = []string{}
+)
+
+type (
+	// RolePermissionSlice is an alias for a slice of pointers to RolePermission.
+	// This should almost always be used instead of []RolePermission.
+	RolePermissionSlice []*RolePermission
+	// RolePermissionHook is the signature for custom RolePermission hook methods
+	RolePermissionHook func(context.Context, boil.ContextExecutor, *RolePermission) error
+
+	rolePermissionQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	rolePermissionType                 = reflect.TypeOf(&RolePermission{})
+	rolePermissionMapping              = queries.MakeStructMapping(rolePermissionType)
+	rolePermissionPrimaryKeyMapping, _ = queries.BindMapping(rolePermissionType, rolePermissionMapping, rolePermissionPrimaryKeyColumns)
+	rolePermissionInsertCacheMut       sync.RWMutex
+	rolePermissionInsertCache          = make(map[string]insertCache)
+	rolePermissionUpdateCacheMut       sync.RWMutex
+	rolePermissionUpdateCache          = make(map[string]updateCache)
+	rolePermissionUpsertCacheMut       sync.RWMutex
+	rolePermissionUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var rolePermissionAfterSelectMu sync.Mutex
+var rolePermissionAfterSelectHooks []RolePermissionHook
+
+var rolePermissionBeforeInsertMu sync.Mutex
+var rolePermissionBeforeInsertHooks []RolePermissionHook
+var rolePermissionAfterInsertMu sync.Mutex
+var rolePermissionAfterInsertHooks []RolePermissionHook
+
+var rolePermissionBeforeUpdateMu sync.Mutex
+var rolePermissionBeforeUpdateHooks []RolePermissionHook
+var rolePermissionAfterUpdateMu sync.Mutex
+var rolePermissionAfterUpdateHooks []RolePermissionHook
+
+var rolePermissionBeforeDeleteMu sync.Mutex
+var rolePermissionBeforeDeleteHooks []RolePermissionHook
+var rolePermissionAfterDeleteMu sync.Mutex
+var rolePermissionAfterDeleteHooks []RolePermissionHook
+
+var rolePermissionBeforeUpsertMu sync.Mutex
+var rolePermissionBeforeUpsertHooks []RolePermissionHook
+var rolePermissionAfterUpsertMu sync.Mutex
+var rolePermissionAfterUpsertHooks []RolePermissionHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *RolePermission) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *RolePermission) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *RolePermission) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *RolePermission) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *RolePermission) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *RolePermission) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *RolePermission) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *RolePermission) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *RolePermission) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range rolePermissionAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddRolePermissionHook registers your hook function for all future operations.
+func AddRolePermissionHook(hookPoint boil.HookPoint, rolePermissionHook RolePermissionHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		rolePermissionAfterSelectMu.Lock()
+		rolePermissionAfterSelectHooks = append(rolePermissionAfterSelectHooks, rolePermissionHook)
+		rolePermissionAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		rolePermissionBeforeInsertMu.Lock()
+		rolePermissionBeforeInsertHooks = append(rolePermissionBeforeInsertHooks, rolePermissionHook)
+		rolePermissionBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		rolePermissionAfterInsertMu.Lock()
+		rolePermissionAfterInsertHooks = append(rolePermissionAfterInsertHooks, rolePermissionHook)
+		rolePermissionAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		rolePermissionBeforeUpdateMu.Lock()
+		rolePermissionBeforeUpdateHooks = append(rolePermissionBeforeUpdateHooks, rolePermissionHook)
+		rolePermissionBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		rolePermissionAfterUpdateMu.Lock()
+		rolePermissionAfterUpdateHooks = append(rolePermissionAfterUpdateHooks, rolePermissionHook)
+		rolePermissionAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		rolePermissionBeforeDeleteMu.Lock()
+		rolePermissionBeforeDeleteHooks = append(rolePermissionBeforeDeleteHooks, rolePermissionHook)
+		rolePermissionBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		rolePermissionAfterDeleteMu.Lock()
+		rolePermissionAfterDeleteHooks = append(rolePermissionAfterDeleteHooks, rolePermissionHook)
+		rolePermissionAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		rolePermissionBeforeUpsertMu.Lock()
+		rolePermissionBeforeUpsertHooks = append(rolePermissionBeforeUpsertHooks, rolePermissionHook)
+		rolePermissionBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		rolePermissionAfterUpsertMu.Lock()
+		rolePermissionAfterUpsertHooks = append(rolePermissionAfterUpsertHooks, rolePermissionHook)
+		rolePermissionAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single rolePermission record from the query.
+func (q rolePermissionQuery) One(ctx context.Context, exec boil.ContextExecutor) (*RolePermission, error) {
+	o := &RolePermission{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for role_permissions")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all RolePermission records from the query.
+func (q rolePermissionQuery) All(ctx context.Context, exec boil.ContextExecutor) (RolePermissionSlice, error) {
+	var o []*RolePermission
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to RolePermission slice")
+	}
+
+	if len(rolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all RolePermission records in the query.
+func (q rolePermissionQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count role_permissions rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q rolePermissionQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if role_permissions exists")
+	}
+
+	return count > 0, nil
+}
+
+// PermissionCodePermission pointed to by the foreign key.
+func (o *RolePermission) PermissionCodePermission(mods ...qm.QueryMod) permissionQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"code\" = ?", o.PermissionCode),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Permissions(queryMods...)
+}
+
+// Role pointed to by the foreign key.
+func (o *RolePermission) Role(mods ...qm.QueryMod) roleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Roles(queryMods...)
+}
+
+// LoadPermissionCodePermission allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rolePermissionL) LoadPermissionCodePermission(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRolePermission interface{}, mods queries.Applicator) error {
+	var slice []*RolePermission
+	var object *RolePermission
+
+	if singular {
+		var ok bool
+		object, ok = maybeRolePermission.(*RolePermission)
+		if !ok {
+			object = new(RolePermission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRolePermission))
+			}
+		}
+	} else {
+		s, ok := maybeRolePermission.(*[]*RolePermission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRolePermission))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &rolePermissionR{}
+		}
+		args[object.PermissionCode] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rolePermissionR{}
+			}
+
+			args[obj.PermissionCode] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`permissions`),
+		qm.WhereIn(`permissions.code in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Permission")
+	}
+
+	var resultSlice []*Permission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Permission")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for permissions")
+	}
+
+	if len(permissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PermissionCodePermission = foreign
+		if foreign.R == nil {
+			foreign.R = &permissionR{}
+		}
+		foreign.R.PermissionCodeRolePermissions = append(foreign.R.PermissionCodeRolePermissions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PermissionCode == foreign.Code {
+				local.R.PermissionCodePermission = foreign
+				if foreign.R == nil {
+					foreign.R = &permissionR{}
+				}
+				foreign.R.PermissionCodeRolePermissions = append(foreign.R.PermissionCodeRolePermissions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (rolePermissionL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRolePermission interface{}, mods queries.Applicator) error {
+	var slice []*RolePermission
+	var object *RolePermission
+
+	if singular {
+		var ok bool
+		object, ok = maybeRolePermission.(*RolePermission)
+		if !ok {
+			object = new(RolePermission)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRolePermission))
+			}
+		}
+	} else {
+		s, ok := maybeRolePermission.(*[]*RolePermission)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRolePermission)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRolePermission))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &rolePermissionR{}
+		}
+		args[object.RoleID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &rolePermissionR{}
+			}
+
+			args[obj.RoleID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`roles.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Role")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Role = foreign
+		if foreign.R == nil {
+			foreign.R = &roleR{}
+		}
+		foreign.R.RolePermissions = append(foreign.R.RolePermissions, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.RoleID == foreign.ID {
+				local.R.Role = foreign
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.RolePermissions = append(foreign.R.RolePermissions, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetPermissionCodePermission of the rolePermission to the related item.
+// Sets o.R.PermissionCodePermission to related.
+// Adds o to related.R.PermissionCodeRolePermissions.
+func (o *RolePermission) SetPermissionCodePermission(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Permission) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"role_permissions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"permission_code"}),
+		strmangle.WhereClause("\"", "\"", 2, rolePermissionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.Code, o.RoleID, o.PermissionCode}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PermissionCode = related.Code
+	if o.R == nil {
+		o.R = &rolePermissionR{
+			PermissionCodePermission: related,
+		}
+	} else {
+		o.R.PermissionCodePermission = related
+	}
+
+	if related.R == nil {
+		related.R = &permissionR{
+			PermissionCodeRolePermissions: RolePermissionSlice{o},
+		}
+	} else {
+		related.R.PermissionCodeRolePermissions = append(related.R.PermissionCodeRolePermissions, o)
+	}
+
+	return nil
+}
+
+// SetRole of the rolePermission to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.RolePermissions.
+func (o *RolePermission) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"role_permissions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, rolePermissionPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.RoleID, o.PermissionCode}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.RoleID = related.ID
+	if o.R == nil {
+		o.R = &rolePermissionR{
+			Role: related,
+		}
+	} else {
+		o.R.Role = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			RolePermissions: RolePermissionSlice{o},
+		}
+	} else {
+		related.R.RolePermissions = append(related.R.RolePermissions, o)
+	}
+
+	return nil
+}
+
+// RolePermissions retrieves all the records using an executor.
+func RolePermissions(mods ...qm.QueryMod) rolePermissionQuery {
+	mods = append(mods, qm.From("\"role_permissions\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"role_permissions\".*"})
+	}
+
+	return rolePermissionQuery{q}
+}
+
+// FindRolePermission retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindRolePermission(ctx context.Context, exec boil.ContextExecutor, roleID string, permissionCode string, selectCols ...string) (*RolePermission, error) {
+	rolePermissionObj := &RolePermission{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"role_permissions\" where \"role_id\"=$1 AND \"permission_code\"=$2", sel,
+	)
+
+	q := queries.Raw(query, roleID, permissionCode)
+
+	err := q.Bind(ctx, exec, rolePermissionObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from role_permissions")
+	}
+
+	if err = rolePermissionObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return rolePermissionObj, err
+	}
+
+	return rolePermissionObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *RolePermission) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no role_permissions provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rolePermissionColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	rolePermissionInsertCacheMut.RLock()
+	cache, cached := rolePermissionInsertCache[key]
+	rolePermissionInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			rolePermissionAllColumns,
+			rolePermissionColumnsWithDefault,
+			rolePermissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(rolePermissionType, rolePermissionMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(rolePermissionType, rolePermissionMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"role_permissions\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"role_permissions\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into role_permissions")
+	}
+
+	if !cached {
+		rolePermissionInsertCacheMut.Lock()
+		rolePermissionInsertCache[key] = cache
+		rolePermissionInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the RolePermission.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *RolePermission) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	rolePermissionUpdateCacheMut.RLock()
+	cache, cached := rolePermissionUpdateCache[key]
+	rolePermissionUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			rolePermissionAllColumns,
+			rolePermissionPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update role_permissions, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"role_permissions\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, rolePermissionPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(rolePermissionType, rolePermissionMapping, append(wl, rolePermissionPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update role_permissions row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for role_permissions")
+	}
+
+	if !cached {
+		rolePermissionUpdateCacheMut.Lock()
+		rolePermissionUpdateCache[key] = cache
+		rolePermissionUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q rolePermissionQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for role_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for role_permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o RolePermissionSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"role_permissions\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, rolePermissionPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in rolePermission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all rolePermission")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *RolePermission) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no role_permissions provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(rolePermissionColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	rolePermissionUpsertCacheMut.RLock()
+	cache, cached := rolePermissionUpsertCache[key]
+	rolePermissionUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			rolePermissionAllColumns,
+			rolePermissionColumnsWithDefault,
+			rolePermissionColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			rolePermissionAllColumns,
+			rolePermissionPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert role_permissions, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(rolePermissionAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(rolePermissionPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert role_permissions, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(rolePermissionPrimaryKeyColumns))
+			copy(conflict, rolePermissionPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"role_permissions\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(rolePermissionType, rolePermissionMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(rolePermissionType, rolePermissionMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert role_permissions")
+	}
+
+	if !cached {
+		rolePermissionUpsertCacheMut.Lock()
+		rolePermissionUpsertCache[key] = cache
+		rolePermissionUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single RolePermission record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *RolePermission) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no RolePermission provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), rolePermissionPrimaryKeyMapping)
+	sql := "DELETE FROM \"role_permissions\" WHERE \"role_id\"=$1 AND \"permission_code\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from role_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for role_permissions")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q rolePermissionQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no rolePermissionQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from role_permissions")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for role_permissions")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o RolePermissionSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(rolePermissionBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"role_permissions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rolePermissionPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from rolePermission slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for role_permissions")
+	}
+
+	if len(rolePermissionAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *RolePermission) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindRolePermission(ctx, exec, o.RoleID, o.PermissionCode)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *RolePermissionSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := RolePermissionSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), rolePermissionPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"role_permissions\".* FROM \"role_permissions\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, rolePermissionPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in RolePermissionSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// RolePermissionExists checks if the RolePermission row exists.
+func RolePermissionExists(ctx context.Context, exec boil.ContextExecutor, roleID string, permissionCode string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"role_permissions\" where \"role_id\"=$1 AND \"permission_code\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, roleID, permissionCode)
+	}
+	row := exec.QueryRowContext(ctx, sql, roleID, permissionCode)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if role_permissions exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the RolePermission row exists.
+func (o *RolePermission) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return RolePermissionExists(ctx, exec, o.RoleID, o.PermissionCode)
+}
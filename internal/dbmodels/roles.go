@@ -32,6 +32,8 @@ type Role struct {
 	CreatedAt   null.Time   `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
 	UpdatedAt   null.Time   `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
 	DeletedAt   null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	// Users of this role must sign in with two-factor authentication
+	MfaRequired bool `boil:"mfa_required" json:"mfa_required" toml:"mfa_required" yaml:"mfa_required"`
 
 	R *roleR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L roleL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -46,6 +48,7 @@ var RoleColumns = struct {
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
+	MfaRequired string
 }{
 	ID:          "id",
 	Name:        "name",
@@ -55,6 +58,7 @@ var RoleColumns = struct {
 	CreatedAt:   "created_at",
 	UpdatedAt:   "updated_at",
 	DeletedAt:   "deleted_at",
+	MfaRequired: "mfa_required",
 }
 
 var RoleTableColumns = struct {
@@ -66,6 +70,7 @@ var RoleTableColumns = struct {
 	CreatedAt   string
 	UpdatedAt   string
 	DeletedAt   string
+	MfaRequired string
 }{
 	ID:          "roles.id",
 	Name:        "roles.name",
@@ -75,6 +80,7 @@ var RoleTableColumns = struct {
 	CreatedAt:   "roles.created_at",
 	UpdatedAt:   "roles.updated_at",
 	DeletedAt:   "roles.deleted_at",
+	MfaRequired: "roles.mfa_required",
 }
 
 // Generated where
@@ -88,6 +94,7 @@ var RoleWhere = struct {
 	CreatedAt   whereHelpernull_Time
 	UpdatedAt   whereHelpernull_Time
 	DeletedAt   whereHelpernull_Time
+	MfaRequired whereHelperbool
 }{
 	ID:          whereHelperstring{field: "\"roles\".\"id\""},
 	Name:        whereHelperstring{field: "\"roles\".\"name\""},
@@ -97,18 +104,22 @@ var RoleWhere = struct {
 	CreatedAt:   whereHelpernull_Time{field: "\"roles\".\"created_at\""},
 	UpdatedAt:   whereHelpernull_Time{field: "\"roles\".\"updated_at\""},
 	DeletedAt:   whereHelpernull_Time{field: "\"roles\".\"deleted_at\""},
+	MfaRequired: whereHelperbool{field: "\"roles\".\"mfa_required\""},
 }
 
 // RoleRels is where relationship names are stored.
 var RoleRels = struct {
-	Users string
+	RolePermissions string
+	Users           string
 }{
-	Users: "Users",
+	RolePermissions: "RolePermissions",
+	Users:           "Users",
 }
 
 // roleR is where relationships are stored.
 type roleR struct {
-	Users UserSlice `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
+	RolePermissions RolePermissionSlice `boil:"RolePermissions" json:"RolePermissions" toml:"RolePermissions" yaml:"RolePermissions"`
+	Users           UserSlice           `boil:"Users" json:"Users" toml:"Users" yaml:"Users"`
 }
 
 // NewStruct creates a new relationship struct
@@ -116,6 +127,22 @@ func (*roleR) NewStruct() *roleR {
 	return &roleR{}
 }
 
+func (o *Role) GetRolePermissions() RolePermissionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetRolePermissions()
+}
+
+func (r *roleR) GetRolePermissions() RolePermissionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.RolePermissions
+}
+
 func (o *Role) GetUsers() UserSlice {
 	if o == nil {
 		return nil
@@ -136,9 +163,9 @@ func (r *roleR) GetUsers() UserSlice {
 type roleL struct{}
 
 var (
-	roleAllColumns            = []string{"id", "name", "code", "alias", "description", "created_at", "updated_at", "deleted_at"}
+	roleAllColumns            = []string{"id", "name", "code", "alias", "description", "created_at", "updated_at", "deleted_at", "mfa_required"}
 	roleColumnsWithoutDefault = []string{"name", "code", "alias"}
-	roleColumnsWithDefault    = []string{"id", "description", "created_at", "updated_at", "deleted_at"}
+	roleColumnsWithDefault    = []string{"id", "description", "created_at", "updated_at", "deleted_at", "mfa_required"}
 	rolePrimaryKeyColumns     = []string{"id"}
 	roleGeneratedColumns      = []string{}
 )
@@ -448,6 +475,20 @@ func (q roleQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// RolePermissions retrieves all the role_permission's RolePermissions with an executor.
+func (o *Role) RolePermissions(mods ...qm.QueryMod) rolePermissionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"role_permissions\".\"role_id\"=?", o.ID),
+	)
+
+	return RolePermissions(queryMods...)
+}
+
 // Users retrieves all the user's Users with an executor.
 func (o *Role) Users(mods ...qm.QueryMod) userQuery {
 	var queryMods []qm.QueryMod
@@ -462,6 +503,119 @@ func (o *Role) Users(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// LoadRolePermissions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (roleL) LoadRolePermissions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
+	var slice []*Role
+	var object *Role
+
+	if singular {
+		var ok bool
+		object, ok = maybeRole.(*Role)
+		if !ok {
+			object = new(Role)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeRole))
+			}
+		}
+	} else {
+		s, ok := maybeRole.(*[]*Role)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeRole)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeRole))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &roleR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &roleR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`role_permissions`),
+		qm.WhereIn(`role_permissions.role_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load role_permissions")
+	}
+
+	var resultSlice []*RolePermission
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice role_permissions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on role_permissions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for role_permissions")
+	}
+
+	if len(rolePermissionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RolePermissions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rolePermissionR{}
+			}
+			foreign.R.Role = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.RoleID {
+				local.R.RolePermissions = append(local.R.RolePermissions, foreign)
+				if foreign.R == nil {
+					foreign.R = &rolePermissionR{}
+				}
+				foreign.R.Role = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUsers allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeRole interface{}, mods queries.Applicator) error {
@@ -576,6 +730,59 @@ func (roleL) LoadUsers(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
+// AddRolePermissions adds the given related objects to the existing relationships
+// of the role, optionally inserting them as new records.
+// Appends related to o.R.RolePermissions.
+// Sets related.R.Role appropriately.
+func (o *Role) AddRolePermissions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RolePermission) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.RoleID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"role_permissions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+				strmangle.WhereClause("\"", "\"", 2, rolePermissionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.RoleID, rel.PermissionCode}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.RoleID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &roleR{
+			RolePermissions: related,
+		}
+	} else {
+		o.R.RolePermissions = append(o.R.RolePermissions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &rolePermissionR{
+				Role: o,
+			}
+		} else {
+			rel.R.Role = o
+		}
+	}
+	return nil
+}
+
 // AddUsers adds the given related objects to the existing relationships
 // of the role, optionally inserting them as new records.
 // Appends related to o.R.Users.
@@ -0,0 +1,1622 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// TeamMember is an object representing the database table.
+type TeamMember struct {
+	ID     string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TeamID string `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	UserID string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// maintainer or member; maintainers manage the team and its members
+	Role string `boil:"role" json:"role" toml:"role" yaml:"role"`
+	// User who added the member
+	AddedBy   null.String `boil:"added_by" json:"added_by,omitempty" toml:"added_by" yaml:"added_by,omitempty"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *teamMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L teamMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var TeamMemberColumns = struct {
+	ID        string
+	TeamID    string
+	UserID    string
+	Role      string
+	AddedBy   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "id",
+	TeamID:    "team_id",
+	UserID:    "user_id",
+	Role:      "role",
+	AddedBy:   "added_by",
+	CreatedAt: "created_at",
+	UpdatedAt: "updated_at",
+}
+
+var TeamMemberTableColumns = struct {
+	ID        string
+	TeamID    string
+	UserID    string
+	Role      string
+	AddedBy   string
+	CreatedAt string
+	UpdatedAt string
+}{
+	ID:        "team_members.id",
+	TeamID:    "team_members.team_id",
+	UserID:    "team_members.user_id",
+	Role:      "team_members.role",
+	AddedBy:   "team_members.added_by",
+	CreatedAt: "team_members.created_at",
+	UpdatedAt: "team_members.updated_at",
+}
+
+// Generated where
+
+var TeamMemberWhere = struct {
+	ID        whereHelperstring
+	TeamID    whereHelperstring
+	UserID    whereHelperstring
+	Role      whereHelperstring
+	AddedBy   whereHelpernull_String
+	CreatedAt whereHelpertime_Time
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"team_members\".\"id\""},
+	TeamID:    whereHelperstring{field: "\"team_members\".\"team_id\""},
+	UserID:    whereHelperstring{field: "\"team_members\".\"user_id\""},
+	Role:      whereHelperstring{field: "\"team_members\".\"role\""},
+	AddedBy:   whereHelpernull_String{field: "\"team_members\".\"added_by\""},
+	CreatedAt: whereHelpertime_Time{field: "\"team_members\".\"created_at\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"team_members\".\"updated_at\""},
+}
+
+// TeamMemberRels is where relationship names are stored.
+var TeamMemberRels = struct {
+	AddedByUser string
+	Team        string
+	User        string
+}{
+	AddedByUser: "AddedByUser",
+	Team:        "Team",
+	User:        "User",
+}
+
+// teamMemberR is where relationships are stored.
+type teamMemberR struct {
+	AddedByUser *User `boil:"AddedByUser" json:"AddedByUser" toml:"AddedByUser" yaml:"AddedByUser"`
+	Team        *Team `boil:"Team" json:"Team" toml:"Team" yaml:"Team"`
+	User        *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*teamMemberR) NewStruct() *teamMemberR {
+	return &teamMemberR{}
+}
+
+func (o *TeamMember) GetAddedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAddedByUser()
+}
+
+func (r *teamMemberR) GetAddedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.AddedByUser
+}
+
+func (o *TeamMember) GetTeam() *Team {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetTeam()
+}
+
+func (r *teamMemberR) GetTeam() *Team {
+	if r == nil {
+		return nil
+	}
+
+	return r.Team
+}
+
+func (o *TeamMember) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *teamMemberR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// teamMemberL is where Load methods for each relationship are stored.
+type teamMemberL struct{}
+
+var (
+	teamMemberAllColumns            = []string{"id", "team_id", "user_id", "role", "added_by", "created_at", "updated_at"}
+	teamMemberColumnsWithoutDefault = []string{"team_id", "user_id", "role"}
+	teamMemberColumnsWithDefault    = []string{"id", "added_by", "created_at", "updated_at"}
+	teamMemberPrimaryKeyColumns     = []string{"id"}
+	teamMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// TeamMemberSlice is an alias for a slice of pointers to TeamMember.
+	// This should almost always be used instead of []TeamMember.
+	TeamMemberSlice []*TeamMember
+	// TeamMemberHook is the signature for custom TeamMember hook methods
+	TeamMemberHook func(context.Context, boil.ContextExecutor, *TeamMember) error
+
+	teamMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	teamMemberType                 = reflect.TypeOf(&TeamMember{})
+	teamMemberMapping              = queries.MakeStructMapping(teamMemberType)
+	teamMemberPrimaryKeyMapping, _ = queries.BindMapping(teamMemberType, teamMemberMapping, teamMemberPrimaryKeyColumns)
+	teamMemberInsertCacheMut       sync.RWMutex
+	teamMemberInsertCache          = make(map[string]insertCache)
+	teamMemberUpdateCacheMut       sync.RWMutex
+	teamMemberUpdateCache          = make(map[string]updateCache)
+	teamMemberUpsertCacheMut       sync.RWMutex
+	teamMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var teamMemberAfterSelectMu sync.Mutex
+var teamMemberAfterSelectHooks []TeamMemberHook
+
+var teamMemberBeforeInsertMu sync.Mutex
+var teamMemberBeforeInsertHooks []TeamMemberHook
+var teamMemberAfterInsertMu sync.Mutex
+var teamMemberAfterInsertHooks []TeamMemberHook
+
+var teamMemberBeforeUpdateMu sync.Mutex
+var teamMemberBeforeUpdateHooks []TeamMemberHook
+var teamMemberAfterUpdateMu sync.Mutex
+var teamMemberAfterUpdateHooks []TeamMemberHook
+
+var teamMemberBeforeDeleteMu sync.Mutex
+var teamMemberBeforeDeleteHooks []TeamMemberHook
+var teamMemberAfterDeleteMu sync.Mutex
+var teamMemberAfterDeleteHooks []TeamMemberHook
+
+var teamMemberBeforeUpsertMu sync.Mutex
+var teamMemberBeforeUpsertHooks []TeamMemberHook
+var teamMemberAfterUpsertMu sync.Mutex
+var teamMemberAfterUpsertHooks []TeamMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *TeamMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *TeamMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *TeamMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *TeamMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *TeamMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *TeamMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *TeamMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *TeamMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *TeamMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range teamMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddTeamMemberHook registers your hook function for all future operations.
+func AddTeamMemberHook(hookPoint boil.HookPoint, teamMemberHook TeamMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		teamMemberAfterSelectMu.Lock()
+		teamMemberAfterSelectHooks = append(teamMemberAfterSelectHooks, teamMemberHook)
+		teamMemberAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		teamMemberBeforeInsertMu.Lock()
+		teamMemberBeforeInsertHooks = append(teamMemberBeforeInsertHooks, teamMemberHook)
+		teamMemberBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		teamMemberAfterInsertMu.Lock()
+		teamMemberAfterInsertHooks = append(teamMemberAfterInsertHooks, teamMemberHook)
+		teamMemberAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		teamMemberBeforeUpdateMu.Lock()
+		teamMemberBeforeUpdateHooks = append(teamMemberBeforeUpdateHooks, teamMemberHook)
+		teamMemberBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		teamMemberAfterUpdateMu.Lock()
+		teamMemberAfterUpdateHooks = append(teamMemberAfterUpdateHooks, teamMemberHook)
+		teamMemberAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		teamMemberBeforeDeleteMu.Lock()
+		teamMemberBeforeDeleteHooks = append(teamMemberBeforeDeleteHooks, teamMemberHook)
+		teamMemberBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		teamMemberAfterDeleteMu.Lock()
+		teamMemberAfterDeleteHooks = append(teamMemberAfterDeleteHooks, teamMemberHook)
+		teamMemberAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		teamMemberBeforeUpsertMu.Lock()
+		teamMemberBeforeUpsertHooks = append(teamMemberBeforeUpsertHooks, teamMemberHook)
+		teamMemberBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		teamMemberAfterUpsertMu.Lock()
+		teamMemberAfterUpsertHooks = append(teamMemberAfterUpsertHooks, teamMemberHook)
+		teamMemberAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single teamMember record from the query.
+func (q teamMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*TeamMember, error) {
+	o := &TeamMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for team_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all TeamMember records from the query.
+func (q teamMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (TeamMemberSlice, error) {
+	var o []*TeamMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to TeamMember slice")
+	}
+
+	if len(teamMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all TeamMember records in the query.
+func (q teamMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count team_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q teamMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if team_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// AddedByUser pointed to by the foreign key.
+func (o *TeamMember) AddedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AddedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Team pointed to by the foreign key.
+func (o *TeamMember) Team(mods ...qm.QueryMod) teamQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.TeamID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Teams(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *TeamMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadAddedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (teamMemberL) LoadAddedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTeamMember interface{}, mods queries.Applicator) error {
+	var slice []*TeamMember
+	var object *TeamMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeTeamMember.(*TeamMember)
+		if !ok {
+			object = new(TeamMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTeamMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTeamMember))
+			}
+		}
+	} else {
+		s, ok := maybeTeamMember.(*[]*TeamMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTeamMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTeamMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &teamMemberR{}
+		}
+		if !queries.IsNil(object.AddedBy) {
+			args[object.AddedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &teamMemberR{}
+			}
+
+			if !queries.IsNil(obj.AddedBy) {
+				args[obj.AddedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AddedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AddedByTeamMembers = append(foreign.R.AddedByTeamMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AddedBy, foreign.ID) {
+				local.R.AddedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AddedByTeamMembers = append(foreign.R.AddedByTeamMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeam allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (teamMemberL) LoadTeam(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTeamMember interface{}, mods queries.Applicator) error {
+	var slice []*TeamMember
+	var object *TeamMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeTeamMember.(*TeamMember)
+		if !ok {
+			object = new(TeamMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTeamMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTeamMember))
+			}
+		}
+	} else {
+		s, ok := maybeTeamMember.(*[]*TeamMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTeamMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTeamMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &teamMemberR{}
+		}
+		args[object.TeamID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &teamMemberR{}
+			}
+
+			args[obj.TeamID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`teams`),
+		qm.WhereIn(`teams.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`teams.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Team")
+	}
+
+	var resultSlice []*Team
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Team")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for teams")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for teams")
+	}
+
+	if len(teamAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Team = foreign
+		if foreign.R == nil {
+			foreign.R = &teamR{}
+		}
+		foreign.R.TeamMembers = append(foreign.R.TeamMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.TeamID == foreign.ID {
+				local.R.Team = foreign
+				if foreign.R == nil {
+					foreign.R = &teamR{}
+				}
+				foreign.R.TeamMembers = append(foreign.R.TeamMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (teamMemberL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTeamMember interface{}, mods queries.Applicator) error {
+	var slice []*TeamMember
+	var object *TeamMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeTeamMember.(*TeamMember)
+		if !ok {
+			object = new(TeamMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTeamMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTeamMember))
+			}
+		}
+	} else {
+		s, ok := maybeTeamMember.(*[]*TeamMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTeamMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTeamMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &teamMemberR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &teamMemberR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.TeamMembers = append(foreign.R.TeamMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.TeamMembers = append(foreign.R.TeamMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAddedByUser of the teamMember to the related item.
+// Sets o.R.AddedByUser to related.
+// Adds o to related.R.AddedByTeamMembers.
+func (o *TeamMember) SetAddedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"team_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+		strmangle.WhereClause("\"", "\"", 2, teamMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AddedBy, related.ID)
+	if o.R == nil {
+		o.R = &teamMemberR{
+			AddedByUser: related,
+		}
+	} else {
+		o.R.AddedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AddedByTeamMembers: TeamMemberSlice{o},
+		}
+	} else {
+		related.R.AddedByTeamMembers = append(related.R.AddedByTeamMembers, o)
+	}
+
+	return nil
+}
+
+// RemoveAddedByUser relationship.
+// Sets o.R.AddedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *TeamMember) RemoveAddedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AddedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AddedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AddedByTeamMembers {
+		if queries.Equal(o.AddedBy, ri.AddedBy) {
+			continue
+		}
+
+		ln := len(related.R.AddedByTeamMembers)
+		if ln > 1 && i < ln-1 {
+			related.R.AddedByTeamMembers[i] = related.R.AddedByTeamMembers[ln-1]
+		}
+		related.R.AddedByTeamMembers = related.R.AddedByTeamMembers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetTeam of the teamMember to the related item.
+// Sets o.R.Team to related.
+// Adds o to related.R.TeamMembers.
+func (o *TeamMember) SetTeam(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Team) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"team_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"team_id"}),
+		strmangle.WhereClause("\"", "\"", 2, teamMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.TeamID = related.ID
+	if o.R == nil {
+		o.R = &teamMemberR{
+			Team: related,
+		}
+	} else {
+		o.R.Team = related
+	}
+
+	if related.R == nil {
+		related.R = &teamR{
+			TeamMembers: TeamMemberSlice{o},
+		}
+	} else {
+		related.R.TeamMembers = append(related.R.TeamMembers, o)
+	}
+
+	return nil
+}
+
+// SetUser of the teamMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.TeamMembers.
+func (o *TeamMember) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"team_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, teamMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &teamMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			TeamMembers: TeamMemberSlice{o},
+		}
+	} else {
+		related.R.TeamMembers = append(related.R.TeamMembers, o)
+	}
+
+	return nil
+}
+
+// TeamMembers retrieves all the records using an executor.
+func TeamMembers(mods ...qm.QueryMod) teamMemberQuery {
+	mods = append(mods, qm.From("\"team_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"team_members\".*"})
+	}
+
+	return teamMemberQuery{q}
+}
+
+// FindTeamMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindTeamMember(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*TeamMember, error) {
+	teamMemberObj := &TeamMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"team_members\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, teamMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from team_members")
+	}
+
+	if err = teamMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return teamMemberObj, err
+	}
+
+	return teamMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *TeamMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no team_members provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(teamMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	teamMemberInsertCacheMut.RLock()
+	cache, cached := teamMemberInsertCache[key]
+	teamMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			teamMemberAllColumns,
+			teamMemberColumnsWithDefault,
+			teamMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(teamMemberType, teamMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(teamMemberType, teamMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"team_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"team_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into team_members")
+	}
+
+	if !cached {
+		teamMemberInsertCacheMut.Lock()
+		teamMemberInsertCache[key] = cache
+		teamMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the TeamMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *TeamMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	teamMemberUpdateCacheMut.RLock()
+	cache, cached := teamMemberUpdateCache[key]
+	teamMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			teamMemberAllColumns,
+			teamMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update team_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"team_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, teamMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(teamMemberType, teamMemberMapping, append(wl, teamMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update team_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for team_members")
+	}
+
+	if !cached {
+		teamMemberUpdateCacheMut.Lock()
+		teamMemberUpdateCache[key] = cache
+		teamMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q teamMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for team_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for team_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o TeamMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), teamMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"team_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, teamMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in teamMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all teamMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *TeamMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no team_members provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(teamMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	teamMemberUpsertCacheMut.RLock()
+	cache, cached := teamMemberUpsertCache[key]
+	teamMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			teamMemberAllColumns,
+			teamMemberColumnsWithDefault,
+			teamMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			teamMemberAllColumns,
+			teamMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert team_members, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(teamMemberAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(teamMemberPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert team_members, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(teamMemberPrimaryKeyColumns))
+			copy(conflict, teamMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"team_members\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(teamMemberType, teamMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(teamMemberType, teamMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert team_members")
+	}
+
+	if !cached {
+		teamMemberUpsertCacheMut.Lock()
+		teamMemberUpsertCache[key] = cache
+		teamMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single TeamMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *TeamMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no TeamMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), teamMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"team_members\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from team_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for team_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q teamMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no teamMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from team_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for team_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o TeamMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(teamMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), teamMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"team_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, teamMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from teamMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for team_members")
+	}
+
+	if len(teamMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *TeamMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindTeamMember(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *TeamMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := TeamMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), teamMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"team_members\".* FROM \"team_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, teamMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in TeamMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// TeamMemberExists checks if the TeamMember row exists.
+func TeamMemberExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"team_members\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if team_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the TeamMember row exists.
+func (o *TeamMember) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return TeamMemberExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1173 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// UserTokenRevocation is an object representing the database table.
+type UserTokenRevocation struct {
+	UserID        string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	RevokedBefore time.Time `boil:"revoked_before" json:"revoked_before" toml:"revoked_before" yaml:"revoked_before"`
+	// When every token issued before the cut-off has expired - the row can be deleted afterwards
+	ExpiresAt time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *userTokenRevocationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L userTokenRevocationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var UserTokenRevocationColumns = struct {
+	UserID        string
+	RevokedBefore string
+	ExpiresAt     string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	UserID:        "user_id",
+	RevokedBefore: "revoked_before",
+	ExpiresAt:     "expires_at",
+	CreatedAt:     "created_at",
+	UpdatedAt:     "updated_at",
+}
+
+var UserTokenRevocationTableColumns = struct {
+	UserID        string
+	RevokedBefore string
+	ExpiresAt     string
+	CreatedAt     string
+	UpdatedAt     string
+}{
+	UserID:        "user_token_revocations.user_id",
+	RevokedBefore: "user_token_revocations.revoked_before",
+	ExpiresAt:     "user_token_revocations.expires_at",
+	CreatedAt:     "user_token_revocations.created_at",
+	UpdatedAt:     "user_token_revocations.updated_at",
+}
+
+// Generated where
+
+var UserTokenRevocationWhere = struct {
+	UserID        whereHelperstring
+	RevokedBefore whereHelpertime_Time
+	ExpiresAt     whereHelpertime_Time
+	CreatedAt     whereHelpertime_Time
+	UpdatedAt     whereHelpertime_Time
+}{
+	UserID:        whereHelperstring{field: "\"user_token_revocations\".\"user_id\""},
+	RevokedBefore: whereHelpertime_Time{field: "\"user_token_revocations\".\"revoked_before\""},
+	ExpiresAt:     whereHelpertime_Time{field: "\"user_token_revocations\".\"expires_at\""},
+	CreatedAt:     whereHelpertime_Time{field: "\"user_token_revocations\".\"created_at\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"user_token_revocations\".\"updated_at\""},
+}
+
+// UserTokenRevocationRels is where relationship names are stored.
+var UserTokenRevocationRels = struct {
+	User string
+}{
+	User: "User",
+}
+
+// userTokenRevocationR is where relationships are stored.
+type userTokenRevocationR struct {
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*userTokenRevocationR) NewStruct() *userTokenRevocationR {
+	return &userTokenRevocationR{}
+}
+
+func (o *UserTokenRevocation) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *userTokenRevocationR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+// userTokenRevocationL is where Load methods for each relationship are stored.
+type userTokenRevocationL struct{}
+
+var (
+	userTokenRevocationAllColumns            = []string{"user_id", "revoked_before", "expires_at", "created_at", "updated_at"}
+	userTokenRevocationColumnsWithoutDefault = []string{"user_id", "revoked_before", "expires_at"}
+	userTokenRevocationColumnsWithDefault    = []string{"created_at", "updated_at"}
+	userTokenRevocationPrimaryKeyColumns     = []string{"user_id"}
+	userTokenRevocationGeneratedColumns      = []string{}
+)
+
+type (
+	// UserTokenRevocationSlice is an alias for a slice of pointers to UserTokenRevocation.
+	// This should almost always be used instead of []UserTokenRevocation.
+	UserTokenRevocationSlice []*UserTokenRevocation
+	// UserTokenRevocationHook is the signature for custom UserTokenRevocation hook methods
+	UserTokenRevocationHook func(context.Context, boil.ContextExecutor, *UserTokenRevocation) error
+
+	userTokenRevocationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	userTokenRevocationType                 = reflect.TypeOf(&UserTokenRevocation{})
+	userTokenRevocationMapping              = queries.MakeStructMapping(userTokenRevocationType)
+	userTokenRevocationPrimaryKeyMapping, _ = queries.BindMapping(userTokenRevocationType, userTokenRevocationMapping, userTokenRevocationPrimaryKeyColumns)
+	userTokenRevocationInsertCacheMut       sync.RWMutex
+	userTokenRevocationInsertCache          = make(map[string]insertCache)
+	userTokenRevocationUpdateCacheMut       sync.RWMutex
+	userTokenRevocationUpdateCache          = make(map[string]updateCache)
+	userTokenRevocationUpsertCacheMut       sync.RWMutex
+	userTokenRevocationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userTokenRevocationAfterSelectMu sync.Mutex
+var userTokenRevocationAfterSelectHooks []UserTokenRevocationHook
+
+var userTokenRevocationBeforeInsertMu sync.Mutex
+var userTokenRevocationBeforeInsertHooks []UserTokenRevocationHook
+var userTokenRevocationAfterInsertMu sync.Mutex
+var userTokenRevocationAfterInsertHooks []UserTokenRevocationHook
+
+var userTokenRevocationBeforeUpdateMu sync.Mutex
+var userTokenRevocationBeforeUpdateHooks []UserTokenRevocationHook
+var userTokenRevocationAfterUpdateMu sync.Mutex
+var userTokenRevocationAfterUpdateHooks []UserTokenRevocationHook
+
+var userTokenRevocationBeforeDeleteMu sync.Mutex
+var userTokenRevocationBeforeDeleteHooks []UserTokenRevocationHook
+var userTokenRevocationAfterDeleteMu sync.Mutex
+var userTokenRevocationAfterDeleteHooks []UserTokenRevocationHook
+
+var userTokenRevocationBeforeUpsertMu sync.Mutex
+var userTokenRevocationBeforeUpsertHooks []UserTokenRevocationHook
+var userTokenRevocationAfterUpsertMu sync.Mutex
+var userTokenRevocationAfterUpsertHooks []UserTokenRevocationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *UserTokenRevocation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *UserTokenRevocation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *UserTokenRevocation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *UserTokenRevocation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *UserTokenRevocation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *UserTokenRevocation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *UserTokenRevocation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *UserTokenRevocation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *UserTokenRevocation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userTokenRevocationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserTokenRevocationHook registers your hook function for all future operations.
+func AddUserTokenRevocationHook(hookPoint boil.HookPoint, userTokenRevocationHook UserTokenRevocationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userTokenRevocationAfterSelectMu.Lock()
+		userTokenRevocationAfterSelectHooks = append(userTokenRevocationAfterSelectHooks, userTokenRevocationHook)
+		userTokenRevocationAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userTokenRevocationBeforeInsertMu.Lock()
+		userTokenRevocationBeforeInsertHooks = append(userTokenRevocationBeforeInsertHooks, userTokenRevocationHook)
+		userTokenRevocationBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userTokenRevocationAfterInsertMu.Lock()
+		userTokenRevocationAfterInsertHooks = append(userTokenRevocationAfterInsertHooks, userTokenRevocationHook)
+		userTokenRevocationAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userTokenRevocationBeforeUpdateMu.Lock()
+		userTokenRevocationBeforeUpdateHooks = append(userTokenRevocationBeforeUpdateHooks, userTokenRevocationHook)
+		userTokenRevocationBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userTokenRevocationAfterUpdateMu.Lock()
+		userTokenRevocationAfterUpdateHooks = append(userTokenRevocationAfterUpdateHooks, userTokenRevocationHook)
+		userTokenRevocationAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userTokenRevocationBeforeDeleteMu.Lock()
+		userTokenRevocationBeforeDeleteHooks = append(userTokenRevocationBeforeDeleteHooks, userTokenRevocationHook)
+		userTokenRevocationBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userTokenRevocationAfterDeleteMu.Lock()
+		userTokenRevocationAfterDeleteHooks = append(userTokenRevocationAfterDeleteHooks, userTokenRevocationHook)
+		userTokenRevocationAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userTokenRevocationBeforeUpsertMu.Lock()
+		userTokenRevocationBeforeUpsertHooks = append(userTokenRevocationBeforeUpsertHooks, userTokenRevocationHook)
+		userTokenRevocationBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userTokenRevocationAfterUpsertMu.Lock()
+		userTokenRevocationAfterUpsertHooks = append(userTokenRevocationAfterUpsertHooks, userTokenRevocationHook)
+		userTokenRevocationAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single userTokenRevocation record from the query.
+func (q userTokenRevocationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*UserTokenRevocation, error) {
+	o := &UserTokenRevocation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for user_token_revocations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all UserTokenRevocation records from the query.
+func (q userTokenRevocationQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserTokenRevocationSlice, error) {
+	var o []*UserTokenRevocation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to UserTokenRevocation slice")
+	}
+
+	if len(userTokenRevocationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all UserTokenRevocation records in the query.
+func (q userTokenRevocationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count user_token_revocations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userTokenRevocationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if user_token_revocations exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *UserTokenRevocation) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userTokenRevocationL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserTokenRevocation interface{}, mods queries.Applicator) error {
+	var slice []*UserTokenRevocation
+	var object *UserTokenRevocation
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserTokenRevocation.(*UserTokenRevocation)
+		if !ok {
+			object = new(UserTokenRevocation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserTokenRevocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserTokenRevocation))
+			}
+		}
+	} else {
+		s, ok := maybeUserTokenRevocation.(*[]*UserTokenRevocation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserTokenRevocation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserTokenRevocation))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userTokenRevocationR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userTokenRevocationR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.UserTokenRevocation = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.UserTokenRevocation = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUser of the userTokenRevocation to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.UserTokenRevocation.
+func (o *UserTokenRevocation) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_token_revocations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userTokenRevocationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &userTokenRevocationR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			UserTokenRevocation: o,
+		}
+	} else {
+		related.R.UserTokenRevocation = o
+	}
+
+	return nil
+}
+
+// UserTokenRevocations retrieves all the records using an executor.
+func UserTokenRevocations(mods ...qm.QueryMod) userTokenRevocationQuery {
+	mods = append(mods, qm.From("\"user_token_revocations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"user_token_revocations\".*"})
+	}
+
+	return userTokenRevocationQuery{q}
+}
+
+// FindUserTokenRevocation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindUserTokenRevocation(ctx context.Context, exec boil.ContextExecutor, userID string, selectCols ...string) (*UserTokenRevocation, error) {
+	userTokenRevocationObj := &UserTokenRevocation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"user_token_revocations\" where \"user_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, userTokenRevocationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from user_token_revocations")
+	}
+
+	if err = userTokenRevocationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return userTokenRevocationObj, err
+	}
+
+	return userTokenRevocationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *UserTokenRevocation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_token_revocations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userTokenRevocationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	userTokenRevocationInsertCacheMut.RLock()
+	cache, cached := userTokenRevocationInsertCache[key]
+	userTokenRevocationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			userTokenRevocationAllColumns,
+			userTokenRevocationColumnsWithDefault,
+			userTokenRevocationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(userTokenRevocationType, userTokenRevocationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(userTokenRevocationType, userTokenRevocationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"user_token_revocations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"user_token_revocations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into user_token_revocations")
+	}
+
+	if !cached {
+		userTokenRevocationInsertCacheMut.Lock()
+		userTokenRevocationInsertCache[key] = cache
+		userTokenRevocationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the UserTokenRevocation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *UserTokenRevocation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	userTokenRevocationUpdateCacheMut.RLock()
+	cache, cached := userTokenRevocationUpdateCache[key]
+	userTokenRevocationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			userTokenRevocationAllColumns,
+			userTokenRevocationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update user_token_revocations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"user_token_revocations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, userTokenRevocationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(userTokenRevocationType, userTokenRevocationMapping, append(wl, userTokenRevocationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update user_token_revocations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for user_token_revocations")
+	}
+
+	if !cached {
+		userTokenRevocationUpdateCacheMut.Lock()
+		userTokenRevocationUpdateCache[key] = cache
+		userTokenRevocationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q userTokenRevocationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for user_token_revocations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for user_token_revocations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o UserTokenRevocationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTokenRevocationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"user_token_revocations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, userTokenRevocationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in userTokenRevocation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all userTokenRevocation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *UserTokenRevocation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no user_token_revocations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(userTokenRevocationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	userTokenRevocationUpsertCacheMut.RLock()
+	cache, cached := userTokenRevocationUpsertCache[key]
+	userTokenRevocationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			userTokenRevocationAllColumns,
+			userTokenRevocationColumnsWithDefault,
+			userTokenRevocationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			userTokenRevocationAllColumns,
+			userTokenRevocationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert user_token_revocations, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(userTokenRevocationAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(userTokenRevocationPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert user_token_revocations, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(userTokenRevocationPrimaryKeyColumns))
+			copy(conflict, userTokenRevocationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"user_token_revocations\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(userTokenRevocationType, userTokenRevocationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(userTokenRevocationType, userTokenRevocationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert user_token_revocations")
+	}
+
+	if !cached {
+		userTokenRevocationUpsertCacheMut.Lock()
+		userTokenRevocationUpsertCache[key] = cache
+		userTokenRevocationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single UserTokenRevocation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *UserTokenRevocation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no UserTokenRevocation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), userTokenRevocationPrimaryKeyMapping)
+	sql := "DELETE FROM \"user_token_revocations\" WHERE \"user_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from user_token_revocations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for user_token_revocations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q userTokenRevocationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no userTokenRevocationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from user_token_revocations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_token_revocations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o UserTokenRevocationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(userTokenRevocationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTokenRevocationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"user_token_revocations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userTokenRevocationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from userTokenRevocation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for user_token_revocations")
+	}
+
+	if len(userTokenRevocationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *UserTokenRevocation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindUserTokenRevocation(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *UserTokenRevocationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := UserTokenRevocationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), userTokenRevocationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"user_token_revocations\".* FROM \"user_token_revocations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, userTokenRevocationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in UserTokenRevocationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// UserTokenRevocationExists checks if the UserTokenRevocation row exists.
+func UserTokenRevocationExists(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"user_token_revocations\" where \"user_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if user_token_revocations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the UserTokenRevocation row exists.
+func (o *UserTokenRevocation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return UserTokenRevocationExists(ctx, exec, o.UserID)
+}
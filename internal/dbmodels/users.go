@@ -115,50 +115,143 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	Role                   string
-	CreatedByBoards        string
-	AssignedToCards        string
-	CreatedByCards         string
-	UpdatedByCards         string
-	EditedByComments       string
-	Comments               string
-	CreatedByLabels        string
-	DeletedByLabels        string
-	UpdatedByLabels        string
-	CreatedByLists         string
-	CreatedByRebalanceJobs string
-	CreatedUserUploads     string
+	Role                      string
+	LoginLockout              string
+	UserMfa                   string
+	UserTokenRevocation       string
+	BoardAuditLogs            string
+	CreatedByBoardImports     string
+	CreatedByBoardInvitations string
+	InviteeBoardInvitations   string
+	AddedByBoardMembers       string
+	BoardMembers              string
+	CreatedByBoardShareLinks  string
+	BoardStars                string
+	AddedByBoardTeamGrants    string
+	CreatedByBoardTemplates   string
+	BoardViews                string
+	ArchivedByBoards          string
+	CreatedByBoards           string
+	DeletedByBoards           string
+	AssignedToCards           string
+	CreatedByCards            string
+	UpdatedByCards            string
+	EditedByComments          string
+	Comments                  string
+	EmailVerifications        string
+	CreatedByLabels           string
+	DeletedByLabels           string
+	UpdatedByLabels           string
+	CreatedByLists            string
+	LoginAttempts             string
+	MfaRecoveryCodes          string
+	PasswordResets            string
+	PersonalAccessTokens      string
+	CreatedByRebalanceJobs    string
+	RefreshTokens             string
+	RevokedTokens             string
+	Sessions                  string
+	AddedByTeamMembers        string
+	TeamMembers               string
+	CreatedByTeams            string
+	CreatedUserUploads        string
+	UserIdentities            string
+	AddedByWorkspaceMembers   string
+	WorkspaceMembers          string
+	CreatedByWorkspaces       string
 }{
-	Role:                   "Role",
-	CreatedByBoards:        "CreatedByBoards",
-	AssignedToCards:        "AssignedToCards",
-	CreatedByCards:         "CreatedByCards",
-	UpdatedByCards:         "UpdatedByCards",
-	EditedByComments:       "EditedByComments",
-	Comments:               "Comments",
-	CreatedByLabels:        "CreatedByLabels",
-	DeletedByLabels:        "DeletedByLabels",
-	UpdatedByLabels:        "UpdatedByLabels",
-	CreatedByLists:         "CreatedByLists",
-	CreatedByRebalanceJobs: "CreatedByRebalanceJobs",
-	CreatedUserUploads:     "CreatedUserUploads",
+	Role:                      "Role",
+	LoginLockout:              "LoginLockout",
+	UserMfa:                   "UserMfa",
+	UserTokenRevocation:       "UserTokenRevocation",
+	BoardAuditLogs:            "BoardAuditLogs",
+	CreatedByBoardImports:     "CreatedByBoardImports",
+	CreatedByBoardInvitations: "CreatedByBoardInvitations",
+	InviteeBoardInvitations:   "InviteeBoardInvitations",
+	AddedByBoardMembers:       "AddedByBoardMembers",
+	BoardMembers:              "BoardMembers",
+	CreatedByBoardShareLinks:  "CreatedByBoardShareLinks",
+	BoardStars:                "BoardStars",
+	AddedByBoardTeamGrants:    "AddedByBoardTeamGrants",
+	CreatedByBoardTemplates:   "CreatedByBoardTemplates",
+	BoardViews:                "BoardViews",
+	ArchivedByBoards:          "ArchivedByBoards",
+	CreatedByBoards:           "CreatedByBoards",
+	DeletedByBoards:           "DeletedByBoards",
+	AssignedToCards:           "AssignedToCards",
+	CreatedByCards:            "CreatedByCards",
+	UpdatedByCards:            "UpdatedByCards",
+	EditedByComments:          "EditedByComments",
+	Comments:                  "Comments",
+	EmailVerifications:        "EmailVerifications",
+	CreatedByLabels:           "CreatedByLabels",
+	DeletedByLabels:           "DeletedByLabels",
+	UpdatedByLabels:           "UpdatedByLabels",
+	CreatedByLists:            "CreatedByLists",
+	LoginAttempts:             "LoginAttempts",
+	MfaRecoveryCodes:          "MfaRecoveryCodes",
+	PasswordResets:            "PasswordResets",
+	PersonalAccessTokens:      "PersonalAccessTokens",
+	CreatedByRebalanceJobs:    "CreatedByRebalanceJobs",
+	RefreshTokens:             "RefreshTokens",
+	RevokedTokens:             "RevokedTokens",
+	Sessions:                  "Sessions",
+	AddedByTeamMembers:        "AddedByTeamMembers",
+	TeamMembers:               "TeamMembers",
+	CreatedByTeams:            "CreatedByTeams",
+	CreatedUserUploads:        "CreatedUserUploads",
+	UserIdentities:            "UserIdentities",
+	AddedByWorkspaceMembers:   "AddedByWorkspaceMembers",
+	WorkspaceMembers:          "WorkspaceMembers",
+	CreatedByWorkspaces:       "CreatedByWorkspaces",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	Role                   *Role             `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
-	CreatedByBoards        BoardSlice        `boil:"CreatedByBoards" json:"CreatedByBoards" toml:"CreatedByBoards" yaml:"CreatedByBoards"`
-	AssignedToCards        CardSlice         `boil:"AssignedToCards" json:"AssignedToCards" toml:"AssignedToCards" yaml:"AssignedToCards"`
-	CreatedByCards         CardSlice         `boil:"CreatedByCards" json:"CreatedByCards" toml:"CreatedByCards" yaml:"CreatedByCards"`
-	UpdatedByCards         CardSlice         `boil:"UpdatedByCards" json:"UpdatedByCards" toml:"UpdatedByCards" yaml:"UpdatedByCards"`
-	EditedByComments       CommentSlice      `boil:"EditedByComments" json:"EditedByComments" toml:"EditedByComments" yaml:"EditedByComments"`
-	Comments               CommentSlice      `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
-	CreatedByLabels        LabelSlice        `boil:"CreatedByLabels" json:"CreatedByLabels" toml:"CreatedByLabels" yaml:"CreatedByLabels"`
-	DeletedByLabels        LabelSlice        `boil:"DeletedByLabels" json:"DeletedByLabels" toml:"DeletedByLabels" yaml:"DeletedByLabels"`
-	UpdatedByLabels        LabelSlice        `boil:"UpdatedByLabels" json:"UpdatedByLabels" toml:"UpdatedByLabels" yaml:"UpdatedByLabels"`
-	CreatedByLists         ListSlice         `boil:"CreatedByLists" json:"CreatedByLists" toml:"CreatedByLists" yaml:"CreatedByLists"`
-	CreatedByRebalanceJobs RebalanceJobSlice `boil:"CreatedByRebalanceJobs" json:"CreatedByRebalanceJobs" toml:"CreatedByRebalanceJobs" yaml:"CreatedByRebalanceJobs"`
-	CreatedUserUploads     UploadSlice       `boil:"CreatedUserUploads" json:"CreatedUserUploads" toml:"CreatedUserUploads" yaml:"CreatedUserUploads"`
+	Role                      *Role                    `boil:"Role" json:"Role" toml:"Role" yaml:"Role"`
+	LoginLockout              *LoginLockout            `boil:"LoginLockout" json:"LoginLockout" toml:"LoginLockout" yaml:"LoginLockout"`
+	UserMfa                   *UserMfa                 `boil:"UserMfa" json:"UserMfa" toml:"UserMfa" yaml:"UserMfa"`
+	UserTokenRevocation       *UserTokenRevocation     `boil:"UserTokenRevocation" json:"UserTokenRevocation" toml:"UserTokenRevocation" yaml:"UserTokenRevocation"`
+	BoardAuditLogs            BoardAuditLogSlice       `boil:"BoardAuditLogs" json:"BoardAuditLogs" toml:"BoardAuditLogs" yaml:"BoardAuditLogs"`
+	CreatedByBoardImports     BoardImportSlice         `boil:"CreatedByBoardImports" json:"CreatedByBoardImports" toml:"CreatedByBoardImports" yaml:"CreatedByBoardImports"`
+	CreatedByBoardInvitations BoardInvitationSlice     `boil:"CreatedByBoardInvitations" json:"CreatedByBoardInvitations" toml:"CreatedByBoardInvitations" yaml:"CreatedByBoardInvitations"`
+	InviteeBoardInvitations   BoardInvitationSlice     `boil:"InviteeBoardInvitations" json:"InviteeBoardInvitations" toml:"InviteeBoardInvitations" yaml:"InviteeBoardInvitations"`
+	AddedByBoardMembers       BoardMemberSlice         `boil:"AddedByBoardMembers" json:"AddedByBoardMembers" toml:"AddedByBoardMembers" yaml:"AddedByBoardMembers"`
+	BoardMembers              BoardMemberSlice         `boil:"BoardMembers" json:"BoardMembers" toml:"BoardMembers" yaml:"BoardMembers"`
+	CreatedByBoardShareLinks  BoardShareLinkSlice      `boil:"CreatedByBoardShareLinks" json:"CreatedByBoardShareLinks" toml:"CreatedByBoardShareLinks" yaml:"CreatedByBoardShareLinks"`
+	BoardStars                BoardStarSlice           `boil:"BoardStars" json:"BoardStars" toml:"BoardStars" yaml:"BoardStars"`
+	AddedByBoardTeamGrants    BoardTeamGrantSlice      `boil:"AddedByBoardTeamGrants" json:"AddedByBoardTeamGrants" toml:"AddedByBoardTeamGrants" yaml:"AddedByBoardTeamGrants"`
+	CreatedByBoardTemplates   BoardTemplateSlice       `boil:"CreatedByBoardTemplates" json:"CreatedByBoardTemplates" toml:"CreatedByBoardTemplates" yaml:"CreatedByBoardTemplates"`
+	BoardViews                BoardViewSlice           `boil:"BoardViews" json:"BoardViews" toml:"BoardViews" yaml:"BoardViews"`
+	ArchivedByBoards          BoardSlice               `boil:"ArchivedByBoards" json:"ArchivedByBoards" toml:"ArchivedByBoards" yaml:"ArchivedByBoards"`
+	CreatedByBoards           BoardSlice               `boil:"CreatedByBoards" json:"CreatedByBoards" toml:"CreatedByBoards" yaml:"CreatedByBoards"`
+	DeletedByBoards           BoardSlice               `boil:"DeletedByBoards" json:"DeletedByBoards" toml:"DeletedByBoards" yaml:"DeletedByBoards"`
+	AssignedToCards           CardSlice                `boil:"AssignedToCards" json:"AssignedToCards" toml:"AssignedToCards" yaml:"AssignedToCards"`
+	CreatedByCards            CardSlice                `boil:"CreatedByCards" json:"CreatedByCards" toml:"CreatedByCards" yaml:"CreatedByCards"`
+	UpdatedByCards            CardSlice                `boil:"UpdatedByCards" json:"UpdatedByCards" toml:"UpdatedByCards" yaml:"UpdatedByCards"`
+	EditedByComments          CommentSlice             `boil:"EditedByComments" json:"EditedByComments" toml:"EditedByComments" yaml:"EditedByComments"`
+	Comments                  CommentSlice             `boil:"Comments" json:"Comments" toml:"Comments" yaml:"Comments"`
+	EmailVerifications        EmailVerificationSlice   `boil:"EmailVerifications" json:"EmailVerifications" toml:"EmailVerifications" yaml:"EmailVerifications"`
+	CreatedByLabels           LabelSlice               `boil:"CreatedByLabels" json:"CreatedByLabels" toml:"CreatedByLabels" yaml:"CreatedByLabels"`
+	DeletedByLabels           LabelSlice               `boil:"DeletedByLabels" json:"DeletedByLabels" toml:"DeletedByLabels" yaml:"DeletedByLabels"`
+	UpdatedByLabels           LabelSlice               `boil:"UpdatedByLabels" json:"UpdatedByLabels" toml:"UpdatedByLabels" yaml:"UpdatedByLabels"`
+	CreatedByLists            ListSlice                `boil:"CreatedByLists" json:"CreatedByLists" toml:"CreatedByLists" yaml:"CreatedByLists"`
+	LoginAttempts             LoginAttemptSlice        `boil:"LoginAttempts" json:"LoginAttempts" toml:"LoginAttempts" yaml:"LoginAttempts"`
+	MfaRecoveryCodes          MfaRecoveryCodeSlice     `boil:"MfaRecoveryCodes" json:"MfaRecoveryCodes" toml:"MfaRecoveryCodes" yaml:"MfaRecoveryCodes"`
+	PasswordResets            PasswordResetSlice       `boil:"PasswordResets" json:"PasswordResets" toml:"PasswordResets" yaml:"PasswordResets"`
+	PersonalAccessTokens      PersonalAccessTokenSlice `boil:"PersonalAccessTokens" json:"PersonalAccessTokens" toml:"PersonalAccessTokens" yaml:"PersonalAccessTokens"`
+	CreatedByRebalanceJobs    RebalanceJobSlice        `boil:"CreatedByRebalanceJobs" json:"CreatedByRebalanceJobs" toml:"CreatedByRebalanceJobs" yaml:"CreatedByRebalanceJobs"`
+	RefreshTokens             RefreshTokenSlice        `boil:"RefreshTokens" json:"RefreshTokens" toml:"RefreshTokens" yaml:"RefreshTokens"`
+	RevokedTokens             RevokedTokenSlice        `boil:"RevokedTokens" json:"RevokedTokens" toml:"RevokedTokens" yaml:"RevokedTokens"`
+	Sessions                  SessionSlice             `boil:"Sessions" json:"Sessions" toml:"Sessions" yaml:"Sessions"`
+	AddedByTeamMembers        TeamMemberSlice          `boil:"AddedByTeamMembers" json:"AddedByTeamMembers" toml:"AddedByTeamMembers" yaml:"AddedByTeamMembers"`
+	TeamMembers               TeamMemberSlice          `boil:"TeamMembers" json:"TeamMembers" toml:"TeamMembers" yaml:"TeamMembers"`
+	CreatedByTeams            TeamSlice                `boil:"CreatedByTeams" json:"CreatedByTeams" toml:"CreatedByTeams" yaml:"CreatedByTeams"`
+	CreatedUserUploads        UploadSlice              `boil:"CreatedUserUploads" json:"CreatedUserUploads" toml:"CreatedUserUploads" yaml:"CreatedUserUploads"`
+	UserIdentities            UserIdentitySlice        `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	AddedByWorkspaceMembers   WorkspaceMemberSlice     `boil:"AddedByWorkspaceMembers" json:"AddedByWorkspaceMembers" toml:"AddedByWorkspaceMembers" yaml:"AddedByWorkspaceMembers"`
+	WorkspaceMembers          WorkspaceMemberSlice     `boil:"WorkspaceMembers" json:"WorkspaceMembers" toml:"WorkspaceMembers" yaml:"WorkspaceMembers"`
+	CreatedByWorkspaces       WorkspaceSlice           `boil:"CreatedByWorkspaces" json:"CreatedByWorkspaces" toml:"CreatedByWorkspaces" yaml:"CreatedByWorkspaces"`
 }
 
 // NewStruct creates a new relationship struct
@@ -182,2282 +275,9403 @@ func (r *userR) GetRole() *Role {
 	return r.Role
 }
 
-func (o *User) GetCreatedByBoards() BoardSlice {
+func (o *User) GetLoginLockout() *LoginLockout {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetCreatedByBoards()
+	return o.R.GetLoginLockout()
 }
 
-func (r *userR) GetCreatedByBoards() BoardSlice {
+func (r *userR) GetLoginLockout() *LoginLockout {
 	if r == nil {
 		return nil
 	}
 
-	return r.CreatedByBoards
+	return r.LoginLockout
 }
 
-func (o *User) GetAssignedToCards() CardSlice {
+func (o *User) GetUserMfa() *UserMfa {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetAssignedToCards()
+	return o.R.GetUserMfa()
 }
 
-func (r *userR) GetAssignedToCards() CardSlice {
+func (r *userR) GetUserMfa() *UserMfa {
 	if r == nil {
 		return nil
 	}
 
-	return r.AssignedToCards
+	return r.UserMfa
 }
 
-func (o *User) GetCreatedByCards() CardSlice {
+func (o *User) GetUserTokenRevocation() *UserTokenRevocation {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetCreatedByCards()
+	return o.R.GetUserTokenRevocation()
 }
 
-func (r *userR) GetCreatedByCards() CardSlice {
+func (r *userR) GetUserTokenRevocation() *UserTokenRevocation {
 	if r == nil {
 		return nil
 	}
 
-	return r.CreatedByCards
+	return r.UserTokenRevocation
 }
 
-func (o *User) GetUpdatedByCards() CardSlice {
+func (o *User) GetBoardAuditLogs() BoardAuditLogSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetUpdatedByCards()
+	return o.R.GetBoardAuditLogs()
 }
 
-func (r *userR) GetUpdatedByCards() CardSlice {
+func (r *userR) GetBoardAuditLogs() BoardAuditLogSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.UpdatedByCards
+	return r.BoardAuditLogs
 }
 
-func (o *User) GetEditedByComments() CommentSlice {
+func (o *User) GetCreatedByBoardImports() BoardImportSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetEditedByComments()
+	return o.R.GetCreatedByBoardImports()
 }
 
-func (r *userR) GetEditedByComments() CommentSlice {
+func (r *userR) GetCreatedByBoardImports() BoardImportSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.EditedByComments
+	return r.CreatedByBoardImports
 }
 
-func (o *User) GetComments() CommentSlice {
+func (o *User) GetCreatedByBoardInvitations() BoardInvitationSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetComments()
+	return o.R.GetCreatedByBoardInvitations()
 }
 
-func (r *userR) GetComments() CommentSlice {
+func (r *userR) GetCreatedByBoardInvitations() BoardInvitationSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.Comments
+	return r.CreatedByBoardInvitations
 }
 
-func (o *User) GetCreatedByLabels() LabelSlice {
+func (o *User) GetInviteeBoardInvitations() BoardInvitationSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetCreatedByLabels()
+	return o.R.GetInviteeBoardInvitations()
 }
 
-func (r *userR) GetCreatedByLabels() LabelSlice {
+func (r *userR) GetInviteeBoardInvitations() BoardInvitationSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.CreatedByLabels
+	return r.InviteeBoardInvitations
 }
 
-func (o *User) GetDeletedByLabels() LabelSlice {
+func (o *User) GetAddedByBoardMembers() BoardMemberSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetDeletedByLabels()
+	return o.R.GetAddedByBoardMembers()
 }
 
-func (r *userR) GetDeletedByLabels() LabelSlice {
+func (r *userR) GetAddedByBoardMembers() BoardMemberSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.DeletedByLabels
+	return r.AddedByBoardMembers
 }
 
-func (o *User) GetUpdatedByLabels() LabelSlice {
+func (o *User) GetBoardMembers() BoardMemberSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetUpdatedByLabels()
+	return o.R.GetBoardMembers()
 }
 
-func (r *userR) GetUpdatedByLabels() LabelSlice {
+func (r *userR) GetBoardMembers() BoardMemberSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.UpdatedByLabels
+	return r.BoardMembers
 }
 
-func (o *User) GetCreatedByLists() ListSlice {
+func (o *User) GetCreatedByBoardShareLinks() BoardShareLinkSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetCreatedByLists()
+	return o.R.GetCreatedByBoardShareLinks()
 }
 
-func (r *userR) GetCreatedByLists() ListSlice {
+func (r *userR) GetCreatedByBoardShareLinks() BoardShareLinkSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.CreatedByLists
+	return r.CreatedByBoardShareLinks
 }
 
-func (o *User) GetCreatedByRebalanceJobs() RebalanceJobSlice {
+func (o *User) GetBoardStars() BoardStarSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetCreatedByRebalanceJobs()
+	return o.R.GetBoardStars()
 }
 
-func (r *userR) GetCreatedByRebalanceJobs() RebalanceJobSlice {
+func (r *userR) GetBoardStars() BoardStarSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.CreatedByRebalanceJobs
+	return r.BoardStars
 }
 
-func (o *User) GetCreatedUserUploads() UploadSlice {
+func (o *User) GetAddedByBoardTeamGrants() BoardTeamGrantSlice {
 	if o == nil {
 		return nil
 	}
 
-	return o.R.GetCreatedUserUploads()
+	return o.R.GetAddedByBoardTeamGrants()
 }
 
-func (r *userR) GetCreatedUserUploads() UploadSlice {
+func (r *userR) GetAddedByBoardTeamGrants() BoardTeamGrantSlice {
 	if r == nil {
 		return nil
 	}
 
-	return r.CreatedUserUploads
+	return r.AddedByBoardTeamGrants
 }
 
-// userL is where Load methods for each relationship are stored.
-type userL struct{}
-
-var (
-	userAllColumns            = []string{"id", "username", "full_name", "password_hash", "avatar_url", "is_active", "role_id", "created_at", "updated_at", "deleted_at"}
-	userColumnsWithoutDefault = []string{"username"}
-	userColumnsWithDefault    = []string{"id", "full_name", "password_hash", "avatar_url", "is_active", "role_id", "created_at", "updated_at", "deleted_at"}
-	userPrimaryKeyColumns     = []string{"id"}
-	userGeneratedColumns      = []string{}
-)
+func (o *User) GetCreatedByBoardTemplates() BoardTemplateSlice {
+	if o == nil {
+		return nil
+	}
 
-type (
-	// UserSlice is an alias for a slice of pointers to User.
-	// This should almost always be used instead of []User.
-	UserSlice []*User
-	// UserHook is the signature for custom User hook methods
-	UserHook func(context.Context, boil.ContextExecutor, *User) error
+	return o.R.GetCreatedByBoardTemplates()
+}
 
-	userQuery struct {
-		*queries.Query
+func (r *userR) GetCreatedByBoardTemplates() BoardTemplateSlice {
+	if r == nil {
+		return nil
 	}
-)
 
-// Cache for insert, update and upsert
-var (
-	userType                 = reflect.TypeOf(&User{})
-	userMapping              = queries.MakeStructMapping(userType)
-	userPrimaryKeyMapping, _ = queries.BindMapping(userType, userMapping, userPrimaryKeyColumns)
-	userInsertCacheMut       sync.RWMutex
-	userInsertCache          = make(map[string]insertCache)
-	userUpdateCacheMut       sync.RWMutex
-	userUpdateCache          = make(map[string]updateCache)
-	userUpsertCacheMut       sync.RWMutex
-	userUpsertCache          = make(map[string]insertCache)
-)
+	return r.CreatedByBoardTemplates
+}
 
-var (
-	// Force time package dependency for automated UpdatedAt/CreatedAt.
-	_ = time.Second
-	// Force qmhelper dependency for where clause generation (which doesn't
-	// always happen)
-	_ = qmhelper.Where
-)
+func (o *User) GetBoardViews() BoardViewSlice {
+	if o == nil {
+		return nil
+	}
 
-var userAfterSelectMu sync.Mutex
-var userAfterSelectHooks []UserHook
+	return o.R.GetBoardViews()
+}
 
-var userBeforeInsertMu sync.Mutex
-var userBeforeInsertHooks []UserHook
-var userAfterInsertMu sync.Mutex
-var userAfterInsertHooks []UserHook
+func (r *userR) GetBoardViews() BoardViewSlice {
+	if r == nil {
+		return nil
+	}
 
-var userBeforeUpdateMu sync.Mutex
-var userBeforeUpdateHooks []UserHook
-var userAfterUpdateMu sync.Mutex
-var userAfterUpdateHooks []UserHook
+	return r.BoardViews
+}
 
-var userBeforeDeleteMu sync.Mutex
-var userBeforeDeleteHooks []UserHook
-var userAfterDeleteMu sync.Mutex
-var userAfterDeleteHooks []UserHook
+func (o *User) GetArchivedByBoards() BoardSlice {
+	if o == nil {
+		return nil
+	}
 
-var userBeforeUpsertMu sync.Mutex
-var userBeforeUpsertHooks []UserHook
-var userAfterUpsertMu sync.Mutex
-var userAfterUpsertHooks []UserHook
+	return o.R.GetArchivedByBoards()
+}
 
-// doAfterSelectHooks executes all "after Select" hooks.
-func (o *User) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetArchivedByBoards() BoardSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userAfterSelectHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.ArchivedByBoards
+}
+
+func (o *User) GetCreatedByBoards() BoardSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetCreatedByBoards()
 }
 
-// doBeforeInsertHooks executes all "before insert" hooks.
-func (o *User) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetCreatedByBoards() BoardSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userBeforeInsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.CreatedByBoards
+}
+
+func (o *User) GetDeletedByBoards() BoardSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetDeletedByBoards()
 }
 
-// doAfterInsertHooks executes all "after Insert" hooks.
-func (o *User) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetDeletedByBoards() BoardSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userAfterInsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.DeletedByBoards
+}
+
+func (o *User) GetAssignedToCards() CardSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetAssignedToCards()
 }
 
-// doBeforeUpdateHooks executes all "before Update" hooks.
-func (o *User) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetAssignedToCards() CardSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userBeforeUpdateHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.AssignedToCards
+}
+
+func (o *User) GetCreatedByCards() CardSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetCreatedByCards()
 }
 
-// doAfterUpdateHooks executes all "after Update" hooks.
-func (o *User) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetCreatedByCards() CardSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userAfterUpdateHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.CreatedByCards
+}
+
+func (o *User) GetUpdatedByCards() CardSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetUpdatedByCards()
 }
 
-// doBeforeDeleteHooks executes all "before Delete" hooks.
-func (o *User) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetUpdatedByCards() CardSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userBeforeDeleteHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.UpdatedByCards
+}
+
+func (o *User) GetEditedByComments() CommentSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetEditedByComments()
 }
 
-// doAfterDeleteHooks executes all "after Delete" hooks.
-func (o *User) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetEditedByComments() CommentSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userAfterDeleteHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.EditedByComments
+}
+
+func (o *User) GetComments() CommentSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetComments()
 }
 
-// doBeforeUpsertHooks executes all "before Upsert" hooks.
-func (o *User) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetComments() CommentSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userBeforeUpsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.Comments
+}
+
+func (o *User) GetEmailVerifications() EmailVerificationSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetEmailVerifications()
 }
 
-// doAfterUpsertHooks executes all "after Upsert" hooks.
-func (o *User) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
-	if boil.HooksAreSkipped(ctx) {
+func (r *userR) GetEmailVerifications() EmailVerificationSlice {
+	if r == nil {
 		return nil
 	}
 
-	for _, hook := range userAfterUpsertHooks {
-		if err := hook(ctx, exec, o); err != nil {
-			return err
-		}
+	return r.EmailVerifications
+}
+
+func (o *User) GetCreatedByLabels() LabelSlice {
+	if o == nil {
+		return nil
 	}
 
-	return nil
+	return o.R.GetCreatedByLabels()
 }
 
-// AddUserHook registers your hook function for all future operations.
-func AddUserHook(hookPoint boil.HookPoint, userHook UserHook) {
-	switch hookPoint {
-	case boil.AfterSelectHook:
-		userAfterSelectMu.Lock()
-		userAfterSelectHooks = append(userAfterSelectHooks, userHook)
-		userAfterSelectMu.Unlock()
-	case boil.BeforeInsertHook:
-		userBeforeInsertMu.Lock()
-		userBeforeInsertHooks = append(userBeforeInsertHooks, userHook)
-		userBeforeInsertMu.Unlock()
-	case boil.AfterInsertHook:
-		userAfterInsertMu.Lock()
-		userAfterInsertHooks = append(userAfterInsertHooks, userHook)
-		userAfterInsertMu.Unlock()
-	case boil.BeforeUpdateHook:
-		userBeforeUpdateMu.Lock()
-		userBeforeUpdateHooks = append(userBeforeUpdateHooks, userHook)
-		userBeforeUpdateMu.Unlock()
-	case boil.AfterUpdateHook:
-		userAfterUpdateMu.Lock()
-		userAfterUpdateHooks = append(userAfterUpdateHooks, userHook)
-		userAfterUpdateMu.Unlock()
-	case boil.BeforeDeleteHook:
-		userBeforeDeleteMu.Lock()
-		userBeforeDeleteHooks = append(userBeforeDeleteHooks, userHook)
-		userBeforeDeleteMu.Unlock()
-	case boil.AfterDeleteHook:
-		userAfterDeleteMu.Lock()
-		userAfterDeleteHooks = append(userAfterDeleteHooks, userHook)
-		userAfterDeleteMu.Unlock()
-	case boil.BeforeUpsertHook:
-		userBeforeUpsertMu.Lock()
-		userBeforeUpsertHooks = append(userBeforeUpsertHooks, userHook)
-		userBeforeUpsertMu.Unlock()
-	case boil.AfterUpsertHook:
-		userAfterUpsertMu.Lock()
-		userAfterUpsertHooks = append(userAfterUpsertHooks, userHook)
-		userAfterUpsertMu.Unlock()
+func (r *userR) GetCreatedByLabels() LabelSlice {
+	if r == nil {
+		return nil
 	}
+
+	return r.CreatedByLabels
 }
 
-// One returns a single user record from the query.
-func (q userQuery) One(ctx context.Context, exec boil.ContextExecutor) (*User, error) {
-	o := &User{}
+func (o *User) GetDeletedByLabels() LabelSlice {
+	if o == nil {
+		return nil
+	}
 
-	queries.SetLimit(q.Query, 1)
+	return o.R.GetDeletedByLabels()
+}
 
-	err := q.Bind(ctx, exec, o)
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, sql.ErrNoRows
-		}
-		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for users")
+func (r *userR) GetDeletedByLabels() LabelSlice {
+	if r == nil {
+		return nil
 	}
 
-	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
-		return o, err
+	return r.DeletedByLabels
+}
+
+func (o *User) GetUpdatedByLabels() LabelSlice {
+	if o == nil {
+		return nil
 	}
 
-	return o, nil
+	return o.R.GetUpdatedByLabels()
 }
 
-// All returns all User records from the query.
-func (q userQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserSlice, error) {
-	var o []*User
-
-	err := q.Bind(ctx, exec, &o)
-	if err != nil {
-		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to User slice")
+func (r *userR) GetUpdatedByLabels() LabelSlice {
+	if r == nil {
+		return nil
 	}
 
-	if len(userAfterSelectHooks) != 0 {
-		for _, obj := range o {
-			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
-				return o, err
-			}
-		}
+	return r.UpdatedByLabels
+}
+
+func (o *User) GetCreatedByLists() ListSlice {
+	if o == nil {
+		return nil
 	}
 
-	return o, nil
+	return o.R.GetCreatedByLists()
 }
 
-// Count returns the count of all User records in the query.
-func (q userQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
-	var count int64
+func (r *userR) GetCreatedByLists() ListSlice {
+	if r == nil {
+		return nil
+	}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
+	return r.CreatedByLists
+}
 
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
-	if err != nil {
-		return 0, errors.Wrap(err, "dbmodels: failed to count users rows")
+func (o *User) GetLoginAttempts() LoginAttemptSlice {
+	if o == nil {
+		return nil
 	}
 
-	return count, nil
+	return o.R.GetLoginAttempts()
 }
 
-// Exists checks if the row exists in the table.
-func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
-	var count int64
+func (r *userR) GetLoginAttempts() LoginAttemptSlice {
+	if r == nil {
+		return nil
+	}
 
-	queries.SetSelect(q.Query, nil)
-	queries.SetCount(q.Query)
-	queries.SetLimit(q.Query, 1)
+	return r.LoginAttempts
+}
 
-	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
-	if err != nil {
-		return false, errors.Wrap(err, "dbmodels: failed to check if users exists")
+func (o *User) GetMfaRecoveryCodes() MfaRecoveryCodeSlice {
+	if o == nil {
+		return nil
 	}
 
-	return count > 0, nil
+	return o.R.GetMfaRecoveryCodes()
 }
 
-// Role pointed to by the foreign key.
-func (o *User) Role(mods ...qm.QueryMod) roleQuery {
-	queryMods := []qm.QueryMod{
-		qm.Where("\"id\" = ?", o.RoleID),
+func (r *userR) GetMfaRecoveryCodes() MfaRecoveryCodeSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods, mods...)
-
-	return Roles(queryMods...)
+	return r.MfaRecoveryCodes
 }
 
-// CreatedByBoards retrieves all the board's Boards with an executor via created_by column.
-func (o *User) CreatedByBoards(mods ...qm.QueryMod) boardQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (o *User) GetPasswordResets() PasswordResetSlice {
+	if o == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"boards\".\"created_by\"=?", o.ID),
-	)
-
-	return Boards(queryMods...)
+	return o.R.GetPasswordResets()
 }
 
-// AssignedToCards retrieves all the card's Cards with an executor via assigned_to column.
-func (o *User) AssignedToCards(mods ...qm.QueryMod) cardQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (r *userR) GetPasswordResets() PasswordResetSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"cards\".\"assigned_to\"=?", o.ID),
-	)
-
-	return Cards(queryMods...)
+	return r.PasswordResets
 }
 
-// CreatedByCards retrieves all the card's Cards with an executor via created_by column.
-func (o *User) CreatedByCards(mods ...qm.QueryMod) cardQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (o *User) GetPersonalAccessTokens() PersonalAccessTokenSlice {
+	if o == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"cards\".\"created_by\"=?", o.ID),
-	)
-
-	return Cards(queryMods...)
+	return o.R.GetPersonalAccessTokens()
 }
 
-// UpdatedByCards retrieves all the card's Cards with an executor via updated_by column.
-func (o *User) UpdatedByCards(mods ...qm.QueryMod) cardQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (r *userR) GetPersonalAccessTokens() PersonalAccessTokenSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"cards\".\"updated_by\"=?", o.ID),
-	)
-
-	return Cards(queryMods...)
+	return r.PersonalAccessTokens
 }
 
-// EditedByComments retrieves all the comment's Comments with an executor via edited_by column.
-func (o *User) EditedByComments(mods ...qm.QueryMod) commentQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (o *User) GetCreatedByRebalanceJobs() RebalanceJobSlice {
+	if o == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"comments\".\"edited_by\"=?", o.ID),
-	)
-
-	return Comments(queryMods...)
+	return o.R.GetCreatedByRebalanceJobs()
 }
 
-// Comments retrieves all the comment's Comments with an executor.
-func (o *User) Comments(mods ...qm.QueryMod) commentQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (r *userR) GetCreatedByRebalanceJobs() RebalanceJobSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"comments\".\"user_id\"=?", o.ID),
-	)
-
-	return Comments(queryMods...)
+	return r.CreatedByRebalanceJobs
 }
 
-// CreatedByLabels retrieves all the label's Labels with an executor via created_by column.
-func (o *User) CreatedByLabels(mods ...qm.QueryMod) labelQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (o *User) GetRefreshTokens() RefreshTokenSlice {
+	if o == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"labels\".\"created_by\"=?", o.ID),
-	)
-
-	return Labels(queryMods...)
+	return o.R.GetRefreshTokens()
 }
 
-// DeletedByLabels retrieves all the label's Labels with an executor via deleted_by column.
-func (o *User) DeletedByLabels(mods ...qm.QueryMod) labelQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (r *userR) GetRefreshTokens() RefreshTokenSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"labels\".\"deleted_by\"=?", o.ID),
-	)
-
-	return Labels(queryMods...)
+	return r.RefreshTokens
 }
 
-// UpdatedByLabels retrieves all the label's Labels with an executor via updated_by column.
-func (o *User) UpdatedByLabels(mods ...qm.QueryMod) labelQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (o *User) GetRevokedTokens() RevokedTokenSlice {
+	if o == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"labels\".\"updated_by\"=?", o.ID),
-	)
-
-	return Labels(queryMods...)
+	return o.R.GetRevokedTokens()
 }
 
-// CreatedByLists retrieves all the list's Lists with an executor via created_by column.
-func (o *User) CreatedByLists(mods ...qm.QueryMod) listQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (r *userR) GetRevokedTokens() RevokedTokenSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"lists\".\"created_by\"=?", o.ID),
-	)
-
-	return Lists(queryMods...)
+	return r.RevokedTokens
 }
 
-// CreatedByRebalanceJobs retrieves all the rebalance_job's RebalanceJobs with an executor via created_by column.
-func (o *User) CreatedByRebalanceJobs(mods ...qm.QueryMod) rebalanceJobQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (o *User) GetSessions() SessionSlice {
+	if o == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"rebalance_jobs\".\"created_by\"=?", o.ID),
-	)
-
-	return RebalanceJobs(queryMods...)
+	return o.R.GetSessions()
 }
 
-// CreatedUserUploads retrieves all the upload's Uploads with an executor via created_user_id column.
-func (o *User) CreatedUserUploads(mods ...qm.QueryMod) uploadQuery {
-	var queryMods []qm.QueryMod
-	if len(mods) != 0 {
-		queryMods = append(queryMods, mods...)
+func (r *userR) GetSessions() SessionSlice {
+	if r == nil {
+		return nil
 	}
 
-	queryMods = append(queryMods,
-		qm.Where("\"uploads\".\"created_user_id\"=?", o.ID),
-	)
-
-	return Uploads(queryMods...)
+	return r.Sessions
 }
 
-// LoadRole allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for an N-1 relationship.
-func (userL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
-			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
-			}
-		}
+func (o *User) GetAddedByTeamMembers() TeamMemberSlice {
+	if o == nil {
+		return nil
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		if !queries.IsNil(object.RoleID) {
-			args[object.RoleID] = struct{}{}
-		}
+	return o.R.GetAddedByTeamMembers()
+}
 
-	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
+func (r *userR) GetAddedByTeamMembers() TeamMemberSlice {
+	if r == nil {
+		return nil
+	}
 
-			if !queries.IsNil(obj.RoleID) {
-				args[obj.RoleID] = struct{}{}
-			}
+	return r.AddedByTeamMembers
+}
 
-		}
+func (o *User) GetTeamMembers() TeamMemberSlice {
+	if o == nil {
+		return nil
 	}
 
-	if len(args) == 0 {
+	return o.R.GetTeamMembers()
+}
+
+func (r *userR) GetTeamMembers() TeamMemberSlice {
+	if r == nil {
 		return nil
 	}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
+	return r.TeamMembers
+}
 
-	query := NewQuery(
-		qm.From(`roles`),
-		qm.WhereIn(`roles.id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`roles.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+func (o *User) GetCreatedByTeams() TeamSlice {
+	if o == nil {
+		return nil
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load Role")
-	}
+	return o.R.GetCreatedByTeams()
+}
 
-	var resultSlice []*Role
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+func (r *userR) GetCreatedByTeams() TeamSlice {
+	if r == nil {
+		return nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results of eager load for roles")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
-	}
+	return r.CreatedByTeams
+}
 
-	if len(roleAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
+func (o *User) GetCreatedUserUploads() UploadSlice {
+	if o == nil {
+		return nil
 	}
 
-	if len(resultSlice) == 0 {
+	return o.R.GetCreatedUserUploads()
+}
+
+func (r *userR) GetCreatedUserUploads() UploadSlice {
+	if r == nil {
 		return nil
 	}
 
-	if singular {
-		foreign := resultSlice[0]
-		object.R.Role = foreign
-		if foreign.R == nil {
-			foreign.R = &roleR{}
-		}
-		foreign.R.Users = append(foreign.R.Users, object)
+	return r.CreatedUserUploads
+}
+
+func (o *User) GetUserIdentities() UserIdentitySlice {
+	if o == nil {
 		return nil
 	}
 
-	for _, local := range slice {
-		for _, foreign := range resultSlice {
-			if queries.Equal(local.RoleID, foreign.ID) {
-				local.R.Role = foreign
-				if foreign.R == nil {
-					foreign.R = &roleR{}
-				}
-				foreign.R.Users = append(foreign.R.Users, local)
-				break
-			}
-		}
+	return o.R.GetUserIdentities()
+}
+
+func (r *userR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
 	}
 
-	return nil
+	return r.UserIdentities
 }
 
-// LoadCreatedByBoards allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByBoards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
-			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
-			}
-		}
+func (o *User) GetAddedByWorkspaceMembers() WorkspaceMemberSlice {
+	if o == nil {
+		return nil
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args[object.ID] = struct{}{}
-	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-			args[obj.ID] = struct{}{}
-		}
-	}
+	return o.R.GetAddedByWorkspaceMembers()
+}
 
-	if len(args) == 0 {
+func (r *userR) GetAddedByWorkspaceMembers() WorkspaceMemberSlice {
+	if r == nil {
 		return nil
 	}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
+	return r.AddedByWorkspaceMembers
+}
 
-	query := NewQuery(
-		qm.From(`boards`),
-		qm.WhereIn(`boards.created_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`boards.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+func (o *User) GetWorkspaceMembers() WorkspaceMemberSlice {
+	if o == nil {
+		return nil
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load boards")
-	}
+	return o.R.GetWorkspaceMembers()
+}
 
-	var resultSlice []*Board
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice boards")
+func (r *userR) GetWorkspaceMembers() WorkspaceMemberSlice {
+	if r == nil {
+		return nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on boards")
+	return r.WorkspaceMembers
+}
+
+func (o *User) GetCreatedByWorkspaces() WorkspaceSlice {
+	if o == nil {
+		return nil
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+
+	return o.R.GetCreatedByWorkspaces()
+}
+
+func (r *userR) GetCreatedByWorkspaces() WorkspaceSlice {
+	if r == nil {
+		return nil
 	}
 
-	if len(boardAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
-		}
+	return r.CreatedByWorkspaces
+}
+
+// userL is where Load methods for each relationship are stored.
+type userL struct{}
+
+var (
+	userAllColumns            = []string{"id", "username", "full_name", "password_hash", "avatar_url", "is_active", "role_id", "created_at", "updated_at", "deleted_at"}
+	userColumnsWithoutDefault = []string{"username"}
+	userColumnsWithDefault    = []string{"id", "full_name", "password_hash", "avatar_url", "is_active", "role_id", "created_at", "updated_at", "deleted_at"}
+	userPrimaryKeyColumns     = []string{"id"}
+	userGeneratedColumns      = []string{}
+)
+
+type (
+	// UserSlice is an alias for a slice of pointers to User.
+	// This should almost always be used instead of []User.
+	UserSlice []*User
+	// UserHook is the signature for custom User hook methods
+	UserHook func(context.Context, boil.ContextExecutor, *User) error
+
+	userQuery struct {
+		*queries.Query
 	}
-	if singular {
-		object.R.CreatedByBoards = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &boardR{}
-			}
-			foreign.R.CreatedByUser = object
-		}
+)
+
+// Cache for insert, update and upsert
+var (
+	userType                 = reflect.TypeOf(&User{})
+	userMapping              = queries.MakeStructMapping(userType)
+	userPrimaryKeyMapping, _ = queries.BindMapping(userType, userMapping, userPrimaryKeyColumns)
+	userInsertCacheMut       sync.RWMutex
+	userInsertCache          = make(map[string]insertCache)
+	userUpdateCacheMut       sync.RWMutex
+	userUpdateCache          = make(map[string]updateCache)
+	userUpsertCacheMut       sync.RWMutex
+	userUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var userAfterSelectMu sync.Mutex
+var userAfterSelectHooks []UserHook
+
+var userBeforeInsertMu sync.Mutex
+var userBeforeInsertHooks []UserHook
+var userAfterInsertMu sync.Mutex
+var userAfterInsertHooks []UserHook
+
+var userBeforeUpdateMu sync.Mutex
+var userBeforeUpdateHooks []UserHook
+var userAfterUpdateMu sync.Mutex
+var userAfterUpdateHooks []UserHook
+
+var userBeforeDeleteMu sync.Mutex
+var userBeforeDeleteHooks []UserHook
+var userAfterDeleteMu sync.Mutex
+var userAfterDeleteHooks []UserHook
+
+var userBeforeUpsertMu sync.Mutex
+var userBeforeUpsertHooks []UserHook
+var userAfterUpsertMu sync.Mutex
+var userAfterUpsertHooks []UserHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *User) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedBy) {
-				local.R.CreatedByBoards = append(local.R.CreatedByBoards, foreign)
-				if foreign.R == nil {
-					foreign.R = &boardR{}
-				}
-				foreign.R.CreatedByUser = local
-				break
-			}
+	for _, hook := range userAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// LoadAssignedToCards allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadAssignedToCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *User) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
 
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
-			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
-			}
+	for _, hook := range userBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
-		}
-		args[object.ID] = struct{}{}
-	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-			args[obj.ID] = struct{}{}
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *User) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
 		}
 	}
 
-	if len(args) == 0 {
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *User) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	for _, hook := range userBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
 	}
 
-	query := NewQuery(
-		qm.From(`cards`),
-		qm.WhereIn(`cards.assigned_to in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`cards.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *User) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load cards")
+	for _, hook := range userAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
 	}
 
-	var resultSlice []*Card
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *User) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on cards")
+	for _, hook := range userBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *User) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
 	}
 
-	if len(cardAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	for _, hook := range userAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
 		}
 	}
-	if singular {
-		object.R.AssignedToCards = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &cardR{}
-			}
-			foreign.R.AssignedToUser = object
-		}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *User) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.AssignedTo) {
-				local.R.AssignedToCards = append(local.R.AssignedToCards, foreign)
-				if foreign.R == nil {
-					foreign.R = &cardR{}
-				}
-				foreign.R.AssignedToUser = local
-				break
-			}
+	for _, hook := range userBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
-// LoadCreatedByCards allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *User) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range userAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddUserHook registers your hook function for all future operations.
+func AddUserHook(hookPoint boil.HookPoint, userHook UserHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		userAfterSelectMu.Lock()
+		userAfterSelectHooks = append(userAfterSelectHooks, userHook)
+		userAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		userBeforeInsertMu.Lock()
+		userBeforeInsertHooks = append(userBeforeInsertHooks, userHook)
+		userBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		userAfterInsertMu.Lock()
+		userAfterInsertHooks = append(userAfterInsertHooks, userHook)
+		userAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		userBeforeUpdateMu.Lock()
+		userBeforeUpdateHooks = append(userBeforeUpdateHooks, userHook)
+		userBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		userAfterUpdateMu.Lock()
+		userAfterUpdateHooks = append(userAfterUpdateHooks, userHook)
+		userAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		userBeforeDeleteMu.Lock()
+		userBeforeDeleteHooks = append(userBeforeDeleteHooks, userHook)
+		userBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		userAfterDeleteMu.Lock()
+		userAfterDeleteHooks = append(userAfterDeleteHooks, userHook)
+		userAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		userBeforeUpsertMu.Lock()
+		userBeforeUpsertHooks = append(userBeforeUpsertHooks, userHook)
+		userBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		userAfterUpsertMu.Lock()
+		userAfterUpsertHooks = append(userAfterUpsertHooks, userHook)
+		userAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single user record from the query.
+func (q userQuery) One(ctx context.Context, exec boil.ContextExecutor) (*User, error) {
+	o := &User{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for users")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all User records from the query.
+func (q userQuery) All(ctx context.Context, exec boil.ContextExecutor) (UserSlice, error) {
+	var o []*User
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to User slice")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all User records in the query.
+func (q userQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count users rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if users exists")
+	}
+
+	return count > 0, nil
+}
+
+// Role pointed to by the foreign key.
+func (o *User) Role(mods ...qm.QueryMod) roleQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.RoleID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Roles(queryMods...)
+}
+
+// LoginLockout pointed to by the foreign key.
+func (o *User) LoginLockout(mods ...qm.QueryMod) loginLockoutQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return LoginLockouts(queryMods...)
+}
+
+// UserMfa pointed to by the foreign key.
+func (o *User) UserMfa(mods ...qm.QueryMod) userMfaQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserMfas(queryMods...)
+}
+
+// UserTokenRevocation pointed to by the foreign key.
+func (o *User) UserTokenRevocation(mods ...qm.QueryMod) userTokenRevocationQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserTokenRevocations(queryMods...)
+}
+
+// BoardAuditLogs retrieves all the board_audit_log's BoardAuditLogs with an executor.
+func (o *User) BoardAuditLogs(mods ...qm.QueryMod) boardAuditLogQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_audit_logs\".\"user_id\"=?", o.ID),
+	)
+
+	return BoardAuditLogs(queryMods...)
+}
+
+// CreatedByBoardImports retrieves all the board_import's BoardImports with an executor via created_by column.
+func (o *User) CreatedByBoardImports(mods ...qm.QueryMod) boardImportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_imports\".\"created_by\"=?", o.ID),
+	)
+
+	return BoardImports(queryMods...)
+}
+
+// CreatedByBoardInvitations retrieves all the board_invitation's BoardInvitations with an executor via created_by column.
+func (o *User) CreatedByBoardInvitations(mods ...qm.QueryMod) boardInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_invitations\".\"created_by\"=?", o.ID),
+	)
+
+	return BoardInvitations(queryMods...)
+}
+
+// InviteeBoardInvitations retrieves all the board_invitation's BoardInvitations with an executor via invitee_id column.
+func (o *User) InviteeBoardInvitations(mods ...qm.QueryMod) boardInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_invitations\".\"invitee_id\"=?", o.ID),
+	)
+
+	return BoardInvitations(queryMods...)
+}
+
+// AddedByBoardMembers retrieves all the board_member's BoardMembers with an executor via added_by column.
+func (o *User) AddedByBoardMembers(mods ...qm.QueryMod) boardMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_members\".\"added_by\"=?", o.ID),
+	)
+
+	return BoardMembers(queryMods...)
+}
+
+// BoardMembers retrieves all the board_member's BoardMembers with an executor.
+func (o *User) BoardMembers(mods ...qm.QueryMod) boardMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_members\".\"user_id\"=?", o.ID),
+	)
+
+	return BoardMembers(queryMods...)
+}
+
+// CreatedByBoardShareLinks retrieves all the board_share_link's BoardShareLinks with an executor via created_by column.
+func (o *User) CreatedByBoardShareLinks(mods ...qm.QueryMod) boardShareLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_share_links\".\"created_by\"=?", o.ID),
+	)
+
+	return BoardShareLinks(queryMods...)
+}
+
+// BoardStars retrieves all the board_star's BoardStars with an executor.
+func (o *User) BoardStars(mods ...qm.QueryMod) boardStarQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_stars\".\"user_id\"=?", o.ID),
+	)
+
+	return BoardStars(queryMods...)
+}
+
+// AddedByBoardTeamGrants retrieves all the board_team_grant's BoardTeamGrants with an executor via added_by column.
+func (o *User) AddedByBoardTeamGrants(mods ...qm.QueryMod) boardTeamGrantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_team_grants\".\"added_by\"=?", o.ID),
+	)
+
+	return BoardTeamGrants(queryMods...)
+}
+
+// CreatedByBoardTemplates retrieves all the board_template's BoardTemplates with an executor via created_by column.
+func (o *User) CreatedByBoardTemplates(mods ...qm.QueryMod) boardTemplateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_templates\".\"created_by\"=?", o.ID),
+	)
+
+	return BoardTemplates(queryMods...)
+}
+
+// BoardViews retrieves all the board_view's BoardViews with an executor.
+func (o *User) BoardViews(mods ...qm.QueryMod) boardViewQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"board_views\".\"user_id\"=?", o.ID),
+	)
+
+	return BoardViews(queryMods...)
+}
+
+// ArchivedByBoards retrieves all the board's Boards with an executor via archived_by column.
+func (o *User) ArchivedByBoards(mods ...qm.QueryMod) boardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"boards\".\"archived_by\"=?", o.ID),
+	)
+
+	return Boards(queryMods...)
+}
+
+// CreatedByBoards retrieves all the board's Boards with an executor via created_by column.
+func (o *User) CreatedByBoards(mods ...qm.QueryMod) boardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"boards\".\"created_by\"=?", o.ID),
+	)
+
+	return Boards(queryMods...)
+}
+
+// DeletedByBoards retrieves all the board's Boards with an executor via deleted_by column.
+func (o *User) DeletedByBoards(mods ...qm.QueryMod) boardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"boards\".\"deleted_by\"=?", o.ID),
+	)
+
+	return Boards(queryMods...)
+}
+
+// AssignedToCards retrieves all the card's Cards with an executor via assigned_to column.
+func (o *User) AssignedToCards(mods ...qm.QueryMod) cardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"cards\".\"assigned_to\"=?", o.ID),
+	)
+
+	return Cards(queryMods...)
+}
+
+// CreatedByCards retrieves all the card's Cards with an executor via created_by column.
+func (o *User) CreatedByCards(mods ...qm.QueryMod) cardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"cards\".\"created_by\"=?", o.ID),
+	)
+
+	return Cards(queryMods...)
+}
+
+// UpdatedByCards retrieves all the card's Cards with an executor via updated_by column.
+func (o *User) UpdatedByCards(mods ...qm.QueryMod) cardQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"cards\".\"updated_by\"=?", o.ID),
+	)
+
+	return Cards(queryMods...)
+}
+
+// EditedByComments retrieves all the comment's Comments with an executor via edited_by column.
+func (o *User) EditedByComments(mods ...qm.QueryMod) commentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"comments\".\"edited_by\"=?", o.ID),
+	)
+
+	return Comments(queryMods...)
+}
+
+// Comments retrieves all the comment's Comments with an executor.
+func (o *User) Comments(mods ...qm.QueryMod) commentQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"comments\".\"user_id\"=?", o.ID),
+	)
+
+	return Comments(queryMods...)
+}
+
+// EmailVerifications retrieves all the email_verification's EmailVerifications with an executor.
+func (o *User) EmailVerifications(mods ...qm.QueryMod) emailVerificationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"email_verifications\".\"user_id\"=?", o.ID),
+	)
+
+	return EmailVerifications(queryMods...)
+}
+
+// CreatedByLabels retrieves all the label's Labels with an executor via created_by column.
+func (o *User) CreatedByLabels(mods ...qm.QueryMod) labelQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"labels\".\"created_by\"=?", o.ID),
+	)
+
+	return Labels(queryMods...)
+}
+
+// DeletedByLabels retrieves all the label's Labels with an executor via deleted_by column.
+func (o *User) DeletedByLabels(mods ...qm.QueryMod) labelQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"labels\".\"deleted_by\"=?", o.ID),
+	)
+
+	return Labels(queryMods...)
+}
+
+// UpdatedByLabels retrieves all the label's Labels with an executor via updated_by column.
+func (o *User) UpdatedByLabels(mods ...qm.QueryMod) labelQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"labels\".\"updated_by\"=?", o.ID),
+	)
+
+	return Labels(queryMods...)
+}
+
+// CreatedByLists retrieves all the list's Lists with an executor via created_by column.
+func (o *User) CreatedByLists(mods ...qm.QueryMod) listQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"lists\".\"created_by\"=?", o.ID),
+	)
+
+	return Lists(queryMods...)
+}
+
+// LoginAttempts retrieves all the login_attempt's LoginAttempts with an executor.
+func (o *User) LoginAttempts(mods ...qm.QueryMod) loginAttemptQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"login_attempts\".\"user_id\"=?", o.ID),
+	)
+
+	return LoginAttempts(queryMods...)
+}
+
+// MfaRecoveryCodes retrieves all the mfa_recovery_code's MfaRecoveryCodes with an executor.
+func (o *User) MfaRecoveryCodes(mods ...qm.QueryMod) mfaRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"mfa_recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return MfaRecoveryCodes(queryMods...)
+}
+
+// PasswordResets retrieves all the password_reset's PasswordResets with an executor.
+func (o *User) PasswordResets(mods ...qm.QueryMod) passwordResetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"password_resets\".\"user_id\"=?", o.ID),
+	)
+
+	return PasswordResets(queryMods...)
+}
+
+// PersonalAccessTokens retrieves all the personal_access_token's PersonalAccessTokens with an executor.
+func (o *User) PersonalAccessTokens(mods ...qm.QueryMod) personalAccessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"personal_access_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return PersonalAccessTokens(queryMods...)
+}
+
+// CreatedByRebalanceJobs retrieves all the rebalance_job's RebalanceJobs with an executor via created_by column.
+func (o *User) CreatedByRebalanceJobs(mods ...qm.QueryMod) rebalanceJobQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"rebalance_jobs\".\"created_by\"=?", o.ID),
+	)
+
+	return RebalanceJobs(queryMods...)
+}
+
+// RefreshTokens retrieves all the refresh_token's RefreshTokens with an executor.
+func (o *User) RefreshTokens(mods ...qm.QueryMod) refreshTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"refresh_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return RefreshTokens(queryMods...)
+}
+
+// RevokedTokens retrieves all the revoked_token's RevokedTokens with an executor.
+func (o *User) RevokedTokens(mods ...qm.QueryMod) revokedTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"revoked_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return RevokedTokens(queryMods...)
+}
+
+// Sessions retrieves all the session's Sessions with an executor.
+func (o *User) Sessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"sessions\".\"user_id\"=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
+// AddedByTeamMembers retrieves all the team_member's TeamMembers with an executor via added_by column.
+func (o *User) AddedByTeamMembers(mods ...qm.QueryMod) teamMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"team_members\".\"added_by\"=?", o.ID),
+	)
+
+	return TeamMembers(queryMods...)
+}
+
+// TeamMembers retrieves all the team_member's TeamMembers with an executor.
+func (o *User) TeamMembers(mods ...qm.QueryMod) teamMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"team_members\".\"user_id\"=?", o.ID),
+	)
+
+	return TeamMembers(queryMods...)
+}
+
+// CreatedByTeams retrieves all the team's Teams with an executor via created_by column.
+func (o *User) CreatedByTeams(mods ...qm.QueryMod) teamQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"teams\".\"created_by\"=?", o.ID),
+	)
+
+	return Teams(queryMods...)
+}
+
+// CreatedUserUploads retrieves all the upload's Uploads with an executor via created_user_id column.
+func (o *User) CreatedUserUploads(mods ...qm.QueryMod) uploadQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"uploads\".\"created_user_id\"=?", o.ID),
+	)
+
+	return Uploads(queryMods...)
+}
+
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *User) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identities\".\"user_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
+// AddedByWorkspaceMembers retrieves all the workspace_member's WorkspaceMembers with an executor via added_by column.
+func (o *User) AddedByWorkspaceMembers(mods ...qm.QueryMod) workspaceMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"workspace_members\".\"added_by\"=?", o.ID),
+	)
+
+	return WorkspaceMembers(queryMods...)
+}
+
+// WorkspaceMembers retrieves all the workspace_member's WorkspaceMembers with an executor.
+func (o *User) WorkspaceMembers(mods ...qm.QueryMod) workspaceMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"workspace_members\".\"user_id\"=?", o.ID),
+	)
+
+	return WorkspaceMembers(queryMods...)
+}
+
+// CreatedByWorkspaces retrieves all the workspace's Workspaces with an executor via created_by column.
+func (o *User) CreatedByWorkspaces(mods ...qm.QueryMod) workspaceQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"workspaces\".\"created_by\"=?", o.ID),
+	)
+
+	return Workspaces(queryMods...)
+}
+
+// LoadRole allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userL) LoadRole(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		if !queries.IsNil(object.RoleID) {
+			args[object.RoleID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			if !queries.IsNil(obj.RoleID) {
+				args[obj.RoleID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`roles`),
+		qm.WhereIn(`roles.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`roles.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Role")
+	}
+
+	var resultSlice []*Role
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Role")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for roles")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for roles")
+	}
+
+	if len(roleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Role = foreign
+		if foreign.R == nil {
+			foreign.R = &roleR{}
+		}
+		foreign.R.Users = append(foreign.R.Users, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.RoleID, foreign.ID) {
+				local.R.Role = foreign
+				if foreign.R == nil {
+					foreign.R = &roleR{}
+				}
+				foreign.R.Users = append(foreign.R.Users, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoginLockout allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadLoginLockout(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`login_lockouts`),
+		qm.WhereIn(`login_lockouts.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load LoginLockout")
+	}
+
+	var resultSlice []*LoginLockout
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice LoginLockout")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for login_lockouts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for login_lockouts")
+	}
+
+	if len(loginLockoutAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.LoginLockout = foreign
+		if foreign.R == nil {
+			foreign.R = &loginLockoutR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.LoginLockout = foreign
+				if foreign.R == nil {
+					foreign.R = &loginLockoutR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserMfa allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadUserMfa(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_mfa`),
+		qm.WhereIn(`user_mfa.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserMfa")
+	}
+
+	var resultSlice []*UserMfa
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserMfa")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_mfa")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_mfa")
+	}
+
+	if len(userMfaAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserMfa = foreign
+		if foreign.R == nil {
+			foreign.R = &userMfaR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.UserMfa = foreign
+				if foreign.R == nil {
+					foreign.R = &userMfaR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserTokenRevocation allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadUserTokenRevocation(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_token_revocations`),
+		qm.WhereIn(`user_token_revocations.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserTokenRevocation")
+	}
+
+	var resultSlice []*UserTokenRevocation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserTokenRevocation")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_token_revocations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_token_revocations")
+	}
+
+	if len(userTokenRevocationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserTokenRevocation = foreign
+		if foreign.R == nil {
+			foreign.R = &userTokenRevocationR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.UserTokenRevocation = foreign
+				if foreign.R == nil {
+					foreign.R = &userTokenRevocationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoardAuditLogs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBoardAuditLogs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_audit_logs`),
+		qm.WhereIn(`board_audit_logs.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_audit_logs")
+	}
+
+	var resultSlice []*BoardAuditLog
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_audit_logs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_audit_logs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_audit_logs")
+	}
+
+	if len(boardAuditLogAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardAuditLogs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardAuditLogR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.BoardAuditLogs = append(local.R.BoardAuditLogs, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardAuditLogR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByBoardImports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByBoardImports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_imports`),
+		qm.WhereIn(`board_imports.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_imports")
+	}
+
+	var resultSlice []*BoardImport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_imports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_imports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_imports")
+	}
+
+	if len(boardImportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByBoardImports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardImportR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedBy {
+				local.R.CreatedByBoardImports = append(local.R.CreatedByBoardImports, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardImportR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByBoardInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByBoardInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_invitations`),
+		qm.WhereIn(`board_invitations.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_invitations")
+	}
+
+	var resultSlice []*BoardInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_invitations")
+	}
+
+	if len(boardInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByBoardInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardInvitationR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedBy {
+				local.R.CreatedByBoardInvitations = append(local.R.CreatedByBoardInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardInvitationR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviteeBoardInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInviteeBoardInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_invitations`),
+		qm.WhereIn(`board_invitations.invitee_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_invitations")
+	}
+
+	var resultSlice []*BoardInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_invitations")
+	}
+
+	if len(boardInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InviteeBoardInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardInvitationR{}
+			}
+			foreign.R.Invitee = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.InviteeID) {
+				local.R.InviteeBoardInvitations = append(local.R.InviteeBoardInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardInvitationR{}
+				}
+				foreign.R.Invitee = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByBoardMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddedByBoardMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_members`),
+		qm.WhereIn(`board_members.added_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_members")
+	}
+
+	var resultSlice []*BoardMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_members")
+	}
+
+	if len(boardMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AddedByBoardMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardMemberR{}
+			}
+			foreign.R.AddedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AddedBy) {
+				local.R.AddedByBoardMembers = append(local.R.AddedByBoardMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardMemberR{}
+				}
+				foreign.R.AddedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoardMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBoardMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_members`),
+		qm.WhereIn(`board_members.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_members")
+	}
+
+	var resultSlice []*BoardMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_members")
+	}
+
+	if len(boardMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.BoardMembers = append(local.R.BoardMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByBoardShareLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByBoardShareLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_share_links`),
+		qm.WhereIn(`board_share_links.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_share_links")
+	}
+
+	var resultSlice []*BoardShareLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_share_links")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_share_links")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_share_links")
+	}
+
+	if len(boardShareLinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByBoardShareLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardShareLinkR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByBoardShareLinks = append(local.R.CreatedByBoardShareLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardShareLinkR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoardStars allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBoardStars(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_stars`),
+		qm.WhereIn(`board_stars.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_stars")
+	}
+
+	var resultSlice []*BoardStar
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_stars")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_stars")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_stars")
+	}
+
+	if len(boardStarAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardStars = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardStarR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.BoardStars = append(local.R.BoardStars, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardStarR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByBoardTeamGrants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddedByBoardTeamGrants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_team_grants`),
+		qm.WhereIn(`board_team_grants.added_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_team_grants")
+	}
+
+	var resultSlice []*BoardTeamGrant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_team_grants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_team_grants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_team_grants")
+	}
+
+	if len(boardTeamGrantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AddedByBoardTeamGrants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardTeamGrantR{}
+			}
+			foreign.R.AddedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AddedBy) {
+				local.R.AddedByBoardTeamGrants = append(local.R.AddedByBoardTeamGrants, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardTeamGrantR{}
+				}
+				foreign.R.AddedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByBoardTemplates allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByBoardTemplates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_templates`),
+		qm.WhereIn(`board_templates.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`board_templates.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_templates")
+	}
+
+	var resultSlice []*BoardTemplate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_templates")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_templates")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_templates")
+	}
+
+	if len(boardTemplateAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByBoardTemplates = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardTemplateR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByBoardTemplates = append(local.R.CreatedByBoardTemplates, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardTemplateR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBoardViews allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBoardViews(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`board_views`),
+		qm.WhereIn(`board_views.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load board_views")
+	}
+
+	var resultSlice []*BoardView
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice board_views")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on board_views")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for board_views")
+	}
+
+	if len(boardViewAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BoardViews = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardViewR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.BoardViews = append(local.R.BoardViews, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardViewR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArchivedByBoards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadArchivedByBoards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`boards`),
+		qm.WhereIn(`boards.archived_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`boards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load boards")
+	}
+
+	var resultSlice []*Board
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice boards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+	}
+
+	if len(boardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArchivedByBoards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardR{}
+			}
+			foreign.R.ArchivedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ArchivedBy) {
+				local.R.ArchivedByBoards = append(local.R.ArchivedByBoards, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardR{}
+				}
+				foreign.R.ArchivedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByBoards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByBoards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`boards`),
+		qm.WhereIn(`boards.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`boards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load boards")
+	}
+
+	var resultSlice []*Board
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice boards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+	}
+
+	if len(boardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByBoards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByBoards = append(local.R.CreatedByBoards, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeletedByBoards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDeletedByBoards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`boards`),
+		qm.WhereIn(`boards.deleted_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`boards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load boards")
+	}
+
+	var resultSlice []*Board
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice boards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on boards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for boards")
+	}
+
+	if len(boardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeletedByBoards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &boardR{}
+			}
+			foreign.R.DeletedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DeletedBy) {
+				local.R.DeletedByBoards = append(local.R.DeletedByBoards, foreign)
+				if foreign.R == nil {
+					foreign.R = &boardR{}
+				}
+				foreign.R.DeletedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAssignedToCards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAssignedToCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`cards`),
+		qm.WhereIn(`cards.assigned_to in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`cards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load cards")
+	}
+
+	var resultSlice []*Card
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on cards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+	}
+
+	if len(cardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AssignedToCards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &cardR{}
+			}
+			foreign.R.AssignedToUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AssignedTo) {
+				local.R.AssignedToCards = append(local.R.AssignedToCards, foreign)
+				if foreign.R == nil {
+					foreign.R = &cardR{}
+				}
+				foreign.R.AssignedToUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByCards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`cards`),
+		qm.WhereIn(`cards.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`cards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load cards")
+	}
+
+	var resultSlice []*Card
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on cards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+	}
+
+	if len(cardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByCards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &cardR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByCards = append(local.R.CreatedByCards, foreign)
+				if foreign.R == nil {
+					foreign.R = &cardR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUpdatedByCards allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUpdatedByCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`cards`),
+		qm.WhereIn(`cards.updated_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`cards.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load cards")
+	}
+
+	var resultSlice []*Card
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on cards")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+	}
+
+	if len(cardAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UpdatedByCards = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &cardR{}
+			}
+			foreign.R.UpdatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UpdatedBy) {
+				local.R.UpdatedByCards = append(local.R.UpdatedByCards, foreign)
+				if foreign.R == nil {
+					foreign.R = &cardR{}
+				}
+				foreign.R.UpdatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEditedByComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadEditedByComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`comments`),
+		qm.WhereIn(`comments.edited_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`comments.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comments")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	}
+
+	if len(commentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.EditedByComments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentR{}
+			}
+			foreign.R.EditedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.EditedBy) {
+				local.R.EditedByComments = append(local.R.EditedByComments, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.EditedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadComments allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`comments`),
+		qm.WhereIn(`comments.user_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`comments.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load comments")
+	}
+
+	var resultSlice []*Comment
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on comments")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	}
+
+	if len(commentAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Comments = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &commentR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Comments = append(local.R.Comments, foreign)
+				if foreign.R == nil {
+					foreign.R = &commentR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadEmailVerifications allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadEmailVerifications(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`email_verifications`),
+		qm.WhereIn(`email_verifications.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load email_verifications")
+	}
+
+	var resultSlice []*EmailVerification
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice email_verifications")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on email_verifications")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for email_verifications")
+	}
+
+	if len(emailVerificationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.EmailVerifications = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &emailVerificationR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.EmailVerifications = append(local.R.EmailVerifications, foreign)
+				if foreign.R == nil {
+					foreign.R = &emailVerificationR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByLabels allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`labels`),
+		qm.WhereIn(`labels.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`labels.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load labels")
+	}
+
+	var resultSlice []*Label
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on labels")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+	}
+
+	if len(labelAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByLabels = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &labelR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByLabels = append(local.R.CreatedByLabels, foreign)
+				if foreign.R == nil {
+					foreign.R = &labelR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDeletedByLabels allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDeletedByLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`labels`),
+		qm.WhereIn(`labels.deleted_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`labels.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load labels")
+	}
+
+	var resultSlice []*Label
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on labels")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+	}
+
+	if len(labelAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DeletedByLabels = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &labelR{}
+			}
+			foreign.R.DeletedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DeletedBy) {
+				local.R.DeletedByLabels = append(local.R.DeletedByLabels, foreign)
+				if foreign.R == nil {
+					foreign.R = &labelR{}
+				}
+				foreign.R.DeletedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUpdatedByLabels allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUpdatedByLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`labels`),
+		qm.WhereIn(`labels.updated_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`labels.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load labels")
+	}
+
+	var resultSlice []*Label
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on labels")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+	}
+
+	if len(labelAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UpdatedByLabels = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &labelR{}
+			}
+			foreign.R.UpdatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UpdatedBy) {
+				local.R.UpdatedByLabels = append(local.R.UpdatedByLabels, foreign)
+				if foreign.R == nil {
+					foreign.R = &labelR{}
+				}
+				foreign.R.UpdatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByLists allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`lists`),
+		qm.WhereIn(`lists.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`lists.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load lists")
+	}
+
+	var resultSlice []*List
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice lists")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on lists")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	}
+
+	if len(listAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByLists = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &listR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByLists = append(local.R.CreatedByLists, foreign)
+				if foreign.R == nil {
+					foreign.R = &listR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoginAttempts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadLoginAttempts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`login_attempts`),
+		qm.WhereIn(`login_attempts.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load login_attempts")
+	}
+
+	var resultSlice []*LoginAttempt
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice login_attempts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on login_attempts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for login_attempts")
+	}
+
+	if len(loginAttemptAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LoginAttempts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loginAttemptR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.LoginAttempts = append(local.R.LoginAttempts, foreign)
+				if foreign.R == nil {
+					foreign.R = &loginAttemptR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMfaRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMfaRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`mfa_recovery_codes`),
+		qm.WhereIn(`mfa_recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mfa_recovery_codes")
+	}
+
+	var resultSlice []*MfaRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mfa_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mfa_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mfa_recovery_codes")
+	}
+
+	if len(mfaRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MfaRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mfaRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.MfaRecoveryCodes = append(local.R.MfaRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &mfaRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordResets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPasswordResets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_resets`),
+		qm.WhereIn(`password_resets.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_resets")
+	}
+
+	var resultSlice []*PasswordReset
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_resets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_resets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_resets")
+	}
+
+	if len(passwordResetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PasswordResets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordResetR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PasswordResets = append(local.R.PasswordResets, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordResetR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPersonalAccessTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPersonalAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`personal_access_tokens`),
+		qm.WhereIn(`personal_access_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load personal_access_tokens")
+	}
+
+	var resultSlice []*PersonalAccessToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice personal_access_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on personal_access_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_access_tokens")
+	}
+
+	if len(personalAccessTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PersonalAccessTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &personalAccessTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PersonalAccessTokens = append(local.R.PersonalAccessTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &personalAccessTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByRebalanceJobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByRebalanceJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`rebalance_jobs`),
+		qm.WhereIn(`rebalance_jobs.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load rebalance_jobs")
+	}
+
+	var resultSlice []*RebalanceJob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice rebalance_jobs")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on rebalance_jobs")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rebalance_jobs")
+	}
+
+	if len(rebalanceJobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByRebalanceJobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &rebalanceJobR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByRebalanceJobs = append(local.R.CreatedByRebalanceJobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &rebalanceJobR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRefreshTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRefreshTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`refresh_tokens`),
+		qm.WhereIn(`refresh_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load refresh_tokens")
+	}
+
+	var resultSlice []*RefreshToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice refresh_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on refresh_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for refresh_tokens")
+	}
+
+	if len(refreshTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RefreshTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &refreshTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.RefreshTokens = append(local.R.RefreshTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &refreshTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadRevokedTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadRevokedTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`revoked_tokens`),
+		qm.WhereIn(`revoked_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load revoked_tokens")
+	}
+
+	var resultSlice []*RevokedToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice revoked_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on revoked_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for revoked_tokens")
+	}
+
+	if len(revokedTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.RevokedTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &revokedTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.RevokedTokens = append(local.R.RevokedTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &revokedTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`sessions`),
+		qm.WhereIn(`sessions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sessions")
+	}
+
+	var resultSlice []*Session
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sessions")
+	}
+
+	if len(sessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Sessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Sessions = append(local.R.Sessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByTeamMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddedByTeamMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`team_members`),
+		qm.WhereIn(`team_members.added_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load team_members")
+	}
+
+	var resultSlice []*TeamMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice team_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on team_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for team_members")
+	}
+
+	if len(teamMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AddedByTeamMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &teamMemberR{}
+			}
+			foreign.R.AddedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AddedBy) {
+				local.R.AddedByTeamMembers = append(local.R.AddedByTeamMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &teamMemberR{}
+				}
+				foreign.R.AddedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTeamMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTeamMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`team_members`),
+		qm.WhereIn(`team_members.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load team_members")
+	}
+
+	var resultSlice []*TeamMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice team_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on team_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for team_members")
+	}
+
+	if len(teamMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeamMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &teamMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.TeamMembers = append(local.R.TeamMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &teamMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByTeams allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByTeams(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`teams`),
+		qm.WhereIn(`teams.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`teams.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load teams")
+	}
+
+	var resultSlice []*Team
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice teams")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on teams")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for teams")
+	}
+
+	if len(teamAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByTeams = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &teamR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByTeams = append(local.R.CreatedByTeams, foreign)
+				if foreign.R == nil {
+					foreign.R = &teamR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedUserUploads allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedUserUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`uploads`),
+		qm.WhereIn(`uploads.created_user_id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`uploads.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load uploads")
+	}
+
+	var resultSlice []*Upload
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice uploads")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on uploads")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for uploads")
+	}
+
+	if len(uploadAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedUserUploads = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &uploadR{}
+			}
+			foreign.R.CreatedUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedUserID {
+				local.R.CreatedUserUploads = append(local.R.CreatedUserUploads, foreign)
+				if foreign.R == nil {
+					foreign.R = &uploadR{}
+				}
+				foreign.R.CreatedUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identities`),
+		qm.WhereIn(`user_identities.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identities")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identities")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identities")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identities")
+	}
+
+	if len(userIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByWorkspaceMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddedByWorkspaceMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`workspace_members`),
+		qm.WhereIn(`workspace_members.added_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workspace_members")
+	}
+
+	var resultSlice []*WorkspaceMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workspace_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workspace_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workspace_members")
+	}
+
+	if len(workspaceMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AddedByWorkspaceMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workspaceMemberR{}
+			}
+			foreign.R.AddedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AddedBy) {
+				local.R.AddedByWorkspaceMembers = append(local.R.AddedByWorkspaceMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &workspaceMemberR{}
+				}
+				foreign.R.AddedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkspaceMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWorkspaceMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`workspace_members`),
+		qm.WhereIn(`workspace_members.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workspace_members")
+	}
+
+	var resultSlice []*WorkspaceMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workspace_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workspace_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workspace_members")
+	}
+
+	if len(workspaceMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WorkspaceMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workspaceMemberR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WorkspaceMembers = append(local.R.WorkspaceMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &workspaceMemberR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByWorkspaces allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByWorkspaces(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`workspaces`),
+		qm.WhereIn(`workspaces.created_by in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`workspaces.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load workspaces")
+	}
+
+	var resultSlice []*Workspace
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice workspaces")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on workspaces")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workspaces")
+	}
+
+	if len(workspaceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByWorkspaces = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &workspaceR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByWorkspaces = append(local.R.CreatedByWorkspaces, foreign)
+				if foreign.R == nil {
+					foreign.R = &workspaceR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetRole of the user to the related item.
+// Sets o.R.Role to related.
+// Adds o to related.R.Users.
+func (o *User) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"users\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.RoleID, related.ID)
+	if o.R == nil {
+		o.R = &userR{
+			Role: related,
+		}
+	} else {
+		o.R.Role = related
+	}
+
+	if related.R == nil {
+		related.R = &roleR{
+			Users: UserSlice{o},
+		}
+	} else {
+		related.R.Users = append(related.R.Users, o)
+	}
+
+	return nil
+}
+
+// RemoveRole relationship.
+// Sets o.R.Role to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *User) RemoveRole(ctx context.Context, exec boil.ContextExecutor, related *Role) error {
+	var err error
+
+	queries.SetScanner(&o.RoleID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("role_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Role = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.Users {
+		if queries.Equal(o.RoleID, ri.RoleID) {
+			continue
+		}
+
+		ln := len(related.R.Users)
+		if ln > 1 && i < ln-1 {
+			related.R.Users[i] = related.R.Users[ln-1]
+		}
+		related.R.Users = related.R.Users[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetLoginLockout of the user to the related item.
+// Sets o.R.LoginLockout to related.
+// Adds o to related.R.User.
+func (o *User) SetLoginLockout(ctx context.Context, exec boil.ContextExecutor, insert bool, related *LoginLockout) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"login_lockouts\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, loginLockoutPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			LoginLockout: related,
+		}
+	} else {
+		o.R.LoginLockout = related
+	}
+
+	if related.R == nil {
+		related.R = &loginLockoutR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetUserMfa of the user to the related item.
+// Sets o.R.UserMfa to related.
+// Adds o to related.R.User.
+func (o *User) SetUserMfa(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserMfa) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"user_mfa\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, userMfaPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserMfa: related,
+		}
+	} else {
+		o.R.UserMfa = related
+	}
+
+	if related.R == nil {
+		related.R = &userMfaR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// SetUserTokenRevocation of the user to the related item.
+// Sets o.R.UserTokenRevocation to related.
+// Adds o to related.R.User.
+func (o *User) SetUserTokenRevocation(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserTokenRevocation) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"user_token_revocations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, userTokenRevocationPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserTokenRevocation: related,
+		}
+	} else {
+		o.R.UserTokenRevocation = related
+	}
+
+	if related.R == nil {
+		related.R = &userTokenRevocationR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddBoardAuditLogs adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BoardAuditLogs.
+// Sets related.R.User appropriately.
+func (o *User) AddBoardAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardAuditLog) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_audit_logs\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardAuditLogPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BoardAuditLogs: related,
+		}
+	} else {
+		o.R.BoardAuditLogs = append(o.R.BoardAuditLogs, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardAuditLogR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetBoardAuditLogs removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's BoardAuditLogs accordingly.
+// Replaces o.R.BoardAuditLogs with related.
+// Sets related.R.User's BoardAuditLogs accordingly.
+func (o *User) SetBoardAuditLogs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardAuditLog) error {
+	query := "update \"board_audit_logs\" set \"user_id\" = null where \"user_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.BoardAuditLogs {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.BoardAuditLogs = nil
+	}
+
+	return o.AddBoardAuditLogs(ctx, exec, insert, related...)
+}
+
+// RemoveBoardAuditLogs relationships from objects passed in.
+// Removes related items from R.BoardAuditLogs (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveBoardAuditLogs(ctx context.Context, exec boil.ContextExecutor, related ...*BoardAuditLog) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.BoardAuditLogs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.BoardAuditLogs)
+			if ln > 1 && i < ln-1 {
+				o.R.BoardAuditLogs[i] = o.R.BoardAuditLogs[ln-1]
+			}
+			o.R.BoardAuditLogs = o.R.BoardAuditLogs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByBoardImports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByBoardImports.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByBoardImports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardImport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_imports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardImportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByBoardImports: related,
+		}
+	} else {
+		o.R.CreatedByBoardImports = append(o.R.CreatedByBoardImports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardImportR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByBoardInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByBoardInvitations.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByBoardInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByBoardInvitations: related,
+		}
+	} else {
+		o.R.CreatedByBoardInvitations = append(o.R.CreatedByBoardInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardInvitationR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddInviteeBoardInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviteeBoardInvitations.
+// Sets related.R.Invitee appropriately.
+func (o *User) AddInviteeBoardInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.InviteeID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"invitee_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.InviteeID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InviteeBoardInvitations: related,
+		}
+	} else {
+		o.R.InviteeBoardInvitations = append(o.R.InviteeBoardInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardInvitationR{
+				Invitee: o,
+			}
+		} else {
+			rel.R.Invitee = o
+		}
+	}
+	return nil
+}
+
+// SetInviteeBoardInvitations removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Invitee's InviteeBoardInvitations accordingly.
+// Replaces o.R.InviteeBoardInvitations with related.
+// Sets related.R.Invitee's InviteeBoardInvitations accordingly.
+func (o *User) SetInviteeBoardInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardInvitation) error {
+	query := "update \"board_invitations\" set \"invitee_id\" = null where \"invitee_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InviteeBoardInvitations {
+			queries.SetScanner(&rel.InviteeID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Invitee = nil
+		}
+		o.R.InviteeBoardInvitations = nil
+	}
+
+	return o.AddInviteeBoardInvitations(ctx, exec, insert, related...)
+}
+
+// RemoveInviteeBoardInvitations relationships from objects passed in.
+// Removes related items from R.InviteeBoardInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Invitee.
+func (o *User) RemoveInviteeBoardInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*BoardInvitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.InviteeID, nil)
+		if rel.R != nil {
+			rel.R.Invitee = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("invitee_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InviteeBoardInvitations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InviteeBoardInvitations)
+			if ln > 1 && i < ln-1 {
+				o.R.InviteeBoardInvitations[i] = o.R.InviteeBoardInvitations[ln-1]
+			}
+			o.R.InviteeBoardInvitations = o.R.InviteeBoardInvitations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAddedByBoardMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddedByBoardMembers.
+// Sets related.R.AddedByUser appropriately.
+func (o *User) AddAddedByBoardMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AddedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AddedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AddedByBoardMembers: related,
+		}
+	} else {
+		o.R.AddedByBoardMembers = append(o.R.AddedByBoardMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardMemberR{
+				AddedByUser: o,
+			}
+		} else {
+			rel.R.AddedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAddedByBoardMembers removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AddedByUser's AddedByBoardMembers accordingly.
+// Replaces o.R.AddedByBoardMembers with related.
+// Sets related.R.AddedByUser's AddedByBoardMembers accordingly.
+func (o *User) SetAddedByBoardMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardMember) error {
+	query := "update \"board_members\" set \"added_by\" = null where \"added_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AddedByBoardMembers {
+			queries.SetScanner(&rel.AddedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AddedByUser = nil
+		}
+		o.R.AddedByBoardMembers = nil
+	}
+
+	return o.AddAddedByBoardMembers(ctx, exec, insert, related...)
+}
+
+// RemoveAddedByBoardMembers relationships from objects passed in.
+// Removes related items from R.AddedByBoardMembers (uses pointer comparison, removal does not keep order)
+// Sets related.R.AddedByUser.
+func (o *User) RemoveAddedByBoardMembers(ctx context.Context, exec boil.ContextExecutor, related ...*BoardMember) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AddedBy, nil)
+		if rel.R != nil {
+			rel.R.AddedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AddedByBoardMembers {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AddedByBoardMembers)
+			if ln > 1 && i < ln-1 {
+				o.R.AddedByBoardMembers[i] = o.R.AddedByBoardMembers[ln-1]
+			}
+			o.R.AddedByBoardMembers = o.R.AddedByBoardMembers[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddBoardMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BoardMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddBoardMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BoardMembers: related,
+		}
+	} else {
+		o.R.BoardMembers = append(o.R.BoardMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardMemberR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByBoardShareLinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByBoardShareLinks.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByBoardShareLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardShareLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_share_links\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardShareLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByBoardShareLinks: related,
+		}
+	} else {
+		o.R.CreatedByBoardShareLinks = append(o.R.CreatedByBoardShareLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardShareLinkR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByBoardShareLinks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByBoardShareLinks accordingly.
+// Replaces o.R.CreatedByBoardShareLinks with related.
+// Sets related.R.CreatedByUser's CreatedByBoardShareLinks accordingly.
+func (o *User) SetCreatedByBoardShareLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardShareLink) error {
+	query := "update \"board_share_links\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByBoardShareLinks {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByBoardShareLinks = nil
+	}
+
+	return o.AddCreatedByBoardShareLinks(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByBoardShareLinks relationships from objects passed in.
+// Removes related items from R.CreatedByBoardShareLinks (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByBoardShareLinks(ctx context.Context, exec boil.ContextExecutor, related ...*BoardShareLink) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByBoardShareLinks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByBoardShareLinks)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByBoardShareLinks[i] = o.R.CreatedByBoardShareLinks[ln-1]
+			}
+			o.R.CreatedByBoardShareLinks = o.R.CreatedByBoardShareLinks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddBoardStars adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BoardStars.
+// Sets related.R.User appropriately.
+func (o *User) AddBoardStars(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardStar) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_stars\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardStarPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.BoardID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BoardStars: related,
+		}
+	} else {
+		o.R.BoardStars = append(o.R.BoardStars, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardStarR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddAddedByBoardTeamGrants adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddedByBoardTeamGrants.
+// Sets related.R.AddedByUser appropriately.
+func (o *User) AddAddedByBoardTeamGrants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardTeamGrant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AddedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_team_grants\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardTeamGrantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AddedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AddedByBoardTeamGrants: related,
+		}
+	} else {
+		o.R.AddedByBoardTeamGrants = append(o.R.AddedByBoardTeamGrants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardTeamGrantR{
+				AddedByUser: o,
+			}
+		} else {
+			rel.R.AddedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAddedByBoardTeamGrants removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AddedByUser's AddedByBoardTeamGrants accordingly.
+// Replaces o.R.AddedByBoardTeamGrants with related.
+// Sets related.R.AddedByUser's AddedByBoardTeamGrants accordingly.
+func (o *User) SetAddedByBoardTeamGrants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardTeamGrant) error {
+	query := "update \"board_team_grants\" set \"added_by\" = null where \"added_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AddedByBoardTeamGrants {
+			queries.SetScanner(&rel.AddedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AddedByUser = nil
+		}
+		o.R.AddedByBoardTeamGrants = nil
+	}
+
+	return o.AddAddedByBoardTeamGrants(ctx, exec, insert, related...)
+}
+
+// RemoveAddedByBoardTeamGrants relationships from objects passed in.
+// Removes related items from R.AddedByBoardTeamGrants (uses pointer comparison, removal does not keep order)
+// Sets related.R.AddedByUser.
+func (o *User) RemoveAddedByBoardTeamGrants(ctx context.Context, exec boil.ContextExecutor, related ...*BoardTeamGrant) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AddedBy, nil)
+		if rel.R != nil {
+			rel.R.AddedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AddedByBoardTeamGrants {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AddedByBoardTeamGrants)
+			if ln > 1 && i < ln-1 {
+				o.R.AddedByBoardTeamGrants[i] = o.R.AddedByBoardTeamGrants[ln-1]
+			}
+			o.R.AddedByBoardTeamGrants = o.R.AddedByBoardTeamGrants[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatedByBoardTemplates adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByBoardTemplates.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByBoardTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardTemplate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_templates\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardTemplatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByBoardTemplates: related,
+		}
+	} else {
+		o.R.CreatedByBoardTemplates = append(o.R.CreatedByBoardTemplates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardTemplateR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByBoardTemplates removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByBoardTemplates accordingly.
+// Replaces o.R.CreatedByBoardTemplates with related.
+// Sets related.R.CreatedByUser's CreatedByBoardTemplates accordingly.
+func (o *User) SetCreatedByBoardTemplates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardTemplate) error {
+	query := "update \"board_templates\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByBoardTemplates {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
 			}
+
+			rel.R.CreatedByUser = nil
 		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
+		o.R.CreatedByBoardTemplates = nil
+	}
+
+	return o.AddCreatedByBoardTemplates(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByBoardTemplates relationships from objects passed in.
+// Removes related items from R.CreatedByBoardTemplates (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByBoardTemplates(ctx context.Context, exec boil.ContextExecutor, related ...*BoardTemplate) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByBoardTemplates {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByBoardTemplates)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByBoardTemplates[i] = o.R.CreatedByBoardTemplates[ln-1]
+			}
+			o.R.CreatedByBoardTemplates = o.R.CreatedByBoardTemplates[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddBoardViews adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BoardViews.
+// Sets related.R.User appropriately.
+func (o *User) AddBoardViews(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BoardView) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"board_views\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, boardViewPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.BoardID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			rel.UserID = o.ID
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			BoardViews: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.BoardViews = append(o.R.BoardViews, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardViewR{
+				User: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.User = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
-	}
+// AddArchivedByBoards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ArchivedByBoards.
+// Sets related.R.ArchivedByUser appropriately.
+func (o *User) AddArchivedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ArchivedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"boards\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	query := NewQuery(
-		qm.From(`cards`),
-		qm.WhereIn(`cards.created_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`cards.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+			queries.Assign(&rel.ArchivedBy, o.ID)
+		}
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load cards")
+	if o.R == nil {
+		o.R = &userR{
+			ArchivedByBoards: related,
+		}
+	} else {
+		o.R.ArchivedByBoards = append(o.R.ArchivedByBoards, related...)
 	}
 
-	var resultSlice []*Card
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice cards")
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardR{
+				ArchivedByUser: o,
+			}
+		} else {
+			rel.R.ArchivedByUser = o
+		}
 	}
+	return nil
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on cards")
+// SetArchivedByBoards removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ArchivedByUser's ArchivedByBoards accordingly.
+// Replaces o.R.ArchivedByBoards with related.
+// Sets related.R.ArchivedByUser's ArchivedByBoards accordingly.
+func (o *User) SetArchivedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+	query := "update \"boards\" set \"archived_by\" = null where \"archived_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	if len(cardAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+	if o.R != nil {
+		for _, rel := range o.R.ArchivedByBoards {
+			queries.SetScanner(&rel.ArchivedBy, nil)
+			if rel.R == nil {
+				continue
 			}
+
+			rel.R.ArchivedByUser = nil
 		}
+		o.R.ArchivedByBoards = nil
 	}
-	if singular {
-		object.R.CreatedByCards = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &cardR{}
-			}
-			foreign.R.CreatedByUser = object
+
+	return o.AddArchivedByBoards(ctx, exec, insert, related...)
+}
+
+// RemoveArchivedByBoards relationships from objects passed in.
+// Removes related items from R.ArchivedByBoards (uses pointer comparison, removal does not keep order)
+// Sets related.R.ArchivedByUser.
+func (o *User) RemoveArchivedByBoards(ctx context.Context, exec boil.ContextExecutor, related ...*Board) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ArchivedBy, nil)
+		if rel.R != nil {
+			rel.R.ArchivedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("archived_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedBy) {
-				local.R.CreatedByCards = append(local.R.CreatedByCards, foreign)
-				if foreign.R == nil {
-					foreign.R = &cardR{}
-				}
-				foreign.R.CreatedByUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.ArchivedByBoards {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ArchivedByBoards)
+			if ln > 1 && i < ln-1 {
+				o.R.ArchivedByBoards[i] = o.R.ArchivedByBoards[ln-1]
 			}
+			o.R.ArchivedByBoards = o.R.ArchivedByBoards[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadUpdatedByCards allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUpdatedByCards(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
-			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
+// AddCreatedByBoards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByBoards.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"boards\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByBoards: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.CreatedByBoards = append(o.R.CreatedByBoards, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardR{
+				CreatedByUser: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.CreatedByUser = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
+// SetCreatedByBoards removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByBoards accordingly.
+// Replaces o.R.CreatedByBoards with related.
+// Sets related.R.CreatedByUser's CreatedByBoards accordingly.
+func (o *User) SetCreatedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+	query := "update \"boards\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	query := NewQuery(
-		qm.From(`cards`),
-		qm.WhereIn(`cards.updated_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`cards.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByBoards {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load cards")
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByBoards = nil
 	}
 
-	var resultSlice []*Card
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice cards")
-	}
+	return o.AddCreatedByBoards(ctx, exec, insert, related...)
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on cards")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for cards")
+// RemoveCreatedByBoards relationships from objects passed in.
+// Removes related items from R.CreatedByBoards (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByBoards(ctx context.Context, exec boil.ContextExecutor, related ...*Board) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if len(cardAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
 		}
-	}
-	if singular {
-		object.R.UpdatedByCards = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &cardR{}
-			}
-			foreign.R.UpdatedByUser = object
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UpdatedBy) {
-				local.R.UpdatedByCards = append(local.R.UpdatedByCards, foreign)
-				if foreign.R == nil {
-					foreign.R = &cardR{}
-				}
-				foreign.R.UpdatedByUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByBoards {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByBoards)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByBoards[i] = o.R.CreatedByBoards[ln-1]
 			}
+			o.R.CreatedByBoards = o.R.CreatedByBoards[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadEditedByComments allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadEditedByComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// AddDeletedByBoards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DeletedByBoards.
+// Sets related.R.DeletedByUser appropriately.
+func (o *User) AddDeletedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DeletedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"boards\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"deleted_by"}),
+				strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.DeletedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			DeletedByBoards: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-			args[obj.ID] = struct{}{}
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
+		o.R.DeletedByBoards = append(o.R.DeletedByBoards, related...)
 	}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &boardR{
+				DeletedByUser: o,
+			}
+		} else {
+			rel.R.DeletedByUser = o
+		}
 	}
+	return nil
+}
 
-	query := NewQuery(
-		qm.From(`comments`),
-		qm.WhereIn(`comments.edited_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`comments.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+// SetDeletedByBoards removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DeletedByUser's DeletedByBoards accordingly.
+// Replaces o.R.DeletedByBoards with related.
+// Sets related.R.DeletedByUser's DeletedByBoards accordingly.
+func (o *User) SetDeletedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+	query := "update \"boards\" set \"deleted_by\" = null where \"deleted_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	results, err := query.QueryContext(ctx, e)
+	_, err := exec.ExecContext(ctx, query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load comments")
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	var resultSlice []*Comment
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comments")
+	if o.R != nil {
+		for _, rel := range o.R.DeletedByBoards {
+			queries.SetScanner(&rel.DeletedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DeletedByUser = nil
+		}
+		o.R.DeletedByBoards = nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comments")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+	return o.AddDeletedByBoards(ctx, exec, insert, related...)
+}
+
+// RemoveDeletedByBoards relationships from objects passed in.
+// Removes related items from R.DeletedByBoards (uses pointer comparison, removal does not keep order)
+// Sets related.R.DeletedByUser.
+func (o *User) RemoveDeletedByBoards(ctx context.Context, exec boil.ContextExecutor, related ...*Board) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if len(commentAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DeletedBy, nil)
+		if rel.R != nil {
+			rel.R.DeletedByUser = nil
 		}
-	}
-	if singular {
-		object.R.EditedByComments = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &commentR{}
-			}
-			foreign.R.EditedByUser = object
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("deleted_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.EditedBy) {
-				local.R.EditedByComments = append(local.R.EditedByComments, foreign)
-				if foreign.R == nil {
-					foreign.R = &commentR{}
-				}
-				foreign.R.EditedByUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.DeletedByBoards {
+			if rel != ri {
+				continue
 			}
+
+			ln := len(o.R.DeletedByBoards)
+			if ln > 1 && i < ln-1 {
+				o.R.DeletedByBoards[i] = o.R.DeletedByBoards[ln-1]
+			}
+			o.R.DeletedByBoards = o.R.DeletedByBoards[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadComments allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadComments(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// AddAssignedToCards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AssignedToCards.
+// Sets related.R.AssignedToUser appropriately.
+func (o *User) AddAssignedToCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AssignedTo, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"cards\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"assigned_to"}),
+				strmangle.WhereClause("\"", "\"", 2, cardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AssignedTo, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			AssignedToCards: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.AssignedToCards = append(o.R.AssignedToCards, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &cardR{
+				AssignedToUser: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.AssignedToUser = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
+// SetAssignedToCards removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AssignedToUser's AssignedToCards accordingly.
+// Replaces o.R.AssignedToCards with related.
+// Sets related.R.AssignedToUser's AssignedToCards accordingly.
+func (o *User) SetAssignedToCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+	query := "update \"cards\" set \"assigned_to\" = null where \"assigned_to\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	query := NewQuery(
-		qm.From(`comments`),
-		qm.WhereIn(`comments.user_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`comments.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
+	if o.R != nil {
+		for _, rel := range o.R.AssignedToCards {
+			queries.SetScanner(&rel.AssignedTo, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load comments")
+			rel.R.AssignedToUser = nil
+		}
+		o.R.AssignedToCards = nil
 	}
 
-	var resultSlice []*Comment
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice comments")
-	}
+	return o.AddAssignedToCards(ctx, exec, insert, related...)
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on comments")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for comments")
+// RemoveAssignedToCards relationships from objects passed in.
+// Removes related items from R.AssignedToCards (uses pointer comparison, removal does not keep order)
+// Sets related.R.AssignedToUser.
+func (o *User) RemoveAssignedToCards(ctx context.Context, exec boil.ContextExecutor, related ...*Card) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if len(commentAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AssignedTo, nil)
+		if rel.R != nil {
+			rel.R.AssignedToUser = nil
 		}
-	}
-	if singular {
-		object.R.Comments = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &commentR{}
-			}
-			foreign.R.User = object
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("assigned_to")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.Comments = append(local.R.Comments, foreign)
-				if foreign.R == nil {
-					foreign.R = &commentR{}
-				}
-				foreign.R.User = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.AssignedToCards {
+			if rel != ri {
+				continue
 			}
+
+			ln := len(o.R.AssignedToCards)
+			if ln > 1 && i < ln-1 {
+				o.R.AssignedToCards[i] = o.R.AssignedToCards[ln-1]
+			}
+			o.R.AssignedToCards = o.R.AssignedToCards[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadCreatedByLabels allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+// AddCreatedByCards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByCards.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"cards\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, cardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
-		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByCards: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.CreatedByCards = append(o.R.CreatedByCards, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &cardR{
+				CreatedByUser: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.CreatedByUser = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
+// SetCreatedByCards removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByCards accordingly.
+// Replaces o.R.CreatedByCards with related.
+// Sets related.R.CreatedByUser's CreatedByCards accordingly.
+func (o *User) SetCreatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+	query := "update \"cards\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	query := NewQuery(
-		qm.From(`labels`),
-		qm.WhereIn(`labels.created_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`labels.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByCards {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load labels")
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByCards = nil
 	}
 
-	var resultSlice []*Label
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice labels")
-	}
+	return o.AddCreatedByCards(ctx, exec, insert, related...)
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on labels")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+// RemoveCreatedByCards relationships from objects passed in.
+// Removes related items from R.CreatedByCards (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByCards(ctx context.Context, exec boil.ContextExecutor, related ...*Card) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if len(labelAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
 		}
-	}
-	if singular {
-		object.R.CreatedByLabels = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &labelR{}
-			}
-			foreign.R.CreatedByUser = object
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedBy) {
-				local.R.CreatedByLabels = append(local.R.CreatedByLabels, foreign)
-				if foreign.R == nil {
-					foreign.R = &labelR{}
-				}
-				foreign.R.CreatedByUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByCards {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByCards)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByCards[i] = o.R.CreatedByCards[ln-1]
 			}
+			o.R.CreatedByCards = o.R.CreatedByCards[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadDeletedByLabels allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadDeletedByLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// AddUpdatedByCards adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UpdatedByCards.
+// Sets related.R.UpdatedByUser appropriately.
+func (o *User) AddUpdatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UpdatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"cards\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by"}),
+				strmangle.WhereClause("\"", "\"", 2, cardPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.UpdatedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			UpdatedByCards: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-			args[obj.ID] = struct{}{}
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
+		o.R.UpdatedByCards = append(o.R.UpdatedByCards, related...)
 	}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &cardR{
+				UpdatedByUser: o,
+			}
+		} else {
+			rel.R.UpdatedByUser = o
+		}
 	}
+	return nil
+}
 
-	query := NewQuery(
-		qm.From(`labels`),
-		qm.WhereIn(`labels.deleted_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`labels.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+// SetUpdatedByCards removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UpdatedByUser's UpdatedByCards accordingly.
+// Replaces o.R.UpdatedByCards with related.
+// Sets related.R.UpdatedByUser's UpdatedByCards accordingly.
+func (o *User) SetUpdatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+	query := "update \"cards\" set \"updated_by\" = null where \"updated_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	results, err := query.QueryContext(ctx, e)
+	_, err := exec.ExecContext(ctx, query, values...)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load labels")
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	var resultSlice []*Label
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+	if o.R != nil {
+		for _, rel := range o.R.UpdatedByCards {
+			queries.SetScanner(&rel.UpdatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.UpdatedByUser = nil
+		}
+		o.R.UpdatedByCards = nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on labels")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+	return o.AddUpdatedByCards(ctx, exec, insert, related...)
+}
+
+// RemoveUpdatedByCards relationships from objects passed in.
+// Removes related items from R.UpdatedByCards (uses pointer comparison, removal does not keep order)
+// Sets related.R.UpdatedByUser.
+func (o *User) RemoveUpdatedByCards(ctx context.Context, exec boil.ContextExecutor, related ...*Card) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if len(labelAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UpdatedBy, nil)
+		if rel.R != nil {
+			rel.R.UpdatedByUser = nil
 		}
-	}
-	if singular {
-		object.R.DeletedByLabels = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &labelR{}
-			}
-			foreign.R.DeletedByUser = object
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("updated_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.DeletedBy) {
-				local.R.DeletedByLabels = append(local.R.DeletedByLabels, foreign)
-				if foreign.R == nil {
-					foreign.R = &labelR{}
-				}
-				foreign.R.DeletedByUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.UpdatedByCards {
+			if rel != ri {
+				continue
 			}
+
+			ln := len(o.R.UpdatedByCards)
+			if ln > 1 && i < ln-1 {
+				o.R.UpdatedByCards[i] = o.R.UpdatedByCards[ln-1]
+			}
+			o.R.UpdatedByCards = o.R.UpdatedByCards[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadUpdatedByLabels allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUpdatedByLabels(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// AddEditedByComments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EditedByComments.
+// Sets related.R.EditedByUser appropriately.
+func (o *User) AddEditedByComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.EditedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"edited_by"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.EditedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			EditedByComments: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.EditedByComments = append(o.R.EditedByComments, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				EditedByUser: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.EditedByUser = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
+// SetEditedByComments removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.EditedByUser's EditedByComments accordingly.
+// Replaces o.R.EditedByComments with related.
+// Sets related.R.EditedByUser's EditedByComments accordingly.
+func (o *User) SetEditedByComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	query := "update \"comments\" set \"edited_by\" = null where \"edited_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	query := NewQuery(
-		qm.From(`labels`),
-		qm.WhereIn(`labels.updated_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`labels.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
+	if o.R != nil {
+		for _, rel := range o.R.EditedByComments {
+			queries.SetScanner(&rel.EditedBy, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load labels")
+			rel.R.EditedByUser = nil
+		}
+		o.R.EditedByComments = nil
 	}
 
-	var resultSlice []*Label
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice labels")
+	return o.AddEditedByComments(ctx, exec, insert, related...)
+}
+
+// RemoveEditedByComments relationships from objects passed in.
+// Removes related items from R.EditedByComments (uses pointer comparison, removal does not keep order)
+// Sets related.R.EditedByUser.
+func (o *User) RemoveEditedByComments(ctx context.Context, exec boil.ContextExecutor, related ...*Comment) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on labels")
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.EditedBy, nil)
+		if rel.R != nil {
+			rel.R.EditedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("edited_by")); err != nil {
+			return err
+		}
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for labels")
+	if o.R == nil {
+		return nil
 	}
 
-	if len(labelAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+	for _, rel := range related {
+		for i, ri := range o.R.EditedByComments {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.EditedByComments)
+			if ln > 1 && i < ln-1 {
+				o.R.EditedByComments[i] = o.R.EditedByComments[ln-1]
+			}
+			o.R.EditedByComments = o.R.EditedByComments[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddComments adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Comments.
+// Sets related.R.User appropriately.
+func (o *User) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"comments\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
 			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
-	if singular {
-		object.R.UpdatedByLabels = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &labelR{}
-			}
-			foreign.R.UpdatedByUser = object
+
+	if o.R == nil {
+		o.R = &userR{
+			Comments: related,
 		}
-		return nil
+	} else {
+		o.R.Comments = append(o.R.Comments, related...)
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.UpdatedBy) {
-				local.R.UpdatedByLabels = append(local.R.UpdatedByLabels, foreign)
-				if foreign.R == nil {
-					foreign.R = &labelR{}
-				}
-				foreign.R.UpdatedByUser = local
-				break
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &commentR{
+				User: o,
 			}
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// LoadCreatedByLists allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByLists(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// AddEmailVerifications adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.EmailVerifications.
+// Sets related.R.User appropriately.
+func (o *User) AddEmailVerifications(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*EmailVerification) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"email_verifications\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, emailVerificationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			rel.UserID = o.ID
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			EmailVerifications: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.EmailVerifications = append(o.R.EmailVerifications, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &emailVerificationR{
+				User: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.User = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
-	}
+// AddCreatedByLabels adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByLabels.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"labels\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, labelPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	query := NewQuery(
-		qm.From(`lists`),
-		qm.WhereIn(`lists.created_by in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`lists.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
 	}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load lists")
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByLabels: related,
+		}
+	} else {
+		o.R.CreatedByLabels = append(o.R.CreatedByLabels, related...)
 	}
 
-	var resultSlice []*List
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice lists")
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &labelR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
 	}
+	return nil
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on lists")
+// SetCreatedByLabels removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByLabels accordingly.
+// Replaces o.R.CreatedByLabels with related.
+// Sets related.R.CreatedByUser's CreatedByLabels accordingly.
+func (o *User) SetCreatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+	query := "update \"labels\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for lists")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	if len(listAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByLabels {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
 			}
+
+			rel.R.CreatedByUser = nil
 		}
+		o.R.CreatedByLabels = nil
 	}
-	if singular {
-		object.R.CreatedByLists = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &listR{}
-			}
-			foreign.R.CreatedByUser = object
-		}
+
+	return o.AddCreatedByLabels(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByLabels relationships from objects passed in.
+// Removes related items from R.CreatedByLabels (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByLabels(ctx context.Context, exec boil.ContextExecutor, related ...*Label) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedBy) {
-				local.R.CreatedByLists = append(local.R.CreatedByLists, foreign)
-				if foreign.R == nil {
-					foreign.R = &listR{}
-				}
-				foreign.R.CreatedByUser = local
-				break
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
 		}
 	}
+	if o.R == nil {
+		return nil
+	}
 
-	return nil
-}
-
-// LoadCreatedByRebalanceJobs allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedByRebalanceJobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByLabels {
+			if rel != ri {
+				continue
+			}
 
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			ln := len(o.R.CreatedByLabels)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByLabels[i] = o.R.CreatedByLabels[ln-1]
 			}
+			o.R.CreatedByLabels = o.R.CreatedByLabels[:ln-1]
+			break
 		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
+	}
+
+	return nil
+}
+
+// AddDeletedByLabels adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DeletedByLabels.
+// Sets related.R.DeletedByUser appropriately.
+func (o *User) AddDeletedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DeletedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"labels\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"deleted_by"}),
+				strmangle.WhereClause("\"", "\"", 2, labelPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.DeletedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			DeletedByLabels: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
+		o.R.DeletedByLabels = append(o.R.DeletedByLabels, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &labelR{
+				DeletedByUser: o,
 			}
-			args[obj.ID] = struct{}{}
+		} else {
+			rel.R.DeletedByUser = o
 		}
 	}
+	return nil
+}
 
-	if len(args) == 0 {
-		return nil
+// SetDeletedByLabels removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DeletedByUser's DeletedByLabels accordingly.
+// Replaces o.R.DeletedByLabels with related.
+// Sets related.R.DeletedByUser's DeletedByLabels accordingly.
+func (o *User) SetDeletedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+	query := "update \"labels\" set \"deleted_by\" = null where \"deleted_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	query := NewQuery(
-		qm.From(`rebalance_jobs`),
-		qm.WhereIn(`rebalance_jobs.created_by in ?`, argsSlice...),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
+	if o.R != nil {
+		for _, rel := range o.R.DeletedByLabels {
+			queries.SetScanner(&rel.DeletedBy, nil)
+			if rel.R == nil {
+				continue
+			}
 
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load rebalance_jobs")
+			rel.R.DeletedByUser = nil
+		}
+		o.R.DeletedByLabels = nil
 	}
 
-	var resultSlice []*RebalanceJob
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice rebalance_jobs")
-	}
+	return o.AddDeletedByLabels(ctx, exec, insert, related...)
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on rebalance_jobs")
-	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for rebalance_jobs")
+// RemoveDeletedByLabels relationships from objects passed in.
+// Removes related items from R.DeletedByLabels (uses pointer comparison, removal does not keep order)
+// Sets related.R.DeletedByUser.
+func (o *User) RemoveDeletedByLabels(ctx context.Context, exec boil.ContextExecutor, related ...*Label) error {
+	if len(related) == 0 {
+		return nil
 	}
 
-	if len(rebalanceJobAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
-			}
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DeletedBy, nil)
+		if rel.R != nil {
+			rel.R.DeletedByUser = nil
 		}
-	}
-	if singular {
-		object.R.CreatedByRebalanceJobs = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &rebalanceJobR{}
-			}
-			foreign.R.CreatedByUser = object
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("deleted_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.CreatedBy) {
-				local.R.CreatedByRebalanceJobs = append(local.R.CreatedByRebalanceJobs, foreign)
-				if foreign.R == nil {
-					foreign.R = &rebalanceJobR{}
-				}
-				foreign.R.CreatedByUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.DeletedByLabels {
+			if rel != ri {
+				continue
 			}
+
+			ln := len(o.R.DeletedByLabels)
+			if ln > 1 && i < ln-1 {
+				o.R.DeletedByLabels[i] = o.R.DeletedByLabels[ln-1]
+			}
+			o.R.DeletedByLabels = o.R.DeletedByLabels[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// LoadCreatedUserUploads allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatedUserUploads(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
-	var slice []*User
-	var object *User
-
-	if singular {
-		var ok bool
-		object, ok = maybeUser.(*User)
-		if !ok {
-			object = new(User)
-			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+// AddUpdatedByLabels adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UpdatedByLabels.
+// Sets related.R.UpdatedByUser appropriately.
+func (o *User) AddUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UpdatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
 			}
-		}
-	} else {
-		s, ok := maybeUser.(*[]*User)
-		if ok {
-			slice = *s
 		} else {
-			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
-			if !ok {
-				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"labels\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by"}),
+				strmangle.WhereClause("\"", "\"", 2, labelPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
+
+			queries.Assign(&rel.UpdatedBy, o.ID)
 		}
 	}
 
-	args := make(map[interface{}]struct{})
-	if singular {
-		if object.R == nil {
-			object.R = &userR{}
+	if o.R == nil {
+		o.R = &userR{
+			UpdatedByLabels: related,
 		}
-		args[object.ID] = struct{}{}
 	} else {
-		for _, obj := range slice {
-			if obj.R == nil {
-				obj.R = &userR{}
-			}
-			args[obj.ID] = struct{}{}
-		}
-	}
-
-	if len(args) == 0 {
-		return nil
-	}
-
-	argsSlice := make([]interface{}, len(args))
-	i := 0
-	for arg := range args {
-		argsSlice[i] = arg
-		i++
-	}
-
-	query := NewQuery(
-		qm.From(`uploads`),
-		qm.WhereIn(`uploads.created_user_id in ?`, argsSlice...),
-		qmhelper.WhereIsNull(`uploads.deleted_at`),
-	)
-	if mods != nil {
-		mods.Apply(query)
-	}
-
-	results, err := query.QueryContext(ctx, e)
-	if err != nil {
-		return errors.Wrap(err, "failed to eager load uploads")
+		o.R.UpdatedByLabels = append(o.R.UpdatedByLabels, related...)
 	}
 
-	var resultSlice []*Upload
-	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice uploads")
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &labelR{
+				UpdatedByUser: o,
+			}
+		} else {
+			rel.R.UpdatedByUser = o
+		}
 	}
+	return nil
+}
 
-	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on uploads")
+// SetUpdatedByLabels removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.UpdatedByUser's UpdatedByLabels accordingly.
+// Replaces o.R.UpdatedByLabels with related.
+// Sets related.R.UpdatedByUser's UpdatedByLabels accordingly.
+func (o *User) SetUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+	query := "update \"labels\" set \"updated_by\" = null where \"updated_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
 	}
-	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for uploads")
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
-	if len(uploadAfterSelectHooks) != 0 {
-		for _, obj := range resultSlice {
-			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
-				return err
+	if o.R != nil {
+		for _, rel := range o.R.UpdatedByLabels {
+			queries.SetScanner(&rel.UpdatedBy, nil)
+			if rel.R == nil {
+				continue
 			}
+
+			rel.R.UpdatedByUser = nil
 		}
+		o.R.UpdatedByLabels = nil
 	}
-	if singular {
-		object.R.CreatedUserUploads = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &uploadR{}
-			}
-			foreign.R.CreatedUser = object
+
+	return o.AddUpdatedByLabels(ctx, exec, insert, related...)
+}
+
+// RemoveUpdatedByLabels relationships from objects passed in.
+// Removes related items from R.UpdatedByLabels (uses pointer comparison, removal does not keep order)
+// Sets related.R.UpdatedByUser.
+func (o *User) RemoveUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor, related ...*Label) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UpdatedBy, nil)
+		if rel.R != nil {
+			rel.R.UpdatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("updated_by")); err != nil {
+			return err
 		}
+	}
+	if o.R == nil {
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.CreatedUserID {
-				local.R.CreatedUserUploads = append(local.R.CreatedUserUploads, foreign)
-				if foreign.R == nil {
-					foreign.R = &uploadR{}
-				}
-				foreign.R.CreatedUser = local
-				break
+	for _, rel := range related {
+		for i, ri := range o.R.UpdatedByLabels {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.UpdatedByLabels)
+			if ln > 1 && i < ln-1 {
+				o.R.UpdatedByLabels[i] = o.R.UpdatedByLabels[ln-1]
 			}
+			o.R.UpdatedByLabels = o.R.UpdatedByLabels[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// SetRole of the user to the related item.
-// Sets o.R.Role to related.
-// Adds o to related.R.Users.
-func (o *User) SetRole(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Role) error {
+// AddCreatedByLists adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByLists.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"lists\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, listPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"users\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"role_id"}),
-		strmangle.WhereClause("\"", "\"", 2, userPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
 	}
 
-	queries.Assign(&o.RoleID, related.ID)
 	if o.R == nil {
 		o.R = &userR{
-			Role: related,
+			CreatedByLists: related,
 		}
 	} else {
-		o.R.Role = related
+		o.R.CreatedByLists = append(o.R.CreatedByLists, related...)
 	}
 
-	if related.R == nil {
-		related.R = &roleR{
-			Users: UserSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &listR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
 		}
-	} else {
-		related.R.Users = append(related.R.Users, o)
 	}
-
 	return nil
 }
 
-// RemoveRole relationship.
-// Sets o.R.Role to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *User) RemoveRole(ctx context.Context, exec boil.ContextExecutor, related *Role) error {
-	var err error
-
-	queries.SetScanner(&o.RoleID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("role_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+// SetCreatedByLists removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByLists accordingly.
+// Replaces o.R.CreatedByLists with related.
+// Sets related.R.CreatedByUser's CreatedByLists accordingly.
+func (o *User) SetCreatedByLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
+	query := "update \"lists\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
 	}
 
 	if o.R != nil {
-		o.R.Role = nil
+		for _, rel := range o.R.CreatedByLists {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByLists = nil
 	}
-	if related == nil || related.R == nil {
+
+	return o.AddCreatedByLists(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByLists relationships from objects passed in.
+// Removes related items from R.CreatedByLists (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByLists(ctx context.Context, exec boil.ContextExecutor, related ...*List) error {
+	if len(related) == 0 {
 		return nil
 	}
 
-	for i, ri := range related.R.Users {
-		if queries.Equal(o.RoleID, ri.RoleID) {
-			continue
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
 		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
 
-		ln := len(related.R.Users)
-		if ln > 1 && i < ln-1 {
-			related.R.Users[i] = related.R.Users[ln-1]
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByLists {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByLists)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByLists[i] = o.R.CreatedByLists[ln-1]
+			}
+			o.R.CreatedByLists = o.R.CreatedByLists[:ln-1]
+			break
 		}
-		related.R.Users = related.R.Users[:ln-1]
-		break
 	}
+
 	return nil
 }
 
-// AddCreatedByBoards adds the given related objects to the existing relationships
+// AddLoginAttempts adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByBoards.
-// Sets related.R.CreatedByUser appropriately.
-func (o *User) AddCreatedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
+// Appends related to o.R.LoginAttempts.
+// Sets related.R.User appropriately.
+func (o *User) AddLoginAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoginAttempt) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.CreatedBy, o.ID)
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"boards\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
-				strmangle.WhereClause("\"", "\"", 2, boardPrimaryKeyColumns),
+				"UPDATE \"login_attempts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loginAttemptPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2470,38 +9684,38 @@ func (o *User) AddCreatedByBoards(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.CreatedBy, o.ID)
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByBoards: related,
+			LoginAttempts: related,
 		}
 	} else {
-		o.R.CreatedByBoards = append(o.R.CreatedByBoards, related...)
+		o.R.LoginAttempts = append(o.R.LoginAttempts, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &boardR{
-				CreatedByUser: o,
+			rel.R = &loginAttemptR{
+				User: o,
 			}
 		} else {
-			rel.R.CreatedByUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetCreatedByBoards removes all previously related items of the
+// SetLoginAttempts removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.CreatedByUser's CreatedByBoards accordingly.
-// Replaces o.R.CreatedByBoards with related.
-// Sets related.R.CreatedByUser's CreatedByBoards accordingly.
-func (o *User) SetCreatedByBoards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Board) error {
-	query := "update \"boards\" set \"created_by\" = null where \"created_by\" = $1"
+// Sets o.R.User's LoginAttempts accordingly.
+// Replaces o.R.LoginAttempts with related.
+// Sets related.R.User's LoginAttempts accordingly.
+func (o *User) SetLoginAttempts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoginAttempt) error {
+	query := "update \"login_attempts\" set \"user_id\" = null where \"user_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2514,35 +9728,35 @@ func (o *User) SetCreatedByBoards(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.CreatedByBoards {
-			queries.SetScanner(&rel.CreatedBy, nil)
+		for _, rel := range o.R.LoginAttempts {
+			queries.SetScanner(&rel.UserID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.CreatedByUser = nil
+			rel.R.User = nil
 		}
-		o.R.CreatedByBoards = nil
+		o.R.LoginAttempts = nil
 	}
 
-	return o.AddCreatedByBoards(ctx, exec, insert, related...)
+	return o.AddLoginAttempts(ctx, exec, insert, related...)
 }
 
-// RemoveCreatedByBoards relationships from objects passed in.
-// Removes related items from R.CreatedByBoards (uses pointer comparison, removal does not keep order)
-// Sets related.R.CreatedByUser.
-func (o *User) RemoveCreatedByBoards(ctx context.Context, exec boil.ContextExecutor, related ...*Board) error {
+// RemoveLoginAttempts relationships from objects passed in.
+// Removes related items from R.LoginAttempts (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveLoginAttempts(ctx context.Context, exec boil.ContextExecutor, related ...*LoginAttempt) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.CreatedBy, nil)
+		queries.SetScanner(&rel.UserID, nil)
 		if rel.R != nil {
-			rel.R.CreatedByUser = nil
+			rel.R.User = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
 			return err
 		}
 	}
@@ -2551,16 +9765,16 @@ func (o *User) RemoveCreatedByBoards(ctx context.Context, exec boil.ContextExecu
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.CreatedByBoards {
+		for i, ri := range o.R.LoginAttempts {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.CreatedByBoards)
+			ln := len(o.R.LoginAttempts)
 			if ln > 1 && i < ln-1 {
-				o.R.CreatedByBoards[i] = o.R.CreatedByBoards[ln-1]
+				o.R.LoginAttempts[i] = o.R.LoginAttempts[ln-1]
 			}
-			o.R.CreatedByBoards = o.R.CreatedByBoards[:ln-1]
+			o.R.LoginAttempts = o.R.LoginAttempts[:ln-1]
 			break
 		}
 	}
@@ -2568,23 +9782,23 @@ func (o *User) RemoveCreatedByBoards(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// AddAssignedToCards adds the given related objects to the existing relationships
+// AddMfaRecoveryCodes adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.AssignedToCards.
-// Sets related.R.AssignedToUser appropriately.
-func (o *User) AddAssignedToCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+// Appends related to o.R.MfaRecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddMfaRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MfaRecoveryCode) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.AssignedTo, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"cards\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"assigned_to"}),
-				strmangle.WhereClause("\"", "\"", 2, cardPrimaryKeyColumns),
+				"UPDATE \"mfa_recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mfaRecoveryCodePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2597,109 +9811,141 @@ func (o *User) AddAssignedToCards(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.AssignedTo, o.ID)
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			AssignedToCards: related,
+			MfaRecoveryCodes: related,
 		}
 	} else {
-		o.R.AssignedToCards = append(o.R.AssignedToCards, related...)
+		o.R.MfaRecoveryCodes = append(o.R.MfaRecoveryCodes, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &cardR{
-				AssignedToUser: o,
+			rel.R = &mfaRecoveryCodeR{
+				User: o,
 			}
 		} else {
-			rel.R.AssignedToUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetAssignedToCards removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.AssignedToUser's AssignedToCards accordingly.
-// Replaces o.R.AssignedToCards with related.
-// Sets related.R.AssignedToUser's AssignedToCards accordingly.
-func (o *User) SetAssignedToCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
-	query := "update \"cards\" set \"assigned_to\" = null where \"assigned_to\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
+// AddPasswordResets adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PasswordResets.
+// Sets related.R.User appropriately.
+func (o *User) AddPasswordResets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordReset) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_resets\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	if o.R != nil {
-		for _, rel := range o.R.AssignedToCards {
-			queries.SetScanner(&rel.AssignedTo, nil)
-			if rel.R == nil {
-				continue
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.R.AssignedToUser = nil
+			rel.UserID = o.ID
 		}
-		o.R.AssignedToCards = nil
 	}
 
-	return o.AddAssignedToCards(ctx, exec, insert, related...)
-}
+	if o.R == nil {
+		o.R = &userR{
+			PasswordResets: related,
+		}
+	} else {
+		o.R.PasswordResets = append(o.R.PasswordResets, related...)
+	}
 
-// RemoveAssignedToCards relationships from objects passed in.
-// Removes related items from R.AssignedToCards (uses pointer comparison, removal does not keep order)
-// Sets related.R.AssignedToUser.
-func (o *User) RemoveAssignedToCards(ctx context.Context, exec boil.ContextExecutor, related ...*Card) error {
-	if len(related) == 0 {
-		return nil
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
 	}
+	return nil
+}
 
+// AddPersonalAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PersonalAccessTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPersonalAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PersonalAccessToken) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.AssignedTo, nil)
-		if rel.R != nil {
-			rel.R.AssignedToUser = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("assigned_to")); err != nil {
-			return err
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"personal_access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, personalAccessTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &userR{
+			PersonalAccessTokens: related,
+		}
+	} else {
+		o.R.PersonalAccessTokens = append(o.R.PersonalAccessTokens, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.AssignedToCards {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.AssignedToCards)
-			if ln > 1 && i < ln-1 {
-				o.R.AssignedToCards[i] = o.R.AssignedToCards[ln-1]
+		if rel.R == nil {
+			rel.R = &personalAccessTokenR{
+				User: o,
 			}
-			o.R.AssignedToCards = o.R.AssignedToCards[:ln-1]
-			break
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddCreatedByCards adds the given related objects to the existing relationships
+// AddCreatedByRebalanceJobs adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByCards.
+// Appends related to o.R.CreatedByRebalanceJobs.
 // Sets related.R.CreatedByUser appropriately.
-func (o *User) AddCreatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+func (o *User) AddCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RebalanceJob) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -2709,9 +9955,9 @@ func (o *User) AddCreatedByCards(ctx context.Context, exec boil.ContextExecutor,
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"cards\" SET %s WHERE %s",
+				"UPDATE \"rebalance_jobs\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
-				strmangle.WhereClause("\"", "\"", 2, cardPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, rebalanceJobPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2730,15 +9976,15 @@ func (o *User) AddCreatedByCards(ctx context.Context, exec boil.ContextExecutor,
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByCards: related,
+			CreatedByRebalanceJobs: related,
 		}
 	} else {
-		o.R.CreatedByCards = append(o.R.CreatedByCards, related...)
+		o.R.CreatedByRebalanceJobs = append(o.R.CreatedByRebalanceJobs, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &cardR{
+			rel.R = &rebalanceJobR{
 				CreatedByUser: o,
 			}
 		} else {
@@ -2748,14 +9994,14 @@ func (o *User) AddCreatedByCards(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
-// SetCreatedByCards removes all previously related items of the
+// SetCreatedByRebalanceJobs removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.CreatedByUser's CreatedByCards accordingly.
-// Replaces o.R.CreatedByCards with related.
-// Sets related.R.CreatedByUser's CreatedByCards accordingly.
-func (o *User) SetCreatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
-	query := "update \"cards\" set \"created_by\" = null where \"created_by\" = $1"
+// Sets o.R.CreatedByUser's CreatedByRebalanceJobs accordingly.
+// Replaces o.R.CreatedByRebalanceJobs with related.
+// Sets related.R.CreatedByUser's CreatedByRebalanceJobs accordingly.
+func (o *User) SetCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RebalanceJob) error {
+	query := "update \"rebalance_jobs\" set \"created_by\" = null where \"created_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2768,7 +10014,7 @@ func (o *User) SetCreatedByCards(ctx context.Context, exec boil.ContextExecutor,
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.CreatedByCards {
+		for _, rel := range o.R.CreatedByRebalanceJobs {
 			queries.SetScanner(&rel.CreatedBy, nil)
 			if rel.R == nil {
 				continue
@@ -2776,71 +10022,124 @@ func (o *User) SetCreatedByCards(ctx context.Context, exec boil.ContextExecutor,
 
 			rel.R.CreatedByUser = nil
 		}
-		o.R.CreatedByCards = nil
+		o.R.CreatedByRebalanceJobs = nil
 	}
 
-	return o.AddCreatedByCards(ctx, exec, insert, related...)
+	return o.AddCreatedByRebalanceJobs(ctx, exec, insert, related...)
 }
 
-// RemoveCreatedByCards relationships from objects passed in.
-// Removes related items from R.CreatedByCards (uses pointer comparison, removal does not keep order)
+// RemoveCreatedByRebalanceJobs relationships from objects passed in.
+// Removes related items from R.CreatedByRebalanceJobs (uses pointer comparison, removal does not keep order)
 // Sets related.R.CreatedByUser.
-func (o *User) RemoveCreatedByCards(ctx context.Context, exec boil.ContextExecutor, related ...*Card) error {
+func (o *User) RemoveCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextExecutor, related ...*RebalanceJob) error {
 	if len(related) == 0 {
 		return nil
 	}
 
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.CreatedBy, nil)
-		if rel.R != nil {
-			rel.R.CreatedByUser = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
-			return err
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByRebalanceJobs {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByRebalanceJobs)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByRebalanceJobs[i] = o.R.CreatedByRebalanceJobs[ln-1]
+			}
+			o.R.CreatedByRebalanceJobs = o.R.CreatedByRebalanceJobs[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddRefreshTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.RefreshTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRefreshTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RefreshToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"refresh_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, refreshTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &userR{
+			RefreshTokens: related,
+		}
+	} else {
+		o.R.RefreshTokens = append(o.R.RefreshTokens, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.CreatedByCards {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.CreatedByCards)
-			if ln > 1 && i < ln-1 {
-				o.R.CreatedByCards[i] = o.R.CreatedByCards[ln-1]
+		if rel.R == nil {
+			rel.R = &refreshTokenR{
+				User: o,
 			}
-			o.R.CreatedByCards = o.R.CreatedByCards[:ln-1]
-			break
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddUpdatedByCards adds the given related objects to the existing relationships
+// AddRevokedTokens adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UpdatedByCards.
-// Sets related.R.UpdatedByUser appropriately.
-func (o *User) AddUpdatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
+// Appends related to o.R.RevokedTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddRevokedTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RevokedToken) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.UpdatedBy, o.ID)
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"cards\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by"}),
-				strmangle.WhereClause("\"", "\"", 2, cardPrimaryKeyColumns),
+				"UPDATE \"revoked_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, revokedTokenPrimaryKeyColumns),
 			)
-			values := []interface{}{o.ID, rel.ID}
+			values := []interface{}{o.ID, rel.Jti}
 
 			if boil.IsDebug(ctx) {
 				writer := boil.DebugWriterFrom(ctx)
@@ -2851,38 +10150,38 @@ func (o *User) AddUpdatedByCards(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UpdatedBy, o.ID)
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			UpdatedByCards: related,
+			RevokedTokens: related,
 		}
 	} else {
-		o.R.UpdatedByCards = append(o.R.UpdatedByCards, related...)
+		o.R.RevokedTokens = append(o.R.RevokedTokens, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &cardR{
-				UpdatedByUser: o,
+			rel.R = &revokedTokenR{
+				User: o,
 			}
 		} else {
-			rel.R.UpdatedByUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetUpdatedByCards removes all previously related items of the
+// SetRevokedTokens removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.UpdatedByUser's UpdatedByCards accordingly.
-// Replaces o.R.UpdatedByCards with related.
-// Sets related.R.UpdatedByUser's UpdatedByCards accordingly.
-func (o *User) SetUpdatedByCards(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Card) error {
-	query := "update \"cards\" set \"updated_by\" = null where \"updated_by\" = $1"
+// Sets o.R.User's RevokedTokens accordingly.
+// Replaces o.R.RevokedTokens with related.
+// Sets related.R.User's RevokedTokens accordingly.
+func (o *User) SetRevokedTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RevokedToken) error {
+	query := "update \"revoked_tokens\" set \"user_id\" = null where \"user_id\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -2895,35 +10194,35 @@ func (o *User) SetUpdatedByCards(ctx context.Context, exec boil.ContextExecutor,
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.UpdatedByCards {
-			queries.SetScanner(&rel.UpdatedBy, nil)
+		for _, rel := range o.R.RevokedTokens {
+			queries.SetScanner(&rel.UserID, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.UpdatedByUser = nil
+			rel.R.User = nil
 		}
-		o.R.UpdatedByCards = nil
+		o.R.RevokedTokens = nil
 	}
 
-	return o.AddUpdatedByCards(ctx, exec, insert, related...)
+	return o.AddRevokedTokens(ctx, exec, insert, related...)
 }
 
-// RemoveUpdatedByCards relationships from objects passed in.
-// Removes related items from R.UpdatedByCards (uses pointer comparison, removal does not keep order)
-// Sets related.R.UpdatedByUser.
-func (o *User) RemoveUpdatedByCards(ctx context.Context, exec boil.ContextExecutor, related ...*Card) error {
+// RemoveRevokedTokens relationships from objects passed in.
+// Removes related items from R.RevokedTokens (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemoveRevokedTokens(ctx context.Context, exec boil.ContextExecutor, related ...*RevokedToken) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.UpdatedBy, nil)
+		queries.SetScanner(&rel.UserID, nil)
 		if rel.R != nil {
-			rel.R.UpdatedByUser = nil
+			rel.R.User = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("updated_by")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
 			return err
 		}
 	}
@@ -2932,16 +10231,16 @@ func (o *User) RemoveUpdatedByCards(ctx context.Context, exec boil.ContextExecut
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.UpdatedByCards {
+		for i, ri := range o.R.RevokedTokens {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.UpdatedByCards)
+			ln := len(o.R.RevokedTokens)
 			if ln > 1 && i < ln-1 {
-				o.R.UpdatedByCards[i] = o.R.UpdatedByCards[ln-1]
+				o.R.RevokedTokens[i] = o.R.RevokedTokens[ln-1]
 			}
-			o.R.UpdatedByCards = o.R.UpdatedByCards[:ln-1]
+			o.R.RevokedTokens = o.R.RevokedTokens[:ln-1]
 			break
 		}
 	}
@@ -2949,23 +10248,23 @@ func (o *User) RemoveUpdatedByCards(ctx context.Context, exec boil.ContextExecut
 	return nil
 }
 
-// AddEditedByComments adds the given related objects to the existing relationships
+// AddSessions adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.EditedByComments.
-// Sets related.R.EditedByUser appropriately.
-func (o *User) AddEditedByComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+// Appends related to o.R.Sessions.
+// Sets related.R.User appropriately.
+func (o *User) AddSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.EditedBy, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comments\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"edited_by"}),
-				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+				"UPDATE \"sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, sessionPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -2978,38 +10277,91 @@ func (o *User) AddEditedByComments(ctx context.Context, exec boil.ContextExecuto
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.EditedBy, o.ID)
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			EditedByComments: related,
+			Sessions: related,
 		}
 	} else {
-		o.R.EditedByComments = append(o.R.EditedByComments, related...)
+		o.R.Sessions = append(o.R.Sessions, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
-				EditedByUser: o,
+			rel.R = &sessionR{
+				User: o,
 			}
 		} else {
-			rel.R.EditedByUser = o
+			rel.R.User = o
 		}
 	}
 	return nil
 }
 
-// SetEditedByComments removes all previously related items of the
+// AddAddedByTeamMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddedByTeamMembers.
+// Sets related.R.AddedByUser appropriately.
+func (o *User) AddAddedByTeamMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TeamMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AddedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"team_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+				strmangle.WhereClause("\"", "\"", 2, teamMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AddedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AddedByTeamMembers: related,
+		}
+	} else {
+		o.R.AddedByTeamMembers = append(o.R.AddedByTeamMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &teamMemberR{
+				AddedByUser: o,
+			}
+		} else {
+			rel.R.AddedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAddedByTeamMembers removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.EditedByUser's EditedByComments accordingly.
-// Replaces o.R.EditedByComments with related.
-// Sets related.R.EditedByUser's EditedByComments accordingly.
-func (o *User) SetEditedByComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
-	query := "update \"comments\" set \"edited_by\" = null where \"edited_by\" = $1"
+// Sets o.R.AddedByUser's AddedByTeamMembers accordingly.
+// Replaces o.R.AddedByTeamMembers with related.
+// Sets related.R.AddedByUser's AddedByTeamMembers accordingly.
+func (o *User) SetAddedByTeamMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TeamMember) error {
+	query := "update \"team_members\" set \"added_by\" = null where \"added_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -3022,35 +10374,35 @@ func (o *User) SetEditedByComments(ctx context.Context, exec boil.ContextExecuto
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.EditedByComments {
-			queries.SetScanner(&rel.EditedBy, nil)
+		for _, rel := range o.R.AddedByTeamMembers {
+			queries.SetScanner(&rel.AddedBy, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.EditedByUser = nil
+			rel.R.AddedByUser = nil
 		}
-		o.R.EditedByComments = nil
+		o.R.AddedByTeamMembers = nil
 	}
 
-	return o.AddEditedByComments(ctx, exec, insert, related...)
+	return o.AddAddedByTeamMembers(ctx, exec, insert, related...)
 }
 
-// RemoveEditedByComments relationships from objects passed in.
-// Removes related items from R.EditedByComments (uses pointer comparison, removal does not keep order)
-// Sets related.R.EditedByUser.
-func (o *User) RemoveEditedByComments(ctx context.Context, exec boil.ContextExecutor, related ...*Comment) error {
+// RemoveAddedByTeamMembers relationships from objects passed in.
+// Removes related items from R.AddedByTeamMembers (uses pointer comparison, removal does not keep order)
+// Sets related.R.AddedByUser.
+func (o *User) RemoveAddedByTeamMembers(ctx context.Context, exec boil.ContextExecutor, related ...*TeamMember) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.EditedBy, nil)
+		queries.SetScanner(&rel.AddedBy, nil)
 		if rel.R != nil {
-			rel.R.EditedByUser = nil
+			rel.R.AddedByUser = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("edited_by")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
 			return err
 		}
 	}
@@ -3059,16 +10411,16 @@ func (o *User) RemoveEditedByComments(ctx context.Context, exec boil.ContextExec
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.EditedByComments {
+		for i, ri := range o.R.AddedByTeamMembers {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.EditedByComments)
+			ln := len(o.R.AddedByTeamMembers)
 			if ln > 1 && i < ln-1 {
-				o.R.EditedByComments[i] = o.R.EditedByComments[ln-1]
+				o.R.AddedByTeamMembers[i] = o.R.AddedByTeamMembers[ln-1]
 			}
-			o.R.EditedByComments = o.R.EditedByComments[:ln-1]
+			o.R.AddedByTeamMembers = o.R.AddedByTeamMembers[:ln-1]
 			break
 		}
 	}
@@ -3076,11 +10428,11 @@ func (o *User) RemoveEditedByComments(ctx context.Context, exec boil.ContextExec
 	return nil
 }
 
-// AddComments adds the given related objects to the existing relationships
+// AddTeamMembers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Comments.
+// Appends related to o.R.TeamMembers.
 // Sets related.R.User appropriately.
-func (o *User) AddComments(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Comment) error {
+func (o *User) AddTeamMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TeamMember) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3090,9 +10442,9 @@ func (o *User) AddComments(ctx context.Context, exec boil.ContextExecutor, inser
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"comments\" SET %s WHERE %s",
+				"UPDATE \"team_members\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, commentPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, teamMemberPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3111,15 +10463,15 @@ func (o *User) AddComments(ctx context.Context, exec boil.ContextExecutor, inser
 
 	if o.R == nil {
 		o.R = &userR{
-			Comments: related,
+			TeamMembers: related,
 		}
 	} else {
-		o.R.Comments = append(o.R.Comments, related...)
+		o.R.TeamMembers = append(o.R.TeamMembers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &commentR{
+			rel.R = &teamMemberR{
 				User: o,
 			}
 		} else {
@@ -3129,11 +10481,11 @@ func (o *User) AddComments(ctx context.Context, exec boil.ContextExecutor, inser
 	return nil
 }
 
-// AddCreatedByLabels adds the given related objects to the existing relationships
+// AddCreatedByTeams adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByLabels.
+// Appends related to o.R.CreatedByTeams.
 // Sets related.R.CreatedByUser appropriately.
-func (o *User) AddCreatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+func (o *User) AddCreatedByTeams(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Team) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3143,9 +10495,9 @@ func (o *User) AddCreatedByLabels(ctx context.Context, exec boil.ContextExecutor
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"labels\" SET %s WHERE %s",
+				"UPDATE \"teams\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
-				strmangle.WhereClause("\"", "\"", 2, labelPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, teamPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3164,15 +10516,15 @@ func (o *User) AddCreatedByLabels(ctx context.Context, exec boil.ContextExecutor
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByLabels: related,
+			CreatedByTeams: related,
 		}
 	} else {
-		o.R.CreatedByLabels = append(o.R.CreatedByLabels, related...)
+		o.R.CreatedByTeams = append(o.R.CreatedByTeams, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &labelR{
+			rel.R = &teamR{
 				CreatedByUser: o,
 			}
 		} else {
@@ -3182,14 +10534,14 @@ func (o *User) AddCreatedByLabels(ctx context.Context, exec boil.ContextExecutor
 	return nil
 }
 
-// SetCreatedByLabels removes all previously related items of the
+// SetCreatedByTeams removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.CreatedByUser's CreatedByLabels accordingly.
-// Replaces o.R.CreatedByLabels with related.
-// Sets related.R.CreatedByUser's CreatedByLabels accordingly.
-func (o *User) SetCreatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
-	query := "update \"labels\" set \"created_by\" = null where \"created_by\" = $1"
+// Sets o.R.CreatedByUser's CreatedByTeams accordingly.
+// Replaces o.R.CreatedByTeams with related.
+// Sets related.R.CreatedByUser's CreatedByTeams accordingly.
+func (o *User) SetCreatedByTeams(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Team) error {
+	query := "update \"teams\" set \"created_by\" = null where \"created_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -3202,7 +10554,7 @@ func (o *User) SetCreatedByLabels(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.CreatedByLabels {
+		for _, rel := range o.R.CreatedByTeams {
 			queries.SetScanner(&rel.CreatedBy, nil)
 			if rel.R == nil {
 				continue
@@ -3210,16 +10562,16 @@ func (o *User) SetCreatedByLabels(ctx context.Context, exec boil.ContextExecutor
 
 			rel.R.CreatedByUser = nil
 		}
-		o.R.CreatedByLabels = nil
+		o.R.CreatedByTeams = nil
 	}
 
-	return o.AddCreatedByLabels(ctx, exec, insert, related...)
+	return o.AddCreatedByTeams(ctx, exec, insert, related...)
 }
 
-// RemoveCreatedByLabels relationships from objects passed in.
-// Removes related items from R.CreatedByLabels (uses pointer comparison, removal does not keep order)
+// RemoveCreatedByTeams relationships from objects passed in.
+// Removes related items from R.CreatedByTeams (uses pointer comparison, removal does not keep order)
 // Sets related.R.CreatedByUser.
-func (o *User) RemoveCreatedByLabels(ctx context.Context, exec boil.ContextExecutor, related ...*Label) error {
+func (o *User) RemoveCreatedByTeams(ctx context.Context, exec boil.ContextExecutor, related ...*Team) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -3239,16 +10591,16 @@ func (o *User) RemoveCreatedByLabels(ctx context.Context, exec boil.ContextExecu
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.CreatedByLabels {
+		for i, ri := range o.R.CreatedByTeams {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.CreatedByLabels)
+			ln := len(o.R.CreatedByTeams)
 			if ln > 1 && i < ln-1 {
-				o.R.CreatedByLabels[i] = o.R.CreatedByLabels[ln-1]
+				o.R.CreatedByTeams[i] = o.R.CreatedByTeams[ln-1]
 			}
-			o.R.CreatedByLabels = o.R.CreatedByLabels[:ln-1]
+			o.R.CreatedByTeams = o.R.CreatedByTeams[:ln-1]
 			break
 		}
 	}
@@ -3256,23 +10608,23 @@ func (o *User) RemoveCreatedByLabels(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// AddDeletedByLabels adds the given related objects to the existing relationships
+// AddCreatedUserUploads adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.DeletedByLabels.
-// Sets related.R.DeletedByUser appropriately.
-func (o *User) AddDeletedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+// Appends related to o.R.CreatedUserUploads.
+// Sets related.R.CreatedUser appropriately.
+func (o *User) AddCreatedUserUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Upload) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.DeletedBy, o.ID)
+			rel.CreatedUserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"labels\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"deleted_by"}),
-				strmangle.WhereClause("\"", "\"", 2, labelPrimaryKeyColumns),
+				"UPDATE \"uploads\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, uploadPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3285,121 +10637,100 @@ func (o *User) AddDeletedByLabels(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.DeletedBy, o.ID)
+			rel.CreatedUserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			DeletedByLabels: related,
+			CreatedUserUploads: related,
 		}
 	} else {
-		o.R.DeletedByLabels = append(o.R.DeletedByLabels, related...)
+		o.R.CreatedUserUploads = append(o.R.CreatedUserUploads, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &labelR{
-				DeletedByUser: o,
+			rel.R = &uploadR{
+				CreatedUser: o,
 			}
 		} else {
-			rel.R.DeletedByUser = o
+			rel.R.CreatedUser = o
 		}
 	}
 	return nil
 }
 
-// SetDeletedByLabels removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.DeletedByUser's DeletedByLabels accordingly.
-// Replaces o.R.DeletedByLabels with related.
-// Sets related.R.DeletedByUser's DeletedByLabels accordingly.
-func (o *User) SetDeletedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
-	query := "update \"labels\" set \"deleted_by\" = null where \"deleted_by\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	if o.R != nil {
-		for _, rel := range o.R.DeletedByLabels {
-			queries.SetScanner(&rel.DeletedBy, nil)
-			if rel.R == nil {
-				continue
-			}
-
-			rel.R.DeletedByUser = nil
-		}
-		o.R.DeletedByLabels = nil
-	}
-
-	return o.AddDeletedByLabels(ctx, exec, insert, related...)
-}
-
-// RemoveDeletedByLabels relationships from objects passed in.
-// Removes related items from R.DeletedByLabels (uses pointer comparison, removal does not keep order)
-// Sets related.R.DeletedByUser.
-func (o *User) RemoveDeletedByLabels(ctx context.Context, exec boil.ContextExecutor, related ...*Label) error {
-	if len(related) == 0 {
-		return nil
-	}
-
+// AddUserIdentities adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.User appropriately.
+func (o *User) AddUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) error {
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.DeletedBy, nil)
-		if rel.R != nil {
-			rel.R.DeletedByUser = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("deleted_by")); err != nil {
-			return err
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_identities\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
 		}
 	}
+
 	if o.R == nil {
-		return nil
+		o.R = &userR{
+			UserIdentities: related,
+		}
+	} else {
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.DeletedByLabels {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.DeletedByLabels)
-			if ln > 1 && i < ln-1 {
-				o.R.DeletedByLabels[i] = o.R.DeletedByLabels[ln-1]
+		if rel.R == nil {
+			rel.R = &userIdentityR{
+				User: o,
 			}
-			o.R.DeletedByLabels = o.R.DeletedByLabels[:ln-1]
-			break
+		} else {
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddUpdatedByLabels adds the given related objects to the existing relationships
+// AddAddedByWorkspaceMembers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.UpdatedByLabels.
-// Sets related.R.UpdatedByUser appropriately.
-func (o *User) AddUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
+// Appends related to o.R.AddedByWorkspaceMembers.
+// Sets related.R.AddedByUser appropriately.
+func (o *User) AddAddedByWorkspaceMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkspaceMember) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.UpdatedBy, o.ID)
+			queries.Assign(&rel.AddedBy, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"labels\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"updated_by"}),
-				strmangle.WhereClause("\"", "\"", 2, labelPrimaryKeyColumns),
+				"UPDATE \"workspace_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+				strmangle.WhereClause("\"", "\"", 2, workspaceMemberPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3412,38 +10743,38 @@ func (o *User) AddUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.UpdatedBy, o.ID)
+			queries.Assign(&rel.AddedBy, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			UpdatedByLabels: related,
+			AddedByWorkspaceMembers: related,
 		}
 	} else {
-		o.R.UpdatedByLabels = append(o.R.UpdatedByLabels, related...)
+		o.R.AddedByWorkspaceMembers = append(o.R.AddedByWorkspaceMembers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &labelR{
-				UpdatedByUser: o,
+			rel.R = &workspaceMemberR{
+				AddedByUser: o,
 			}
 		} else {
-			rel.R.UpdatedByUser = o
+			rel.R.AddedByUser = o
 		}
 	}
 	return nil
 }
 
-// SetUpdatedByLabels removes all previously related items of the
+// SetAddedByWorkspaceMembers removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.UpdatedByUser's UpdatedByLabels accordingly.
-// Replaces o.R.UpdatedByLabels with related.
-// Sets related.R.UpdatedByUser's UpdatedByLabels accordingly.
-func (o *User) SetUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Label) error {
-	query := "update \"labels\" set \"updated_by\" = null where \"updated_by\" = $1"
+// Sets o.R.AddedByUser's AddedByWorkspaceMembers accordingly.
+// Replaces o.R.AddedByWorkspaceMembers with related.
+// Sets related.R.AddedByUser's AddedByWorkspaceMembers accordingly.
+func (o *User) SetAddedByWorkspaceMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkspaceMember) error {
+	query := "update \"workspace_members\" set \"added_by\" = null where \"added_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -3456,35 +10787,35 @@ func (o *User) SetUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.UpdatedByLabels {
-			queries.SetScanner(&rel.UpdatedBy, nil)
+		for _, rel := range o.R.AddedByWorkspaceMembers {
+			queries.SetScanner(&rel.AddedBy, nil)
 			if rel.R == nil {
 				continue
 			}
 
-			rel.R.UpdatedByUser = nil
+			rel.R.AddedByUser = nil
 		}
-		o.R.UpdatedByLabels = nil
+		o.R.AddedByWorkspaceMembers = nil
 	}
 
-	return o.AddUpdatedByLabels(ctx, exec, insert, related...)
+	return o.AddAddedByWorkspaceMembers(ctx, exec, insert, related...)
 }
 
-// RemoveUpdatedByLabels relationships from objects passed in.
-// Removes related items from R.UpdatedByLabels (uses pointer comparison, removal does not keep order)
-// Sets related.R.UpdatedByUser.
-func (o *User) RemoveUpdatedByLabels(ctx context.Context, exec boil.ContextExecutor, related ...*Label) error {
+// RemoveAddedByWorkspaceMembers relationships from objects passed in.
+// Removes related items from R.AddedByWorkspaceMembers (uses pointer comparison, removal does not keep order)
+// Sets related.R.AddedByUser.
+func (o *User) RemoveAddedByWorkspaceMembers(ctx context.Context, exec boil.ContextExecutor, related ...*WorkspaceMember) error {
 	if len(related) == 0 {
 		return nil
 	}
 
 	var err error
 	for _, rel := range related {
-		queries.SetScanner(&rel.UpdatedBy, nil)
+		queries.SetScanner(&rel.AddedBy, nil)
 		if rel.R != nil {
-			rel.R.UpdatedByUser = nil
+			rel.R.AddedByUser = nil
 		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("updated_by")); err != nil {
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
 			return err
 		}
 	}
@@ -3493,16 +10824,16 @@ func (o *User) RemoveUpdatedByLabels(ctx context.Context, exec boil.ContextExecu
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.UpdatedByLabels {
+		for i, ri := range o.R.AddedByWorkspaceMembers {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.UpdatedByLabels)
+			ln := len(o.R.AddedByWorkspaceMembers)
 			if ln > 1 && i < ln-1 {
-				o.R.UpdatedByLabels[i] = o.R.UpdatedByLabels[ln-1]
+				o.R.AddedByWorkspaceMembers[i] = o.R.AddedByWorkspaceMembers[ln-1]
 			}
-			o.R.UpdatedByLabels = o.R.UpdatedByLabels[:ln-1]
+			o.R.AddedByWorkspaceMembers = o.R.AddedByWorkspaceMembers[:ln-1]
 			break
 		}
 	}
@@ -3510,23 +10841,23 @@ func (o *User) RemoveUpdatedByLabels(ctx context.Context, exec boil.ContextExecu
 	return nil
 }
 
-// AddCreatedByLists adds the given related objects to the existing relationships
+// AddWorkspaceMembers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByLists.
-// Sets related.R.CreatedByUser appropriately.
-func (o *User) AddCreatedByLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
+// Appends related to o.R.WorkspaceMembers.
+// Sets related.R.User appropriately.
+func (o *User) AddWorkspaceMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WorkspaceMember) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			queries.Assign(&rel.CreatedBy, o.ID)
+			rel.UserID = o.ID
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"lists\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
-				strmangle.WhereClause("\"", "\"", 2, listPrimaryKeyColumns),
+				"UPDATE \"workspace_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, workspaceMemberPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3539,109 +10870,35 @@ func (o *User) AddCreatedByLists(ctx context.Context, exec boil.ContextExecutor,
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			queries.Assign(&rel.CreatedBy, o.ID)
+			rel.UserID = o.ID
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByLists: related,
+			WorkspaceMembers: related,
 		}
 	} else {
-		o.R.CreatedByLists = append(o.R.CreatedByLists, related...)
+		o.R.WorkspaceMembers = append(o.R.WorkspaceMembers, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &listR{
-				CreatedByUser: o,
+			rel.R = &workspaceMemberR{
+				User: o,
 			}
 		} else {
-			rel.R.CreatedByUser = o
-		}
-	}
-	return nil
-}
-
-// SetCreatedByLists removes all previously related items of the
-// user replacing them completely with the passed
-// in related items, optionally inserting them as new records.
-// Sets o.R.CreatedByUser's CreatedByLists accordingly.
-// Replaces o.R.CreatedByLists with related.
-// Sets related.R.CreatedByUser's CreatedByLists accordingly.
-func (o *User) SetCreatedByLists(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*List) error {
-	query := "update \"lists\" set \"created_by\" = null where \"created_by\" = $1"
-	values := []interface{}{o.ID}
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, query)
-		fmt.Fprintln(writer, values)
-	}
-	_, err := exec.ExecContext(ctx, query, values...)
-	if err != nil {
-		return errors.Wrap(err, "failed to remove relationships before set")
-	}
-
-	if o.R != nil {
-		for _, rel := range o.R.CreatedByLists {
-			queries.SetScanner(&rel.CreatedBy, nil)
-			if rel.R == nil {
-				continue
-			}
-
-			rel.R.CreatedByUser = nil
-		}
-		o.R.CreatedByLists = nil
-	}
-
-	return o.AddCreatedByLists(ctx, exec, insert, related...)
-}
-
-// RemoveCreatedByLists relationships from objects passed in.
-// Removes related items from R.CreatedByLists (uses pointer comparison, removal does not keep order)
-// Sets related.R.CreatedByUser.
-func (o *User) RemoveCreatedByLists(ctx context.Context, exec boil.ContextExecutor, related ...*List) error {
-	if len(related) == 0 {
-		return nil
-	}
-
-	var err error
-	for _, rel := range related {
-		queries.SetScanner(&rel.CreatedBy, nil)
-		if rel.R != nil {
-			rel.R.CreatedByUser = nil
-		}
-		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
-			return err
-		}
-	}
-	if o.R == nil {
-		return nil
-	}
-
-	for _, rel := range related {
-		for i, ri := range o.R.CreatedByLists {
-			if rel != ri {
-				continue
-			}
-
-			ln := len(o.R.CreatedByLists)
-			if ln > 1 && i < ln-1 {
-				o.R.CreatedByLists[i] = o.R.CreatedByLists[ln-1]
-			}
-			o.R.CreatedByLists = o.R.CreatedByLists[:ln-1]
-			break
+			rel.R.User = o
 		}
 	}
-
 	return nil
 }
 
-// AddCreatedByRebalanceJobs adds the given related objects to the existing relationships
+// AddCreatedByWorkspaces adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedByRebalanceJobs.
+// Appends related to o.R.CreatedByWorkspaces.
 // Sets related.R.CreatedByUser appropriately.
-func (o *User) AddCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RebalanceJob) error {
+func (o *User) AddCreatedByWorkspaces(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Workspace) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -3651,9 +10908,9 @@ func (o *User) AddCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextE
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE \"rebalance_jobs\" SET %s WHERE %s",
+				"UPDATE \"workspaces\" SET %s WHERE %s",
 				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
-				strmangle.WhereClause("\"", "\"", 2, rebalanceJobPrimaryKeyColumns),
+				strmangle.WhereClause("\"", "\"", 2, workspacePrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -3672,15 +10929,15 @@ func (o *User) AddCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextE
 
 	if o.R == nil {
 		o.R = &userR{
-			CreatedByRebalanceJobs: related,
+			CreatedByWorkspaces: related,
 		}
 	} else {
-		o.R.CreatedByRebalanceJobs = append(o.R.CreatedByRebalanceJobs, related...)
+		o.R.CreatedByWorkspaces = append(o.R.CreatedByWorkspaces, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &rebalanceJobR{
+			rel.R = &workspaceR{
 				CreatedByUser: o,
 			}
 		} else {
@@ -3690,14 +10947,14 @@ func (o *User) AddCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextE
 	return nil
 }
 
-// SetCreatedByRebalanceJobs removes all previously related items of the
+// SetCreatedByWorkspaces removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.CreatedByUser's CreatedByRebalanceJobs accordingly.
-// Replaces o.R.CreatedByRebalanceJobs with related.
-// Sets related.R.CreatedByUser's CreatedByRebalanceJobs accordingly.
-func (o *User) SetCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*RebalanceJob) error {
-	query := "update \"rebalance_jobs\" set \"created_by\" = null where \"created_by\" = $1"
+// Sets o.R.CreatedByUser's CreatedByWorkspaces accordingly.
+// Replaces o.R.CreatedByWorkspaces with related.
+// Sets related.R.CreatedByUser's CreatedByWorkspaces accordingly.
+func (o *User) SetCreatedByWorkspaces(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Workspace) error {
+	query := "update \"workspaces\" set \"created_by\" = null where \"created_by\" = $1"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -3710,7 +10967,7 @@ func (o *User) SetCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextE
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.CreatedByRebalanceJobs {
+		for _, rel := range o.R.CreatedByWorkspaces {
 			queries.SetScanner(&rel.CreatedBy, nil)
 			if rel.R == nil {
 				continue
@@ -3718,16 +10975,16 @@ func (o *User) SetCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextE
 
 			rel.R.CreatedByUser = nil
 		}
-		o.R.CreatedByRebalanceJobs = nil
+		o.R.CreatedByWorkspaces = nil
 	}
 
-	return o.AddCreatedByRebalanceJobs(ctx, exec, insert, related...)
+	return o.AddCreatedByWorkspaces(ctx, exec, insert, related...)
 }
 
-// RemoveCreatedByRebalanceJobs relationships from objects passed in.
-// Removes related items from R.CreatedByRebalanceJobs (uses pointer comparison, removal does not keep order)
+// RemoveCreatedByWorkspaces relationships from objects passed in.
+// Removes related items from R.CreatedByWorkspaces (uses pointer comparison, removal does not keep order)
 // Sets related.R.CreatedByUser.
-func (o *User) RemoveCreatedByRebalanceJobs(ctx context.Context, exec boil.ContextExecutor, related ...*RebalanceJob) error {
+func (o *User) RemoveCreatedByWorkspaces(ctx context.Context, exec boil.ContextExecutor, related ...*Workspace) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -3747,16 +11004,16 @@ func (o *User) RemoveCreatedByRebalanceJobs(ctx context.Context, exec boil.Conte
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.CreatedByRebalanceJobs {
+		for i, ri := range o.R.CreatedByWorkspaces {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.CreatedByRebalanceJobs)
+			ln := len(o.R.CreatedByWorkspaces)
 			if ln > 1 && i < ln-1 {
-				o.R.CreatedByRebalanceJobs[i] = o.R.CreatedByRebalanceJobs[ln-1]
+				o.R.CreatedByWorkspaces[i] = o.R.CreatedByWorkspaces[ln-1]
 			}
-			o.R.CreatedByRebalanceJobs = o.R.CreatedByRebalanceJobs[:ln-1]
+			o.R.CreatedByWorkspaces = o.R.CreatedByWorkspaces[:ln-1]
 			break
 		}
 	}
@@ -3764,59 +11021,6 @@ func (o *User) RemoveCreatedByRebalanceJobs(ctx context.Context, exec boil.Conte
 	return nil
 }
 
-// AddCreatedUserUploads adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatedUserUploads.
-// Sets related.R.CreatedUser appropriately.
-func (o *User) AddCreatedUserUploads(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Upload) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.CreatedUserID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"uploads\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"created_user_id"}),
-				strmangle.WhereClause("\"", "\"", 2, uploadPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
-
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
-			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
-			}
-
-			rel.CreatedUserID = o.ID
-		}
-	}
-
-	if o.R == nil {
-		o.R = &userR{
-			CreatedUserUploads: related,
-		}
-	} else {
-		o.R.CreatedUserUploads = append(o.R.CreatedUserUploads, related...)
-	}
-
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &uploadR{
-				CreatedUser: o,
-			}
-		} else {
-			rel.R.CreatedUser = o
-		}
-	}
-	return nil
-}
-
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""), qmhelper.WhereIsNull("\"users\".\"deleted_at\""))
@@ -0,0 +1,1622 @@
+// Code generated by SQLBoiler 4.19.5 (https://github.com/aarondl/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/queries/qmhelper"
+	"github.com/aarondl/strmangle"
+	"github.com/friendsofgo/errors"
+)
+
+// WorkspaceMember is an object representing the database table.
+type WorkspaceMember struct {
+	ID          string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WorkspaceID string `boil:"workspace_id" json:"workspace_id" toml:"workspace_id" yaml:"workspace_id"`
+	UserID      string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	// owner, admin or member; owners and admins hold the same role on every board of the workspace
+	Role string `boil:"role" json:"role" toml:"role" yaml:"role"`
+	// User who added the member
+	AddedBy   null.String `boil:"added_by" json:"added_by,omitempty" toml:"added_by" yaml:"added_by,omitempty"`
+	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *workspaceMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L workspaceMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WorkspaceMemberColumns = struct {
+	ID          string
+	WorkspaceID string
+	UserID      string
+	Role        string
+	AddedBy     string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "id",
+	WorkspaceID: "workspace_id",
+	UserID:      "user_id",
+	Role:        "role",
+	AddedBy:     "added_by",
+	CreatedAt:   "created_at",
+	UpdatedAt:   "updated_at",
+}
+
+var WorkspaceMemberTableColumns = struct {
+	ID          string
+	WorkspaceID string
+	UserID      string
+	Role        string
+	AddedBy     string
+	CreatedAt   string
+	UpdatedAt   string
+}{
+	ID:          "workspace_members.id",
+	WorkspaceID: "workspace_members.workspace_id",
+	UserID:      "workspace_members.user_id",
+	Role:        "workspace_members.role",
+	AddedBy:     "workspace_members.added_by",
+	CreatedAt:   "workspace_members.created_at",
+	UpdatedAt:   "workspace_members.updated_at",
+}
+
+// Generated where
+
+var WorkspaceMemberWhere = struct {
+	ID          whereHelperstring
+	WorkspaceID whereHelperstring
+	UserID      whereHelperstring
+	Role        whereHelperstring
+	AddedBy     whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+	UpdatedAt   whereHelpertime_Time
+}{
+	ID:          whereHelperstring{field: "\"workspace_members\".\"id\""},
+	WorkspaceID: whereHelperstring{field: "\"workspace_members\".\"workspace_id\""},
+	UserID:      whereHelperstring{field: "\"workspace_members\".\"user_id\""},
+	Role:        whereHelperstring{field: "\"workspace_members\".\"role\""},
+	AddedBy:     whereHelpernull_String{field: "\"workspace_members\".\"added_by\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"workspace_members\".\"created_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"workspace_members\".\"updated_at\""},
+}
+
+// WorkspaceMemberRels is where relationship names are stored.
+var WorkspaceMemberRels = struct {
+	AddedByUser string
+	User        string
+	Workspace   string
+}{
+	AddedByUser: "AddedByUser",
+	User:        "User",
+	Workspace:   "Workspace",
+}
+
+// workspaceMemberR is where relationships are stored.
+type workspaceMemberR struct {
+	AddedByUser *User      `boil:"AddedByUser" json:"AddedByUser" toml:"AddedByUser" yaml:"AddedByUser"`
+	User        *User      `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Workspace   *Workspace `boil:"Workspace" json:"Workspace" toml:"Workspace" yaml:"Workspace"`
+}
+
+// NewStruct creates a new relationship struct
+func (*workspaceMemberR) NewStruct() *workspaceMemberR {
+	return &workspaceMemberR{}
+}
+
+func (o *WorkspaceMember) GetAddedByUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAddedByUser()
+}
+
+func (r *workspaceMemberR) GetAddedByUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.AddedByUser
+}
+
+func (o *WorkspaceMember) GetUser() *User {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUser()
+}
+
+func (r *workspaceMemberR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+
+	return r.User
+}
+
+func (o *WorkspaceMember) GetWorkspace() *Workspace {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWorkspace()
+}
+
+func (r *workspaceMemberR) GetWorkspace() *Workspace {
+	if r == nil {
+		return nil
+	}
+
+	return r.Workspace
+}
+
+// workspaceMemberL is where Load methods for each relationship are stored.
+type workspaceMemberL struct{}
+
+var (
+	workspaceMemberAllColumns            = []string{"id", "workspace_id", "user_id", "role", "added_by", "created_at", "updated_at"}
+	workspaceMemberColumnsWithoutDefault = []string{"workspace_id", "user_id", "role"}
+	workspaceMemberColumnsWithDefault    = []string{"id", "added_by", "created_at", "updated_at"}
+	workspaceMemberPrimaryKeyColumns     = []string{"id"}
+	workspaceMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// WorkspaceMemberSlice is an alias for a slice of pointers to WorkspaceMember.
+	// This should almost always be used instead of []WorkspaceMember.
+	WorkspaceMemberSlice []*WorkspaceMember
+	// WorkspaceMemberHook is the signature for custom WorkspaceMember hook methods
+	WorkspaceMemberHook func(context.Context, boil.ContextExecutor, *WorkspaceMember) error
+
+	workspaceMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	workspaceMemberType                 = reflect.TypeOf(&WorkspaceMember{})
+	workspaceMemberMapping              = queries.MakeStructMapping(workspaceMemberType)
+	workspaceMemberPrimaryKeyMapping, _ = queries.BindMapping(workspaceMemberType, workspaceMemberMapping, workspaceMemberPrimaryKeyColumns)
+	workspaceMemberInsertCacheMut       sync.RWMutex
+	workspaceMemberInsertCache          = make(map[string]insertCache)
+	workspaceMemberUpdateCacheMut       sync.RWMutex
+	workspaceMemberUpdateCache          = make(map[string]updateCache)
+	workspaceMemberUpsertCacheMut       sync.RWMutex
+	workspaceMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var workspaceMemberAfterSelectMu sync.Mutex
+var workspaceMemberAfterSelectHooks []WorkspaceMemberHook
+
+var workspaceMemberBeforeInsertMu sync.Mutex
+var workspaceMemberBeforeInsertHooks []WorkspaceMemberHook
+var workspaceMemberAfterInsertMu sync.Mutex
+var workspaceMemberAfterInsertHooks []WorkspaceMemberHook
+
+var workspaceMemberBeforeUpdateMu sync.Mutex
+var workspaceMemberBeforeUpdateHooks []WorkspaceMemberHook
+var workspaceMemberAfterUpdateMu sync.Mutex
+var workspaceMemberAfterUpdateHooks []WorkspaceMemberHook
+
+var workspaceMemberBeforeDeleteMu sync.Mutex
+var workspaceMemberBeforeDeleteHooks []WorkspaceMemberHook
+var workspaceMemberAfterDeleteMu sync.Mutex
+var workspaceMemberAfterDeleteHooks []WorkspaceMemberHook
+
+var workspaceMemberBeforeUpsertMu sync.Mutex
+var workspaceMemberBeforeUpsertHooks []WorkspaceMemberHook
+var workspaceMemberAfterUpsertMu sync.Mutex
+var workspaceMemberAfterUpsertHooks []WorkspaceMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WorkspaceMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WorkspaceMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WorkspaceMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WorkspaceMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WorkspaceMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WorkspaceMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WorkspaceMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WorkspaceMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WorkspaceMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range workspaceMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWorkspaceMemberHook registers your hook function for all future operations.
+func AddWorkspaceMemberHook(hookPoint boil.HookPoint, workspaceMemberHook WorkspaceMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		workspaceMemberAfterSelectMu.Lock()
+		workspaceMemberAfterSelectHooks = append(workspaceMemberAfterSelectHooks, workspaceMemberHook)
+		workspaceMemberAfterSelectMu.Unlock()
+	case boil.BeforeInsertHook:
+		workspaceMemberBeforeInsertMu.Lock()
+		workspaceMemberBeforeInsertHooks = append(workspaceMemberBeforeInsertHooks, workspaceMemberHook)
+		workspaceMemberBeforeInsertMu.Unlock()
+	case boil.AfterInsertHook:
+		workspaceMemberAfterInsertMu.Lock()
+		workspaceMemberAfterInsertHooks = append(workspaceMemberAfterInsertHooks, workspaceMemberHook)
+		workspaceMemberAfterInsertMu.Unlock()
+	case boil.BeforeUpdateHook:
+		workspaceMemberBeforeUpdateMu.Lock()
+		workspaceMemberBeforeUpdateHooks = append(workspaceMemberBeforeUpdateHooks, workspaceMemberHook)
+		workspaceMemberBeforeUpdateMu.Unlock()
+	case boil.AfterUpdateHook:
+		workspaceMemberAfterUpdateMu.Lock()
+		workspaceMemberAfterUpdateHooks = append(workspaceMemberAfterUpdateHooks, workspaceMemberHook)
+		workspaceMemberAfterUpdateMu.Unlock()
+	case boil.BeforeDeleteHook:
+		workspaceMemberBeforeDeleteMu.Lock()
+		workspaceMemberBeforeDeleteHooks = append(workspaceMemberBeforeDeleteHooks, workspaceMemberHook)
+		workspaceMemberBeforeDeleteMu.Unlock()
+	case boil.AfterDeleteHook:
+		workspaceMemberAfterDeleteMu.Lock()
+		workspaceMemberAfterDeleteHooks = append(workspaceMemberAfterDeleteHooks, workspaceMemberHook)
+		workspaceMemberAfterDeleteMu.Unlock()
+	case boil.BeforeUpsertHook:
+		workspaceMemberBeforeUpsertMu.Lock()
+		workspaceMemberBeforeUpsertHooks = append(workspaceMemberBeforeUpsertHooks, workspaceMemberHook)
+		workspaceMemberBeforeUpsertMu.Unlock()
+	case boil.AfterUpsertHook:
+		workspaceMemberAfterUpsertMu.Lock()
+		workspaceMemberAfterUpsertHooks = append(workspaceMemberAfterUpsertHooks, workspaceMemberHook)
+		workspaceMemberAfterUpsertMu.Unlock()
+	}
+}
+
+// One returns a single workspaceMember record from the query.
+func (q workspaceMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WorkspaceMember, error) {
+	o := &WorkspaceMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for workspace_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WorkspaceMember records from the query.
+func (q workspaceMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (WorkspaceMemberSlice, error) {
+	var o []*WorkspaceMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to WorkspaceMember slice")
+	}
+
+	if len(workspaceMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WorkspaceMember records in the query.
+func (q workspaceMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count workspace_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q workspaceMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if workspace_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// AddedByUser pointed to by the foreign key.
+func (o *WorkspaceMember) AddedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AddedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *WorkspaceMember) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Workspace pointed to by the foreign key.
+func (o *WorkspaceMember) Workspace(mods ...qm.QueryMod) workspaceQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WorkspaceID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Workspaces(queryMods...)
+}
+
+// LoadAddedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (workspaceMemberL) LoadAddedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkspaceMember interface{}, mods queries.Applicator) error {
+	var slice []*WorkspaceMember
+	var object *WorkspaceMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkspaceMember.(*WorkspaceMember)
+		if !ok {
+			object = new(WorkspaceMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkspaceMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkspaceMember))
+			}
+		}
+	} else {
+		s, ok := maybeWorkspaceMember.(*[]*WorkspaceMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkspaceMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkspaceMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workspaceMemberR{}
+		}
+		if !queries.IsNil(object.AddedBy) {
+			args[object.AddedBy] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workspaceMemberR{}
+			}
+
+			if !queries.IsNil(obj.AddedBy) {
+				args[obj.AddedBy] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AddedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AddedByWorkspaceMembers = append(foreign.R.AddedByWorkspaceMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.AddedBy, foreign.ID) {
+				local.R.AddedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AddedByWorkspaceMembers = append(foreign.R.AddedByWorkspaceMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (workspaceMemberL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkspaceMember interface{}, mods queries.Applicator) error {
+	var slice []*WorkspaceMember
+	var object *WorkspaceMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkspaceMember.(*WorkspaceMember)
+		if !ok {
+			object = new(WorkspaceMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkspaceMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkspaceMember))
+			}
+		}
+	} else {
+		s, ok := maybeWorkspaceMember.(*[]*WorkspaceMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkspaceMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkspaceMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workspaceMemberR{}
+		}
+		args[object.UserID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workspaceMemberR{}
+			}
+
+			args[obj.UserID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`users.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.WorkspaceMembers = append(foreign.R.WorkspaceMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.WorkspaceMembers = append(foreign.R.WorkspaceMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWorkspace allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (workspaceMemberL) LoadWorkspace(ctx context.Context, e boil.ContextExecutor, singular bool, maybeWorkspaceMember interface{}, mods queries.Applicator) error {
+	var slice []*WorkspaceMember
+	var object *WorkspaceMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeWorkspaceMember.(*WorkspaceMember)
+		if !ok {
+			object = new(WorkspaceMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeWorkspaceMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeWorkspaceMember))
+			}
+		}
+	} else {
+		s, ok := maybeWorkspaceMember.(*[]*WorkspaceMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeWorkspaceMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeWorkspaceMember))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &workspaceMemberR{}
+		}
+		args[object.WorkspaceID] = struct{}{}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &workspaceMemberR{}
+			}
+
+			args[obj.WorkspaceID] = struct{}{}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`workspaces`),
+		qm.WhereIn(`workspaces.id in ?`, argsSlice...),
+		qmhelper.WhereIsNull(`workspaces.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Workspace")
+	}
+
+	var resultSlice []*Workspace
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Workspace")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for workspaces")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for workspaces")
+	}
+
+	if len(workspaceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Workspace = foreign
+		if foreign.R == nil {
+			foreign.R = &workspaceR{}
+		}
+		foreign.R.WorkspaceMembers = append(foreign.R.WorkspaceMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WorkspaceID == foreign.ID {
+				local.R.Workspace = foreign
+				if foreign.R == nil {
+					foreign.R = &workspaceR{}
+				}
+				foreign.R.WorkspaceMembers = append(foreign.R.WorkspaceMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetAddedByUser of the workspaceMember to the related item.
+// Sets o.R.AddedByUser to related.
+// Adds o to related.R.AddedByWorkspaceMembers.
+func (o *WorkspaceMember) SetAddedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"workspace_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+		strmangle.WhereClause("\"", "\"", 2, workspaceMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.AddedBy, related.ID)
+	if o.R == nil {
+		o.R = &workspaceMemberR{
+			AddedByUser: related,
+		}
+	} else {
+		o.R.AddedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AddedByWorkspaceMembers: WorkspaceMemberSlice{o},
+		}
+	} else {
+		related.R.AddedByWorkspaceMembers = append(related.R.AddedByWorkspaceMembers, o)
+	}
+
+	return nil
+}
+
+// RemoveAddedByUser relationship.
+// Sets o.R.AddedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *WorkspaceMember) RemoveAddedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.AddedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("added_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.AddedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.AddedByWorkspaceMembers {
+		if queries.Equal(o.AddedBy, ri.AddedBy) {
+			continue
+		}
+
+		ln := len(related.R.AddedByWorkspaceMembers)
+		if ln > 1 && i < ln-1 {
+			related.R.AddedByWorkspaceMembers[i] = related.R.AddedByWorkspaceMembers[ln-1]
+		}
+		related.R.AddedByWorkspaceMembers = related.R.AddedByWorkspaceMembers[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetUser of the workspaceMember to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.WorkspaceMembers.
+func (o *WorkspaceMember) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"workspace_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, workspaceMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &workspaceMemberR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			WorkspaceMembers: WorkspaceMemberSlice{o},
+		}
+	} else {
+		related.R.WorkspaceMembers = append(related.R.WorkspaceMembers, o)
+	}
+
+	return nil
+}
+
+// SetWorkspace of the workspaceMember to the related item.
+// Sets o.R.Workspace to related.
+// Adds o to related.R.WorkspaceMembers.
+func (o *WorkspaceMember) SetWorkspace(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Workspace) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"workspace_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"workspace_id"}),
+		strmangle.WhereClause("\"", "\"", 2, workspaceMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WorkspaceID = related.ID
+	if o.R == nil {
+		o.R = &workspaceMemberR{
+			Workspace: related,
+		}
+	} else {
+		o.R.Workspace = related
+	}
+
+	if related.R == nil {
+		related.R = &workspaceR{
+			WorkspaceMembers: WorkspaceMemberSlice{o},
+		}
+	} else {
+		related.R.WorkspaceMembers = append(related.R.WorkspaceMembers, o)
+	}
+
+	return nil
+}
+
+// WorkspaceMembers retrieves all the records using an executor.
+func WorkspaceMembers(mods ...qm.QueryMod) workspaceMemberQuery {
+	mods = append(mods, qm.From("\"workspace_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"workspace_members\".*"})
+	}
+
+	return workspaceMemberQuery{q}
+}
+
+// FindWorkspaceMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWorkspaceMember(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*WorkspaceMember, error) {
+	workspaceMemberObj := &WorkspaceMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"workspace_members\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, workspaceMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from workspace_members")
+	}
+
+	if err = workspaceMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return workspaceMemberObj, err
+	}
+
+	return workspaceMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WorkspaceMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no workspace_members provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(workspaceMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	workspaceMemberInsertCacheMut.RLock()
+	cache, cached := workspaceMemberInsertCache[key]
+	workspaceMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			workspaceMemberAllColumns,
+			workspaceMemberColumnsWithDefault,
+			workspaceMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(workspaceMemberType, workspaceMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(workspaceMemberType, workspaceMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"workspace_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"workspace_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into workspace_members")
+	}
+
+	if !cached {
+		workspaceMemberInsertCacheMut.Lock()
+		workspaceMemberInsertCache[key] = cache
+		workspaceMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WorkspaceMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WorkspaceMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	workspaceMemberUpdateCacheMut.RLock()
+	cache, cached := workspaceMemberUpdateCache[key]
+	workspaceMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			workspaceMemberAllColumns,
+			workspaceMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update workspace_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"workspace_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, workspaceMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(workspaceMemberType, workspaceMemberMapping, append(wl, workspaceMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update workspace_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for workspace_members")
+	}
+
+	if !cached {
+		workspaceMemberUpdateCacheMut.Lock()
+		workspaceMemberUpdateCache[key] = cache
+		workspaceMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q workspaceMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for workspace_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for workspace_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WorkspaceMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), workspaceMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"workspace_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, workspaceMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in workspaceMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all workspaceMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WorkspaceMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns, opts ...UpsertOptionFunc) error {
+	if o == nil {
+		return errors.New("dbmodels: no workspace_members provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(workspaceMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	workspaceMemberUpsertCacheMut.RLock()
+	cache, cached := workspaceMemberUpsertCache[key]
+	workspaceMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, _ := insertColumns.InsertColumnSet(
+			workspaceMemberAllColumns,
+			workspaceMemberColumnsWithDefault,
+			workspaceMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			workspaceMemberAllColumns,
+			workspaceMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert workspace_members, could not build update column list")
+		}
+
+		ret := strmangle.SetComplement(workspaceMemberAllColumns, strmangle.SetIntersect(insert, update))
+
+		conflict := conflictColumns
+		if len(conflict) == 0 && updateOnConflict && len(update) != 0 {
+			if len(workspaceMemberPrimaryKeyColumns) == 0 {
+				return errors.New("dbmodels: unable to upsert workspace_members, could not build conflict column list")
+			}
+
+			conflict = make([]string, len(workspaceMemberPrimaryKeyColumns))
+			copy(conflict, workspaceMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"workspace_members\"", updateOnConflict, ret, update, conflict, insert, opts...)
+
+		cache.valueMapping, err = queries.BindMapping(workspaceMemberType, workspaceMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(workspaceMemberType, workspaceMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert workspace_members")
+	}
+
+	if !cached {
+		workspaceMemberUpsertCacheMut.Lock()
+		workspaceMemberUpsertCache[key] = cache
+		workspaceMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WorkspaceMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WorkspaceMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no WorkspaceMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), workspaceMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"workspace_members\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from workspace_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for workspace_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q workspaceMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no workspaceMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from workspace_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for workspace_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WorkspaceMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(workspaceMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), workspaceMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"workspace_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, workspaceMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from workspaceMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for workspace_members")
+	}
+
+	if len(workspaceMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WorkspaceMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWorkspaceMember(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WorkspaceMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WorkspaceMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), workspaceMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"workspace_members\".* FROM \"workspace_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, workspaceMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in WorkspaceMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WorkspaceMemberExists checks if the WorkspaceMember row exists.
+func WorkspaceMemberExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"workspace_members\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if workspace_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the WorkspaceMember row exists.
+func (o *WorkspaceMember) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return WorkspaceMemberExists(ctx, exec, o.ID)
+}
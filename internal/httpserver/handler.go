@@ -6,13 +6,30 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
 	"github.com/nguyentantai21042004/kanban-api/pkg/discord"
 	"github.com/nguyentantai21042004/kanban-api/pkg/i18n"
+	"github.com/nguyentantai21042004/kanban-api/pkg/oidc"
 	"github.com/nguyentantai21042004/kanban-api/pkg/position"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
 
 	boardHTTP "github.com/nguyentantai21042004/kanban-api/internal/boards/delivery/http"
 	boardRepository "github.com/nguyentantai21042004/kanban-api/internal/boards/repository/postgres"
 	boardUC "github.com/nguyentantai21042004/kanban-api/internal/boards/usecase"
 
+	memberHTTP "github.com/nguyentantai21042004/kanban-api/internal/members/delivery/http"
+	memberRepository "github.com/nguyentantai21042004/kanban-api/internal/members/repository/postgres"
+	memberUC "github.com/nguyentantai21042004/kanban-api/internal/members/usecase"
+
+	teamHTTP "github.com/nguyentantai21042004/kanban-api/internal/teams/delivery/http"
+	teamRepository "github.com/nguyentantai21042004/kanban-api/internal/teams/repository/postgres"
+	teamUC "github.com/nguyentantai21042004/kanban-api/internal/teams/usecase"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	templateHTTP "github.com/nguyentantai21042004/kanban-api/internal/templates/delivery/http"
+	templateRepository "github.com/nguyentantai21042004/kanban-api/internal/templates/repository/postgres"
+	templateUC "github.com/nguyentantai21042004/kanban-api/internal/templates/usecase"
+	workspaceHTTP "github.com/nguyentantai21042004/kanban-api/internal/workspaces/delivery/http"
+	workspaceRepository "github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository/postgres"
+	workspaceUC "github.com/nguyentantai21042004/kanban-api/internal/workspaces/usecase"
+
 	listHTTP "github.com/nguyentantai21042004/kanban-api/internal/lists/delivery/http"
 	listRepository "github.com/nguyentantai21042004/kanban-api/internal/lists/repository/postgres"
 	listUC "github.com/nguyentantai21042004/kanban-api/internal/lists/usecase"
@@ -53,7 +70,10 @@ import (
 	userRepository "github.com/nguyentantai21042004/kanban-api/internal/user/repository/postgres"
 	userUC "github.com/nguyentantai21042004/kanban-api/internal/user/usecase"
 
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
 	authHTTP "github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/http"
+	authProducer "github.com/nguyentantai21042004/kanban-api/internal/auth/delivery/rabbitmq/producer"
+	authRepository "github.com/nguyentantai21042004/kanban-api/internal/auth/repository/postgres"
 	authUC "github.com/nguyentantai21042004/kanban-api/internal/auth/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -89,15 +109,11 @@ func (srv HTTPServer) mapHandlers() error {
 
 	i18n.Init()
 
-	// Middleware
-	mw := middleware.New(srv.l, scopeUC)
-
 	// Initialize WebSocket
 	if err := wsService.InitWebSocketHub(srv.l); err != nil {
 		srv.l.Error(context.Background(), "Failed to initialize WebSocket hub", "error", err)
 		return err
 	}
-	wsH := wsHTTP.New(wsService.GetHub(), scopeUC, srv.l)
 
 	roleRepo := roleRepository.New(srv.l, srv.postgresDB)
 	roleUC := roleUC.New(srv.l, roleRepo)
@@ -111,31 +127,87 @@ func (srv HTTPServer) mapHandlers() error {
 	userUC := userUC.New(srv.l, userRepo)
 	userH := userHTTP.New(srv.l, userUC, discord)
 
-	authUC := authUC.New(srv.l, srv.encrypter, scopeUC, userUC, roleUC)
+	authProd := authProducer.New(srv.l, srv.amqpConn)
+	if err := authProd.Run(); err != nil {
+		srv.l.Error(context.Background(), "Failed to run auth producer", "error", err)
+		return err
+	}
+
+	// Sliding window rate limiter, shared through Redis when it is configured
+	limiter := ratelimit.New(srv.redisClient)
+
+	// Single sign-on is only offered when an OpenID Connect issuer is configured
+	var oidcProvider oidc.Provider
+	if srv.oidc.IssuerURL != "" {
+		oidcProvider = oidc.New(oidc.Config{
+			IssuerURL:    srv.oidc.IssuerURL,
+			ClientID:     srv.oidc.ClientID,
+			ClientSecret: srv.oidc.ClientSecret,
+			RedirectURL:  srv.oidc.RedirectURL,
+			Scopes:       srv.oidc.Scopes,
+		})
+	}
+
+	authRepo := authRepository.New(srv.l, srv.postgresDB)
+	authUC := authUC.New(srv.l, srv.encrypter, scopeUC, authRepo, userUC, roleUC, authProd, limiter, oidcProvider, auth.TokenConfig{
+		AccessTokenTTL:  srv.accessTokenTTL,
+		RefreshTokenTTL: srv.refreshTokenTTL,
+	}, auth.LoginProtectionConfig{
+		MaxAttempts:      srv.loginProtection.MaxAttempts,
+		MaxAttemptsPerIP: srv.loginProtection.MaxAttemptsPerIP,
+		AttemptWindow:    srv.loginProtection.AttemptWindow,
+		MaxFailures:      srv.loginProtection.MaxFailures,
+		LockoutDuration:  srv.loginProtection.LockoutDuration,
+	})
 	authH := authHTTP.New(srv.l, authUC, discord)
 
+	// Middleware
+	mw := middleware.New(srv.l, scopeUC, authUC, roleUC, limiter)
+
+	// Board membership, every board scoped use case authorizes through it
+	memberRepo := memberRepository.New(srv.l, srv.postgresDB)
+	memberUC := memberUC.New(srv.l, memberRepo, wsService.GetHub(), userUC, roleUC)
+	memberH := memberHTTP.New(srv.l, memberUC, discord)
+
+	workspaceRepo := workspaceRepository.New(srv.l, srv.postgresDB)
+	workspaceUC := workspaceUC.New(srv.l, workspaceRepo, userUC, roleUC)
+	workspaceH := workspaceHTTP.New(srv.l, workspaceUC, discord)
+
+	teamRepo := teamRepository.New(srv.l, srv.postgresDB)
+	teamUC := teamUC.New(srv.l, teamRepo, userUC, roleUC, memberUC)
+	teamH := teamHTTP.New(srv.l, teamUC, discord)
+
 	// Fractical Indexing Algorithm
 	positionUC := position.NewPositionManager()
 
 	boardRepo := boardRepository.New(srv.l, srv.postgresDB)
-	boardUC := boardUC.New(srv.l, boardRepo, wsService.GetHub(), userUC, roleUC, nil)
+	boardUC := boardUC.New(srv.l, boardRepo, wsService.GetHub(), userUC, memberUC, workspaceUC, roleUC, uploadUC, positionUC)
 	boardH := boardHTTP.New(srv.l, boardUC, discord)
 
+	wsH := wsHTTP.New(wsService.GetHub(), scopeUC, authUC, memberUC, boardUC, srv.l)
+
+	// Boards start with the lists of a template, the default one is configured
+	templateRepo := templateRepository.New(srv.l, srv.postgresDB)
+	templateUC := templateUC.New(srv.l, templateRepo, boardUC, userUC, workspaceUC, templates.Config{
+		DefaultKey: srv.boardConfig.DefaultTemplate,
+	})
+	templateH := templateHTTP.New(srv.l, templateUC, discord)
+	boardUC.SetTemplate(templateUC)
+
 	listRepo := listRepository.New(srv.l, srv.postgresDB)
-	listUC := listUC.New(srv.l, listRepo, wsService.GetHub(), positionUC, boardUC, userUC, roleUC)
+	listUC := listUC.New(srv.l, listRepo, wsService.GetHub(), positionUC, boardUC, memberUC)
 	listH := listHTTP.New(srv.l, listUC, discord)
-	boardUC.SetList(listUC)
 
 	labelRepo := labelRepository.New(srv.l, srv.postgresDB)
-	labelUC := labelUC.New(srv.l, labelRepo)
+	labelUC := labelUC.New(srv.l, labelRepo, memberUC)
 	labelH := labelHTTP.New(srv.l, labelUC, discord)
 
 	cardRepo := cardRepository.New(srv.l, srv.postgresDB)
-	cardUC := cardUC.New(srv.l, cardRepo, wsService.GetHub(), positionUC, boardUC, listUC, userUC, roleUC)
+	cardUC := cardUC.New(srv.l, cardRepo, wsService.GetHub(), positionUC, boardUC, listUC, labelUC, userUC, memberUC, teamUC)
 	cardH := cardHTTP.New(srv.l, cardUC, discord)
 
 	commentRepo := commentRepository.New(srv.l, srv.postgresDB)
-	commentUC := commentUC.New(srv.l, commentRepo, userUC, cardUC, wsService.GetHub())
+	commentUC := commentUC.New(srv.l, commentRepo, userUC, cardUC, memberUC, wsService.GetHub())
 	commentH := commentHTTP.New(srv.l, commentUC, discord)
 
 	// Apply locale + metrics middleware
@@ -145,7 +217,15 @@ func (srv HTTPServer) mapHandlers() error {
 
 	// routes
 	api := srv.gin.Group(Api)
+	workspaceHTTP.MapWorkspaceRoutes(api.Group("/workspaces"), workspaceH, mw)
+	teamHTTP.MapTeamRoutes(api.Group("/teams"), teamH, mw)
+	templateHTTP.MapTemplateRoutes(api.Group("/templates"), templateH, mw)
 	boardHTTP.MapBoardRoutes(api.Group("/boards"), boardH, mw)
+	boardHTTP.MapSharedBoardRoutes(api.Group("/shared/boards"), boardH, mw)
+	memberHTTP.MapBoardMemberRoutes(api.Group("/boards/:id/members"), memberH, mw)
+	memberHTTP.MapBoardInvitationRoutes(api.Group("/boards/:id/invitations"), memberH, mw)
+	teamHTTP.MapBoardTeamRoutes(api.Group("/boards/:id/teams"), teamH, mw)
+	memberHTTP.MapInvitationRoutes(api.Group("/invitations"), memberH, mw)
 	listHTTP.MapListRoutes(api.Group("/lists"), listH, mw)
 	labelHTTP.MapLabelRoutes(api.Group("/labels"), labelH, mw)
 	cardHTTP.MapCardRoutes(api.Group("/cards"), cardH, mw)
@@ -160,12 +240,14 @@ func (srv HTTPServer) mapHandlers() error {
 	wsHTTP.MapWebSocketRoutes(websocketGroup, wsH, mw)
 
 	// Admin routes
-	adminUC := adminUC.New(srv.l, userUC, boardUC, cardUC, commentUC, roleUC, wsService.GetHub())
+	adminUC := adminUC.New(srv.l, userUC, boardUC, cardUC, commentUC, roleUC, authUC, workspaceUC, wsService.GetHub())
 	adminH := adminHTTP.New(srv.l, adminUC, discord)
 	adminHTTP.MapAdminRoutes(api.Group("/admin"), adminH, mw)
 
 	authHTTP.MapAuthRoutes(api.Group("/auth"), authH, mw)
 	userHTTP.MapUserRoutes(api.Group("/users"), userH, mw)
+	authHTTP.MapPersonalAccessTokenRoutes(api.Group("/users/me/tokens"), authH, mw)
+	boardHTTP.MapMyBoardRoutes(api.Group("/users/me/boards"), boardH, mw)
 
 	return nil
 }
@@ -3,6 +3,7 @@ package httpserver
 import (
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/config"
@@ -11,6 +12,8 @@ import (
 	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
 	"github.com/nguyentantai21042004/kanban-api/pkg/minio"
 	"github.com/nguyentantai21042004/kanban-api/pkg/mongo"
+	"github.com/nguyentantai21042004/kanban-api/pkg/rabbitmq"
+	"github.com/redis/go-redis/v9"
 )
 
 type HTTPServer struct {
@@ -22,19 +25,30 @@ type HTTPServer struct {
 	mode string
 
 	// Database Configuration
-	postgresDB *sql.DB
+	postgresDB  *sql.DB
+	redisClient *redis.Client
+
+	// Message Queue Configuration
+	amqpConn *rabbitmq.Connection
 
 	// Storage Configuration
 	minioClient minio.MinIO
 
 	// Authentication & Security Configuration
-	jwtSecretKey string
-	encrypter    pkgCrt.Encrypter
-	internalKey  string
+	jwtSecretKey    string
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	encrypter       pkgCrt.Encrypter
+	internalKey     string
+	loginProtection config.LoginProtectionConfig
+	oidc            config.OIDCConfig
 
 	// WebSocket Configuration
 	wsConfig config.WebSocketConfig
 
+	// Board Configuration
+	boardConfig config.BoardConfig
+
 	// Monitoring & Notification Configuration
 	discord *discord.DiscordWebhook
 }
@@ -47,20 +61,31 @@ type Config struct {
 	Mode   string
 
 	// Database Configuration
-	PostgresDB *sql.DB
-	MongoDB    mongo.Client
+	PostgresDB  *sql.DB
+	MongoDB     mongo.Client
+	RedisClient *redis.Client
+
+	// Message Queue Configuration
+	AMQPConn *rabbitmq.Connection
 
 	// Storage Configuration
 	MinIOClient minio.MinIO
 
 	// Authentication & Security Configuration
-	JwtSecretKey string
-	Encrypter    pkgCrt.Encrypter
-	InternalKey  string
+	JwtSecretKey    string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	Encrypter       pkgCrt.Encrypter
+	InternalKey     string
+	LoginProtection config.LoginProtectionConfig
+	OIDC            config.OIDCConfig
 
 	// WebSocket Configuration
 	WebSocketConfig config.WebSocketConfig
 
+	// Board Configuration
+	BoardConfig config.BoardConfig
+
 	// Monitoring & Notification Configuration
 	DiscordConfig *discord.DiscordWebhook
 }
@@ -81,19 +106,30 @@ func New(l pkgLog.Logger, cfg Config) (*HTTPServer, error) {
 		mode: cfg.Mode,
 
 		// Database Configuration
-		postgresDB: cfg.PostgresDB,
+		postgresDB:  cfg.PostgresDB,
+		redisClient: cfg.RedisClient,
+
+		// Message Queue Configuration
+		amqpConn: cfg.AMQPConn,
 
 		// Storage Configuration
 		minioClient: cfg.MinIOClient,
 
 		// Authentication & Security Configuration
-		jwtSecretKey: cfg.JwtSecretKey,
-		encrypter:    cfg.Encrypter,
-		internalKey:  cfg.InternalKey,
+		jwtSecretKey:    cfg.JwtSecretKey,
+		accessTokenTTL:  cfg.AccessTokenTTL,
+		refreshTokenTTL: cfg.RefreshTokenTTL,
+		encrypter:       cfg.Encrypter,
+		internalKey:     cfg.InternalKey,
+		loginProtection: cfg.LoginProtection,
+		oidc:            cfg.OIDC,
 
 		// WebSocket Configuration
 		wsConfig: cfg.WebSocketConfig,
 
+		// Board Configuration
+		boardConfig: cfg.BoardConfig,
+
 		// Monitoring & Notification Configuration
 		discord: cfg.DiscordConfig,
 	}
@@ -119,6 +155,9 @@ func (s HTTPServer) validate() error {
 		// Database Configuration
 		{s.postgresDB, "postgresDB is required"},
 
+		// Message Queue Configuration
+		{s.amqpConn, "amqpConn is required"},
+
 		// Storage Configuration
 		// {s.minioClient, "minioClient is required"},
 
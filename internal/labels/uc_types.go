@@ -9,6 +9,9 @@ type Filter struct {
 	IDs     []string
 	BoardID string
 	Keyword string
+	// MemberID keeps the rows of the boards the user is a member of that are
+	// not in the trash
+	MemberID string
 }
 
 type GetInput struct {
@@ -5,10 +5,18 @@ import (
 
 	"github.com/nguyentantai21042004/kanban-api/internal/labels"
 	"github.com/nguyentantai21042004/kanban-api/internal/labels/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 )
 
 func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip labels.GetInput) (labels.GetOutput, error) {
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.labels.usecase.Get.memberUC.MemberFilter: %v", err)
+		return labels.GetOutput{}, err
+	}
+	ip.Filter.MemberID = memberID
+
 	u, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
 		Filter:   ip.Filter,
 		PagQuery: ip.PagQuery,
@@ -25,6 +33,11 @@ func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip labels.GetInp
 }
 
 func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip labels.CreateInput) (labels.DetailOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.labels.usecase.Create.memberUC.Authorize: %v", err)
+		return labels.DetailOutput{}, err
+	}
+
 	b, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
 		BoardID: ip.BoardID,
 		Name:    ip.Name,
@@ -52,6 +65,11 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip labels.Upd
 		return labels.DetailOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: oldModel.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.labels.usecase.Update.memberUC.Authorize: %v", err)
+		return labels.DetailOutput{}, err
+	}
+
 	b, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
 		ID:       ip.ID,
 		Name:     ip.Name,
@@ -78,6 +96,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (l
 		uc.l.Errorf(ctx, "internal.labels.usecase.Detail.repo.Detail: %v", err)
 		return labels.DetailOutput{}, err
 	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: b.BoardID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.labels.usecase.Detail.memberUC.Authorize: %v", err)
+		return labels.DetailOutput{}, err
+	}
 	return labels.DetailOutput{
 		Label: b,
 	}, nil
@@ -89,6 +112,28 @@ func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ids []string)
 		return labels.ErrFieldRequired
 	}
 
+	authorized := make(map[string]bool)
+	for _, id := range ids {
+		lb, err := uc.repo.Detail(ctx, sc, id)
+		if err != nil {
+			if err == repository.ErrNotFound {
+				uc.l.Warnf(ctx, "internal.labels.usecase.Delete.repo.Detail.NotFound: %v", err)
+				return labels.ErrLabelNotFound
+			}
+			uc.l.Errorf(ctx, "internal.labels.usecase.Delete.repo.Detail: %v", err)
+			return err
+		}
+		if authorized[lb.BoardID] {
+			continue
+		}
+
+		if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: lb.BoardID, Role: models.BoardRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.labels.usecase.Delete.memberUC.Authorize: %v", err)
+			return err
+		}
+		authorized[lb.BoardID] = true
+	}
+
 	err := uc.repo.Delete(ctx, sc, ids)
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.labels.usecase.Delete.repo.Delete: %v", err)
@@ -5,22 +5,25 @@ import (
 
 	"github.com/nguyentantai21042004/kanban-api/internal/labels"
 	"github.com/nguyentantai21042004/kanban-api/internal/labels/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
 type implUsecase struct {
-	l     log.Logger
-	repo  repository.Repository
-	clock func() time.Time
+	l        log.Logger
+	repo     repository.Repository
+	memberUC members.UseCase
+	clock    func() time.Time
 }
 
 var _ labels.UseCase = &implUsecase{}
 
-func New(l log.Logger, repo repository.Repository) labels.UseCase {
+func New(l log.Logger, repo repository.Repository, memberUC members.UseCase) labels.UseCase {
 	return &implUsecase{
-		l:     l,
-		repo:  repo,
-		clock: util.Now,
+		l:        l,
+		repo:     repo,
+		memberUC: memberUC,
+		clock:    util.Now,
 	}
 }
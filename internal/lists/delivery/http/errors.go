@@ -1,7 +1,10 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/lists"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 )
 
@@ -10,6 +13,8 @@ var (
 	errWrongBody     = pkgErrors.NewHTTPError(10102, "Wrong body")
 	errNotFound      = pkgErrors.NewHTTPError(10103, "List not found")
 	errFieldRequired = pkgErrors.NewHTTPError(10104, "Field required")
+	errForbidden     = &pkgErrors.HTTPError{Code: 10105, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errBoardArchived = &pkgErrors.HTTPError{Code: 10106, Message: "This board is archived and read-only", StatusCode: http.StatusForbidden}
 )
 
 func (h handler) mapErrorCode(err error) error {
@@ -18,6 +23,10 @@ func (h handler) mapErrorCode(err error) error {
 		return errNotFound
 	case lists.ErrFieldRequired:
 		return errFieldRequired
+	case members.ErrForbidden:
+		return errForbidden
+	case members.ErrBoardArchived:
+		return errBoardArchived
 	default:
 		return err
 	}
@@ -25,4 +34,6 @@ func (h handler) mapErrorCode(err error) error {
 
 var NotFound = []error{
 	errNotFound,
+	errForbidden,
+	errBoardArchived,
 }
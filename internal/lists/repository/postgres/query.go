@@ -55,6 +55,15 @@ func (r implRepository) buildGetQuery(ctx context.Context, fils lists.Filter) ([
 		qr = append(qr, qm.Where("created_by = ?", fils.CreatedBy))
 	}
 
+	if fils.MemberID != "" {
+		if err := postgres.IsUUID(fils.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.lists.repository.postgres.buildGetQuery.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		// The lists of a board in the trash stay hidden until it is restored
+		qr = append(qr, qm.Where("board_id IN (SELECT ba.board_id FROM board_access ba JOIN boards b ON b.id = ba.board_id WHERE ba.user_id = ? AND b.deleted_at IS NULL)", fils.MemberID))
+	}
+
 	return qr, nil
 }
 
@@ -10,6 +10,9 @@ type Filter struct {
 	BoardID   string
 	Keyword   string
 	CreatedBy string
+	// MemberID keeps the rows of the boards the user is a member of that are
+	// not in the trash
+	MemberID string
 }
 
 type GetInput struct {
@@ -6,6 +6,7 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/lists"
 	"github.com/nguyentantai21042004/kanban-api/internal/lists/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
 	"github.com/nguyentantai21042004/kanban-api/pkg/util"
@@ -17,9 +18,16 @@ func (uc implUsecase) broadcastListEvent(ctx context.Context, boardID, eventType
 		return nil
 	}
 
-	err := uc.wsHub.BroadcastToBoard(ctx, boardID, eventType, data, userID)
+	// The version is read once the change is saved, so it is never lower than
+	// the version the change produced. The event is still sent without it
+	v, err := uc.boardUC.Version(ctx, boardID)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.lists.usecase.broadcastListEvent.wsHub.BroadcastToBoard: %v", err)
+		uc.l.Warnf(ctx, "internal.lists.usecase.broadcastListEvent.boardUC.Version: %v", err)
+	}
+
+	err = uc.wsHub.BroadcastToBoardWithVersion(ctx, boardID, eventType, data, userID, v)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.lists.usecase.broadcastListEvent.wsHub.BroadcastToBoardWithVersion: %v", err)
 		return err
 	}
 
@@ -27,22 +35,12 @@ func (uc implUsecase) broadcastListEvent(ctx context.Context, boardID, eventType
 }
 
 func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip lists.GetInput) (lists.GetOutput, error) {
-	// Only admin can see all lists
-	me, err := uc.userUC.DetailMe(ctx, sc)
-	if err != nil {
-		uc.l.Errorf(ctx, "internal.lists.usecase.Get.userUC.DetailMe: %v", err)
-		return lists.GetOutput{}, err
-	}
-
-	rl, err := uc.roleUC.Detail(ctx, sc, me.User.RoleID)
+	memberID, err := uc.memberUC.MemberFilter(ctx, sc)
 	if err != nil {
-		uc.l.Errorf(ctx, "internal.lists.usecase.Get.roleUC.Detail: %v", err)
+		uc.l.Errorf(ctx, "internal.lists.usecase.Get.memberUC.MemberFilter: %v", err)
 		return lists.GetOutput{}, err
 	}
-
-	if rl.Code != models.ADMIN_ROLE {
-		ip.Filter.CreatedBy = me.User.ID
-	}
+	ip.Filter.MemberID = memberID
 
 	lsts, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
 		Filter:   ip.Filter,
@@ -60,6 +58,11 @@ func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip lists.GetInpu
 }
 
 func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip lists.CreateInput) (lists.DetailOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.lists.usecase.Create.memberUC.Authorize: %v", err)
+		return lists.DetailOutput{}, err
+	}
+
 	b, err := uc.boardUC.Detail(ctx, sc, ip.BoardID)
 	if err != nil {
 		if err == boards.ErrNotFound {
@@ -122,6 +125,11 @@ func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip lists.Upda
 		return lists.DetailOutput{}, err
 	}
 
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: om.BoardID, Role: models.BoardRoleMember}); err != nil {
+		uc.l.Warnf(ctx, "internal.lists.usecase.Update.memberUC.Authorize: %v", err)
+		return lists.DetailOutput{}, err
+	}
+
 	_, err = uc.repo.Update(ctx, sc, repository.UpdateOptions{
 		ID:       ip.ID,
 		Name:     ip.Name,
@@ -162,6 +170,11 @@ func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (l
 		uc.l.Errorf(ctx, "internal.lists.usecase.Detail.repo.Detail: %v", err)
 		return lists.DetailOutput{}, err
 	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: b.BoardID, Role: models.BoardRoleObserver}); err != nil {
+		uc.l.Warnf(ctx, "internal.lists.usecase.Detail.memberUC.Authorize: %v", err)
+		return lists.DetailOutput{}, err
+	}
 	return lists.DetailOutput{
 		List: b,
 	}, nil
@@ -188,6 +201,11 @@ func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ids []string)
 		return lists.ErrNotFound
 	}
 
+	if err := uc.authorizeBoards(ctx, sc, ls); err != nil {
+		uc.l.Warnf(ctx, "internal.lists.usecase.Delete.authorizeBoards: %v", err)
+		return err
+	}
+
 	err = uc.repo.Delete(ctx, sc, ids)
 	if err != nil {
 		uc.l.Errorf(ctx, "internal.lists.usecase.Delete.repo.Delete: %v", err)
@@ -238,6 +256,11 @@ func (uc implUsecase) Move(ctx context.Context, sc models.Scope, ip lists.MoveIn
 		return repository.ErrNotFound
 	}
 
+	if err := uc.authorizeBoards(ctx, sc, ls); err != nil {
+		uc.l.Warnf(ctx, "internal.lists.usecase.Move.authorizeBoards: %v", err)
+		return err
+	}
+
 	// Determine neighbor positions
 	afterPos := ""
 	beforePos := ""
@@ -278,3 +301,19 @@ func (uc implUsecase) Move(ctx context.Context, sc models.Scope, ip lists.MoveIn
 	}
 	return nil
 }
+
+// authorizeBoards checks that the user can edit the boards of the lists.
+func (uc implUsecase) authorizeBoards(ctx context.Context, sc models.Scope, ls []models.List) error {
+	bIDs := make([]string, len(ls))
+	for i, l := range ls {
+		bIDs[i] = l.BoardID
+	}
+
+	for _, bID := range util.RemoveDuplicates(bIDs) {
+		if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: bID, Role: models.BoardRoleMember}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
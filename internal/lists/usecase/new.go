@@ -6,8 +6,7 @@ import (
 	"github.com/nguyentantai21042004/kanban-api/internal/boards"
 	"github.com/nguyentantai21042004/kanban-api/internal/lists"
 	"github.com/nguyentantai21042004/kanban-api/internal/lists/repository"
-	"github.com/nguyentantai21042004/kanban-api/internal/role"
-	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
 	"github.com/nguyentantai21042004/kanban-api/internal/websocket/service"
 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
 	"github.com/nguyentantai21042004/kanban-api/pkg/position"
@@ -20,22 +19,20 @@ type implUsecase struct {
 	wsHub      *service.Hub
 	positionUC position.Usecase
 	boardUC    boards.UseCase
-	userUC     user.UseCase
-	roleUC     role.UseCase
+	memberUC   members.UseCase
 	clock      func() time.Time
 }
 
 var _ lists.UseCase = &implUsecase{}
 
-func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, positionUC position.Usecase, boardUC boards.UseCase, userUC user.UseCase, roleUC role.UseCase) lists.UseCase {
+func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, positionUC position.Usecase, boardUC boards.UseCase, memberUC members.UseCase) lists.UseCase {
 	return &implUsecase{
 		l:          l,
 		repo:       repo,
 		wsHub:      wsHub,
 		positionUC: positionUC,
 		boardUC:    boardUC,
-		userUC:     userUC,
-		roleUC:     roleUC,
+		memberUC:   memberUC,
 		clock:      util.Now,
 	}
 }
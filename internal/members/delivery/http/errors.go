@@ -0,0 +1,61 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+)
+
+var (
+	errWrongQuery     = pkgErrors.NewHTTPError(10901, "Wrong query")
+	errFieldRequired  = pkgErrors.NewHTTPError(10902, "Field required")
+	errInvalidRole    = pkgErrors.NewHTTPError(10903, "Role must be owner, admin, member or observer")
+	errForbidden      = &pkgErrors.HTTPError{Code: 10904, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errMemberNotFound = pkgErrors.NewHTTPError(10905, "Board member not found")
+	errAlreadyMember  = pkgErrors.NewHTTPError(10906, "User is already a member of the board")
+	errUserNotFound   = pkgErrors.NewHTTPError(10907, "User not found")
+	errLastOwner      = pkgErrors.NewHTTPError(10908, "The board must keep at least one owner")
+
+	errInvalidInvitationRole = pkgErrors.NewHTTPError(10909, "Invitations can grant admin, member or observer")
+	errInvitationNotFound    = pkgErrors.NewHTTPError(10910, "Invitation not found")
+	errInvitationInvalid     = pkgErrors.NewHTTPError(10911, "Invitation was revoked, has expired or was used up")
+	errBoardArchived         = &pkgErrors.HTTPError{Code: 10912, Message: "This board is archived and read-only", StatusCode: http.StatusForbidden}
+)
+
+func (h handler) mapErrorCode(err error) error {
+	switch err {
+	case members.ErrFieldRequired:
+		return errFieldRequired
+	case members.ErrInvalidRole:
+		return errInvalidRole
+	case members.ErrForbidden:
+		return errForbidden
+	case members.ErrBoardArchived:
+		return errBoardArchived
+	case members.ErrMemberNotFound:
+		return errMemberNotFound
+	case members.ErrAlreadyMember:
+		return errAlreadyMember
+	case members.ErrUserNotFound:
+		return errUserNotFound
+	case members.ErrLastOwner:
+		return errLastOwner
+	case members.ErrInvalidInvitationRole:
+		return errInvalidInvitationRole
+	case members.ErrInvitationNotFound:
+		return errInvitationNotFound
+	case members.ErrInvitationInvalid:
+		return errInvitationInvalid
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errForbidden,
+	errMemberNotFound,
+	errUserNotFound,
+	errInvitationNotFound,
+	errBoardArchived,
+}
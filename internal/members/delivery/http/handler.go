@@ -0,0 +1,348 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary List board members
+// @Description List the members of a board and their roles. Requires access to the board
+// @Tags Board Member
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Success 200 {object} []memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/members [GET]
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	boardID, sc, err := h.processListRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.List.processListRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.List(ctx, sc, boardID)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.List.uc.List: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.List.uc.List: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newListResp(o))
+}
+
+// @Summary Add a board member
+// @Description Give a user a role on the board. Requires the admin role, or the owner role to add an owner
+// @Tags Board Member
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param body body addReq true "Member data"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/members [POST]
+func (h handler) Add(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processAddRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.Add.processAddRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Add(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.Add.uc.Add: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.Add.uc.Add: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Change the role of a board member
+// @Description Change the role of a member. Requires the admin role, or the owner role when ownership is granted or taken away. The last owner cannot be demoted
+// @Tags Board Member
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param user_id path string true "User ID"
+// @Param body body updateRoleReq true "New role"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/members/{user_id} [PUT]
+func (h handler) UpdateRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateRoleRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.UpdateRole.processUpdateRoleRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.UpdateRole(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.UpdateRole.uc.UpdateRole: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.UpdateRole.uc.UpdateRole: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Remove a board member
+// @Description Remove a member from the board. Members can always remove themselves; removing someone else requires the admin role, or the owner role for an owner. The last owner cannot leave
+// @Tags Board Member
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param user_id path string true "User ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/members/{user_id} [DELETE]
+func (h handler) Remove(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processRemoveRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.Remove.processRemoveRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.Remove(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.Remove.uc.Remove: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.Remove.uc.Remove: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary Create a board invitation
+// @Description Invite a user by username, or create a join link anyone with the token can use when no username is given. Join links can be limited to a number of uses. Invitations expire after 7 days unless expires_in_hours is given (at most 30 days). The token is only returned once. Requires the admin role
+// @Tags Board Invitation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param body body createInvitationReq true "Invitation data"
+// @Success 200 {object} createInvitationResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/invitations [POST]
+func (h handler) CreateInvitation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processCreateInvitationRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.CreateInvitation.processCreateInvitationRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.CreateInvitation(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.CreateInvitation.uc.CreateInvitation: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.CreateInvitation.uc.CreateInvitation: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newCreateInvitationResp(o, sc))
+}
+
+// @Summary List board invitations
+// @Description List the invitations of the board that can still be accepted. Requires the admin role
+// @Tags Board Invitation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Success 200 {object} []invitationItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/invitations [GET]
+func (h handler) ListInvitations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	boardID, sc, err := h.processListRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.ListInvitations.processListRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.ListInvitations(ctx, sc, boardID)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.ListInvitations.uc.ListInvitations: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.ListInvitations.uc.ListInvitations: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newListInvitationsResp(o))
+}
+
+// @Summary Revoke a board invitation
+// @Description Revoke an invitation or join link so it can no longer be accepted. Requires the admin role
+// @Tags Board Invitation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param invitation_id path string true "Invitation ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/invitations/{invitation_id} [DELETE]
+func (h handler) RevokeInvitation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processRevokeInvitationRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.RevokeInvitation.processRevokeInvitationRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.RevokeInvitation(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.RevokeInvitation.uc.RevokeInvitation: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.RevokeInvitation.uc.RevokeInvitation: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary List my invitations
+// @Description List the pending invitations sent to the current user
+// @Tags Board Invitation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Success 200 {object} []invitationItem "Success"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/invitations [GET]
+func (h handler) ListMyInvitations(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	sc, err := h.processListMyInvitationsRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.ListMyInvitations.processListMyInvitationsRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.ListMyInvitations(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "internal.members.http.ListMyInvitations.uc.ListMyInvitations: %v", err)
+		response.Error(c, h.mapErrorCode(err), h.d)
+		return
+	}
+
+	response.OK(c, h.newListInvitationsResp(o))
+}
+
+// @Summary Accept an invitation
+// @Description Join the board of an invitation. POST /invitations/accept takes the token of a join link or invitation in the body, POST /invitations/{id}/accept accepts an invitation sent to the current user. A member_joined event is broadcast to the board
+// @Tags Board Invitation
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string false "Invitation ID"
+// @Param body body acceptInvitationReq false "Invitation token"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/invitations/accept [POST]
+// @Router /api/v1/invitations/{id}/accept [POST]
+func (h handler) AcceptInvitation(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processAcceptInvitationRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.members.http.AcceptInvitation.processAcceptInvitationRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	m, err := h.uc.AcceptInvitation(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.members.http.AcceptInvitation.uc.AcceptInvitation: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.members.http.AcceptInvitation.uc.AcceptInvitation: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, newMemberItem(m, models.User{Username: sc.Username}))
+}
@@ -0,0 +1,16 @@
+package http
+
+import "github.com/gin-gonic/gin"
+
+type Handler interface {
+	List(c *gin.Context)
+	Add(c *gin.Context)
+	UpdateRole(c *gin.Context)
+	Remove(c *gin.Context)
+
+	CreateInvitation(c *gin.Context)
+	ListInvitations(c *gin.Context)
+	RevokeInvitation(c *gin.Context)
+	ListMyInvitations(c *gin.Context)
+	AcceptInvitation(c *gin.Context)
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"strings"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+type memberUser struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+type memberItem struct {
+	ID        string           `json:"id"`
+	BoardID   string           `json:"board_id"`
+	Role      models.BoardRole `json:"role"`
+	User      memberUser       `json:"user"`
+	AddedBy   string           `json:"added_by,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+func newMemberItem(m models.BoardMember, u models.User) memberItem {
+	item := memberItem{
+		ID:      m.ID,
+		BoardID: m.BoardID,
+		Role:    m.Role,
+		User: memberUser{
+			ID:        m.UserID,
+			Username:  u.Username,
+			FullName:  u.FullName,
+			AvatarURL: u.AvatarURL,
+		},
+		CreatedAt: m.CreatedAt,
+	}
+	if m.AddedBy != nil {
+		item.AddedBy = *m.AddedBy
+	}
+
+	return item
+}
+
+func (h handler) newListResp(o members.ListOutput) []memberItem {
+	userMap := make(map[string]models.User, len(o.Users))
+	for _, u := range o.Users {
+		userMap[u.ID] = u
+	}
+
+	items := make([]memberItem, len(o.Members))
+	for i, m := range o.Members {
+		items[i] = newMemberItem(m, userMap[m.UserID])
+	}
+
+	return items
+}
+
+func (h handler) newDetailResp(o members.DetailOutput) memberItem {
+	return newMemberItem(o.Member, o.User)
+}
+
+// Add
+type addReq struct {
+	BoardID string `json:"-"`
+	UserID  string `json:"user_id" binding:"required"`
+	Role    string `json:"role" binding:"required"`
+}
+
+func (req addReq) toInput() members.AddInput {
+	return members.AddInput{
+		BoardID: req.BoardID,
+		UserID:  req.UserID,
+		Role:    models.BoardRole(req.Role),
+	}
+}
+
+// UpdateRole
+type updateRoleReq struct {
+	BoardID string `json:"-"`
+	UserID  string `json:"-"`
+	Role    string `json:"role" binding:"required"`
+}
+
+func (req updateRoleReq) toInput() members.UpdateRoleInput {
+	return members.UpdateRoleInput{
+		BoardID: req.BoardID,
+		UserID:  req.UserID,
+		Role:    models.BoardRole(req.Role),
+	}
+}
+
+// Remove
+type removeReq struct {
+	BoardID string
+	UserID  string
+}
+
+func (req removeReq) toInput() members.RemoveInput {
+	return members.RemoveInput{
+		BoardID: req.BoardID,
+		UserID:  req.UserID,
+	}
+}
+
+type invitationItem struct {
+	ID        string           `json:"id"`
+	BoardID   string           `json:"board_id"`
+	Role      models.BoardRole `json:"role"`
+	Kind      string           `json:"kind"`
+	Invitee   *memberUser      `json:"invitee,omitempty"`
+	InvitedBy memberUser       `json:"invited_by"`
+	MaxUses   *int             `json:"max_uses,omitempty"`
+	UseCount  int              `json:"use_count"`
+	ExpiresAt time.Time        `json:"expires_at"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// Kinds of invitation
+const (
+	invitationKindUser = "user"
+	invitationKindLink = "link"
+)
+
+func newMemberUser(id string, u models.User) memberUser {
+	return memberUser{
+		ID:        id,
+		Username:  u.Username,
+		FullName:  u.FullName,
+		AvatarURL: u.AvatarURL,
+	}
+}
+
+func newInvitationItem(i models.BoardInvitation, userMap map[string]models.User) invitationItem {
+	item := invitationItem{
+		ID:        i.ID,
+		BoardID:   i.BoardID,
+		Role:      i.Role,
+		Kind:      invitationKindLink,
+		InvitedBy: newMemberUser(i.CreatedBy, userMap[i.CreatedBy]),
+		MaxUses:   i.MaxUses,
+		UseCount:  i.UseCount,
+		ExpiresAt: i.ExpiresAt,
+		CreatedAt: i.CreatedAt,
+	}
+	if i.InviteeID != nil {
+		invitee := newMemberUser(*i.InviteeID, userMap[*i.InviteeID])
+		item.Kind = invitationKindUser
+		item.Invitee = &invitee
+	}
+
+	return item
+}
+
+func (h handler) newListInvitationsResp(o members.ListInvitationsOutput) []invitationItem {
+	userMap := make(map[string]models.User, len(o.Users))
+	for _, u := range o.Users {
+		userMap[u.ID] = u
+	}
+
+	items := make([]invitationItem, len(o.Invitations))
+	for i, iv := range o.Invitations {
+		items[i] = newInvitationItem(iv, userMap)
+	}
+
+	return items
+}
+
+// CreateInvitation
+type createInvitationReq struct {
+	BoardID        string `json:"-"`
+	Username       string `json:"username"`
+	Role           string `json:"role" binding:"required"`
+	MaxUses        *int   `json:"max_uses" binding:"omitempty,min=1"`
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=1,max=720"`
+}
+
+func (req createInvitationReq) toInput() members.CreateInvitationInput {
+	return members.CreateInvitationInput{
+		BoardID:   req.BoardID,
+		Username:  strings.ToLower(strings.TrimSpace(req.Username)),
+		Role:      models.BoardRole(req.Role),
+		MaxUses:   req.MaxUses,
+		ExpiresIn: time.Duration(req.ExpiresInHours) * time.Hour,
+	}
+}
+
+type createInvitationResp struct {
+	invitationItem
+	// Token is only returned once, it is the secret of the join link
+	Token string `json:"token"`
+}
+
+func (h handler) newCreateInvitationResp(o members.InvitationOutput, inviter models.Scope) createInvitationResp {
+	userMap := map[string]models.User{}
+	if o.Invitee != nil {
+		userMap[o.Invitee.ID] = *o.Invitee
+	}
+
+	item := newInvitationItem(o.Invitation, userMap)
+	item.InvitedBy = memberUser{ID: inviter.UserID, Username: inviter.Username}
+
+	return createInvitationResp{
+		invitationItem: item,
+		Token:          o.Token,
+	}
+}
+
+// RevokeInvitation
+type revokeInvitationReq struct {
+	BoardID      string
+	InvitationID string
+}
+
+func (req revokeInvitationReq) toInput() members.RevokeInvitationInput {
+	return members.RevokeInvitationInput{
+		BoardID:      req.BoardID,
+		InvitationID: req.InvitationID,
+	}
+}
+
+// AcceptInvitation
+type acceptInvitationReq struct {
+	Token        string `json:"token"`
+	InvitationID string `json:"-"`
+}
+
+func (req acceptInvitationReq) toInput() members.AcceptInvitationInput {
+	return members.AcceptInvitationInput{
+		Token:        req.Token,
+		InvitationID: req.InvitationID,
+	}
+}
@@ -0,0 +1,199 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+)
+
+func (h handler) processListRequest(c *gin.Context) (string, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processListRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return "", models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	boardID := c.Param("id")
+	if err := postgres.IsUUID(boardID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processListRequest.c.Param: %v", err)
+		return "", models.Scope{}, errWrongQuery
+	}
+
+	return boardID, scope.NewScope(p), nil
+}
+
+func (h handler) processAddRequest(c *gin.Context) (addReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processAddRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return addReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req addReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processAddRequest.c.ShouldBindJSON: %v", err)
+		return addReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.BoardID = c.Param("id")
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processAddRequest.c.Param: %v", err)
+		return addReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.UserID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processAddRequest.IsUUID: %v", err)
+		return addReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processUpdateRoleRequest(c *gin.Context) (updateRoleReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processUpdateRoleRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return updateRoleReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req updateRoleReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processUpdateRoleRequest.c.ShouldBindJSON: %v", err)
+		return updateRoleReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.BoardID = c.Param("id")
+	req.UserID = c.Param("user_id")
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processUpdateRoleRequest.c.Param: %v", err)
+		return updateRoleReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.UserID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processUpdateRoleRequest.c.Param: %v", err)
+		return updateRoleReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processRemoveRequest(c *gin.Context) (removeReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processRemoveRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return removeReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	req := removeReq{
+		BoardID: c.Param("id"),
+		UserID:  c.Param("user_id"),
+	}
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processRemoveRequest.c.Param: %v", err)
+		return removeReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.UserID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processRemoveRequest.c.Param: %v", err)
+		return removeReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processCreateInvitationRequest(c *gin.Context) (createInvitationReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processCreateInvitationRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return createInvitationReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req createInvitationReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processCreateInvitationRequest.c.ShouldBindJSON: %v", err)
+		return createInvitationReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.BoardID = c.Param("id")
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processCreateInvitationRequest.c.Param: %v", err)
+		return createInvitationReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processRevokeInvitationRequest(c *gin.Context) (revokeInvitationReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processRevokeInvitationRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return revokeInvitationReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	req := revokeInvitationReq{
+		BoardID:      c.Param("id"),
+		InvitationID: c.Param("invitation_id"),
+	}
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processRevokeInvitationRequest.c.Param: %v", err)
+		return revokeInvitationReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.InvitationID); err != nil {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processRevokeInvitationRequest.c.Param: %v", err)
+		return revokeInvitationReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processListMyInvitationsRequest(c *gin.Context) (models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processListMyInvitationsRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	return scope.NewScope(p), nil
+}
+
+// processAcceptInvitationRequest reads the invitation ID from the path, or
+// the token of a join link from the body.
+func (h handler) processAcceptInvitationRequest(c *gin.Context) (acceptInvitationReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processAcceptInvitationRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return acceptInvitationReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req acceptInvitationReq
+	if id := c.Param("id"); id != "" {
+		if err := postgres.IsUUID(id); err != nil {
+			h.l.Errorf(ctx, "internal.members.delivery.http.processAcceptInvitationRequest.c.Param: %v", err)
+			return acceptInvitationReq{}, models.Scope{}, errWrongQuery
+		}
+		req.InvitationID = id
+		return req, scope.NewScope(p), nil
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" {
+		h.l.Errorf(ctx, "internal.members.delivery.http.processAcceptInvitationRequest.c.ShouldBindJSON: %v", err)
+		return acceptInvitationReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
+)
+
+func MapBoardMemberRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.Use(mw.Auth())
+	r.GET("", h.List)
+	r.POST("", h.Add)
+	r.PUT("/:user_id", h.UpdateRole)
+	r.DELETE("/:user_id", h.Remove)
+}
+
+func MapBoardInvitationRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.Use(mw.Auth())
+	r.GET("", h.ListInvitations)
+	r.POST("", h.CreateInvitation)
+	r.DELETE("/:invitation_id", h.RevokeInvitation)
+}
+
+func MapInvitationRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.Use(mw.Auth())
+	r.GET("", h.ListMyInvitations)
+	r.POST("/accept", h.AcceptInvitation)
+	r.POST("/:id/accept", h.AcceptInvitation)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	List(ctx context.Context, sc models.Scope, opts ListOptions) ([]models.BoardMember, error)
+	Detail(ctx context.Context, sc models.Scope, opts DetailOptions) (models.BoardMember, error)
+	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.BoardMember, error)
+	UpdateRole(ctx context.Context, sc models.Scope, opts UpdateRoleOptions) (models.BoardMember, error)
+	Delete(ctx context.Context, sc models.Scope, id string) error
+	Count(ctx context.Context, sc models.Scope, opts CountOptions) (int64, error)
+	// ListRoles returns every role the user holds on the board, directly or
+	// through a workspace.
+	ListRoles(ctx context.Context, sc models.Scope, opts DetailOptions) ([]models.BoardRole, error)
+	// DetailBoardState tells whether the board is archived or in the trash.
+	DetailBoardState(ctx context.Context, sc models.Scope, boardID string) (BoardState, error)
+
+	CreateInvitation(ctx context.Context, sc models.Scope, opts CreateInvitationOptions) (models.BoardInvitation, error)
+	// ListInvitations returns the invitations that can still be accepted.
+	ListInvitations(ctx context.Context, sc models.Scope, opts ListInvitationsOptions) ([]models.BoardInvitation, error)
+	DetailInvitation(ctx context.Context, sc models.Scope, opts DetailInvitationOptions) (models.BoardInvitation, error)
+	RevokeInvitation(ctx context.Context, sc models.Scope, id string) error
+	// UseInvitation counts one more use of the invitation. It returns ErrNotFound
+	// when the invitation was revoked, expired or used up in the meantime.
+	UseInvitation(ctx context.Context, sc models.Scope, id string) error
+}
+
+type BoardState struct {
+	Archived bool
+	Deleted  bool
+	Version  int64
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+type ListOptions struct {
+	BoardID string
+}
+
+type DetailOptions struct {
+	BoardID string
+	UserID  string
+}
+
+type CreateOptions struct {
+	BoardID string
+	UserID  string
+	Role    models.BoardRole
+	AddedBy string
+}
+
+type UpdateRoleOptions struct {
+	ID   string
+	Role models.BoardRole
+}
+
+type CountOptions struct {
+	BoardID string
+	Role    models.BoardRole
+}
+
+type CreateInvitationOptions struct {
+	BoardID   string
+	TokenHash string
+	Role      models.BoardRole
+	InviteeID string
+	MaxUses   *int
+	ExpiresAt time.Time
+}
+
+type ListInvitationsOptions struct {
+	BoardID   string
+	InviteeID string
+}
+
+type DetailInvitationOptions struct {
+	ID        string
+	TokenHash string
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// listRolesQuery reads the board_access view, which holds the direct board
+// memberships together with the roles granted through a workspace or a team.
+// Every user observes the public boards.
+const listRolesQuery = `
+	SELECT role FROM board_access
+	WHERE board_id = $1 AND user_id = $2
+	UNION ALL
+	SELECT 'observer' FROM boards
+	WHERE id = $1 AND settings->>'visibility' = 'public'
+`
+
+// detailBoardStateQuery reads the lifecycle of the board next to the roles,
+// archived boards are read-only and trashed ones are closed. The version
+// stamps the member events.
+const detailBoardStateQuery = `
+	SELECT archived_at IS NOT NULL, deleted_at IS NOT NULL, version FROM boards
+	WHERE id = $1
+`
+
+func (r implRepository) ListRoles(ctx context.Context, sc models.Scope, opts repository.DetailOptions) ([]models.BoardRole, error) {
+	rows, err := r.database.QueryContext(ctx, listRolesQuery, opts.BoardID, opts.UserID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.ListRoles.QueryContext: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []models.BoardRole
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			r.l.Errorf(ctx, "internal.members.repository.postgres.ListRoles.Scan: %v", err)
+			return nil, err
+		}
+		roles = append(roles, models.BoardRole(role))
+	}
+	if err := rows.Err(); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.ListRoles.Err: %v", err)
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (r implRepository) DetailBoardState(ctx context.Context, sc models.Scope, boardID string) (repository.BoardState, error) {
+	var st repository.BoardState
+	if err := r.database.QueryRowContext(ctx, detailBoardStateQuery, boardID).Scan(&st.Archived, &st.Deleted, &st.Version); err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.members.repository.postgres.DetailBoardState.Scan.NoRows: %v", err)
+			return repository.BoardState{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.members.repository.postgres.DetailBoardState.Scan: %v", err)
+		return repository.BoardState{}, err
+	}
+
+	return st, nil
+}
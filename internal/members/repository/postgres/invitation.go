@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// useInvitationQuery counts a use only while the invitation can be accepted,
+// so concurrent accepts cannot go past max_uses.
+const useInvitationQuery = `
+	UPDATE board_invitations
+	SET use_count = use_count + 1, updated_at = $2
+	WHERE id = $1
+		AND revoked_at IS NULL
+		AND expires_at > $2
+		AND (max_uses IS NULL OR use_count < max_uses)
+`
+
+func (r implRepository) CreateInvitation(ctx context.Context, sc models.Scope, opts repository.CreateInvitationOptions) (models.BoardInvitation, error) {
+	m := r.buildInvitationModel(sc, opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.CreateInvitation.Insert: %v", err)
+		return models.BoardInvitation{}, err
+	}
+
+	return models.NewBoardInvitation(m), nil
+}
+
+func (r implRepository) ListInvitations(ctx context.Context, sc models.Scope, opts repository.ListInvitationsOptions) ([]models.BoardInvitation, error) {
+	qr, err := r.buildListInvitationsQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.ListInvitations.buildListInvitationsQuery: %v", err)
+		return nil, err
+	}
+
+	is, err := dbmodels.BoardInvitations(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.ListInvitations.All: %v", err)
+		return nil, err
+	}
+
+	invitations := make([]models.BoardInvitation, len(is))
+	for i, iv := range is {
+		invitations[i] = models.NewBoardInvitation(*iv)
+	}
+
+	return invitations, nil
+}
+
+func (r implRepository) DetailInvitation(ctx context.Context, sc models.Scope, opts repository.DetailInvitationOptions) (models.BoardInvitation, error) {
+	qr, err := r.buildDetailInvitationQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.DetailInvitation.buildDetailInvitationQuery: %v", err)
+		return models.BoardInvitation{}, err
+	}
+
+	i, err := dbmodels.BoardInvitations(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.members.repository.postgres.DetailInvitation.One.NoRows: %v", err)
+			return models.BoardInvitation{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.members.repository.postgres.DetailInvitation.One: %v", err)
+		return models.BoardInvitation{}, err
+	}
+
+	return models.NewBoardInvitation(*i), nil
+}
+
+func (r implRepository) RevokeInvitation(ctx context.Context, sc models.Scope, id string) error {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.RevokeInvitation.InvalidID: %v", err)
+		return err
+	}
+
+	now := r.clock()
+	n, err := dbmodels.BoardInvitations(
+		dbmodels.BoardInvitationWhere.ID.EQ(id),
+		dbmodels.BoardInvitationWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.BoardInvitationColumns.RevokedAt: null.TimeFrom(now),
+		dbmodels.BoardInvitationColumns.UpdatedAt: now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.RevokeInvitation.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) UseInvitation(ctx context.Context, sc models.Scope, id string) error {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.UseInvitation.InvalidID: %v", err)
+		return err
+	}
+
+	res, err := r.database.ExecContext(ctx, useInvitationQuery, id, r.clock())
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.UseInvitation.ExecContext: %v", err)
+		return err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.UseInvitation.RowsAffected: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) List(ctx context.Context, sc models.Scope, opts repository.ListOptions) ([]models.BoardMember, error) {
+	qr, err := r.buildListQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.List.buildListQuery: %v", err)
+		return nil, err
+	}
+
+	ms, err := dbmodels.BoardMembers(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.List.All: %v", err)
+		return nil, err
+	}
+
+	members := make([]models.BoardMember, len(ms))
+	for i, m := range ms {
+		members[i] = models.NewBoardMember(*m)
+	}
+
+	return members, nil
+}
+
+func (r implRepository) Detail(ctx context.Context, sc models.Scope, opts repository.DetailOptions) (models.BoardMember, error) {
+	qr, err := r.buildDetailQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Detail.buildDetailQuery: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	m, err := dbmodels.BoardMembers(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.members.repository.postgres.Detail.One.NoRows: %v", err)
+			return models.BoardMember{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Detail.One: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	return models.NewBoardMember(*m), nil
+}
+
+func (r implRepository) Create(ctx context.Context, sc models.Scope, opts repository.CreateOptions) (models.BoardMember, error) {
+	m := r.buildModel(sc, opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Create.Insert: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	return models.NewBoardMember(m), nil
+}
+
+func (r implRepository) UpdateRole(ctx context.Context, sc models.Scope, opts repository.UpdateRoleOptions) (models.BoardMember, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.UpdateRole.InvalidID: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	m := dbmodels.BoardMember{
+		ID:        opts.ID,
+		Role:      string(opts.Role),
+		UpdatedAt: r.clock(),
+	}
+	n, err := m.Update(ctx, r.database, boil.Whitelist(dbmodels.BoardMemberColumns.Role, dbmodels.BoardMemberColumns.UpdatedAt))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.UpdateRole.Update: %v", err)
+		return models.BoardMember{}, err
+	}
+	if n == 0 {
+		return models.BoardMember{}, repository.ErrNotFound
+	}
+
+	if err := m.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.UpdateRole.Reload: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	return models.NewBoardMember(m), nil
+}
+
+func (r implRepository) Delete(ctx context.Context, sc models.Scope, id string) error {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Delete.InvalidID: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.BoardMembers(dbmodels.BoardMemberWhere.ID.EQ(id)).DeleteAll(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Delete.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) Count(ctx context.Context, sc models.Scope, opts repository.CountOptions) (int64, error) {
+	qr, err := r.buildCountQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Count.buildCountQuery: %v", err)
+		return 0, err
+	}
+
+	n, err := dbmodels.BoardMembers(qr...).Count(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.Count.Count: %v", err)
+		return 0, err
+	}
+
+	return n, nil
+}
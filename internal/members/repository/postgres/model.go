@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (r implRepository) buildModel(sc models.Scope, opts repository.CreateOptions) dbmodels.BoardMember {
+	now := r.clock()
+
+	return dbmodels.BoardMember{
+		BoardID:   opts.BoardID,
+		UserID:    opts.UserID,
+		Role:      string(opts.Role),
+		AddedBy:   null.NewString(opts.AddedBy, opts.AddedBy != ""),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r implRepository) buildInvitationModel(sc models.Scope, opts repository.CreateInvitationOptions) dbmodels.BoardInvitation {
+	now := r.clock()
+
+	return dbmodels.BoardInvitation{
+		BoardID:   opts.BoardID,
+		TokenHash: opts.TokenHash,
+		Role:      string(opts.Role),
+		InviteeID: null.NewString(opts.InviteeID, opts.InviteeID != ""),
+		MaxUses:   null.IntFromPtr(opts.MaxUses),
+		ExpiresAt: opts.ExpiresAt,
+		CreatedBy: sc.UserID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
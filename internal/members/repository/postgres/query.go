@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildListQuery(ctx context.Context, opts repository.ListOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.buildListQuery.InvalidBoardID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.BoardMemberWhere.BoardID.EQ(opts.BoardID),
+		qm.OrderBy(dbmodels.BoardMemberColumns.CreatedAt + " ASC"),
+	}, nil
+}
+
+func (r implRepository) buildDetailQuery(ctx context.Context, opts repository.DetailOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.buildDetailQuery.InvalidBoardID: %v", err)
+		return nil, err
+	}
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.buildDetailQuery.InvalidUserID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.BoardMemberWhere.BoardID.EQ(opts.BoardID),
+		dbmodels.BoardMemberWhere.UserID.EQ(opts.UserID),
+	}, nil
+}
+
+func (r implRepository) buildCountQuery(ctx context.Context, opts repository.CountOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.buildCountQuery.InvalidBoardID: %v", err)
+		return nil, err
+	}
+
+	qr := []qm.QueryMod{dbmodels.BoardMemberWhere.BoardID.EQ(opts.BoardID)}
+	if opts.Role != "" {
+		qr = append(qr, dbmodels.BoardMemberWhere.Role.EQ(string(opts.Role)))
+	}
+
+	return qr, nil
+}
+
+func (r implRepository) buildListInvitationsQuery(ctx context.Context, opts repository.ListInvitationsOptions) ([]qm.QueryMod, error) {
+	qr := []qm.QueryMod{
+		dbmodels.BoardInvitationWhere.RevokedAt.IsNull(),
+		dbmodels.BoardInvitationWhere.ExpiresAt.GT(r.clock()),
+		qm.Where("(" + dbmodels.BoardInvitationColumns.MaxUses + " IS NULL OR " + dbmodels.BoardInvitationColumns.UseCount + " < " + dbmodels.BoardInvitationColumns.MaxUses + ")"),
+	}
+
+	if opts.BoardID != "" {
+		if err := postgres.IsUUID(opts.BoardID); err != nil {
+			r.l.Errorf(ctx, "internal.members.repository.postgres.buildListInvitationsQuery.InvalidBoardID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardInvitationWhere.BoardID.EQ(opts.BoardID))
+	}
+
+	if opts.InviteeID != "" {
+		if err := postgres.IsUUID(opts.InviteeID); err != nil {
+			r.l.Errorf(ctx, "internal.members.repository.postgres.buildListInvitationsQuery.InvalidInviteeID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardInvitationWhere.InviteeID.EQ(null.StringFrom(opts.InviteeID)))
+	}
+
+	qr = append(qr, qm.OrderBy(dbmodels.BoardInvitationColumns.CreatedAt+" DESC"))
+
+	return qr, nil
+}
+
+func (r implRepository) buildDetailInvitationQuery(ctx context.Context, opts repository.DetailInvitationOptions) ([]qm.QueryMod, error) {
+	if opts.TokenHash != "" {
+		return []qm.QueryMod{dbmodels.BoardInvitationWhere.TokenHash.EQ(opts.TokenHash)}, nil
+	}
+
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.members.repository.postgres.buildDetailInvitationQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{dbmodels.BoardInvitationWhere.ID.EQ(opts.ID)}, nil
+}
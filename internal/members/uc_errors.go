@@ -0,0 +1,18 @@
+package members
+
+import "errors"
+
+var (
+	ErrFieldRequired  = errors.New("field required")
+	ErrInvalidRole    = errors.New("invalid board role")
+	ErrForbidden      = errors.New("not allowed on this board")
+	ErrMemberNotFound = errors.New("board member not found")
+	ErrAlreadyMember  = errors.New("user is already a board member")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrLastOwner      = errors.New("board must keep an owner")
+	ErrBoardArchived  = errors.New("board is archived")
+
+	ErrInvalidInvitationRole = errors.New("invitations cannot grant ownership")
+	ErrInvitationNotFound    = errors.New("invitation not found")
+	ErrInvitationInvalid     = errors.New("invitation was revoked, expired or used up")
+)
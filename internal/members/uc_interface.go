@@ -0,0 +1,37 @@
+package members
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Authorize returns ErrForbidden unless the user holds at least the role on
+	// the board. The board.manage permission grants every role on every board.
+	// Trashed boards are forbidden, and archived boards return
+	// ErrBoardArchived for every role above observer.
+	Authorize(ctx context.Context, sc models.Scope, ip AuthorizeInput) error
+	// MemberFilter returns the user board queries have to be restricted to, or
+	// an empty string when the user can see every board.
+	MemberFilter(ctx context.Context, sc models.Scope) (string, error)
+	// Join adds the user to the board without an authorization check. It is
+	// used by the flows that grant the access themselves, like board creation.
+	Join(ctx context.Context, sc models.Scope, ip JoinInput) (models.BoardMember, error)
+	List(ctx context.Context, sc models.Scope, boardID string) (ListOutput, error)
+	Add(ctx context.Context, sc models.Scope, ip AddInput) (DetailOutput, error)
+	UpdateRole(ctx context.Context, sc models.Scope, ip UpdateRoleInput) (DetailOutput, error)
+	Remove(ctx context.Context, sc models.Scope, ip RemoveInput) error
+
+	// CreateInvitation invites a user by username, or creates a join link when
+	// no username is given. The token is only returned here.
+	CreateInvitation(ctx context.Context, sc models.Scope, ip CreateInvitationInput) (InvitationOutput, error)
+	ListInvitations(ctx context.Context, sc models.Scope, boardID string) (ListInvitationsOutput, error)
+	RevokeInvitation(ctx context.Context, sc models.Scope, ip RevokeInvitationInput) error
+	// ListMyInvitations returns the pending invitations sent to the user.
+	ListMyInvitations(ctx context.Context, sc models.Scope) (ListInvitationsOutput, error)
+	// AcceptInvitation makes the user a member of the board, with the token of
+	// the invitation or, for an invitation sent to the user, its ID.
+	AcceptInvitation(ctx context.Context, sc models.Scope, ip AcceptInvitationInput) (models.BoardMember, error)
+}
@@ -0,0 +1,86 @@
+package members
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+const (
+	// DefaultInvitationTTL is how long an invitation stays valid when no expiry is given.
+	DefaultInvitationTTL = 7 * 24 * time.Hour
+	// MaxInvitationTTL is the longest an invitation can stay valid.
+	MaxInvitationTTL = 30 * 24 * time.Hour
+)
+
+type AuthorizeInput struct {
+	BoardID string
+	Role    models.BoardRole
+	// AllowInactive lets the archive, trash and restore actions through on
+	// archived and trashed boards
+	AllowInactive bool
+}
+
+type JoinInput struct {
+	BoardID string
+	Role    models.BoardRole
+}
+
+type AddInput struct {
+	BoardID string
+	UserID  string
+	Role    models.BoardRole
+}
+
+type UpdateRoleInput struct {
+	BoardID string
+	UserID  string
+	Role    models.BoardRole
+}
+
+type RemoveInput struct {
+	BoardID string
+	UserID  string
+}
+
+type ListOutput struct {
+	Members []models.BoardMember
+	Users   []models.User
+}
+
+type DetailOutput struct {
+	Member models.BoardMember
+	User   models.User
+}
+
+type CreateInvitationInput struct {
+	BoardID  string
+	Username string
+	Role     models.BoardRole
+	// MaxUses limits how many users can use a join link, nil means unlimited.
+	MaxUses *int
+	// ExpiresIn defaults to DefaultInvitationTTL when zero.
+	ExpiresIn time.Duration
+}
+
+type InvitationOutput struct {
+	Invitation models.BoardInvitation
+	Token      string
+	Invitee    *models.User
+}
+
+type ListInvitationsOutput struct {
+	Invitations []models.BoardInvitation
+	// Users holds the invitees and the inviters of the invitations
+	Users []models.User
+}
+
+type RevokeInvitationInput struct {
+	BoardID      string
+	InvitationID string
+}
+
+type AcceptInvitationInput struct {
+	Token        string
+	InvitationID string
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+func (uc implUsecase) Authorize(ctx context.Context, sc models.Scope, ip members.AuthorizeInput) error {
+	if ip.BoardID == "" {
+		return members.ErrFieldRequired
+	}
+
+	if err := uc.authorizeRole(ctx, sc, ip); err != nil {
+		return err
+	}
+	if ip.AllowInactive {
+		return nil
+	}
+
+	st, err := uc.repo.DetailBoardState(ctx, sc, ip.BoardID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.Authorize.repo.DetailBoardState.NotFound: %v", err)
+			return members.ErrForbidden
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.Authorize.repo.DetailBoardState: %v", err)
+		return err
+	}
+	// Trashed boards are closed until they are restored, archived ones can
+	// still be read
+	if st.Deleted {
+		uc.l.Warnf(ctx, "internal.members.usecase.Authorize.Deleted: board %s is in the trash", ip.BoardID)
+		return members.ErrForbidden
+	}
+	if st.Archived && ip.Role != models.BoardRoleObserver {
+		uc.l.Warnf(ctx, "internal.members.usecase.Authorize.Archived: board %s is read-only", ip.BoardID)
+		return members.ErrBoardArchived
+	}
+
+	return nil
+}
+
+func (uc implUsecase) authorizeRole(ctx context.Context, sc models.Scope, ip members.AuthorizeInput) error {
+	roles, err := uc.repo.ListRoles(ctx, sc, repository.DetailOptions{
+		BoardID: ip.BoardID,
+		UserID:  sc.UserID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.authorizeRole.repo.ListRoles: %v", err)
+		return err
+	}
+	for _, role := range roles {
+		if role.AtLeast(ip.Role) {
+			return nil
+		}
+	}
+
+	admin, err := uc.canManageAll(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.authorizeRole.canManageAll: %v", err)
+		return err
+	}
+	if admin {
+		return nil
+	}
+
+	uc.l.Warnf(ctx, "internal.members.usecase.authorizeRole.Forbidden: user %s is not %s of board %s", sc.UserID, ip.Role, ip.BoardID)
+	return members.ErrForbidden
+}
+
+func (uc implUsecase) MemberFilter(ctx context.Context, sc models.Scope) (string, error) {
+	admin, err := uc.canManageAll(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.MemberFilter.canManageAll: %v", err)
+		return "", err
+	}
+
+	// Only admin can see all boards
+	if admin {
+		return "", nil
+	}
+
+	return sc.UserID, nil
+}
+
+// canManageAll reports whether the role of the user grants access to all boards.
+func (uc implUsecase) canManageAll(ctx context.Context, sc models.Scope) (bool, error) {
+	ok, err := uc.roleUC.HasPermission(ctx, sc, models.PermissionBoardManage)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.canManageAll.roleUC.HasPermission: %v", err)
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	sc := models.Scope{UserID: "user-1"}
+
+	tcs := map[string]struct {
+		// roles are the board_access rows of the user on board-1
+		roles       []models.BoardRole
+		public      bool
+		state       repository.BoardState
+		permissions []string
+		ip          members.AuthorizeInput
+		wantErr     error
+	}{
+		"direct member": {
+			roles: []models.BoardRole{models.BoardRoleMember},
+			ip:    members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleMember},
+		},
+		"direct member below the role": {
+			roles:   []models.BoardRole{models.BoardRoleObserver},
+			ip:      members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleMember},
+			wantErr: members.ErrForbidden,
+		},
+		"team grant above the direct role": {
+			roles: []models.BoardRole{models.BoardRoleObserver, models.BoardRoleAdmin},
+			ip:    members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleAdmin},
+		},
+		"workspace admin": {
+			roles: []models.BoardRole{models.BoardRoleAdmin},
+			ip:    members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleAdmin},
+		},
+		"public board is read-only": {
+			public: true,
+			ip:     members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleObserver},
+		},
+		"public board cannot be written": {
+			public:  true,
+			ip:      members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleMember},
+			wantErr: members.ErrForbidden,
+		},
+		"no access": {
+			ip:      members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleObserver},
+			wantErr: members.ErrForbidden,
+		},
+		"can manage all boards": {
+			permissions: []string{models.PermissionBoardManage},
+			ip:          members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleAdmin},
+		},
+		"archived board can be read": {
+			roles: []models.BoardRole{models.BoardRoleAdmin},
+			state: repository.BoardState{Archived: true},
+			ip:    members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleObserver},
+		},
+		"archived board cannot be written": {
+			roles:   []models.BoardRole{models.BoardRoleAdmin},
+			state:   repository.BoardState{Archived: true},
+			ip:      members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleMember},
+			wantErr: members.ErrBoardArchived,
+		},
+		"archived board can be restored": {
+			roles: []models.BoardRole{models.BoardRoleAdmin},
+			state: repository.BoardState{Archived: true},
+			ip:    members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleAdmin, AllowInactive: true},
+		},
+		"trashed board cannot be read": {
+			roles:   []models.BoardRole{models.BoardRoleAdmin},
+			state:   repository.BoardState{Deleted: true},
+			ip:      members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleObserver},
+			wantErr: members.ErrForbidden,
+		},
+		"trashed board is closed to those who can manage all boards": {
+			permissions: []string{models.PermissionBoardManage},
+			state:       repository.BoardState{Deleted: true},
+			ip:          members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleObserver},
+			wantErr:     members.ErrForbidden,
+		},
+		"trashed board can be restored": {
+			roles: []models.BoardRole{models.BoardRoleAdmin},
+			state: repository.BoardState{Deleted: true},
+			ip:    members.AuthorizeInput{BoardID: "board-1", Role: models.BoardRoleAdmin, AllowInactive: true},
+		},
+		"unknown board": {
+			permissions: []string{models.PermissionBoardManage},
+			ip:          members.AuthorizeInput{BoardID: "board-2", Role: models.BoardRoleObserver},
+			wantErr:     members.ErrForbidden,
+		},
+		"missing board": {
+			ip:      members.AuthorizeInput{Role: models.BoardRoleObserver},
+			wantErr: members.ErrFieldRequired,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.repo.access["board-1"] = map[string][]models.BoardRole{"user-1": tc.roles}
+			deps.repo.public["board-1"] = tc.public
+			deps.repo.states["board-1"] = tc.state
+			deps.roleUC.permissions = tc.permissions
+
+			err := uc.Authorize(context.Background(), sc, tc.ip)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMemberFilter(t *testing.T) {
+	sc := models.Scope{UserID: "user-1"}
+
+	tcs := map[string]struct {
+		permissions []string
+		want        string
+	}{
+		"user": {
+			want: "user-1",
+		},
+		"can manage all boards": {
+			permissions: []string{models.PermissionBoardManage},
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.roleUC.permissions = tc.permissions
+
+			got, err := uc.MemberFilter(context.Background(), sc)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) CreateInvitation(ctx context.Context, sc models.Scope, ip members.CreateInvitationInput) (members.InvitationOutput, error) {
+	if ip.BoardID == "" {
+		return members.InvitationOutput{}, members.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return members.InvitationOutput{}, members.ErrInvalidRole
+	}
+	if ip.Role == models.BoardRoleOwner {
+		return members.InvitationOutput{}, members.ErrInvalidInvitationRole
+	}
+
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		return members.InvitationOutput{}, err
+	}
+
+	ttl := ip.ExpiresIn
+	if ttl <= 0 {
+		ttl = members.DefaultInvitationTTL
+	}
+	if ttl > members.MaxInvitationTTL {
+		ttl = members.MaxInvitationTTL
+	}
+
+	opts := repository.CreateInvitationOptions{
+		BoardID:   ip.BoardID,
+		Role:      ip.Role,
+		MaxUses:   ip.MaxUses,
+		ExpiresAt: uc.clock().Add(ttl),
+	}
+
+	var invitee *models.User
+	if ip.Username != "" {
+		u, err := uc.userUC.GetOne(ctx, sc, user.GetOneInput{Username: ip.Username})
+		if err != nil {
+			if err == user.ErrUserNotFound {
+				uc.l.Warnf(ctx, "internal.members.usecase.CreateInvitation.userUC.GetOne.NotFound: %v", err)
+				return members.InvitationOutput{}, members.ErrUserNotFound
+			}
+			uc.l.Errorf(ctx, "internal.members.usecase.CreateInvitation.userUC.GetOne: %v", err)
+			return members.InvitationOutput{}, err
+		}
+
+		_, err = uc.repo.Detail(ctx, sc, repository.DetailOptions{
+			BoardID: ip.BoardID,
+			UserID:  u.ID,
+		})
+		if err == nil {
+			return members.InvitationOutput{}, members.ErrAlreadyMember
+		}
+		if err != repository.ErrNotFound {
+			uc.l.Errorf(ctx, "internal.members.usecase.CreateInvitation.repo.Detail: %v", err)
+			return members.InvitationOutput{}, err
+		}
+
+		// An invitation sent to a user is used once, by that user
+		maxUses := 1
+		opts.InviteeID = u.ID
+		opts.MaxUses = &maxUses
+		invitee = &u
+	}
+
+	token, err := generateToken(invitationTokenBytes)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.CreateInvitation.generateToken: %v", err)
+		return members.InvitationOutput{}, err
+	}
+	opts.TokenHash = hashToken(token)
+
+	i, err := uc.repo.CreateInvitation(ctx, sc, opts)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.CreateInvitation.repo.CreateInvitation: %v", err)
+		return members.InvitationOutput{}, err
+	}
+
+	return members.InvitationOutput{
+		Invitation: i,
+		Token:      token,
+		Invitee:    invitee,
+	}, nil
+}
+
+func (uc implUsecase) ListInvitations(ctx context.Context, sc models.Scope, boardID string) (members.ListInvitationsOutput, error) {
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleAdmin}); err != nil {
+		return members.ListInvitationsOutput{}, err
+	}
+
+	is, err := uc.repo.ListInvitations(ctx, sc, repository.ListInvitationsOptions{BoardID: boardID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.ListInvitations.repo.ListInvitations: %v", err)
+		return members.ListInvitationsOutput{}, err
+	}
+
+	o, err := uc.newListInvitationsOutput(ctx, sc, is)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.ListInvitations.newListInvitationsOutput: %v", err)
+		return members.ListInvitationsOutput{}, err
+	}
+
+	return o, nil
+}
+
+func (uc implUsecase) RevokeInvitation(ctx context.Context, sc models.Scope, ip members.RevokeInvitationInput) error {
+	if ip.BoardID == "" || ip.InvitationID == "" {
+		return members.ErrFieldRequired
+	}
+
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		return err
+	}
+
+	i, err := uc.repo.DetailInvitation(ctx, sc, repository.DetailInvitationOptions{ID: ip.InvitationID})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.RevokeInvitation.repo.DetailInvitation.NotFound: %v", err)
+			return members.ErrInvitationNotFound
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.RevokeInvitation.repo.DetailInvitation: %v", err)
+		return err
+	}
+	if i.BoardID != ip.BoardID {
+		return members.ErrInvitationNotFound
+	}
+
+	if err := uc.repo.RevokeInvitation(ctx, sc, i.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.RevokeInvitation.repo.RevokeInvitation.NotFound: %v", err)
+			return members.ErrInvitationNotFound
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.RevokeInvitation.repo.RevokeInvitation: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) ListMyInvitations(ctx context.Context, sc models.Scope) (members.ListInvitationsOutput, error) {
+	is, err := uc.repo.ListInvitations(ctx, sc, repository.ListInvitationsOptions{InviteeID: sc.UserID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.ListMyInvitations.repo.ListInvitations: %v", err)
+		return members.ListInvitationsOutput{}, err
+	}
+
+	o, err := uc.newListInvitationsOutput(ctx, sc, is)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.ListMyInvitations.newListInvitationsOutput: %v", err)
+		return members.ListInvitationsOutput{}, err
+	}
+
+	return o, nil
+}
+
+func (uc implUsecase) AcceptInvitation(ctx context.Context, sc models.Scope, ip members.AcceptInvitationInput) (models.BoardMember, error) {
+	if ip.Token == "" && ip.InvitationID == "" {
+		return models.BoardMember{}, members.ErrFieldRequired
+	}
+
+	opts := repository.DetailInvitationOptions{ID: ip.InvitationID}
+	if ip.Token != "" {
+		opts = repository.DetailInvitationOptions{TokenHash: hashToken(ip.Token)}
+	}
+
+	i, err := uc.repo.DetailInvitation(ctx, sc, opts)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.AcceptInvitation.repo.DetailInvitation.NotFound: %v", err)
+			return models.BoardMember{}, members.ErrInvitationNotFound
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.AcceptInvitation.repo.DetailInvitation: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	// Without the token only the invitee can find the invitation, and an
+	// invitation sent to a user cannot be used by anybody else
+	if (ip.Token == "" && i.InviteeID == nil) || (i.InviteeID != nil && *i.InviteeID != sc.UserID) {
+		uc.l.Warnf(ctx, "internal.members.usecase.AcceptInvitation.NotInvitee: user %s, invitation %s", sc.UserID, i.ID)
+		return models.BoardMember{}, members.ErrInvitationNotFound
+	}
+	if !i.IsUsable(uc.clock()) {
+		return models.BoardMember{}, members.ErrInvitationInvalid
+	}
+
+	_, err = uc.repo.Detail(ctx, sc, repository.DetailOptions{
+		BoardID: i.BoardID,
+		UserID:  sc.UserID,
+	})
+	if err == nil {
+		return models.BoardMember{}, members.ErrAlreadyMember
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.members.usecase.AcceptInvitation.repo.Detail: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	if err := uc.repo.UseInvitation(ctx, sc, i.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.AcceptInvitation.repo.UseInvitation.NotFound: %v", err)
+			return models.BoardMember{}, members.ErrInvitationInvalid
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.AcceptInvitation.repo.UseInvitation: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	m, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		BoardID: i.BoardID,
+		UserID:  sc.UserID,
+		Role:    i.Role,
+		AddedBy: i.CreatedBy,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.AcceptInvitation.repo.Create: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	if err := uc.broadcastMemberEvent(ctx, sc, m.BoardID, websocket.MSG_MEMBER_JOINED, m); err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.AcceptInvitation.broadcastMemberEvent: %v", err)
+	}
+
+	return m, nil
+}
+
+// newListInvitationsOutput loads the invitees and the inviters of the invitations.
+func (uc implUsecase) newListInvitationsOutput(ctx context.Context, sc models.Scope, is []models.BoardInvitation) (members.ListInvitationsOutput, error) {
+	uIDs := make([]string, 0, len(is)*2)
+	for _, i := range is {
+		uIDs = append(uIDs, i.CreatedBy)
+		if i.InviteeID != nil {
+			uIDs = append(uIDs, *i.InviteeID)
+		}
+	}
+	if len(uIDs) == 0 {
+		return members.ListInvitationsOutput{Invitations: is}, nil
+	}
+
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: util.RemoveDuplicates(uIDs),
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.newListInvitationsOutput.userUC.List: %v", err)
+		return members.ListInvitationsOutput{}, err
+	}
+
+	return members.ListInvitationsOutput{
+		Invitations: is,
+		Users:       us,
+	}, nil
+}
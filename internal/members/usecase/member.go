@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket"
+)
+
+func (uc implUsecase) Join(ctx context.Context, sc models.Scope, ip members.JoinInput) (models.BoardMember, error) {
+	if ip.BoardID == "" {
+		return models.BoardMember{}, members.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return models.BoardMember{}, members.ErrInvalidRole
+	}
+
+	_, err := uc.repo.Detail(ctx, sc, repository.DetailOptions{
+		BoardID: ip.BoardID,
+		UserID:  sc.UserID,
+	})
+	if err == nil {
+		return models.BoardMember{}, members.ErrAlreadyMember
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.members.usecase.Join.repo.Detail: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	m, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		BoardID: ip.BoardID,
+		UserID:  sc.UserID,
+		Role:    ip.Role,
+		AddedBy: sc.UserID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.Join.repo.Create: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	return m, nil
+}
+
+func (uc implUsecase) List(ctx context.Context, sc models.Scope, boardID string) (members.ListOutput, error) {
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleObserver}); err != nil {
+		return members.ListOutput{}, err
+	}
+
+	ms, err := uc.repo.List(ctx, sc, repository.ListOptions{BoardID: boardID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.List.repo.List: %v", err)
+		return members.ListOutput{}, err
+	}
+
+	uIDs := make([]string, len(ms))
+	for i, m := range ms {
+		uIDs[i] = m.UserID
+	}
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: uIDs,
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.List.userUC.List: %v", err)
+		return members.ListOutput{}, err
+	}
+
+	return members.ListOutput{
+		Members: ms,
+		Users:   us,
+	}, nil
+}
+
+func (uc implUsecase) Add(ctx context.Context, sc models.Scope, ip members.AddInput) (members.DetailOutput, error) {
+	if ip.BoardID == "" || ip.UserID == "" {
+		return members.DetailOutput{}, members.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return members.DetailOutput{}, members.ErrInvalidRole
+	}
+
+	// Admins manage the members, only an owner can make another owner
+	minRole := models.BoardRoleAdmin
+	if ip.Role == models.BoardRoleOwner {
+		minRole = models.BoardRoleOwner
+	}
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: minRole}); err != nil {
+		return members.DetailOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, ip.UserID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.Add.userUC.Detail.NotFound: %v", err)
+			return members.DetailOutput{}, members.ErrUserNotFound
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.Add.userUC.Detail: %v", err)
+		return members.DetailOutput{}, err
+	}
+
+	_, err = uc.repo.Detail(ctx, sc, repository.DetailOptions{
+		BoardID: ip.BoardID,
+		UserID:  ip.UserID,
+	})
+	if err == nil {
+		return members.DetailOutput{}, members.ErrAlreadyMember
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.members.usecase.Add.repo.Detail: %v", err)
+		return members.DetailOutput{}, err
+	}
+
+	m, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		BoardID: ip.BoardID,
+		UserID:  ip.UserID,
+		Role:    ip.Role,
+		AddedBy: sc.UserID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.Add.repo.Create: %v", err)
+		return members.DetailOutput{}, err
+	}
+
+	if err := uc.broadcastMemberEvent(ctx, sc, m.BoardID, websocket.MSG_MEMBER_JOINED, m); err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.Add.broadcastMemberEvent: %v", err)
+	}
+
+	return members.DetailOutput{
+		Member: m,
+		User:   u.User,
+	}, nil
+}
+
+func (uc implUsecase) UpdateRole(ctx context.Context, sc models.Scope, ip members.UpdateRoleInput) (members.DetailOutput, error) {
+	if ip.BoardID == "" || ip.UserID == "" {
+		return members.DetailOutput{}, members.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return members.DetailOutput{}, members.ErrInvalidRole
+	}
+
+	om, err := uc.detail(ctx, sc, ip.BoardID, ip.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.members.usecase.UpdateRole.detail: %v", err)
+		return members.DetailOutput{}, err
+	}
+
+	// Granting or taking away ownership is left to the owners
+	minRole := models.BoardRoleAdmin
+	if om.Role == models.BoardRoleOwner || ip.Role == models.BoardRoleOwner {
+		minRole = models.BoardRoleOwner
+	}
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: minRole}); err != nil {
+		return members.DetailOutput{}, err
+	}
+
+	if om.Role == models.BoardRoleOwner && ip.Role != models.BoardRoleOwner {
+		if err := uc.checkOtherOwner(ctx, sc, ip.BoardID); err != nil {
+			uc.l.Warnf(ctx, "internal.members.usecase.UpdateRole.checkOtherOwner: %v", err)
+			return members.DetailOutput{}, err
+		}
+	}
+
+	m, err := uc.repo.UpdateRole(ctx, sc, repository.UpdateRoleOptions{
+		ID:   om.ID,
+		Role: ip.Role,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.UpdateRole.repo.UpdateRole.NotFound: %v", err)
+			return members.DetailOutput{}, members.ErrMemberNotFound
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.UpdateRole.repo.UpdateRole: %v", err)
+		return members.DetailOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, m.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.UpdateRole.userUC.Detail: %v", err)
+		return members.DetailOutput{}, err
+	}
+
+	return members.DetailOutput{
+		Member: m,
+		User:   u.User,
+	}, nil
+}
+
+func (uc implUsecase) Remove(ctx context.Context, sc models.Scope, ip members.RemoveInput) error {
+	if ip.BoardID == "" || ip.UserID == "" {
+		return members.ErrFieldRequired
+	}
+
+	om, err := uc.detail(ctx, sc, ip.BoardID, ip.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.members.usecase.Remove.detail: %v", err)
+		return err
+	}
+
+	// Anyone can leave a board, removing somebody else takes an admin, or an
+	// owner when the member is an owner
+	if ip.UserID != sc.UserID {
+		minRole := models.BoardRoleAdmin
+		if om.Role == models.BoardRoleOwner {
+			minRole = models.BoardRoleOwner
+		}
+		if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: minRole}); err != nil {
+			return err
+		}
+	}
+
+	if om.Role == models.BoardRoleOwner {
+		if err := uc.checkOtherOwner(ctx, sc, ip.BoardID); err != nil {
+			uc.l.Warnf(ctx, "internal.members.usecase.Remove.checkOtherOwner: %v", err)
+			return err
+		}
+	}
+
+	if err := uc.repo.Delete(ctx, sc, om.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.members.usecase.Remove.repo.Delete.NotFound: %v", err)
+			return members.ErrMemberNotFound
+		}
+		uc.l.Errorf(ctx, "internal.members.usecase.Remove.repo.Delete: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// detail returns the membership of the user, ErrMemberNotFound is only
+// returned to callers that can see the board.
+func (uc implUsecase) detail(ctx context.Context, sc models.Scope, boardID, userID string) (models.BoardMember, error) {
+	m, err := uc.repo.Detail(ctx, sc, repository.DetailOptions{
+		BoardID: boardID,
+		UserID:  userID,
+	})
+	if err == nil {
+		return m, nil
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.members.usecase.detail.repo.Detail: %v", err)
+		return models.BoardMember{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleObserver}); err != nil {
+		return models.BoardMember{}, err
+	}
+	return models.BoardMember{}, members.ErrMemberNotFound
+}
+
+// checkOtherOwner returns ErrLastOwner when the board has a single owner.
+func (uc implUsecase) checkOtherOwner(ctx context.Context, sc models.Scope, boardID string) error {
+	n, err := uc.repo.Count(ctx, sc, repository.CountOptions{
+		BoardID: boardID,
+		Role:    models.BoardRoleOwner,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.members.usecase.checkOtherOwner.repo.Count: %v", err)
+		return err
+	}
+	if n <= 1 {
+		return members.ErrLastOwner
+	}
+
+	return nil
+}
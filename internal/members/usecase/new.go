@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/websocket/service"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+type implUsecase struct {
+	l      log.Logger
+	repo   repository.Repository
+	wsHub  *service.Hub
+	userUC user.UseCase
+	roleUC role.UseCase
+	clock  func() time.Time
+}
+
+var _ members.UseCase = &implUsecase{}
+
+func New(l log.Logger, repo repository.Repository, wsHub *service.Hub, userUC user.UseCase, roleUC role.UseCase) members.UseCase {
+	return &implUsecase{
+		l:      l,
+		repo:   repo,
+		wsHub:  wsHub,
+		userUC: userUC,
+		roleUC: roleUC,
+		clock:  util.Now,
+	}
+}
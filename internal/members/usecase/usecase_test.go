@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+)
+
+// fakeRepo keeps the board access rows in memory. Calling a method it does
+// not implement panics through the nil embedded interface.
+type fakeRepo struct {
+	repository.Repository
+
+	// access are the rows of the board_access view by board and user, the
+	// direct memberships and the roles granted through teams and workspaces
+	access map[string]map[string][]models.BoardRole
+	public map[string]bool
+	states map[string]repository.BoardState
+}
+
+func (r *fakeRepo) ListRoles(ctx context.Context, sc models.Scope, opts repository.DetailOptions) ([]models.BoardRole, error) {
+	roles := append([]models.BoardRole(nil), r.access[opts.BoardID][opts.UserID]...)
+	if r.public[opts.BoardID] {
+		roles = append(roles, models.BoardRoleObserver)
+	}
+	return roles, nil
+}
+
+func (r *fakeRepo) DetailBoardState(ctx context.Context, sc models.Scope, boardID string) (repository.BoardState, error) {
+	st, ok := r.states[boardID]
+	if !ok {
+		return repository.BoardState{}, repository.ErrNotFound
+	}
+	return st, nil
+}
+
+// fakeRoleUC grants the permissions it holds to every user.
+type fakeRoleUC struct {
+	role.UseCase
+
+	permissions []string
+}
+
+func (u fakeRoleUC) HasPermission(ctx context.Context, sc models.Scope, permissions ...string) (bool, error) {
+	for _, p := range permissions {
+		found := false
+		for _, granted := range u.permissions {
+			if granted == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type mockDeps struct {
+	repo   *fakeRepo
+	roleUC *fakeRoleUC
+}
+
+func initUseCase(t *testing.T, mockTime time.Time) (*implUsecase, mockDeps) {
+	t.Helper()
+
+	repo := &fakeRepo{
+		access: make(map[string]map[string][]models.BoardRole),
+		public: make(map[string]bool),
+		states: make(map[string]repository.BoardState),
+	}
+	roleUC := &fakeRoleUC{}
+
+	uc := &implUsecase{
+		l:      log.InitializeTestZapLogger(),
+		repo:   repo,
+		roleUC: roleUC,
+		clock:  func() time.Time { return mockTime },
+	}
+
+	return uc, mockDeps{
+		repo:   repo,
+		roleUC: roleUC,
+	}
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// invitationTokenBytes is the entropy of an invitation token.
+const invitationTokenBytes = 32
+
+// hashToken returns the digest stored in place of a token.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// broadcastMemberEvent sends the event with the version of the board. The
+// version is read once the change is saved, so it is never lower than the
+// version the change produced. The event is still sent without it.
+func (uc implUsecase) broadcastMemberEvent(ctx context.Context, sc models.Scope, boardID, eventType string, data interface{}) error {
+	st, err := uc.repo.DetailBoardState(ctx, sc, boardID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.members.usecase.broadcastMemberEvent.repo.DetailBoardState: %v", err)
+	}
+
+	return uc.wsHub.BroadcastToBoardWithVersion(ctx, boardID, eventType, data, sc.UserID, st.Version)
+}
+
+// generateToken returns a random URL-safe token of n bytes of entropy.
+func generateToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
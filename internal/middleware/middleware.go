@@ -1,10 +1,15 @@
 package middleware
 
 import (
+	"context"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/pkg/metrics"
 	"github.com/nguyentantai21042004/kanban-api/pkg/response"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
@@ -19,14 +24,25 @@ func (m Middleware) Auth() gin.HandlerFunc {
 			return
 		}
 
+		if strings.HasPrefix(tokenString, auth.PersonalAccessTokenPrefix) {
+			m.personalAccessTokenAuth(c, tokenString)
+			return
+		}
+
 		payload, err := m.jwtManager.Verify(tokenString)
-		if err != nil {
+		if err != nil || payload.Refresh || payload.Type == scope.TokenTypeMFAPending {
 			response.Unauthorized(c)
 			c.Abort()
 			return
 		}
 
 		ctx := c.Request.Context()
+		if m.isRevoked(ctx, payload) {
+			response.Unauthorized(c)
+			c.Abort()
+			return
+		}
+
 		ctx = scope.SetPayloadToContext(ctx, payload)
 		c.Request = c.Request.WithContext(ctx)
 
@@ -34,6 +50,66 @@ func (m Middleware) Auth() gin.HandlerFunc {
 	}
 }
 
+// personalAccessTokenAuth authenticates the request with a personal access
+// token. Read-only tokens are limited to safe methods.
+func (m Middleware) personalAccessTokenAuth(c *gin.Context, token string) {
+	ctx := c.Request.Context()
+
+	o, err := m.authUC.AuthenticatePersonalAccessToken(ctx, token)
+	if err != nil {
+		m.l.Warnf(ctx, "internal.middleware.personalAccessTokenAuth.authUC.AuthenticatePersonalAccessToken: %v", err)
+		response.Unauthorized(c)
+		c.Abort()
+		return
+	}
+
+	if o.Scope.TokenScope != models.TokenScopeWrite && !isSafeMethod(c.Request.Method) {
+		response.Forbidden(c)
+		c.Abort()
+		return
+	}
+
+	ctx = scope.SetPayloadToContext(ctx, scope.Payload{
+		StandardClaims: jwt.StandardClaims{
+			Id:      o.TokenID,
+			Subject: o.Scope.UserID,
+		},
+		UserID:     o.Scope.UserID,
+		Username:   o.Scope.Username,
+		Type:       scope.TokenTypePersonal,
+		TokenScope: o.Scope.TokenScope,
+	})
+	c.Request = c.Request.WithContext(ctx)
+
+	c.Next()
+}
+
+func isSafeMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRevoked reports whether the token was revoked by logout or an admin.
+// Lookup failures are treated as revoked so an outage never lets tokens through.
+func (m Middleware) isRevoked(ctx context.Context, payload scope.Payload) bool {
+	revoked, err := m.authUC.IsTokenRevoked(ctx, auth.IsTokenRevokedInput{
+		TokenID:   payload.Id,
+		UserID:    payload.UserID,
+		SessionID: payload.SessionID,
+		IssuedAt:  time.Unix(payload.IssuedAt, 0),
+	})
+	if err != nil {
+		m.l.Errorf(ctx, "internal.middleware.isRevoked.authUC.IsTokenRevoked: %v", err)
+		return true
+	}
+
+	return revoked
+}
+
 // Metrics records simple per-request timing and uptime proxy
 func (m Middleware) Metrics() gin.HandlerFunc {
 	return func(c *gin.Context) {
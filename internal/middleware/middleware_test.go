@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuthUC answers the revocation lookups of the middleware. Calling a
+// method it does not implement panics through the nil embedded interface.
+type fakeAuthUC struct {
+	auth.UseCase
+
+	revoked map[string]bool
+	err     error
+}
+
+func (u fakeAuthUC) IsTokenRevoked(ctx context.Context, ip auth.IsTokenRevokedInput) (bool, error) {
+	if u.err != nil {
+		return false, u.err
+	}
+	return u.revoked[ip.TokenID], nil
+}
+
+func newTestToken(t *testing.T, jwtManager scope.Manager, jti, tokenType string) string {
+	t.Helper()
+
+	now := time.Now()
+	token, err := jwtManager.CreateToken(scope.Payload{
+		StandardClaims: jwt.StandardClaims{
+			Id:        jti,
+			ExpiresAt: now.Add(time.Minute).Unix(),
+			IssuedAt:  now.Unix(),
+			Subject:   "user-1",
+		},
+		UserID:  "user-1",
+		Type:    tokenType,
+		Refresh: tokenType == scope.TokenTypeRefresh,
+	})
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestAuth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtManager := scope.New("test-secret")
+
+	tcs := map[string]struct {
+		token    string
+		authUC   fakeAuthUC
+		wantCode int
+	}{
+		"access token": {
+			token:    newTestToken(t, jwtManager, "jti-1", scope.TokenTypeAccess),
+			wantCode: http.StatusOK,
+		},
+		"no token": {
+			wantCode: http.StatusUnauthorized,
+		},
+		"revoked token": {
+			token:    newTestToken(t, jwtManager, "jti-1", scope.TokenTypeAccess),
+			authUC:   fakeAuthUC{revoked: map[string]bool{"jti-1": true}},
+			wantCode: http.StatusUnauthorized,
+		},
+		"revocation store error": {
+			token:    newTestToken(t, jwtManager, "jti-1", scope.TokenTypeAccess),
+			authUC:   fakeAuthUC{err: errors.New("connection refused")},
+			wantCode: http.StatusUnauthorized,
+		},
+		"refresh token": {
+			token:    newTestToken(t, jwtManager, "jti-1", scope.TokenTypeRefresh),
+			wantCode: http.StatusUnauthorized,
+		},
+		"mfa pending token": {
+			token:    newTestToken(t, jwtManager, "jti-1", scope.TokenTypeMFAPending),
+			wantCode: http.StatusUnauthorized,
+		},
+		"token signed with another key": {
+			token:    newTestToken(t, scope.New("other-secret"), "jti-1", scope.TokenTypeAccess),
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			m := New(log.InitializeTestZapLogger(), jwtManager, tc.authUC, nil, nil)
+
+			r := gin.New()
+			r.GET("/", m.Auth(), func(c *gin.Context) {
+				p, ok := scope.GetPayloadFromContext(c.Request.Context())
+				assert.True(t, ok)
+				assert.Equal(t, "user-1", p.UserID)
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tc.token)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
@@ -1,18 +1,27 @@
 package middleware
 
 import (
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
 	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
 	pkgScope "github.com/nguyentantai21042004/kanban-api/pkg/scope"
 )
 
 type Middleware struct {
 	l          pkgLog.Logger
 	jwtManager pkgScope.Manager
+	authUC     auth.UseCase
+	roleUC     role.UseCase
+	limiter    ratelimit.Limiter
 }
 
-func New(l pkgLog.Logger, jwtManager pkgScope.Manager) Middleware {
+func New(l pkgLog.Logger, jwtManager pkgScope.Manager, authUC auth.UseCase, roleUC role.UseCase, limiter ratelimit.Limiter) Middleware {
 	return Middleware{
 		l:          l,
 		jwtManager: jwtManager,
+		authUC:     authUC,
+		roleUC:     roleUC,
+		limiter:    limiter,
 	}
 }
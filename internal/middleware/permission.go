@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+)
+
+// RequirePermission lets the request through only when the role of the user
+// grants all the permissions. It has to run after Auth.
+func (m Middleware) RequirePermission(permissions ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		p, ok := scope.GetPayloadFromContext(ctx)
+		if !ok {
+			response.Unauthorized(c)
+			c.Abort()
+			return
+		}
+
+		if err := m.roleUC.Authorize(ctx, scope.NewScope(p), permissions...); err != nil {
+			if err == role.ErrForbidden {
+				m.l.Warnf(ctx, "internal.middleware.RequirePermission.roleUC.Authorize: %v", err)
+				response.Forbidden(c)
+			} else {
+				m.l.Errorf(ctx, "internal.middleware.RequirePermission.roleUC.Authorize: %v", err)
+				response.Error(c, err, nil)
+			}
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
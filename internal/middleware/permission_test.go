@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeRoleUC grants the permissions it holds to every user.
+type fakeRoleUC struct {
+	role.UseCase
+
+	permissions []string
+	err         error
+}
+
+func (u fakeRoleUC) Authorize(ctx context.Context, sc models.Scope, permissions ...string) error {
+	if u.err != nil {
+		return u.err
+	}
+	for _, p := range permissions {
+		found := false
+		for _, granted := range u.permissions {
+			if granted == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return role.ErrForbidden
+		}
+	}
+	return nil
+}
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tcs := map[string]struct {
+		noAuth   bool
+		roleUC   fakeRoleUC
+		required []string
+		wantCode int
+	}{
+		"granted": {
+			roleUC:   fakeRoleUC{permissions: []string{models.PermissionUserManage}},
+			required: []string{models.PermissionUserManage},
+			wantCode: http.StatusOK,
+		},
+		"all granted": {
+			roleUC:   fakeRoleUC{permissions: []string{models.PermissionUserManage, models.PermissionRoleManage}},
+			required: []string{models.PermissionUserManage, models.PermissionRoleManage},
+			wantCode: http.StatusOK,
+		},
+		"one missing": {
+			roleUC:   fakeRoleUC{permissions: []string{models.PermissionUserManage}},
+			required: []string{models.PermissionUserManage, models.PermissionRoleManage},
+			wantCode: http.StatusForbidden,
+		},
+		"none granted": {
+			required: []string{models.PermissionUserManage},
+			wantCode: http.StatusForbidden,
+		},
+		"role lookup error": {
+			roleUC:   fakeRoleUC{err: errors.New("connection refused")},
+			required: []string{models.PermissionUserManage},
+			wantCode: http.StatusInternalServerError,
+		},
+		"not authenticated": {
+			noAuth:   true,
+			roleUC:   fakeRoleUC{permissions: []string{models.PermissionUserManage}},
+			required: []string{models.PermissionUserManage},
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			m := New(log.InitializeTestZapLogger(), nil, nil, tc.roleUC, nil)
+
+			r := gin.New()
+			r.GET("/", func(c *gin.Context) {
+				if !tc.noAuth {
+					ctx := scope.SetPayloadToContext(c.Request.Context(), scope.Payload{UserID: "user-1"})
+					c.Request = c.Request.WithContext(ctx)
+				}
+				c.Next()
+			}, m.RequirePermission(tc.required...), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tc.wantCode, w.Code)
+		})
+	}
+}
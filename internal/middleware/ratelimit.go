@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/ratelimit"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+)
+
+// RateLimitPolicy limits the requests of a route group. Name separates the
+// counters of different policies; Key picks what is counted and defaults to
+// KeyByIP.
+type RateLimitPolicy struct {
+	Name   string
+	Limit  int
+	Window time.Duration
+	Key    func(c *gin.Context) string
+}
+
+// KeyByIP counts requests per client IP.
+func KeyByIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// KeyByUser counts requests per authenticated user and falls back to the
+// client IP, so it has to run after Auth to be per user.
+func KeyByUser(c *gin.Context) string {
+	if p, ok := scope.GetPayloadFromContext(c.Request.Context()); ok {
+		return p.UserID
+	}
+	return c.ClientIP()
+}
+
+func (m Middleware) RateLimit(p RateLimitPolicy) gin.HandlerFunc {
+	key := p.Key
+	if key == nil {
+		key = KeyByIP
+	}
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		res, err := m.limiter.Allow(ctx, p.Name+":"+key(c), ratelimit.Policy{
+			Limit:  p.Limit,
+			Window: p.Window,
+		})
+		if err != nil {
+			// Do not take the API down with the limiter.
+			m.l.Errorf(ctx, "internal.middleware.RateLimit.limiter.Allow: %v", err)
+			c.Next()
+			return
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(p.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		if !res.Allowed {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(res.RetryAfter.Seconds()))))
+			response.TooManyRequests(c)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
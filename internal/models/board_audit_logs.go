@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type BoardAuditAction string
+
+const (
+	BoardAuditArchived   BoardAuditAction = "archived"
+	BoardAuditUnarchived BoardAuditAction = "unarchived"
+	BoardAuditDeleted    BoardAuditAction = "deleted"
+	BoardAuditRestored   BoardAuditAction = "restored"
+	BoardAuditPurged     BoardAuditAction = "purged"
+)
+
+// BoardAuditLog records a change in the lifecycle of a board. The logs are
+// kept after the board is purged.
+type BoardAuditLog struct {
+	ID        string           `json:"id"`
+	BoardID   string           `json:"board_id"`
+	BoardName string           `json:"board_name"`
+	Action    BoardAuditAction `json:"action"`
+	UserID    *string          `json:"user_id,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+func NewBoardAuditLog(dbLog dbmodels.BoardAuditLog) BoardAuditLog {
+	return BoardAuditLog{
+		ID:        dbLog.ID,
+		BoardID:   dbLog.BoardID,
+		BoardName: dbLog.BoardName,
+		Action:    BoardAuditAction(dbLog.Action),
+		UserID:    dbLog.UserID.Ptr(),
+		CreatedAt: dbLog.CreatedAt,
+	}
+}
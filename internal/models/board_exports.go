@@ -0,0 +1,101 @@
+package models
+
+import "time"
+
+const (
+	// BoardExportFormat names the documents of board exports
+	BoardExportFormat = "kanban-api/board"
+	// BoardExportVersion is the version of the document written by exports,
+	// imports read it and the versions before it
+	BoardExportVersion = 1
+)
+
+// BoardExport is the self-describing document of a board export. Its items
+// keep the IDs they had when exported, the items refer to each other by these
+// IDs and to users by username.
+type BoardExport struct {
+	Format      string             `json:"format"`
+	Version     int                `json:"version"`
+	ExportedAt  time.Time          `json:"exported_at"`
+	Board       ExportBoard        `json:"board"`
+	Lists       []ExportList       `json:"lists"`
+	Labels      []ExportLabel      `json:"labels"`
+	Cards       []ExportCard       `json:"cards"`
+	Comments    []ExportComment    `json:"comments"`
+	Activities  []ExportActivity   `json:"activities"`
+	Attachments []ExportAttachment `json:"attachments"`
+}
+
+type ExportBoard struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	CreatedBy   string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type ExportList struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Position   string `json:"position"`
+	IsArchived bool   `json:"is_archived"`
+}
+
+type ExportLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// ExportCard refers to its labels by ID, and to its attachments by the ID of
+// their upload or by their link.
+type ExportCard struct {
+	ID             string                `json:"id"`
+	ListID         string                `json:"list_id"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description,omitempty"`
+	Position       string                `json:"position"`
+	Priority       CardPriority          `json:"priority"`
+	Labels         []string              `json:"labels,omitempty"`
+	Tags           []string              `json:"tags,omitempty"`
+	Checklist      []ExportChecklistItem `json:"checklist,omitempty"`
+	Attachments    []string              `json:"attachments,omitempty"`
+	DueDate        *time.Time            `json:"due_date,omitempty"`
+	StartDate      *time.Time            `json:"start_date,omitempty"`
+	CompletionDate *time.Time            `json:"completion_date,omitempty"`
+	AssignedTo     string                `json:"assigned_to,omitempty"`
+	CreatedBy      string                `json:"created_by,omitempty"`
+	IsArchived     bool                  `json:"is_archived"`
+	CreatedAt      time.Time             `json:"created_at"`
+}
+
+type ExportChecklistItem struct {
+	Content     string `json:"content"`
+	IsCompleted bool   `json:"is_completed"`
+}
+
+type ExportComment struct {
+	ID        string    `json:"id"`
+	CardID    string    `json:"card_id"`
+	ParentID  string    `json:"parent_id,omitempty"`
+	User      string    `json:"user"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExportActivity struct {
+	CardID     string                 `json:"card_id"`
+	ActionType CardActionType         `json:"action_type"`
+	OldData    map[string]interface{} `json:"old_data,omitempty"`
+	NewData    map[string]interface{} `json:"new_data,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// ExportAttachment describes an uploaded file, the file itself is not part
+// of the document.
+type ExportAttachment struct {
+	ID           string `json:"id"`
+	OriginalName string `json:"original_name"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	URL          string `json:"url,omitempty"`
+}
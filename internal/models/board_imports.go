@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type BoardImportSource string
+
+const (
+	BoardImportSourceTrello BoardImportSource = "trello"
+)
+
+type BoardImportStatus string
+
+const (
+	BoardImportPending   BoardImportStatus = "pending"
+	BoardImportRunning   BoardImportStatus = "running"
+	BoardImportCompleted BoardImportStatus = "completed"
+	BoardImportFailed    BoardImportStatus = "failed"
+)
+
+// BoardImport follows the import of a board from another tool, the board is
+// set once the import is completed.
+type BoardImport struct {
+	ID           string            `json:"id"`
+	Source       BoardImportSource `json:"source"`
+	Status       BoardImportStatus `json:"status"`
+	WorkspaceID  *string           `json:"workspace_id,omitempty"`
+	BoardID      *string           `json:"board_id,omitempty"`
+	Report       BoardImportReport `json:"report"`
+	ErrorMessage *string           `json:"error_message,omitempty"`
+	CreatedBy    string            `json:"created_by"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+	CompletedAt  *time.Time        `json:"completed_at,omitempty"`
+}
+
+// BoardImportReport lists the items of the source board left out of the
+// imported board.
+type BoardImportReport struct {
+	Skipped []BoardImportSkip `json:"skipped"`
+}
+
+type BoardImportSkip struct {
+	Kind   string `json:"kind"`
+	ID     string `json:"id"`
+	Reason string `json:"reason"`
+}
+
+func NewBoardImport(dbImport dbmodels.BoardImport) BoardImport {
+	var report BoardImportReport
+	if dbImport.Report.Valid {
+		_ = json.Unmarshal(dbImport.Report.JSON, &report)
+	}
+
+	return BoardImport{
+		ID:           dbImport.ID,
+		Source:       BoardImportSource(dbImport.Source),
+		Status:       BoardImportStatus(dbImport.Status),
+		WorkspaceID:  dbImport.WorkspaceID.Ptr(),
+		BoardID:      dbImport.BoardID.Ptr(),
+		Report:       report,
+		ErrorMessage: dbImport.ErrorMessage.Ptr(),
+		CreatedBy:    dbImport.CreatedBy,
+		CreatedAt:    dbImport.CreatedAt,
+		UpdatedAt:    dbImport.UpdatedAt,
+		CompletedAt:  dbImport.CompletedAt.Ptr(),
+	}
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// BoardInvitation lets users join a board with a role. An invitation sent to
+// a user can only be accepted by that user, a join link by anyone holding the
+// token.
+type BoardInvitation struct {
+	ID        string     `json:"id"`
+	BoardID   string     `json:"board_id"`
+	TokenHash string     `json:"-"`
+	Role      BoardRole  `json:"role"`
+	InviteeID *string    `json:"invitee_id,omitempty"`
+	MaxUses   *int       `json:"max_uses,omitempty"`
+	UseCount  int        `json:"use_count"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedBy string     `json:"created_by"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func NewBoardInvitation(dbInvitation dbmodels.BoardInvitation) BoardInvitation {
+	return BoardInvitation{
+		ID:        dbInvitation.ID,
+		BoardID:   dbInvitation.BoardID,
+		TokenHash: dbInvitation.TokenHash,
+		Role:      BoardRole(dbInvitation.Role),
+		InviteeID: dbInvitation.InviteeID.Ptr(),
+		MaxUses:   dbInvitation.MaxUses.Ptr(),
+		UseCount:  dbInvitation.UseCount,
+		ExpiresAt: dbInvitation.ExpiresAt,
+		RevokedAt: dbInvitation.RevokedAt.Ptr(),
+		CreatedBy: dbInvitation.CreatedBy,
+		CreatedAt: dbInvitation.CreatedAt,
+		UpdatedAt: dbInvitation.UpdatedAt,
+	}
+}
+
+// IsUsable reports whether the invitation can still be accepted at the given time.
+func (i BoardInvitation) IsUsable(now time.Time) bool {
+	if i.RevokedAt != nil || !now.Before(i.ExpiresAt) {
+		return false
+	}
+
+	return i.MaxUses == nil || i.UseCount < *i.MaxUses
+}
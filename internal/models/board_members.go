@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type BoardRole string
+
+const (
+	BoardRoleOwner    BoardRole = "owner"
+	BoardRoleAdmin    BoardRole = "admin"
+	BoardRoleMember   BoardRole = "member"
+	BoardRoleObserver BoardRole = "observer"
+)
+
+// boardRoleRanks orders the roles, each role can do everything the lower ones can.
+var boardRoleRanks = map[BoardRole]int{
+	BoardRoleObserver: 1,
+	BoardRoleMember:   2,
+	BoardRoleAdmin:    3,
+	BoardRoleOwner:    4,
+}
+
+// IsValid reports whether the role is one of the board roles.
+func (r BoardRole) IsValid() bool {
+	_, ok := boardRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether the role grants everything the other role does.
+func (r BoardRole) AtLeast(other BoardRole) bool {
+	return r.IsValid() && boardRoleRanks[r] >= boardRoleRanks[other]
+}
+
+// BoardMember gives a user access to a board.
+type BoardMember struct {
+	ID        string    `json:"id"`
+	BoardID   string    `json:"board_id"`
+	UserID    string    `json:"user_id"`
+	Role      BoardRole `json:"role"`
+	AddedBy   *string   `json:"added_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewBoardMember(dbMember dbmodels.BoardMember) BoardMember {
+	return BoardMember{
+		ID:        dbMember.ID,
+		BoardID:   dbMember.BoardID,
+		UserID:    dbMember.UserID,
+		Role:      BoardRole(dbMember.Role),
+		AddedBy:   dbMember.AddedBy.Ptr(),
+		CreatedAt: dbMember.CreatedAt,
+		UpdatedAt: dbMember.UpdatedAt,
+	}
+}
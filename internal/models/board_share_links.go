@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// BoardShareLink lets anyone holding the token view the board read-only,
+// without an account. A board has at most one link.
+type BoardShareLink struct {
+	ID        string     `json:"id"`
+	BoardID   string     `json:"board_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedBy *string    `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func NewBoardShareLink(dbLink dbmodels.BoardShareLink) BoardShareLink {
+	return BoardShareLink{
+		ID:        dbLink.ID,
+		BoardID:   dbLink.BoardID,
+		TokenHash: dbLink.TokenHash,
+		ExpiresAt: dbLink.ExpiresAt.Ptr(),
+		CreatedBy: dbLink.CreatedBy.Ptr(),
+		CreatedAt: dbLink.CreatedAt,
+		UpdatedAt: dbLink.UpdatedAt,
+	}
+}
+
+// IsUsable reports whether the link still opens the board at the given time.
+func (l BoardShareLink) IsUsable(now time.Time) bool {
+	return l.ExpiresAt == nil || now.Before(*l.ExpiresAt)
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// BoardTemplate holds the lists, labels and sample cards a new board starts
+// with. Built-in templates are shared with everyone, the others with their
+// creator or with the members of their workspace.
+type BoardTemplate struct {
+	ID          string               `json:"id"`
+	Key         *string              `json:"key,omitempty"`
+	Name        string               `json:"name"`
+	Description *string              `json:"description,omitempty"`
+	Content     BoardTemplateContent `json:"content"`
+	IsBuiltin   bool                 `json:"is_builtin"`
+	WorkspaceID *string              `json:"workspace_id,omitempty"`
+	CreatedBy   *string              `json:"created_by,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+	DeletedAt   *time.Time           `json:"deleted_at,omitempty"`
+}
+
+type BoardTemplateContent struct {
+	Lists  []TemplateList  `json:"lists"`
+	Labels []TemplateLabel `json:"labels,omitempty"`
+}
+
+type TemplateList struct {
+	Name  string         `json:"name"`
+	Cards []TemplateCard `json:"cards,omitempty"`
+}
+
+type TemplateLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// TemplateCard refers to the labels of the template by name, the checklist
+// holds the content of the items.
+type TemplateCard struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description,omitempty"`
+	Priority    CardPriority `json:"priority,omitempty"`
+	Labels      []string     `json:"labels,omitempty"`
+	Checklist   []string     `json:"checklist,omitempty"`
+}
+
+func NewBoardTemplate(dbTemplate dbmodels.BoardTemplate) BoardTemplate {
+	var content BoardTemplateContent
+	_ = json.Unmarshal(dbTemplate.Content, &content)
+
+	return BoardTemplate{
+		ID:          dbTemplate.ID,
+		Key:         dbTemplate.Key.Ptr(),
+		Name:        dbTemplate.Name,
+		Description: dbTemplate.Description.Ptr(),
+		Content:     content,
+		IsBuiltin:   dbTemplate.IsBuiltin,
+		WorkspaceID: dbTemplate.WorkspaceID.Ptr(),
+		CreatedBy:   dbTemplate.CreatedBy.Ptr(),
+		CreatedAt:   dbTemplate.CreatedAt,
+		UpdatedAt:   dbTemplate.UpdatedAt,
+		DeletedAt:   dbTemplate.DeletedAt.Ptr(),
+	}
+}
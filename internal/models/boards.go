@@ -1,33 +1,99 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
 )
 
 type Board struct {
-	ID          string     `json:"id"`
-	Name        string     `json:"name"`
-	Alias       string     `json:"alias"`
-	Description *string    `json:"description,omitempty"`
-	CreatedBy   *string    `json:"created_by,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	ID          string        `json:"id"`
+	Name        string        `json:"name"`
+	Alias       string        `json:"alias"`
+	Description *string       `json:"description,omitempty"`
+	WorkspaceID *string       `json:"workspace_id,omitempty"`
+	CreatedBy   *string       `json:"created_by,omitempty"`
+	Version     int64         `json:"version"`
+	ArchivedAt  *time.Time    `json:"archived_at,omitempty"`
+	ArchivedBy  *string       `json:"archived_by,omitempty"`
+	Settings    BoardSettings `json:"settings"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+	DeletedAt   *time.Time    `json:"deleted_at,omitempty"`
+	DeletedBy   *string       `json:"deleted_by,omitempty"`
+}
+
+type BoardVisibility string
+
+const (
+	// BoardVisibilityPrivate opens the board to its members only
+	BoardVisibilityPrivate BoardVisibility = "private"
+	// BoardVisibilityWorkspace lets the members of its workspace read it
+	BoardVisibilityWorkspace BoardVisibility = "workspace"
+	// BoardVisibilityPublic lets every user read it
+	BoardVisibilityPublic BoardVisibility = "public"
+)
+
+func (v BoardVisibility) IsValid() bool {
+	switch v {
+	case BoardVisibilityPrivate, BoardVisibilityWorkspace, BoardVisibilityPublic:
+		return true
+	default:
+		return false
+	}
+}
+
+// BoardSettings is the settings document of a board. The settings missing
+// from the stored document keep their default.
+type BoardSettings struct {
+	Visibility BoardVisibility `json:"visibility"`
+	// DefaultPriority is the priority of the cards created without one
+	DefaultPriority CardPriority `json:"default_priority"`
+	// CardKeyPrefix starts the keys of the cards of the board, like "PROJ"
+	CardKeyPrefix   string `json:"card_key_prefix,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	// BackgroundImage is the ID of the upload shown behind the board
+	BackgroundImage string `json:"background_image,omitempty"`
+	CommentsEnabled bool   `json:"comments_enabled"`
+}
+
+// DefaultBoardSettings returns the settings of a new board.
+func DefaultBoardSettings() BoardSettings {
+	return BoardSettings{
+		Visibility:      BoardVisibilityPrivate,
+		DefaultPriority: CardPriorityMedium,
+		CommentsEnabled: true,
+	}
 }
 
 func NewBoard(dbBoard dbmodels.Board) Board {
+	settings := DefaultBoardSettings()
+	if len(dbBoard.Settings) > 0 {
+		_ = json.Unmarshal(dbBoard.Settings, &settings)
+	}
+
 	board := Board{
 		ID:          dbBoard.ID,
 		Name:        dbBoard.Name,
 		Alias:       dbBoard.Alias.String,
 		Description: dbBoard.Description.Ptr(),
+		WorkspaceID: dbBoard.WorkspaceID.Ptr(),
 		CreatedBy:   dbBoard.CreatedBy.Ptr(),
+		Version:     dbBoard.Version,
+		ArchivedAt:  dbBoard.ArchivedAt.Ptr(),
+		ArchivedBy:  dbBoard.ArchivedBy.Ptr(),
+		Settings:    settings,
 		CreatedAt:   dbBoard.CreatedAt,
 		UpdatedAt:   dbBoard.UpdatedAt,
 		DeletedAt:   dbBoard.DeletedAt.Ptr(),
+		DeletedBy:   dbBoard.DeletedBy.Ptr(),
 	}
 
 	return board
 }
+
+// IsArchived reports whether the board is archived, and so read-only.
+func (b Board) IsArchived() bool {
+	return b.ArchivedAt != nil
+}
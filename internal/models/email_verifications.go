@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type EmailVerification struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	OTPHash    string     `json:"-"`
+	Attempts   int        `json:"attempts"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func NewEmailVerification(dbVerification dbmodels.EmailVerification) EmailVerification {
+	return EmailVerification{
+		ID:         dbVerification.ID,
+		UserID:     dbVerification.UserID,
+		OTPHash:    dbVerification.OtpHash,
+		Attempts:   dbVerification.Attempts,
+		ExpiresAt:  dbVerification.ExpiresAt,
+		VerifiedAt: dbVerification.VerifiedAt.Ptr(),
+		CreatedAt:  dbVerification.CreatedAt,
+		UpdatedAt:  dbVerification.UpdatedAt,
+	}
+}
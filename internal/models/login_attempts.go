@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// Reasons a login attempt was rejected.
+const (
+	LoginFailureInvalidPassword = "invalid_password"
+	LoginFailureInvalidMFACode  = "invalid_mfa_code"
+	LoginFailureUnknownUser     = "unknown_user"
+	LoginFailureLocked          = "locked"
+	LoginFailureRateLimited     = "rate_limited"
+)
+
+type LoginAttempt struct {
+	ID        string    `json:"id"`
+	UserID    *string   `json:"user_id,omitempty"`
+	Username  string    `json:"username"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewLoginAttempt(dbAttempt dbmodels.LoginAttempt) LoginAttempt {
+	return LoginAttempt{
+		ID:        dbAttempt.ID,
+		UserID:    dbAttempt.UserID.Ptr(),
+		Username:  dbAttempt.Username,
+		IPAddress: dbAttempt.IPAddress.String,
+		UserAgent: dbAttempt.UserAgent.String,
+		Reason:    dbAttempt.Reason,
+		CreatedAt: dbAttempt.CreatedAt,
+	}
+}
+
+type LoginLockout struct {
+	UserID         string     `json:"user_id"`
+	FailedAttempts int        `json:"failed_attempts"`
+	LockedUntil    *time.Time `json:"locked_until,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+func NewLoginLockout(dbLockout dbmodels.LoginLockout) LoginLockout {
+	return LoginLockout{
+		UserID:         dbLockout.UserID,
+		FailedAttempts: dbLockout.FailedAttempts,
+		LockedUntil:    dbLockout.LockedUntil.Ptr(),
+		CreatedAt:      dbLockout.CreatedAt,
+		UpdatedAt:      dbLockout.UpdatedAt,
+	}
+}
+
+// IsLocked reports whether logins are refused at the given time.
+func (l LoginLockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type PasswordReset struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func NewPasswordReset(dbReset dbmodels.PasswordReset) PasswordReset {
+	return PasswordReset{
+		ID:        dbReset.ID,
+		UserID:    dbReset.UserID,
+		TokenHash: dbReset.TokenHash,
+		ExpiresAt: dbReset.ExpiresAt,
+		UsedAt:    dbReset.UsedAt.Ptr(),
+		CreatedAt: dbReset.CreatedAt,
+		UpdatedAt: dbReset.UpdatedAt,
+	}
+}
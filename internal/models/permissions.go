@@ -0,0 +1,31 @@
+package models
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// Permissions granted to roles, see the permissions table.
+const (
+	PermissionBoardCreate        = "board.create"
+	PermissionBoardManage        = "board.manage"
+	PermissionWorkspaceCreate    = "workspace.create"
+	PermissionWorkspaceManage    = "workspace.manage"
+	PermissionTeamCreate         = "team.create"
+	PermissionTeamManage         = "team.manage"
+	PermissionUserManage         = "user.manage"
+	PermissionRoleManage         = "role.manage"
+	PermissionAdminDashboardView = "admin.dashboard.view"
+	PermissionAdminHealthView    = "admin.health.view"
+)
+
+type Permission struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+func NewPermission(p dbmodels.Permission) Permission {
+	return Permission{
+		Code:        p.Code,
+		Description: p.Description,
+	}
+}
@@ -0,0 +1,48 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// Scopes of a personal access token.
+const (
+	TokenScopeRead  = "read"
+	TokenScopeWrite = "write"
+)
+
+type PersonalAccessToken struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Name        string     `json:"name"`
+	TokenHash   string     `json:"-"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scope       string     `json:"scope"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func NewPersonalAccessToken(dbToken dbmodels.PersonalAccessToken) PersonalAccessToken {
+	return PersonalAccessToken{
+		ID:          dbToken.ID,
+		UserID:      dbToken.UserID,
+		Name:        dbToken.Name,
+		TokenHash:   dbToken.TokenHash,
+		TokenPrefix: dbToken.TokenPrefix,
+		Scope:       dbToken.Scope,
+		ExpiresAt:   dbToken.ExpiresAt.Ptr(),
+		LastUsedAt:  dbToken.LastUsedAt.Ptr(),
+		RevokedAt:   dbToken.RevokedAt.Ptr(),
+		CreatedAt:   dbToken.CreatedAt,
+		UpdatedAt:   dbToken.UpdatedAt,
+	}
+}
+
+// IsExpired reports whether the token can no longer be used at the given time.
+func (t PersonalAccessToken) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type RefreshToken struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	FamilyID  string     `json:"family_id"`
+	TokenHash string     `json:"-"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+func NewRefreshToken(dbToken dbmodels.RefreshToken) RefreshToken {
+	return RefreshToken{
+		ID:        dbToken.ID,
+		UserID:    dbToken.UserID,
+		FamilyID:  dbToken.FamilyID,
+		TokenHash: dbToken.TokenHash,
+		ExpiresAt: dbToken.ExpiresAt,
+		UsedAt:    dbToken.UsedAt.Ptr(),
+		RevokedAt: dbToken.RevokedAt.Ptr(),
+		CreatedAt: dbToken.CreatedAt,
+		UpdatedAt: dbToken.UpdatedAt,
+	}
+}
@@ -12,6 +12,8 @@ type Role struct {
 	Code        string     `json:"code"`
 	Alias       string     `json:"alias"`
 	Description string     `json:"description,omitempty"`
+	MFARequired bool       `json:"mfa_required"`
+	Permissions []string   `json:"permissions,omitempty"`
 	CreatedAt   time.Time  `json:"created_at,omitempty"`
 	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
 	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
@@ -24,6 +26,7 @@ func NewRole(r dbmodels.Role) Role {
 		Code:        r.Code,
 		Alias:       r.Alias,
 		Description: r.Description.String,
+		MFARequired: r.MfaRequired,
 		CreatedAt:   r.CreatedAt.Time,
 		UpdatedAt:   r.UpdatedAt.Time,
 		DeletedAt:   r.DeletedAt.Ptr(),
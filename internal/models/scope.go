@@ -3,4 +3,7 @@ package models
 type Scope struct {
 	UserID   string `json:"user_id"`
 	Username string `json:"username"`
+	// TokenScope is set when the request is authenticated with a personal
+	// access token (TokenScopeRead or TokenScopeWrite) and empty for a login session.
+	TokenScope string `json:"token_scope,omitempty"`
 }
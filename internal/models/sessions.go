@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type Session struct {
+	ID         string     `json:"id"`
+	UserID     string     `json:"user_id"`
+	UserAgent  string     `json:"user_agent"`
+	IPAddress  string     `json:"ip_address"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	LastUsedAt time.Time  `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+func NewSession(dbSession dbmodels.Session) Session {
+	return Session{
+		ID:         dbSession.ID,
+		UserID:     dbSession.UserID,
+		UserAgent:  dbSession.UserAgent.String,
+		IPAddress:  dbSession.IPAddress.String,
+		ExpiresAt:  dbSession.ExpiresAt,
+		LastUsedAt: dbSession.LastUsedAt,
+		RevokedAt:  dbSession.RevokedAt.Ptr(),
+		CreatedAt:  dbSession.CreatedAt,
+		UpdatedAt:  dbSession.UpdatedAt,
+	}
+}
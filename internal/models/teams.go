@@ -0,0 +1,103 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type Team struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Alias       string     `json:"alias"`
+	Description *string    `json:"description,omitempty"`
+	CreatedBy   *string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewTeam(dbTeam dbmodels.Team) Team {
+	return Team{
+		ID:          dbTeam.ID,
+		Name:        dbTeam.Name,
+		Alias:       dbTeam.Alias.String,
+		Description: dbTeam.Description.Ptr(),
+		CreatedBy:   dbTeam.CreatedBy.Ptr(),
+		CreatedAt:   dbTeam.CreatedAt,
+		UpdatedAt:   dbTeam.UpdatedAt,
+		DeletedAt:   dbTeam.DeletedAt.Ptr(),
+	}
+}
+
+type TeamRole string
+
+const (
+	TeamRoleMaintainer TeamRole = "maintainer"
+	TeamRoleMember     TeamRole = "member"
+)
+
+// teamRoleRanks orders the roles, each role can do everything the lower ones can.
+var teamRoleRanks = map[TeamRole]int{
+	TeamRoleMember:     1,
+	TeamRoleMaintainer: 2,
+}
+
+// IsValid reports whether the role is one of the team roles.
+func (r TeamRole) IsValid() bool {
+	_, ok := teamRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether the role grants everything the other role does.
+func (r TeamRole) AtLeast(other TeamRole) bool {
+	return r.IsValid() && teamRoleRanks[r] >= teamRoleRanks[other]
+}
+
+// TeamMember puts a user in a team. Maintainers manage the team and its
+// members, every member holds the roles granted to the team on boards.
+type TeamMember struct {
+	ID        string    `json:"id"`
+	TeamID    string    `json:"team_id"`
+	UserID    string    `json:"user_id"`
+	Role      TeamRole  `json:"role"`
+	AddedBy   *string   `json:"added_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewTeamMember(dbMember dbmodels.TeamMember) TeamMember {
+	return TeamMember{
+		ID:        dbMember.ID,
+		TeamID:    dbMember.TeamID,
+		UserID:    dbMember.UserID,
+		Role:      TeamRole(dbMember.Role),
+		AddedBy:   dbMember.AddedBy.Ptr(),
+		CreatedAt: dbMember.CreatedAt,
+		UpdatedAt: dbMember.UpdatedAt,
+	}
+}
+
+// BoardTeamGrant gives every member of a team a role on a board. A user's
+// effective role on a board is the highest of their direct and team roles.
+type BoardTeamGrant struct {
+	ID        string    `json:"id"`
+	BoardID   string    `json:"board_id"`
+	TeamID    string    `json:"team_id"`
+	Role      BoardRole `json:"role"`
+	AddedBy   *string   `json:"added_by,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func NewBoardTeamGrant(dbGrant dbmodels.BoardTeamGrant) BoardTeamGrant {
+	return BoardTeamGrant{
+		ID:        dbGrant.ID,
+		BoardID:   dbGrant.BoardID,
+		TeamID:    dbGrant.TeamID,
+		Role:      BoardRole(dbGrant.Role),
+		AddedBy:   dbGrant.AddedBy.Ptr(),
+		CreatedAt: dbGrant.CreatedAt,
+		UpdatedAt: dbGrant.UpdatedAt,
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+// UserIdentity links a user to an account at an OpenID Provider.
+type UserIdentity struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Issuer      string     `json:"issuer"`
+	Subject     string     `json:"subject"`
+	Email       string     `json:"email"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func NewUserIdentity(dbIdentity dbmodels.UserIdentity) UserIdentity {
+	return UserIdentity{
+		ID:          dbIdentity.ID,
+		UserID:      dbIdentity.UserID,
+		Issuer:      dbIdentity.Issuer,
+		Subject:     dbIdentity.Subject,
+		Email:       dbIdentity.Email.String,
+		LastLoginAt: dbIdentity.LastLoginAt.Ptr(),
+		CreatedAt:   dbIdentity.CreatedAt,
+		UpdatedAt:   dbIdentity.UpdatedAt,
+	}
+}
+
+// OIDCLoginState is an authorization request waiting for its callback.
+type OIDCLoginState struct {
+	ID           string    `json:"id"`
+	StateHash    string    `json:"-"`
+	CodeVerifier string    `json:"-"`
+	Nonce        string    `json:"-"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func NewOIDCLoginState(dbState dbmodels.OidcLoginState) OIDCLoginState {
+	return OIDCLoginState{
+		ID:           dbState.ID,
+		StateHash:    dbState.StateHash,
+		CodeVerifier: dbState.CodeVerifier,
+		Nonce:        dbState.Nonce,
+		ExpiresAt:    dbState.ExpiresAt,
+		CreatedAt:    dbState.CreatedAt,
+	}
+}
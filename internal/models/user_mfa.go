@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type UserMFA struct {
+	UserID          string     `json:"user_id"`
+	SecretEncrypted string     `json:"-"`
+	EnabledAt       *time.Time `json:"enabled_at,omitempty"`
+	LastUsedStep    int64      `json:"-"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+func NewUserMFA(dbMFA dbmodels.UserMfa) UserMFA {
+	return UserMFA{
+		UserID:          dbMFA.UserID,
+		SecretEncrypted: dbMFA.SecretEncrypted,
+		EnabledAt:       dbMFA.EnabledAt.Ptr(),
+		LastUsedStep:    dbMFA.LastUsedStep,
+		CreatedAt:       dbMFA.CreatedAt,
+		UpdatedAt:       dbMFA.UpdatedAt,
+	}
+}
+
+// Enabled reports whether the enrollment was confirmed with a first code.
+func (m UserMFA) Enabled() bool {
+	return m.EnabledAt != nil
+}
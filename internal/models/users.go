@@ -34,4 +34,7 @@ func NewUser(user *dbmodels.User) *User {
 	}
 }
 
-const ADMIN_ROLE = "SUPER_ADMIN"
+const (
+	ADMIN_ROLE = "SUPER_ADMIN"
+	USER_ROLE  = "USER"
+)
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+)
+
+type Workspace struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Alias       string     `json:"alias"`
+	Description *string    `json:"description,omitempty"`
+	CreatedBy   *string    `json:"created_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+}
+
+func NewWorkspace(dbWorkspace dbmodels.Workspace) Workspace {
+	return Workspace{
+		ID:          dbWorkspace.ID,
+		Name:        dbWorkspace.Name,
+		Alias:       dbWorkspace.Alias.String,
+		Description: dbWorkspace.Description.Ptr(),
+		CreatedBy:   dbWorkspace.CreatedBy.Ptr(),
+		CreatedAt:   dbWorkspace.CreatedAt,
+		UpdatedAt:   dbWorkspace.UpdatedAt,
+		DeletedAt:   dbWorkspace.DeletedAt.Ptr(),
+	}
+}
+
+type WorkspaceRole string
+
+const (
+	WorkspaceRoleOwner  WorkspaceRole = "owner"
+	WorkspaceRoleAdmin  WorkspaceRole = "admin"
+	WorkspaceRoleMember WorkspaceRole = "member"
+)
+
+// workspaceRoleRanks orders the roles, each role can do everything the lower ones can.
+var workspaceRoleRanks = map[WorkspaceRole]int{
+	WorkspaceRoleMember: 1,
+	WorkspaceRoleAdmin:  2,
+	WorkspaceRoleOwner:  3,
+}
+
+// IsValid reports whether the role is one of the workspace roles.
+func (r WorkspaceRole) IsValid() bool {
+	_, ok := workspaceRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether the role grants everything the other role does.
+func (r WorkspaceRole) AtLeast(other WorkspaceRole) bool {
+	return r.IsValid() && workspaceRoleRanks[r] >= workspaceRoleRanks[other]
+}
+
+// WorkspaceMember gives a user access to a workspace. Owners and admins of a
+// workspace hold the same role on all of its boards.
+type WorkspaceMember struct {
+	ID          string        `json:"id"`
+	WorkspaceID string        `json:"workspace_id"`
+	UserID      string        `json:"user_id"`
+	Role        WorkspaceRole `json:"role"`
+	AddedBy     *string       `json:"added_by,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	UpdatedAt   time.Time     `json:"updated_at"`
+}
+
+func NewWorkspaceMember(dbMember dbmodels.WorkspaceMember) WorkspaceMember {
+	return WorkspaceMember{
+		ID:          dbMember.ID,
+		WorkspaceID: dbMember.WorkspaceID,
+		UserID:      dbMember.UserID,
+		Role:        WorkspaceRole(dbMember.Role),
+		AddedBy:     dbMember.AddedBy.Ptr(),
+		CreatedAt:   dbMember.CreatedAt,
+		UpdatedAt:   dbMember.UpdatedAt,
+	}
+}
@@ -1,14 +1,22 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/role"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
 )
 
 var (
-	errWrongQuery    = pkgErrors.NewHTTPError(10401, "Wrong query")
-	errNotFound      = pkgErrors.NewHTTPError(10403, "Role not found")
-	errFieldRequired = pkgErrors.NewHTTPError(10404, "Field required")
+	errWrongQuery        = pkgErrors.NewHTTPError(10401, "Wrong query")
+	errWrongBody         = pkgErrors.NewHTTPError(10402, "Wrong body")
+	errNotFound          = pkgErrors.NewHTTPError(10403, "Role not found")
+	errFieldRequired     = pkgErrors.NewHTTPError(10404, "Field required")
+	errRoleExists        = pkgErrors.NewHTTPError(10411, "Role already exists")
+	errInvalidPermission = pkgErrors.NewHTTPError(10412, "Invalid permission")
+	errBuiltInRole       = pkgErrors.NewHTTPError(10413, "Built-in role cannot be changed")
+	errRoleInUse         = pkgErrors.NewHTTPError(10414, "Role is still assigned to users")
+	errForbidden         = &pkgErrors.HTTPError{Code: 10415, Message: "You do not have the permission", StatusCode: http.StatusForbidden}
 )
 
 func (h handler) mapErrorCode(err error) error {
@@ -17,6 +25,16 @@ func (h handler) mapErrorCode(err error) error {
 		return errNotFound
 	case role.ErrFieldRequired:
 		return errFieldRequired
+	case role.ErrRoleExists:
+		return errRoleExists
+	case role.ErrInvalidPermission:
+		return errInvalidPermission
+	case role.ErrBuiltInRole:
+		return errBuiltInRole
+	case role.ErrRoleInUse:
+		return errRoleInUse
+	case role.ErrForbidden:
+		return errForbidden
 	default:
 		return err
 	}
@@ -24,4 +42,5 @@ func (h handler) mapErrorCode(err error) error {
 
 var NotFound = []error{
 	errNotFound,
+	errForbidden,
 }
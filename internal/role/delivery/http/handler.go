@@ -87,3 +87,162 @@ func (h handler) List(c *gin.Context) {
 
 	response.OK(c, items)
 }
+
+// @Summary Create role
+// @Description Create a role with a set of permissions. Requires the role.manage permission
+// @Tags Role
+// @Accept json
+// @Produce json
+// @Param Access-Control-Allow-Origin header string false "Access-Control-Allow-Origin" default(*)
+// @Param User-Agent header string false "User-Agent" default(Swagger-Codegen/1.0.0/go)
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param body body createReq true "Role data"
+// @Success 200 {object} roleItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/roles [POST]
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.role.http.Create.processCreateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Create(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.role.http.Create.uc.Create: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.role.http.Create.uc.Create: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newItem(o))
+}
+
+// @Summary Update role
+// @Description Rename a role and replace its permissions when permissions is set. The permissions of SUPER_ADMIN cannot be changed. Requires the role.manage permission
+// @Tags Role
+// @Accept json
+// @Produce json
+// @Param Access-Control-Allow-Origin header string false "Access-Control-Allow-Origin" default(*)
+// @Param User-Agent header string false "User-Agent" default(Swagger-Codegen/1.0.0/go)
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Role ID"
+// @Param body body updateReq true "Role data"
+// @Success 200 {object} roleItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/roles/{id} [PUT]
+func (h handler) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.role.http.Update.processUpdateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Update(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.role.http.Update.uc.Update: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.role.http.Update.uc.Update: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newItem(o))
+}
+
+// @Summary Delete role
+// @Description Delete a role that is not assigned to any user. The built-in roles cannot be deleted. Requires the role.manage permission
+// @Tags Role
+// @Accept json
+// @Produce json
+// @Param Access-Control-Allow-Origin header string false "Access-Control-Allow-Origin" default(*)
+// @Param User-Agent header string false "User-Agent" default(Swagger-Codegen/1.0.0/go)
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Role ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/roles/{id} [DELETE]
+func (h handler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processDetailRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.role.http.Delete.processDetailRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.Delete(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.role.http.Delete.uc.Delete: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.role.http.Delete.uc.Delete: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary List permissions
+// @Description List the permissions that can be granted to roles
+// @Tags Role
+// @Accept json
+// @Produce json
+// @Param Access-Control-Allow-Origin header string false "Access-Control-Allow-Origin" default(*)
+// @Param User-Agent header string false "User-Agent" default(Swagger-Codegen/1.0.0/go)
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Success 200 {object} []permissionItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/roles/permissions [GET]
+func (h handler) ListPermissions(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	sc, err := h.processListRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.role.http.ListPermissions.processListRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.ListPermissions(ctx, sc)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.role.http.ListPermissions.uc.ListPermissions: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.role.http.ListPermissions.uc.ListPermissions: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newPermissionItems(o))
+}
@@ -5,4 +5,8 @@ import "github.com/gin-gonic/gin"
 type Handler interface {
 	Detail(c *gin.Context)
 	List(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	ListPermissions(c *gin.Context)
 }
@@ -2,22 +2,85 @@ package http
 
 import (
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
 )
 
 type roleItem struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Code        string `json:"code"`
-	Alias       string `json:"alias"`
-	Description string `json:"description"`
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Code        string   `json:"code"`
+	Alias       string   `json:"alias"`
+	Description string   `json:"description"`
+	MFARequired bool     `json:"mfa_required"`
+	Permissions []string `json:"permissions"`
 }
 
 func (h handler) newItem(o models.Role) roleItem {
+	permissions := o.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+
 	return roleItem{
 		ID:          o.ID,
 		Name:        o.Name,
 		Code:        o.Code,
 		Alias:       o.Alias,
 		Description: o.Description,
+		MFARequired: o.MFARequired,
+		Permissions: permissions,
+	}
+}
+
+// Create
+type createReq struct {
+	Name        string   `json:"name" binding:"required,max=50"`
+	Code        string   `json:"code" binding:"required,max=50"`
+	Description string   `json:"description" binding:"max=255"`
+	MFARequired bool     `json:"mfa_required"`
+	Permissions []string `json:"permissions"`
+}
+
+func (req createReq) toInput() role.CreateInput {
+	return role.CreateInput{
+		Name:        req.Name,
+		Code:        req.Code,
+		Description: req.Description,
+		MFARequired: req.MFARequired,
+		Permissions: req.Permissions,
+	}
+}
+
+// Update
+type updateReq struct {
+	ID          string   `json:"-"`
+	Name        string   `json:"name" binding:"required,max=50"`
+	Description string   `json:"description" binding:"max=255"`
+	Permissions []string `json:"permissions"`
+}
+
+func (req updateReq) toInput() role.UpdateInput {
+	return role.UpdateInput{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
 	}
 }
+
+type permissionItem struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+}
+
+func (h handler) newPermissionItems(ps []models.Permission) []permissionItem {
+	items := make([]permissionItem, len(ps))
+	for i, p := range ps {
+		items[i] = permissionItem{
+			Code:        p.Code,
+			Description: p.Description,
+		}
+	}
+
+	return items
+}
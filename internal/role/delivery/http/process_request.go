@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
 )
 
@@ -36,3 +37,45 @@ func (h handler) processListRequest(c *gin.Context) (models.Scope, error) {
 
 	return scope.NewScope(p), nil
 }
+
+func (h handler) processCreateRequest(c *gin.Context) (createReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.role.delivery.http.processCreateRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return createReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req createReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.role.delivery.http.processCreateRequest.c.ShouldBindJSON: %v", err)
+		return createReq{}, models.Scope{}, errWrongBody
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processUpdateRequest(c *gin.Context) (updateReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.role.delivery.http.processUpdateRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return updateReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req updateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.role.delivery.http.processUpdateRequest.c.ShouldBindJSON: %v", err)
+		return updateReq{}, models.Scope{}, errWrongBody
+	}
+
+	req.ID = c.Param("id")
+	if err := postgres.IsUUID(req.ID); err != nil {
+		h.l.Errorf(ctx, "internal.role.delivery.http.processUpdateRequest.IsUUID: %v", err)
+		return updateReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
@@ -2,11 +2,16 @@ package http
 
 import (
 	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 
 	"github.com/gin-gonic/gin"
 )
 
 func MapRoleRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
 	r.GET("/", mw.Auth(), h.List)
+	r.GET("/permissions", mw.Auth(), h.ListPermissions)
 	r.GET("/:id", mw.Auth(), h.Detail)
+	r.POST("/", mw.Auth(), mw.RequirePermission(models.PermissionRoleManage), h.Create)
+	r.PUT("/:id", mw.Auth(), mw.RequirePermission(models.PermissionRoleManage), h.Update)
+	r.DELETE("/:id", mw.Auth(), mw.RequirePermission(models.PermissionRoleManage), h.Delete)
 }
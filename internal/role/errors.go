@@ -3,6 +3,11 @@ package role
 import "errors"
 
 var (
-	ErrRoleNotFound  = errors.New("role not found")
-	ErrFieldRequired = errors.New("field required")
+	ErrRoleNotFound      = errors.New("role not found")
+	ErrFieldRequired     = errors.New("field required")
+	ErrForbidden         = errors.New("forbidden")
+	ErrRoleExists        = errors.New("role already exists")
+	ErrInvalidPermission = errors.New("invalid permission")
+	ErrBuiltInRole       = errors.New("built-in role cannot be changed")
+	ErrRoleInUse         = errors.New("role is in use")
 )
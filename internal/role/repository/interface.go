@@ -13,4 +13,14 @@ type Repository interface {
 	Detail(ctx context.Context, sc models.Scope, ID string) (models.Role, error)
 	Get(ctx context.Context, sc models.Scope, opts GetOptions) ([]models.Role, paginator.Paginator, error)
 	List(ctx context.Context, sc models.Scope, opts ListOptions) ([]models.Role, error)
+	UpdateMFARequired(ctx context.Context, sc models.Scope, opts UpdateMFARequiredOptions) (models.Role, error)
+	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.Role, error)
+	Update(ctx context.Context, sc models.Scope, opts UpdateOptions) (models.Role, error)
+	Delete(ctx context.Context, sc models.Scope, ID string) error
+	// CountUsers counts the users that have the role.
+	CountUsers(ctx context.Context, sc models.Scope, ID string) (int64, error)
+
+	ListPermissions(ctx context.Context, sc models.Scope) ([]models.Permission, error)
+	// HasPermissions reports whether the role of the user grants all the permissions.
+	HasPermissions(ctx context.Context, sc models.Scope, opts HasPermissionsOptions) (bool, error)
 }
@@ -17,3 +17,31 @@ type GetOptions struct {
 type ListOptions struct {
 	Filter role.Filter
 }
+
+type UpdateMFARequiredOptions struct {
+	ID       string
+	Required bool
+}
+
+type CreateOptions struct {
+	Name        string
+	Code        string
+	Alias       string
+	Description string
+	MFARequired bool
+	Permissions []string
+}
+
+type UpdateOptions struct {
+	ID          string
+	Name        string
+	Alias       string
+	Description string
+	// Permissions replaces the permissions of the role, nil keeps them
+	Permissions []string
+}
+
+type HasPermissionsOptions struct {
+	UserID      string
+	Permissions []string
+}
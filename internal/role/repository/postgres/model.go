@@ -0,0 +1,23 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/role/repository"
+)
+
+func (r implRepository) buildModel(opts repository.CreateOptions) dbmodels.Role {
+	now := time.Now()
+
+	return dbmodels.Role{
+		Name:        opts.Name,
+		Code:        opts.Code,
+		Alias:       opts.Alias,
+		Description: null.StringFrom(opts.Description),
+		MfaRequired: opts.MFARequired,
+		CreatedAt:   null.TimeFrom(now),
+		UpdatedAt:   null.TimeFrom(now),
+	}
+}
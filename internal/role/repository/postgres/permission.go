@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/lib/pq"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+// hasPermissionsQuery counts the requested permissions granted to the role of
+// an active user.
+const hasPermissionsQuery = `
+	SELECT COUNT(DISTINCT rp.permission_code)
+	FROM users u
+	JOIN roles r ON r.id = u.role_id AND r.deleted_at IS NULL
+	JOIN role_permissions rp ON rp.role_id = r.id
+	WHERE u.id = $1 AND u.deleted_at IS NULL AND rp.permission_code = ANY($2)
+`
+
+func (r *implRepository) ListPermissions(ctx context.Context, sc models.Scope) ([]models.Permission, error) {
+	ps, err := dbmodels.Permissions(qm.OrderBy(dbmodels.PermissionColumns.Code)).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.ListPermissions.All: %v", err)
+		return nil, err
+	}
+
+	permissions := make([]models.Permission, len(ps))
+	for i, p := range ps {
+		permissions[i] = models.NewPermission(*p)
+	}
+
+	return permissions, nil
+}
+
+func (r *implRepository) HasPermissions(ctx context.Context, sc models.Scope, opts repository.HasPermissionsOptions) (bool, error) {
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.HasPermissions.InvalidUserID: %v", err)
+		return false, err
+	}
+	if len(opts.Permissions) == 0 {
+		return true, nil
+	}
+
+	var n int
+	if err := r.database.QueryRowContext(ctx, hasPermissionsQuery, opts.UserID, pq.Array(opts.Permissions)).Scan(&n); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.HasPermissions.QueryRowContext: %v", err)
+		return false, err
+	}
+
+	return n == len(opts.Permissions), nil
+}
+
+// loadPermissions fills the permission codes of the roles.
+func (r *implRepository) loadPermissions(ctx context.Context, roles []models.Role) ([]models.Role, error) {
+	if len(roles) == 0 {
+		return roles, nil
+	}
+
+	ids := make([]string, len(roles))
+	for i, rl := range roles {
+		ids[i] = rl.ID
+	}
+
+	rps, err := dbmodels.RolePermissions(
+		dbmodels.RolePermissionWhere.RoleID.IN(ids),
+		qm.OrderBy(dbmodels.RolePermissionColumns.PermissionCode),
+	).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.loadPermissions.All: %v", err)
+		return nil, err
+	}
+
+	byRole := make(map[string][]string, len(roles))
+	for _, rp := range rps {
+		byRole[rp.RoleID] = append(byRole[rp.RoleID], rp.PermissionCode)
+	}
+	for i := range roles {
+		roles[i].Permissions = byRole[roles[i].ID]
+	}
+
+	return roles, nil
+}
+
+// replacePermissions grants exactly the permissions to the role.
+func (r *implRepository) replacePermissions(ctx context.Context, exec boil.ContextExecutor, roleID string, permissions []string) error {
+	if _, err := dbmodels.RolePermissions(dbmodels.RolePermissionWhere.RoleID.EQ(roleID)).DeleteAll(ctx, exec); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.replacePermissions.DeleteAll: %v", err)
+		return err
+	}
+
+	for _, p := range permissions {
+		m := dbmodels.RolePermission{
+			RoleID:         roleID,
+			PermissionCode: p,
+		}
+		if err := m.Insert(ctx, exec, boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "internal.role.repository.postgres.replacePermissions.Insert: %v", err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -22,6 +22,18 @@ func (r implRepository) buildDetailQuery(ctx context.Context, ID string) ([]qm.Q
 	return qr, nil
 }
 
+func (r implRepository) buildGetOneQuery(ctx context.Context, opts repository.GetOneOptions) ([]qm.QueryMod, error) {
+	qr := postgres.BuildQueryWithSoftDelete()
+
+	if opts.Filter.Code == "" {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.buildGetOneQuery: %v", repository.ErrNotFound)
+		return nil, repository.ErrNotFound
+	}
+	qr = append(qr, dbmodels.RoleWhere.Code.EQ(opts.Filter.Code))
+
+	return qr, nil
+}
+
 func (r implRepository) buildListQuery(ctx context.Context, sc models.Scope, opts repository.ListOptions) ([]qm.QueryMod, error) {
 	qr := postgres.BuildQueryWithSoftDelete()
 
@@ -3,11 +3,15 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
 	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/role/repository"
 	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
 )
 
 func (r *implRepository) Detail(ctx context.Context, sc models.Scope, ID string) (models.Role, error) {
@@ -27,13 +31,39 @@ func (r *implRepository) Detail(ctx context.Context, sc models.Scope, ID string)
 		return models.Role{}, err
 	}
 
-	return models.NewRole(*rl), nil
+	roles, err := r.loadPermissions(ctx, []models.Role{models.NewRole(*rl)})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Detail.loadPermissions: %v", err)
+		return models.Role{}, err
+	}
+
+	return roles[0], nil
 }
 
 func (r *implRepository) GetOne(ctx context.Context, sc models.Scope, opts repository.GetOneOptions) (models.Role, error) {
-	// TODO: Implement actual database query
-	// For now, return empty role
-	return models.Role{}, sql.ErrNoRows
+	qr, err := r.buildGetOneQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.GetOne.buildGetOneQuery: %v", err)
+		return models.Role{}, err
+	}
+
+	rl, err := dbmodels.Roles(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.role.repository.postgres.GetOne.One.NoRows: %v", err)
+			return models.Role{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.role.repository.postgres.GetOne.One: %v", err)
+		return models.Role{}, err
+	}
+
+	roles, err := r.loadPermissions(ctx, []models.Role{models.NewRole(*rl)})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.GetOne.loadPermissions: %v", err)
+		return models.Role{}, err
+	}
+
+	return roles[0], nil
 }
 
 func (r *implRepository) Get(ctx context.Context, sc models.Scope, opts repository.GetOptions) ([]models.Role, paginator.Paginator, error) {
@@ -60,5 +90,146 @@ func (r *implRepository) List(ctx context.Context, sc models.Scope, opts reposit
 		roles[i] = models.NewRole(*r)
 	}
 
+	roles, err = r.loadPermissions(ctx, roles)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.List.loadPermissions: %v", err)
+		return nil, err
+	}
+
 	return roles, nil
 }
+
+func (r *implRepository) UpdateMFARequired(ctx context.Context, sc models.Scope, opts repository.UpdateMFARequiredOptions) (models.Role, error) {
+	qr, err := r.buildDetailQuery(ctx, opts.ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.UpdateMFARequired.buildDetailQuery: %v", err)
+		return models.Role{}, err
+	}
+
+	n, err := dbmodels.Roles(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.RoleColumns.MfaRequired: opts.Required,
+		dbmodels.RoleColumns.UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.UpdateMFARequired.UpdateAll: %v", err)
+		return models.Role{}, err
+	}
+	if n == 0 {
+		return models.Role{}, repository.ErrNotFound
+	}
+
+	return r.Detail(ctx, sc, opts.ID)
+}
+
+func (r *implRepository) Create(ctx context.Context, sc models.Scope, opts repository.CreateOptions) (models.Role, error) {
+	m := r.buildModel(opts)
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Create.BeginTx: %v", err)
+		return models.Role{}, err
+	}
+	defer tx.Rollback()
+
+	if err := m.Insert(ctx, tx, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Create.Insert: %v", err)
+		return models.Role{}, err
+	}
+
+	if err := r.replacePermissions(ctx, tx, m.ID, opts.Permissions); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Create.replacePermissions: %v", err)
+		return models.Role{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Create.Commit: %v", err)
+		return models.Role{}, err
+	}
+
+	rl := models.NewRole(m)
+	rl.Permissions = opts.Permissions
+
+	return rl, nil
+}
+
+func (r *implRepository) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.Role, error) {
+	qr, err := r.buildDetailQuery(ctx, opts.ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Update.buildDetailQuery: %v", err)
+		return models.Role{}, err
+	}
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Update.BeginTx: %v", err)
+		return models.Role{}, err
+	}
+	defer tx.Rollback()
+
+	n, err := dbmodels.Roles(qr...).UpdateAll(ctx, tx, dbmodels.M{
+		dbmodels.RoleColumns.Name:        opts.Name,
+		dbmodels.RoleColumns.Alias:       opts.Alias,
+		dbmodels.RoleColumns.Description: null.StringFrom(opts.Description),
+		dbmodels.RoleColumns.UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Update.UpdateAll: %v", err)
+		return models.Role{}, err
+	}
+	if n == 0 {
+		return models.Role{}, repository.ErrNotFound
+	}
+
+	if opts.Permissions != nil {
+		if err := r.replacePermissions(ctx, tx, opts.ID, opts.Permissions); err != nil {
+			r.l.Errorf(ctx, "internal.role.repository.postgres.Update.replacePermissions: %v", err)
+			return models.Role{}, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Update.Commit: %v", err)
+		return models.Role{}, err
+	}
+
+	return r.Detail(ctx, sc, opts.ID)
+}
+
+func (r *implRepository) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Delete.buildDetailQuery: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.Roles(qr...).UpdateAll(ctx, r.database, dbmodels.M{
+		dbmodels.RoleColumns.DeletedAt: time.Now(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.Delete.UpdateAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *implRepository) CountUsers(ctx context.Context, sc models.Scope, ID string) (int64, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.CountUsers.InvalidID: %v", err)
+		return 0, err
+	}
+
+	qr := postgres.BuildQueryWithSoftDelete()
+	qr = append(qr, dbmodels.UserWhere.RoleID.EQ(null.StringFrom(ID)))
+
+	n, err := dbmodels.Users(qr...).Count(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.role.repository.postgres.CountUsers.Count: %v", err)
+		return 0, err
+	}
+
+	return n, nil
+}
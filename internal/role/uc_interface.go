@@ -10,4 +10,14 @@ import (
 type UseCase interface {
 	Detail(ctx context.Context, sc models.Scope, ID string) (models.Role, error)
 	List(ctx context.Context, sc models.Scope, ip ListInput) ([]models.Role, error)
+	UpdateMFARequired(ctx context.Context, sc models.Scope, ip UpdateMFARequiredInput) (models.Role, error)
+	Create(ctx context.Context, sc models.Scope, ip CreateInput) (models.Role, error)
+	Update(ctx context.Context, sc models.Scope, ip UpdateInput) (models.Role, error)
+	Delete(ctx context.Context, sc models.Scope, ID string) error
+	ListPermissions(ctx context.Context, sc models.Scope) ([]models.Permission, error)
+
+	// HasPermission reports whether the role of the user grants all the permissions.
+	HasPermission(ctx context.Context, sc models.Scope, permissions ...string) (bool, error)
+	// Authorize returns ErrForbidden unless the role of the user grants all the permissions.
+	Authorize(ctx context.Context, sc models.Scope, permissions ...string) error
 }
@@ -40,3 +40,24 @@ type GetOutput struct {
 type ListOutput struct {
 	Roles []models.Role
 }
+
+type UpdateMFARequiredInput struct {
+	ID       string
+	Required bool
+}
+
+type CreateInput struct {
+	Name        string
+	Code        string
+	Description string
+	MFARequired bool
+	Permissions []string
+}
+
+type UpdateInput struct {
+	ID          string
+	Name        string
+	Description string
+	// Permissions replaces the permissions of the role, nil keeps them
+	Permissions []string
+}
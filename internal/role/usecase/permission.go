@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/role/repository"
+)
+
+func (uc *usecase) ListPermissions(ctx context.Context, sc models.Scope) ([]models.Permission, error) {
+	ps, err := uc.repo.ListPermissions(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.role.usecase.ListPermissions.repo.ListPermissions: %v", err)
+		return nil, err
+	}
+
+	return ps, nil
+}
+
+func (uc *usecase) HasPermission(ctx context.Context, sc models.Scope, permissions ...string) (bool, error) {
+	ok, err := uc.repo.HasPermissions(ctx, sc, repository.HasPermissionsOptions{
+		UserID:      sc.UserID,
+		Permissions: permissions,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.role.usecase.HasPermission.repo.HasPermissions: %v", err)
+		return false, err
+	}
+
+	return ok, nil
+}
+
+func (uc *usecase) Authorize(ctx context.Context, sc models.Scope, permissions ...string) error {
+	ok, err := uc.HasPermission(ctx, sc, permissions...)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.role.usecase.Authorize.HasPermission: %v", err)
+		return err
+	}
+	if !ok {
+		uc.l.Warnf(ctx, "internal.role.usecase.Authorize.Forbidden: user %s lacks %v", sc.UserID, permissions)
+		return role.ErrForbidden
+	}
+
+	return nil
+}
+
+// validatePermissions checks that every permission exists.
+func (uc *usecase) validatePermissions(ctx context.Context, sc models.Scope, permissions []string) error {
+	ps, err := uc.repo.ListPermissions(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.role.usecase.validatePermissions.repo.ListPermissions: %v", err)
+		return err
+	}
+
+	known := make(map[string]bool, len(ps))
+	for _, p := range ps {
+		known[p.Code] = true
+	}
+	for _, p := range permissions {
+		if !known[p] {
+			uc.l.Warnf(ctx, "internal.role.usecase.validatePermissions: unknown permission %s", p)
+			return role.ErrInvalidPermission
+		}
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/role"
 	"github.com/nguyentantai21042004/kanban-api/internal/role/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
 )
 
 func (uc *usecase) Detail(ctx context.Context, sc models.Scope, ID string) (models.Role, error) {
@@ -31,3 +33,137 @@ func (uc *usecase) List(ctx context.Context, sc models.Scope, ip role.ListInput)
 
 	return rls, nil
 }
+
+func (uc *usecase) UpdateMFARequired(ctx context.Context, sc models.Scope, ip role.UpdateMFARequiredInput) (models.Role, error) {
+	r, err := uc.repo.UpdateMFARequired(ctx, sc, repository.UpdateMFARequiredOptions{
+		ID:       ip.ID,
+		Required: ip.Required,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.role.usecase.UpdateMFARequired.repo.UpdateMFARequired: %v", err)
+			return models.Role{}, role.ErrRoleNotFound
+		}
+		uc.l.Errorf(ctx, "internal.role.usecase.UpdateMFARequired.repo.UpdateMFARequired: %v", err)
+		return models.Role{}, err
+	}
+
+	return r, nil
+}
+
+func (uc *usecase) Create(ctx context.Context, sc models.Scope, ip role.CreateInput) (models.Role, error) {
+	code := strings.ToUpper(strings.TrimSpace(ip.Code))
+	if ip.Name == "" || code == "" {
+		return models.Role{}, role.ErrFieldRequired
+	}
+
+	permissions := util.RemoveDuplicates(ip.Permissions)
+	if err := uc.validatePermissions(ctx, sc, permissions); err != nil {
+		uc.l.Warnf(ctx, "internal.role.usecase.Create.validatePermissions: %v", err)
+		return models.Role{}, err
+	}
+
+	_, err := uc.repo.GetOne(ctx, sc, repository.GetOneOptions{Filter: role.Filter{Code: code}})
+	if err == nil {
+		return models.Role{}, role.ErrRoleExists
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.role.usecase.Create.repo.GetOne: %v", err)
+		return models.Role{}, err
+	}
+
+	r, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		Name:        ip.Name,
+		Code:        code,
+		Alias:       strings.ToLower(code),
+		Description: ip.Description,
+		MFARequired: ip.MFARequired,
+		Permissions: permissions,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.role.usecase.Create.repo.Create: %v", err)
+		return models.Role{}, err
+	}
+
+	return r, nil
+}
+
+func (uc *usecase) Update(ctx context.Context, sc models.Scope, ip role.UpdateInput) (models.Role, error) {
+	if ip.ID == "" || ip.Name == "" {
+		return models.Role{}, role.ErrFieldRequired
+	}
+
+	old, err := uc.Detail(ctx, sc, ip.ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.role.usecase.Update.Detail: %v", err)
+		return models.Role{}, err
+	}
+
+	var permissions []string
+	if ip.Permissions != nil {
+		// Super admins keep every permission so nobody can lock the system out
+		if old.Code == models.ADMIN_ROLE {
+			return models.Role{}, role.ErrBuiltInRole
+		}
+
+		permissions = util.RemoveDuplicates(ip.Permissions)
+		if err := uc.validatePermissions(ctx, sc, permissions); err != nil {
+			uc.l.Warnf(ctx, "internal.role.usecase.Update.validatePermissions: %v", err)
+			return models.Role{}, err
+		}
+		if permissions == nil {
+			permissions = []string{}
+		}
+	}
+
+	r, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
+		ID:          old.ID,
+		Name:        ip.Name,
+		Alias:       old.Alias,
+		Description: ip.Description,
+		Permissions: permissions,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.role.usecase.Update.repo.Update: %v", err)
+			return models.Role{}, role.ErrRoleNotFound
+		}
+		uc.l.Errorf(ctx, "internal.role.usecase.Update.repo.Update: %v", err)
+		return models.Role{}, err
+	}
+
+	return r, nil
+}
+
+func (uc *usecase) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	r, err := uc.Detail(ctx, sc, ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.role.usecase.Delete.Detail: %v", err)
+		return err
+	}
+
+	// New accounts get the USER role, so both built-in roles have to stay
+	if r.Code == models.ADMIN_ROLE || r.Code == models.USER_ROLE {
+		return role.ErrBuiltInRole
+	}
+
+	n, err := uc.repo.CountUsers(ctx, sc, r.ID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.role.usecase.Delete.repo.CountUsers: %v", err)
+		return err
+	}
+	if n > 0 {
+		return role.ErrRoleInUse
+	}
+
+	if err := uc.repo.Delete(ctx, sc, r.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.role.usecase.Delete.repo.Delete: %v", err)
+			return role.ErrRoleNotFound
+		}
+		uc.l.Errorf(ctx, "internal.role.usecase.Delete.repo.Delete: %v", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+)
+
+var (
+	errWrongQuery       = pkgErrors.NewHTTPError(11101, "Wrong query")
+	errFieldRequired    = pkgErrors.NewHTTPError(11102, "Field required")
+	errNotFound         = pkgErrors.NewHTTPError(11103, "Team not found")
+	errInvalidRole      = pkgErrors.NewHTTPError(11104, "Invalid role")
+	errForbidden        = &pkgErrors.HTTPError{Code: 11105, Message: "You do not have access to this team", StatusCode: http.StatusForbidden}
+	errMemberNotFound   = pkgErrors.NewHTTPError(11106, "Team member not found")
+	errAlreadyMember    = pkgErrors.NewHTTPError(11107, "User is already a member of the team")
+	errUserNotFound     = pkgErrors.NewHTTPError(11108, "User not found")
+	errLastMaintainer   = pkgErrors.NewHTTPError(11109, "The team must keep at least one maintainer")
+	errNoPermission     = &pkgErrors.HTTPError{Code: 11110, Message: "You are not allowed to create teams", StatusCode: http.StatusForbidden}
+	errBoardForbidden   = &pkgErrors.HTTPError{Code: 11111, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errInvalidGrantRole = pkgErrors.NewHTTPError(11112, "Teams cannot own a board")
+	errGrantNotFound    = pkgErrors.NewHTTPError(11113, "The team has no access to this board")
+	errAlreadyGranted   = pkgErrors.NewHTTPError(11114, "The team already has access to this board")
+	errBoardArchived    = &pkgErrors.HTTPError{Code: 11115, Message: "This board is archived and read-only", StatusCode: http.StatusForbidden}
+)
+
+func (h handler) mapErrorCode(err error) error {
+	switch err {
+	case teams.ErrFieldRequired:
+		return errFieldRequired
+	case role.ErrForbidden:
+		return errNoPermission
+	case members.ErrForbidden:
+		return errBoardForbidden
+	case members.ErrBoardArchived:
+		return errBoardArchived
+	case teams.ErrNotFound:
+		return errNotFound
+	case teams.ErrInvalidRole:
+		return errInvalidRole
+	case teams.ErrForbidden:
+		return errForbidden
+	case teams.ErrMemberNotFound:
+		return errMemberNotFound
+	case teams.ErrAlreadyMember:
+		return errAlreadyMember
+	case teams.ErrUserNotFound:
+		return errUserNotFound
+	case teams.ErrLastMaintainer:
+		return errLastMaintainer
+	case teams.ErrInvalidGrantRole:
+		return errInvalidGrantRole
+	case teams.ErrGrantNotFound:
+		return errGrantNotFound
+	case teams.ErrAlreadyGranted:
+		return errAlreadyGranted
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errNotFound,
+	errForbidden,
+	errMemberNotFound,
+	errUserNotFound,
+	errNoPermission,
+	errBoardForbidden,
+	errGrantNotFound,
+	errBoardArchived,
+}
@@ -0,0 +1,511 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Get teams
+// @Description List the teams the user is a member of. Users with the team.manage permission see every team
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param ids[] query []string false "IDs"
+// @Param keyword query string false "Keyword"
+// @Param page query integer false "Page"
+// @Param limit query integer false "Limit"
+// @Success 200 {object} getResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams [GET]
+func (h handler) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processGetRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.Get.processGetRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Get(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.Get.uc.Get: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.Get.uc.Get: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newGetResp(o))
+}
+
+// @Summary Create team
+// @Description Create a team, the creator becomes its maintainer
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param body body createReq true "Team data"
+// @Success 200 {object} teamItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams [POST]
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.Create.processCreateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Create(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.Create.uc.Create: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.Create.uc.Create: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Get team
+// @Description Get a team. Requires team membership
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Success 200 {object} teamItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id} [GET]
+func (h handler) Detail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.Detail.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Detail(ctx, sc, id)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.Detail.uc.Detail: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.Detail.uc.Detail: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Update team
+// @Description Rename a team or change its description. Requires the maintainer role
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Param body body updateReq true "Team data"
+// @Success 200 {object} teamItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id} [PUT]
+func (h handler) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.Update.processUpdateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Update(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.Update.uc.Update: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.Update.uc.Update: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Delete team
+// @Description Delete a team, its members lose the access granted to the team. Requires the maintainer role
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id} [DELETE]
+func (h handler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.Delete.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.Delete(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.Delete.uc.Delete: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.Delete.uc.Delete: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary List team members
+// @Description List the members of a team and their roles. Requires team membership
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Success 200 {object} []memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id}/members [GET]
+func (h handler) ListMembers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.ListMembers.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.ListMembers(ctx, sc, id)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.ListMembers.uc.ListMembers: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.ListMembers.uc.ListMembers: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newListMembersResp(o))
+}
+
+// @Summary Add a team member
+// @Description Give a user a role in the team. Every member holds the roles granted to the team on boards. Requires the maintainer role
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Param body body addMemberReq true "Member data"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id}/members [POST]
+func (h handler) AddMember(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processAddMemberRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.AddMember.processAddMemberRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.AddMember(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.AddMember.uc.AddMember: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.AddMember.uc.AddMember: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newMemberResp(o))
+}
+
+// @Summary Change the role of a team member
+// @Description Change the role of a member. Requires the maintainer role. The last maintainer cannot be demoted
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Param user_id path string true "User ID"
+// @Param body body updateMemberRoleReq true "New role"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id}/members/{user_id} [PUT]
+func (h handler) UpdateMemberRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateMemberRoleRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.UpdateMemberRole.processUpdateMemberRoleRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.UpdateMemberRole(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.UpdateMemberRole.uc.UpdateMemberRole: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.UpdateMemberRole.uc.UpdateMemberRole: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newMemberResp(o))
+}
+
+// @Summary Remove a team member
+// @Description Remove a member from the team. Members can always remove themselves; removing someone else requires the maintainer role. The last maintainer cannot leave
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Team ID"
+// @Param user_id path string true "User ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/teams/{id}/members/{user_id} [DELETE]
+func (h handler) RemoveMember(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processRemoveMemberRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.RemoveMember.processRemoveMemberRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.RemoveMember(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.RemoveMember.uc.RemoveMember: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.RemoveMember.uc.RemoveMember: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary List the teams of a board
+// @Description List the teams that have access to a board and their roles. Every member of a team holds its role on the board. Requires board membership
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Success 200 {object} []grantItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/teams [GET]
+func (h handler) ListBoardGrants(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.ListBoardGrants.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.ListBoardGrants(ctx, sc, id)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.ListBoardGrants.uc.ListBoardGrants: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.ListBoardGrants.uc.ListBoardGrants: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newListBoardGrantsResp(o))
+}
+
+// @Summary Give a team access to a board
+// @Description Grant a team the admin, member or observer role on a board. Requires the admin role on the board and membership of the team
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param body body grantBoardReq true "Grant data"
+// @Success 200 {object} grantItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/teams [POST]
+func (h handler) GrantBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processGrantBoardRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.GrantBoard.processGrantBoardRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.GrantBoard(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.GrantBoard.uc.GrantBoard: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.GrantBoard.uc.GrantBoard: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newBoardGrantResp(o))
+}
+
+// @Summary Change the role of a team on a board
+// @Description Change the role granted to a team on a board. Requires the admin role on the board
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param team_id path string true "Team ID"
+// @Param body body updateBoardGrantReq true "New role"
+// @Success 200 {object} grantItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/teams/{team_id} [PUT]
+func (h handler) UpdateBoardGrant(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateBoardGrantRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.UpdateBoardGrant.processUpdateBoardGrantRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.UpdateBoardGrant(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.UpdateBoardGrant.uc.UpdateBoardGrant: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.UpdateBoardGrant.uc.UpdateBoardGrant: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newBoardGrantResp(o))
+}
+
+// @Summary Remove the access of a team to a board
+// @Description Revoke the role granted to a team on a board. Members keep the roles they hold directly. Requires the admin role on the board
+// @Tags Team
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Board ID"
+// @Param team_id path string true "Team ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/boards/{id}/teams/{team_id} [DELETE]
+func (h handler) RevokeBoard(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processRevokeBoardRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.teams.http.RevokeBoard.processRevokeBoardRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.RevokeBoard(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.teams.http.RevokeBoard.uc.RevokeBoard: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.teams.http.RevokeBoard.uc.RevokeBoard: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
@@ -0,0 +1,310 @@
+package http
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+type respObj struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type teamItem struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Alias       string    `json:"alias,omitempty"`
+	CreatedBy   respObj   `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func newTeamItem(t models.Team, userMap map[string]models.User) teamItem {
+	item := teamItem{
+		ID:        t.ID,
+		Name:      t.Name,
+		Alias:     t.Alias,
+		CreatedAt: t.CreatedAt,
+	}
+	if t.Description != nil {
+		item.Description = *t.Description
+	}
+	if t.CreatedBy != nil {
+		item.CreatedBy = respObj{
+			ID:   *t.CreatedBy,
+			Name: userMap[*t.CreatedBy].FullName,
+		}
+	}
+
+	return item
+}
+
+func newUserMap(us []models.User) map[string]models.User {
+	userMap := make(map[string]models.User, len(us))
+	for _, u := range us {
+		userMap[u.ID] = u
+	}
+
+	return userMap
+}
+
+// Get
+type getReq struct {
+	IDs       []string `form:"ids[]"`
+	Keyword   string   `form:"keyword"`
+	PageQuery paginator.PaginateQuery
+}
+
+func (req getReq) validate() error {
+	for _, id := range req.IDs {
+		if err := postgres.IsUUID(id); err != nil {
+			return errors.New("invalid id")
+		}
+	}
+
+	return nil
+}
+
+func (req getReq) toInput() teams.GetInput {
+	return teams.GetInput{
+		Filter: teams.Filter{
+			IDs:     req.IDs,
+			Keyword: req.Keyword,
+		},
+		PagQuery: req.PageQuery,
+	}
+}
+
+type getResp struct {
+	Items []teamItem                  `json:"items"`
+	Meta  paginator.PaginatorResponse `json:"meta"`
+}
+
+func (h handler) newGetResp(o teams.GetOutput) getResp {
+	userMap := newUserMap(o.Users)
+
+	items := make([]teamItem, len(o.Teams))
+	for i, t := range o.Teams {
+		items[i] = newTeamItem(t, userMap)
+	}
+
+	return getResp{
+		Items: items,
+		Meta:  o.Pagination.ToResponse(),
+	}
+}
+
+func (h handler) newDetailResp(o teams.DetailOutput) teamItem {
+	return newTeamItem(o.Team, newUserMap(o.Users))
+}
+
+// Create
+type createReq struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+func (req createReq) toInput() teams.CreateInput {
+	return teams.CreateInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+// Update
+type updateReq struct {
+	ID          string `json:"-"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+func (req updateReq) toInput() teams.UpdateInput {
+	return teams.UpdateInput{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+// Members
+type memberUser struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+type memberItem struct {
+	ID        string          `json:"id"`
+	TeamID    string          `json:"team_id"`
+	Role      models.TeamRole `json:"role"`
+	User      memberUser      `json:"user"`
+	AddedBy   string          `json:"added_by,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func newMemberItem(m models.TeamMember, u models.User) memberItem {
+	item := memberItem{
+		ID:     m.ID,
+		TeamID: m.TeamID,
+		Role:   m.Role,
+		User: memberUser{
+			ID:        m.UserID,
+			Username:  u.Username,
+			FullName:  u.FullName,
+			AvatarURL: u.AvatarURL,
+		},
+		CreatedAt: m.CreatedAt,
+	}
+	if m.AddedBy != nil {
+		item.AddedBy = *m.AddedBy
+	}
+
+	return item
+}
+
+func (h handler) newListMembersResp(o teams.ListMembersOutput) []memberItem {
+	userMap := newUserMap(o.Users)
+
+	items := make([]memberItem, len(o.Members))
+	for i, m := range o.Members {
+		items[i] = newMemberItem(m, userMap[m.UserID])
+	}
+
+	return items
+}
+
+func (h handler) newMemberResp(o teams.MemberOutput) memberItem {
+	return newMemberItem(o.Member, o.User)
+}
+
+type addMemberReq struct {
+	TeamID string `json:"-"`
+	UserID string `json:"user_id" binding:"required"`
+	Role   string `json:"role" binding:"required"`
+}
+
+func (req addMemberReq) toInput() teams.AddMemberInput {
+	return teams.AddMemberInput{
+		TeamID: req.TeamID,
+		UserID: req.UserID,
+		Role:   models.TeamRole(req.Role),
+	}
+}
+
+type updateMemberRoleReq struct {
+	TeamID string `json:"-"`
+	UserID string `json:"-"`
+	Role   string `json:"role" binding:"required"`
+}
+
+func (req updateMemberRoleReq) toInput() teams.UpdateMemberRoleInput {
+	return teams.UpdateMemberRoleInput{
+		TeamID: req.TeamID,
+		UserID: req.UserID,
+		Role:   models.TeamRole(req.Role),
+	}
+}
+
+type removeMemberReq struct {
+	TeamID string
+	UserID string
+}
+
+func (req removeMemberReq) toInput() teams.RemoveMemberInput {
+	return teams.RemoveMemberInput{
+		TeamID: req.TeamID,
+		UserID: req.UserID,
+	}
+}
+
+// Board grants
+type grantItem struct {
+	ID        string           `json:"id"`
+	BoardID   string           `json:"board_id"`
+	Role      models.BoardRole `json:"role"`
+	Team      respObj          `json:"team"`
+	AddedBy   string           `json:"added_by,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+func newGrantItem(g models.BoardTeamGrant, t models.Team) grantItem {
+	item := grantItem{
+		ID:      g.ID,
+		BoardID: g.BoardID,
+		Role:    g.Role,
+		Team: respObj{
+			ID:   g.TeamID,
+			Name: t.Name,
+		},
+		CreatedAt: g.CreatedAt,
+	}
+	if g.AddedBy != nil {
+		item.AddedBy = *g.AddedBy
+	}
+
+	return item
+}
+
+func (h handler) newListBoardGrantsResp(o teams.ListBoardGrantsOutput) []grantItem {
+	teamMap := make(map[string]models.Team, len(o.Teams))
+	for _, t := range o.Teams {
+		teamMap[t.ID] = t
+	}
+
+	items := make([]grantItem, len(o.Grants))
+	for i, g := range o.Grants {
+		items[i] = newGrantItem(g, teamMap[g.TeamID])
+	}
+
+	return items
+}
+
+func (h handler) newBoardGrantResp(o teams.BoardGrantOutput) grantItem {
+	return newGrantItem(o.Grant, o.Team)
+}
+
+type grantBoardReq struct {
+	BoardID string `json:"-"`
+	TeamID  string `json:"team_id" binding:"required"`
+	Role    string `json:"role" binding:"required"`
+}
+
+func (req grantBoardReq) toInput() teams.GrantBoardInput {
+	return teams.GrantBoardInput{
+		BoardID: req.BoardID,
+		TeamID:  req.TeamID,
+		Role:    models.BoardRole(req.Role),
+	}
+}
+
+type updateBoardGrantReq struct {
+	BoardID string `json:"-"`
+	TeamID  string `json:"-"`
+	Role    string `json:"role" binding:"required"`
+}
+
+func (req updateBoardGrantReq) toInput() teams.UpdateBoardGrantInput {
+	return teams.UpdateBoardGrantInput{
+		BoardID: req.BoardID,
+		TeamID:  req.TeamID,
+		Role:    models.BoardRole(req.Role),
+	}
+}
+
+type revokeBoardReq struct {
+	BoardID string
+	TeamID  string
+}
+
+func (req revokeBoardReq) toInput() teams.RevokeBoardInput {
+	return teams.RevokeBoardInput{
+		BoardID: req.BoardID,
+		TeamID:  req.TeamID,
+	}
+}
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	List(ctx context.Context, sc models.Scope, opts ListOptions) ([]models.Team, error)
+	Get(ctx context.Context, sc models.Scope, opts GetOptions) ([]models.Team, paginator.Paginator, error)
+	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.Team, error)
+	Update(ctx context.Context, sc models.Scope, opts UpdateOptions) (models.Team, error)
+	Detail(ctx context.Context, sc models.Scope, id string) (models.Team, error)
+	Delete(ctx context.Context, sc models.Scope, id string) error
+
+	ListMembers(ctx context.Context, sc models.Scope, opts ListMembersOptions) ([]models.TeamMember, error)
+	DetailMember(ctx context.Context, sc models.Scope, opts DetailMemberOptions) (models.TeamMember, error)
+	CreateMember(ctx context.Context, sc models.Scope, opts CreateMemberOptions) (models.TeamMember, error)
+	UpdateMemberRole(ctx context.Context, sc models.Scope, opts UpdateMemberRoleOptions) (models.TeamMember, error)
+	DeleteMember(ctx context.Context, sc models.Scope, id string) error
+	CountMembers(ctx context.Context, sc models.Scope, opts CountMembersOptions) (int64, error)
+
+	ListBoardGrants(ctx context.Context, sc models.Scope, opts ListBoardGrantsOptions) ([]models.BoardTeamGrant, error)
+	DetailBoardGrant(ctx context.Context, sc models.Scope, opts DetailBoardGrantOptions) (models.BoardTeamGrant, error)
+	CreateBoardGrant(ctx context.Context, sc models.Scope, opts CreateBoardGrantOptions) (models.BoardTeamGrant, error)
+	UpdateBoardGrantRole(ctx context.Context, sc models.Scope, opts UpdateBoardGrantRoleOptions) (models.BoardTeamGrant, error)
+	DeleteBoardGrant(ctx context.Context, sc models.Scope, id string) error
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+type ListOptions struct {
+	Filter teams.Filter
+}
+
+type GetOptions struct {
+	Filter   teams.Filter
+	PagQuery paginator.PaginateQuery
+}
+
+type CreateOptions struct {
+	Name        string
+	Alias       string
+	Description string
+}
+
+type UpdateOptions struct {
+	ID          string
+	Name        string
+	Alias       string
+	Description string
+}
+
+type ListMembersOptions struct {
+	TeamID string
+}
+
+type DetailMemberOptions struct {
+	TeamID string
+	UserID string
+}
+
+type CreateMemberOptions struct {
+	TeamID  string
+	UserID  string
+	Role    models.TeamRole
+	AddedBy string
+}
+
+type UpdateMemberRoleOptions struct {
+	ID   string
+	Role models.TeamRole
+}
+
+type CountMembersOptions struct {
+	TeamID string
+	Role   models.TeamRole
+}
+
+type ListBoardGrantsOptions struct {
+	BoardID string
+	TeamID  string
+}
+
+type DetailBoardGrantOptions struct {
+	BoardID string
+	TeamID  string
+}
+
+type CreateBoardGrantOptions struct {
+	BoardID string
+	TeamID  string
+	Role    models.BoardRole
+	AddedBy string
+}
+
+type UpdateBoardGrantRoleOptions struct {
+	ID   string
+	Role models.BoardRole
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) ListBoardGrants(ctx context.Context, sc models.Scope, opts repository.ListBoardGrantsOptions) ([]models.BoardTeamGrant, error) {
+	qr, err := r.buildListBoardGrantsQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.ListBoardGrants.buildListBoardGrantsQuery: %v", err)
+		return nil, err
+	}
+
+	gs, err := dbmodels.BoardTeamGrants(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.ListBoardGrants.All: %v", err)
+		return nil, err
+	}
+
+	grants := make([]models.BoardTeamGrant, len(gs))
+	for i, g := range gs {
+		grants[i] = models.NewBoardTeamGrant(*g)
+	}
+
+	return grants, nil
+}
+
+func (r implRepository) DetailBoardGrant(ctx context.Context, sc models.Scope, opts repository.DetailBoardGrantOptions) (models.BoardTeamGrant, error) {
+	qr, err := r.buildDetailBoardGrantQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DetailBoardGrant.buildDetailBoardGrantQuery: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+
+	g, err := dbmodels.BoardTeamGrants(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.teams.repository.postgres.DetailBoardGrant.One.NoRows: %v", err)
+			return models.BoardTeamGrant{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DetailBoardGrant.One: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+
+	return models.NewBoardTeamGrant(*g), nil
+}
+
+func (r implRepository) CreateBoardGrant(ctx context.Context, sc models.Scope, opts repository.CreateBoardGrantOptions) (models.BoardTeamGrant, error) {
+	g := r.buildBoardGrantModel(opts)
+
+	if err := g.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.CreateBoardGrant.Insert: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+
+	return models.NewBoardTeamGrant(g), nil
+}
+
+func (r implRepository) UpdateBoardGrantRole(ctx context.Context, sc models.Scope, opts repository.UpdateBoardGrantRoleOptions) (models.BoardTeamGrant, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.UpdateBoardGrantRole.InvalidID: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+
+	g := dbmodels.BoardTeamGrant{
+		ID:        opts.ID,
+		Role:      string(opts.Role),
+		UpdatedAt: r.clock(),
+	}
+	n, err := g.Update(ctx, r.database, boil.Whitelist(dbmodels.BoardTeamGrantColumns.Role, dbmodels.BoardTeamGrantColumns.UpdatedAt))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.UpdateBoardGrantRole.Update: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+	if n == 0 {
+		return models.BoardTeamGrant{}, repository.ErrNotFound
+	}
+
+	if err := g.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.UpdateBoardGrantRole.Reload: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+
+	return models.NewBoardTeamGrant(g), nil
+}
+
+func (r implRepository) DeleteBoardGrant(ctx context.Context, sc models.Scope, id string) error {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DeleteBoardGrant.InvalidID: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.BoardTeamGrants(dbmodels.BoardTeamGrantWhere.ID.EQ(id)).DeleteAll(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DeleteBoardGrant.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) ListMembers(ctx context.Context, sc models.Scope, opts repository.ListMembersOptions) ([]models.TeamMember, error) {
+	qr, err := r.buildListMembersQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.ListMembers.buildListMembersQuery: %v", err)
+		return nil, err
+	}
+
+	ms, err := dbmodels.TeamMembers(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.ListMembers.All: %v", err)
+		return nil, err
+	}
+
+	members := make([]models.TeamMember, len(ms))
+	for i, m := range ms {
+		members[i] = models.NewTeamMember(*m)
+	}
+
+	return members, nil
+}
+
+func (r implRepository) DetailMember(ctx context.Context, sc models.Scope, opts repository.DetailMemberOptions) (models.TeamMember, error) {
+	qr, err := r.buildDetailMemberQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DetailMember.buildDetailMemberQuery: %v", err)
+		return models.TeamMember{}, err
+	}
+
+	m, err := dbmodels.TeamMembers(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.teams.repository.postgres.DetailMember.One.NoRows: %v", err)
+			return models.TeamMember{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DetailMember.One: %v", err)
+		return models.TeamMember{}, err
+	}
+
+	return models.NewTeamMember(*m), nil
+}
+
+func (r implRepository) CreateMember(ctx context.Context, sc models.Scope, opts repository.CreateMemberOptions) (models.TeamMember, error) {
+	m := r.buildMemberModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.CreateMember.Insert: %v", err)
+		return models.TeamMember{}, err
+	}
+
+	return models.NewTeamMember(m), nil
+}
+
+func (r implRepository) UpdateMemberRole(ctx context.Context, sc models.Scope, opts repository.UpdateMemberRoleOptions) (models.TeamMember, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.UpdateMemberRole.InvalidID: %v", err)
+		return models.TeamMember{}, err
+	}
+
+	m := dbmodels.TeamMember{
+		ID:        opts.ID,
+		Role:      string(opts.Role),
+		UpdatedAt: r.clock(),
+	}
+	n, err := m.Update(ctx, r.database, boil.Whitelist(dbmodels.TeamMemberColumns.Role, dbmodels.TeamMemberColumns.UpdatedAt))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.UpdateMemberRole.Update: %v", err)
+		return models.TeamMember{}, err
+	}
+	if n == 0 {
+		return models.TeamMember{}, repository.ErrNotFound
+	}
+
+	if err := m.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.UpdateMemberRole.Reload: %v", err)
+		return models.TeamMember{}, err
+	}
+
+	return models.NewTeamMember(m), nil
+}
+
+func (r implRepository) DeleteMember(ctx context.Context, sc models.Scope, id string) error {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DeleteMember.InvalidID: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.TeamMembers(dbmodels.TeamMemberWhere.ID.EQ(id)).DeleteAll(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.DeleteMember.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) CountMembers(ctx context.Context, sc models.Scope, opts repository.CountMembersOptions) (int64, error) {
+	qr, err := r.buildCountMembersQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.CountMembers.buildCountMembersQuery: %v", err)
+		return 0, err
+	}
+
+	n, err := dbmodels.TeamMembers(qr...).Count(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.CountMembers.Count: %v", err)
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,73 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildModel(sc models.Scope, opts repository.CreateOptions) dbmodels.Team {
+	now := r.clock()
+
+	return dbmodels.Team{
+		Name:        opts.Name,
+		Alias:       null.StringFrom(opts.Alias),
+		Description: null.StringFrom(opts.Description),
+		CreatedBy:   null.StringFrom(sc.UserID),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (r implRepository) buildUpdateModel(ctx context.Context, opts repository.UpdateOptions) (dbmodels.Team, []string, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildUpdateModel.IsUUID: %v", err)
+		return dbmodels.Team{}, nil, err
+	}
+
+	t := dbmodels.Team{
+		ID:          opts.ID,
+		Name:        opts.Name,
+		Alias:       null.StringFrom(opts.Alias),
+		Description: null.StringFrom(opts.Description),
+		UpdatedAt:   r.clock(),
+	}
+	cols := []string{
+		dbmodels.TeamColumns.Name,
+		dbmodels.TeamColumns.Alias,
+		dbmodels.TeamColumns.Description,
+		dbmodels.TeamColumns.UpdatedAt,
+	}
+
+	return t, cols, nil
+}
+
+func (r implRepository) buildMemberModel(opts repository.CreateMemberOptions) dbmodels.TeamMember {
+	now := r.clock()
+
+	return dbmodels.TeamMember{
+		TeamID:    opts.TeamID,
+		UserID:    opts.UserID,
+		Role:      string(opts.Role),
+		AddedBy:   null.NewString(opts.AddedBy, opts.AddedBy != ""),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func (r implRepository) buildBoardGrantModel(opts repository.CreateBoardGrantOptions) dbmodels.BoardTeamGrant {
+	now := r.clock()
+
+	return dbmodels.BoardTeamGrant{
+		BoardID:   opts.BoardID,
+		TeamID:    opts.TeamID,
+		Role:      string(opts.Role),
+		AddedBy:   null.NewString(opts.AddedBy, opts.AddedBy != ""),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
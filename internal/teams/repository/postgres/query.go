@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildGetQuery(ctx context.Context, fils teams.Filter) ([]qm.QueryMod, error) {
+	qr := postgres.BuildQueryWithSoftDelete()
+
+	if len(fils.IDs) > 0 {
+		for _, id := range fils.IDs {
+			if err := postgres.IsUUID(id); err != nil {
+				r.l.Errorf(ctx, "internal.teams.repository.postgres.buildGetQuery.InvalidID: %v", err)
+				return nil, err
+			}
+		}
+		qr = append(qr, dbmodels.TeamWhere.ID.IN(fils.IDs))
+	}
+
+	if fils.Keyword != "" {
+		qr = append(qr, qm.Where("(alias ILIKE ? OR description ILIKE ?)", "%"+fils.Keyword+"%", "%"+fils.Keyword+"%"))
+	}
+
+	if fils.MemberID != "" {
+		if err := postgres.IsUUID(fils.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.teams.repository.postgres.buildGetQuery.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("id IN (SELECT team_id FROM team_members WHERE user_id = ?)", fils.MemberID))
+	}
+
+	return qr, nil
+}
+
+func (r implRepository) buildDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildDetailQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	qr := postgres.BuildQueryWithSoftDelete()
+	qr = append(qr, dbmodels.TeamWhere.ID.EQ(ID))
+
+	return qr, nil
+}
+
+func (r implRepository) buildListMembersQuery(ctx context.Context, opts repository.ListMembersOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.TeamID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildListMembersQuery.InvalidTeamID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.TeamMemberWhere.TeamID.EQ(opts.TeamID),
+		qm.OrderBy(dbmodels.TeamMemberColumns.CreatedAt + " ASC"),
+	}, nil
+}
+
+func (r implRepository) buildDetailMemberQuery(ctx context.Context, opts repository.DetailMemberOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.TeamID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildDetailMemberQuery.InvalidTeamID: %v", err)
+		return nil, err
+	}
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildDetailMemberQuery.InvalidUserID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.TeamMemberWhere.TeamID.EQ(opts.TeamID),
+		dbmodels.TeamMemberWhere.UserID.EQ(opts.UserID),
+	}, nil
+}
+
+func (r implRepository) buildCountMembersQuery(ctx context.Context, opts repository.CountMembersOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.TeamID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildCountMembersQuery.InvalidTeamID: %v", err)
+		return nil, err
+	}
+
+	qr := []qm.QueryMod{dbmodels.TeamMemberWhere.TeamID.EQ(opts.TeamID)}
+	if opts.Role != "" {
+		qr = append(qr, dbmodels.TeamMemberWhere.Role.EQ(string(opts.Role)))
+	}
+
+	return qr, nil
+}
+
+func (r implRepository) buildListBoardGrantsQuery(ctx context.Context, opts repository.ListBoardGrantsOptions) ([]qm.QueryMod, error) {
+	qr := []qm.QueryMod{qm.OrderBy(dbmodels.BoardTeamGrantColumns.CreatedAt + " ASC")}
+
+	if opts.BoardID != "" {
+		if err := postgres.IsUUID(opts.BoardID); err != nil {
+			r.l.Errorf(ctx, "internal.teams.repository.postgres.buildListBoardGrantsQuery.InvalidBoardID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardTeamGrantWhere.BoardID.EQ(opts.BoardID))
+	}
+
+	if opts.TeamID != "" {
+		if err := postgres.IsUUID(opts.TeamID); err != nil {
+			r.l.Errorf(ctx, "internal.teams.repository.postgres.buildListBoardGrantsQuery.InvalidTeamID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardTeamGrantWhere.TeamID.EQ(opts.TeamID))
+	}
+
+	return qr, nil
+}
+
+func (r implRepository) buildDetailBoardGrantQuery(ctx context.Context, opts repository.DetailBoardGrantOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.BoardID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildDetailBoardGrantQuery.InvalidBoardID: %v", err)
+		return nil, err
+	}
+	if err := postgres.IsUUID(opts.TeamID); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.buildDetailBoardGrantQuery.InvalidTeamID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.BoardTeamGrantWhere.BoardID.EQ(opts.BoardID),
+		dbmodels.BoardTeamGrantWhere.TeamID.EQ(opts.TeamID),
+	}, nil
+}
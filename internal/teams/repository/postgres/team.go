@@ -0,0 +1,176 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+func (r implRepository) List(ctx context.Context, sc models.Scope, opts repository.ListOptions) ([]models.Team, error) {
+	qr, err := r.buildGetQuery(ctx, opts.Filter)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.List.buildGetQuery: %v", err)
+		return nil, err
+	}
+
+	ts, err := dbmodels.Teams(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.List.All: %v", err)
+		return nil, err
+	}
+
+	teams := make([]models.Team, len(ts))
+	for i, t := range ts {
+		teams[i] = models.NewTeam(*t)
+	}
+
+	return teams, nil
+}
+
+func (r implRepository) Get(ctx context.Context, sc models.Scope, opts repository.GetOptions) ([]models.Team, paginator.Paginator, error) {
+	qr, err := r.buildGetQuery(ctx, opts.Filter)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Get.buildGetQuery: %v", err)
+		return nil, paginator.Paginator{}, err
+	}
+
+	var (
+		total int64
+		ts    dbmodels.TeamSlice
+	)
+
+	errChan := make(chan error, 2)
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var countErr error
+		total, countErr = dbmodels.Teams(qr...).Count(ctx, r.database)
+		if countErr != nil {
+			r.l.Errorf(ctx, "internal.teams.repository.postgres.Get.Count: %v", countErr)
+			errChan <- countErr
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pageQr := append(qr[:len(qr):len(qr)],
+			qm.OrderBy(dbmodels.TeamColumns.CreatedAt+" DESC"),
+			qm.Limit(int(opts.PagQuery.Limit)),
+			qm.Offset(int(opts.PagQuery.Offset())),
+		)
+		var allErr error
+		ts, allErr = dbmodels.Teams(pageQr...).All(ctx, r.database)
+		if allErr != nil {
+			r.l.Errorf(ctx, "internal.teams.repository.postgres.Get.All: %v", allErr)
+			errChan <- allErr
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(errChan)
+	}()
+
+	for err := range errChan {
+		if err != nil {
+			r.l.Errorf(ctx, "internal.teams.repository.postgres.Get.errChan: %v", err)
+			return nil, paginator.Paginator{}, err
+		}
+	}
+
+	teams := make([]models.Team, len(ts))
+	for i, t := range ts {
+		teams[i] = models.NewTeam(*t)
+	}
+
+	return teams, paginator.Paginator{
+		Total:       total,
+		Count:       int64(len(teams)),
+		PerPage:     opts.PagQuery.Limit,
+		CurrentPage: opts.PagQuery.Page,
+	}, nil
+}
+
+func (r implRepository) Create(ctx context.Context, sc models.Scope, opts repository.CreateOptions) (models.Team, error) {
+	m := r.buildModel(sc, opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Create.Insert: %v", err)
+		return models.Team{}, err
+	}
+
+	return models.NewTeam(m), nil
+}
+
+func (r implRepository) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.Team, error) {
+	t, cols, err := r.buildUpdateModel(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Update.buildUpdateModel: %v", err)
+		return models.Team{}, err
+	}
+
+	n, err := t.Update(ctx, r.database, boil.Whitelist(cols...))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Update.Update: %v", err)
+		return models.Team{}, err
+	}
+	if n == 0 {
+		return models.Team{}, repository.ErrNotFound
+	}
+
+	if err := t.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Update.Reload: %v", err)
+		return models.Team{}, err
+	}
+
+	return models.NewTeam(t), nil
+}
+
+func (r implRepository) Detail(ctx context.Context, sc models.Scope, ID string) (models.Team, error) {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Detail.buildDetailQuery: %v", err)
+		return models.Team{}, err
+	}
+
+	t, err := dbmodels.Teams(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.teams.repository.postgres.Detail.One.NoRows: %v", err)
+			return models.Team{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Detail.One: %v", err)
+		return models.Team{}, err
+	}
+
+	return models.NewTeam(*t), nil
+}
+
+func (r implRepository) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Delete.buildDetailQuery: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.Teams(qr...).DeleteAll(ctx, r.database, false)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.teams.repository.postgres.Delete.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package teams
+
+import "errors"
+
+var (
+	ErrFieldRequired  = errors.New("field required")
+	ErrNotFound       = errors.New("team not found")
+	ErrInvalidRole    = errors.New("invalid team role")
+	ErrForbidden      = errors.New("not allowed in this team")
+	ErrMemberNotFound = errors.New("team member not found")
+	ErrAlreadyMember  = errors.New("user is already a team member")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrLastMaintainer = errors.New("team must keep a maintainer")
+
+	ErrInvalidGrantRole = errors.New("teams cannot be granted board ownership")
+	ErrGrantNotFound    = errors.New("team has no access to the board")
+	ErrAlreadyGranted   = errors.New("team already has access to the board")
+)
@@ -0,0 +1,31 @@
+package teams
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	Get(ctx context.Context, sc models.Scope, ip GetInput) (GetOutput, error)
+	Create(ctx context.Context, sc models.Scope, ip CreateInput) (DetailOutput, error)
+	Update(ctx context.Context, sc models.Scope, ip UpdateInput) (DetailOutput, error)
+	Detail(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	Delete(ctx context.Context, sc models.Scope, ID string) error
+
+	// Authorize returns ErrForbidden unless the user holds at least the role in
+	// the team. The team.manage permission grants every role in every team.
+	Authorize(ctx context.Context, sc models.Scope, ip AuthorizeInput) error
+	ListMembers(ctx context.Context, sc models.Scope, teamID string) (ListMembersOutput, error)
+	AddMember(ctx context.Context, sc models.Scope, ip AddMemberInput) (MemberOutput, error)
+	UpdateMemberRole(ctx context.Context, sc models.Scope, ip UpdateMemberRoleInput) (MemberOutput, error)
+	RemoveMember(ctx context.Context, sc models.Scope, ip RemoveMemberInput) error
+
+	// ListBoardGrants returns the teams that have access to the board. Every
+	// member of a team holds the role granted to the team on the board.
+	ListBoardGrants(ctx context.Context, sc models.Scope, boardID string) (ListBoardGrantsOutput, error)
+	GrantBoard(ctx context.Context, sc models.Scope, ip GrantBoardInput) (BoardGrantOutput, error)
+	UpdateBoardGrant(ctx context.Context, sc models.Scope, ip UpdateBoardGrantInput) (BoardGrantOutput, error)
+	RevokeBoard(ctx context.Context, sc models.Scope, ip RevokeBoardInput) error
+}
@@ -0,0 +1,99 @@
+package teams
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+type Filter struct {
+	IDs     []string
+	Keyword string
+	// MemberID keeps the teams the user is a member of
+	MemberID string
+}
+
+type GetInput struct {
+	Filter   Filter
+	PagQuery paginator.PaginateQuery
+}
+
+type CreateInput struct {
+	Name        string
+	Description string
+}
+
+type UpdateInput struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+type GetOutput struct {
+	Teams      []models.Team
+	Users      []models.User
+	Pagination paginator.Paginator
+}
+
+type DetailOutput struct {
+	Team  models.Team
+	Users []models.User
+}
+
+type AuthorizeInput struct {
+	TeamID string
+	Role   models.TeamRole
+}
+
+type AddMemberInput struct {
+	TeamID string
+	UserID string
+	Role   models.TeamRole
+}
+
+type UpdateMemberRoleInput struct {
+	TeamID string
+	UserID string
+	Role   models.TeamRole
+}
+
+type RemoveMemberInput struct {
+	TeamID string
+	UserID string
+}
+
+type ListMembersOutput struct {
+	Members []models.TeamMember
+	Users   []models.User
+}
+
+type MemberOutput struct {
+	Member models.TeamMember
+	User   models.User
+}
+
+type GrantBoardInput struct {
+	BoardID string
+	TeamID  string
+	Role    models.BoardRole
+}
+
+type UpdateBoardGrantInput struct {
+	BoardID string
+	TeamID  string
+	Role    models.BoardRole
+}
+
+type RevokeBoardInput struct {
+	BoardID string
+	TeamID  string
+}
+
+type ListBoardGrantsOutput struct {
+	Grants []models.BoardTeamGrant
+	Teams  []models.Team
+}
+
+type BoardGrantOutput struct {
+	Grant models.BoardTeamGrant
+	Team  models.Team
+}
@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+)
+
+func (uc implUsecase) Authorize(ctx context.Context, sc models.Scope, ip teams.AuthorizeInput) error {
+	if ip.TeamID == "" {
+		return teams.ErrFieldRequired
+	}
+
+	m, err := uc.repo.DetailMember(ctx, sc, repository.DetailMemberOptions{
+		TeamID: ip.TeamID,
+		UserID: sc.UserID,
+	})
+	if err != nil && err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Authorize.repo.DetailMember: %v", err)
+		return err
+	}
+	if err == nil && m.Role.AtLeast(ip.Role) {
+		return nil
+	}
+
+	admin, err := uc.canManageAll(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Authorize.canManageAll: %v", err)
+		return err
+	}
+	if admin {
+		return nil
+	}
+
+	uc.l.Warnf(ctx, "internal.teams.usecase.Authorize.Forbidden: user %s is not %s of team %s", sc.UserID, ip.Role, ip.TeamID)
+	return teams.ErrForbidden
+}
+
+// memberFilter returns the user team queries have to be restricted to,
+// or an empty string when the user can see every team.
+func (uc implUsecase) memberFilter(ctx context.Context, sc models.Scope) (string, error) {
+	admin, err := uc.canManageAll(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.memberFilter.canManageAll: %v", err)
+		return "", err
+	}
+	if admin {
+		return "", nil
+	}
+
+	return sc.UserID, nil
+}
+
+// canManageAll reports whether the role of the user grants access to all teams.
+func (uc implUsecase) canManageAll(ctx context.Context, sc models.Scope) (bool, error) {
+	ok, err := uc.roleUC.HasPermission(ctx, sc, models.PermissionTeamManage)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.canManageAll.roleUC.HasPermission: %v", err)
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorize(t *testing.T) {
+	sc := models.Scope{UserID: "user-1"}
+
+	tcs := map[string]struct {
+		members     []models.TeamMember
+		permissions []string
+		ip          teams.AuthorizeInput
+		wantErr     error
+	}{
+		"member": {
+			members: []models.TeamMember{{TeamID: "team-1", UserID: "user-1", Role: models.TeamRoleMember}},
+			ip:      teams.AuthorizeInput{TeamID: "team-1", Role: models.TeamRoleMember},
+		},
+		"member below maintainer": {
+			members: []models.TeamMember{{TeamID: "team-1", UserID: "user-1", Role: models.TeamRoleMember}},
+			ip:      teams.AuthorizeInput{TeamID: "team-1", Role: models.TeamRoleMaintainer},
+			wantErr: teams.ErrForbidden,
+		},
+		"maintainer": {
+			members: []models.TeamMember{{TeamID: "team-1", UserID: "user-1", Role: models.TeamRoleMaintainer}},
+			ip:      teams.AuthorizeInput{TeamID: "team-1", Role: models.TeamRoleMaintainer},
+		},
+		"member of another team": {
+			members: []models.TeamMember{{TeamID: "team-2", UserID: "user-1", Role: models.TeamRoleMaintainer}},
+			ip:      teams.AuthorizeInput{TeamID: "team-1", Role: models.TeamRoleMember},
+			wantErr: teams.ErrForbidden,
+		},
+		"can manage all teams": {
+			permissions: []string{models.PermissionTeamManage},
+			ip:          teams.AuthorizeInput{TeamID: "team-1", Role: models.TeamRoleMaintainer},
+		},
+		"missing team": {
+			ip:      teams.AuthorizeInput{Role: models.TeamRoleMember},
+			wantErr: teams.ErrFieldRequired,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.repo.members = tc.members
+			deps.roleUC.permissions = tc.permissions
+
+			err := uc.Authorize(context.Background(), sc, tc.ip)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
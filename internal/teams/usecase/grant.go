@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+)
+
+func (uc implUsecase) ListBoardGrants(ctx context.Context, sc models.Scope, boardID string) (teams.ListBoardGrantsOutput, error) {
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: boardID, Role: models.BoardRoleObserver}); err != nil {
+		return teams.ListBoardGrantsOutput{}, err
+	}
+
+	gs, err := uc.repo.ListBoardGrants(ctx, sc, repository.ListBoardGrantsOptions{BoardID: boardID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.ListBoardGrants.repo.ListBoardGrants: %v", err)
+		return teams.ListBoardGrantsOutput{}, err
+	}
+	if len(gs) == 0 {
+		return teams.ListBoardGrantsOutput{}, nil
+	}
+
+	tIDs := make([]string, len(gs))
+	for i, g := range gs {
+		tIDs[i] = g.TeamID
+	}
+	ts, err := uc.repo.List(ctx, sc, repository.ListOptions{
+		Filter: teams.Filter{
+			IDs: tIDs,
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.ListBoardGrants.repo.List: %v", err)
+		return teams.ListBoardGrantsOutput{}, err
+	}
+
+	return teams.ListBoardGrantsOutput{
+		Grants: gs,
+		Teams:  ts,
+	}, nil
+}
+
+func (uc implUsecase) GrantBoard(ctx context.Context, sc models.Scope, ip teams.GrantBoardInput) (teams.BoardGrantOutput, error) {
+	if ip.BoardID == "" || ip.TeamID == "" {
+		return teams.BoardGrantOutput{}, teams.ErrFieldRequired
+	}
+	if err := validateGrantRole(ip.Role); err != nil {
+		return teams.BoardGrantOutput{}, err
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		return teams.BoardGrantOutput{}, err
+	}
+
+	// Only the teams the user can see can be given access
+	t, err := uc.detail(ctx, sc, ip.TeamID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.GrantBoard.detail: %v", err)
+		return teams.BoardGrantOutput{}, err
+	}
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: ip.TeamID, Role: models.TeamRoleMember}); err != nil {
+		return teams.BoardGrantOutput{}, err
+	}
+
+	_, err = uc.repo.DetailBoardGrant(ctx, sc, repository.DetailBoardGrantOptions{
+		BoardID: ip.BoardID,
+		TeamID:  ip.TeamID,
+	})
+	if err == nil {
+		return teams.BoardGrantOutput{}, teams.ErrAlreadyGranted
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.teams.usecase.GrantBoard.repo.DetailBoardGrant: %v", err)
+		return teams.BoardGrantOutput{}, err
+	}
+
+	g, err := uc.repo.CreateBoardGrant(ctx, sc, repository.CreateBoardGrantOptions{
+		BoardID: ip.BoardID,
+		TeamID:  ip.TeamID,
+		Role:    ip.Role,
+		AddedBy: sc.UserID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.GrantBoard.repo.CreateBoardGrant: %v", err)
+		return teams.BoardGrantOutput{}, err
+	}
+
+	return teams.BoardGrantOutput{
+		Grant: g,
+		Team:  t,
+	}, nil
+}
+
+func (uc implUsecase) UpdateBoardGrant(ctx context.Context, sc models.Scope, ip teams.UpdateBoardGrantInput) (teams.BoardGrantOutput, error) {
+	if ip.BoardID == "" || ip.TeamID == "" {
+		return teams.BoardGrantOutput{}, teams.ErrFieldRequired
+	}
+	if err := validateGrantRole(ip.Role); err != nil {
+		return teams.BoardGrantOutput{}, err
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		return teams.BoardGrantOutput{}, err
+	}
+
+	og, err := uc.detailBoardGrant(ctx, sc, ip.BoardID, ip.TeamID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.UpdateBoardGrant.detailBoardGrant: %v", err)
+		return teams.BoardGrantOutput{}, err
+	}
+
+	g, err := uc.repo.UpdateBoardGrantRole(ctx, sc, repository.UpdateBoardGrantRoleOptions{
+		ID:   og.ID,
+		Role: ip.Role,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.UpdateBoardGrant.repo.UpdateBoardGrantRole.NotFound: %v", err)
+			return teams.BoardGrantOutput{}, teams.ErrGrantNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.UpdateBoardGrant.repo.UpdateBoardGrantRole: %v", err)
+		return teams.BoardGrantOutput{}, err
+	}
+
+	t, err := uc.detail(ctx, sc, g.TeamID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.UpdateBoardGrant.detail: %v", err)
+		return teams.BoardGrantOutput{}, err
+	}
+
+	return teams.BoardGrantOutput{
+		Grant: g,
+		Team:  t,
+	}, nil
+}
+
+func (uc implUsecase) RevokeBoard(ctx context.Context, sc models.Scope, ip teams.RevokeBoardInput) error {
+	if ip.BoardID == "" || ip.TeamID == "" {
+		return teams.ErrFieldRequired
+	}
+
+	if err := uc.memberUC.Authorize(ctx, sc, members.AuthorizeInput{BoardID: ip.BoardID, Role: models.BoardRoleAdmin}); err != nil {
+		return err
+	}
+
+	g, err := uc.detailBoardGrant(ctx, sc, ip.BoardID, ip.TeamID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.RevokeBoard.detailBoardGrant: %v", err)
+		return err
+	}
+
+	if err := uc.repo.DeleteBoardGrant(ctx, sc, g.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.RevokeBoard.repo.DeleteBoardGrant.NotFound: %v", err)
+			return teams.ErrGrantNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.RevokeBoard.repo.DeleteBoardGrant: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) detailBoardGrant(ctx context.Context, sc models.Scope, boardID, teamID string) (models.BoardTeamGrant, error) {
+	g, err := uc.repo.DetailBoardGrant(ctx, sc, repository.DetailBoardGrantOptions{
+		BoardID: boardID,
+		TeamID:  teamID,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.detailBoardGrant.repo.DetailBoardGrant.NotFound: %v", err)
+			return models.BoardTeamGrant{}, teams.ErrGrantNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.detailBoardGrant.repo.DetailBoardGrant: %v", err)
+		return models.BoardTeamGrant{}, err
+	}
+
+	return g, nil
+}
+
+// validateGrantRole checks the role can be granted to a team, board ownership
+// stays with individual users.
+func validateGrantRole(role models.BoardRole) error {
+	if !role.IsValid() {
+		return teams.ErrInvalidRole
+	}
+	if role == models.BoardRoleOwner {
+		return teams.ErrInvalidGrantRole
+	}
+
+	return nil
+}
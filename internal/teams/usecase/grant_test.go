@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantBoard(t *testing.T) {
+	sc := models.Scope{UserID: "user-1"}
+	maintainer := []models.TeamMember{{TeamID: "team-1", UserID: "user-1", Role: models.TeamRoleMaintainer}}
+
+	tcs := map[string]struct {
+		boardRole models.BoardRole
+		members   []models.TeamMember
+		grants    []models.BoardTeamGrant
+		ip        teams.GrantBoardInput
+		wantErr   error
+	}{
+		"board admin grants a team of their own": {
+			boardRole: models.BoardRoleAdmin,
+			members:   maintainer,
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-1", Role: models.BoardRoleMember},
+		},
+		"board member": {
+			boardRole: models.BoardRoleMember,
+			members:   maintainer,
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-1", Role: models.BoardRoleMember},
+			wantErr:   members.ErrForbidden,
+		},
+		"team the user is not in": {
+			boardRole: models.BoardRoleAdmin,
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-1", Role: models.BoardRoleMember},
+			wantErr:   teams.ErrForbidden,
+		},
+		"unknown team": {
+			boardRole: models.BoardRoleAdmin,
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-2", Role: models.BoardRoleMember},
+			wantErr:   teams.ErrNotFound,
+		},
+		"ownership": {
+			boardRole: models.BoardRoleOwner,
+			members:   maintainer,
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-1", Role: models.BoardRoleOwner},
+			wantErr:   teams.ErrInvalidGrantRole,
+		},
+		"invalid role": {
+			boardRole: models.BoardRoleAdmin,
+			members:   maintainer,
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-1", Role: "superuser"},
+			wantErr:   teams.ErrInvalidRole,
+		},
+		"already granted": {
+			boardRole: models.BoardRoleAdmin,
+			members:   maintainer,
+			grants:    []models.BoardTeamGrant{{ID: "grant-1", BoardID: "board-1", TeamID: "team-1", Role: models.BoardRoleObserver}},
+			ip:        teams.GrantBoardInput{BoardID: "board-1", TeamID: "team-1", Role: models.BoardRoleMember},
+			wantErr:   teams.ErrAlreadyGranted,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.repo.teams["team-1"] = models.Team{ID: "team-1", Name: "Platform"}
+			deps.repo.members = tc.members
+			deps.repo.grants = tc.grants
+			deps.memberUC.roles["board-1"] = tc.boardRole
+
+			o, err := uc.GrantBoard(context.Background(), sc, tc.ip)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Equal(t, tc.grants, deps.repo.grants)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "team-1", o.Team.ID)
+			assert.Equal(t, tc.ip.Role, o.Grant.Role)
+			require.Len(t, deps.repo.grants, 1)
+			assert.Equal(t, "user-1", *deps.repo.grants[0].AddedBy)
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+)
+
+func (uc implUsecase) ListMembers(ctx context.Context, sc models.Scope, teamID string) (teams.ListMembersOutput, error) {
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: teamID, Role: models.TeamRoleMember}); err != nil {
+		return teams.ListMembersOutput{}, err
+	}
+
+	ms, err := uc.repo.ListMembers(ctx, sc, repository.ListMembersOptions{TeamID: teamID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.ListMembers.repo.ListMembers: %v", err)
+		return teams.ListMembersOutput{}, err
+	}
+
+	uIDs := make([]string, len(ms))
+	for i, m := range ms {
+		uIDs[i] = m.UserID
+	}
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: uIDs,
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.ListMembers.userUC.List: %v", err)
+		return teams.ListMembersOutput{}, err
+	}
+
+	return teams.ListMembersOutput{
+		Members: ms,
+		Users:   us,
+	}, nil
+}
+
+func (uc implUsecase) AddMember(ctx context.Context, sc models.Scope, ip teams.AddMemberInput) (teams.MemberOutput, error) {
+	if ip.TeamID == "" || ip.UserID == "" {
+		return teams.MemberOutput{}, teams.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return teams.MemberOutput{}, teams.ErrInvalidRole
+	}
+
+	if _, err := uc.detail(ctx, sc, ip.TeamID); err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.AddMember.detail: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: ip.TeamID, Role: models.TeamRoleMaintainer}); err != nil {
+		return teams.MemberOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, ip.UserID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.AddMember.userUC.Detail.NotFound: %v", err)
+			return teams.MemberOutput{}, teams.ErrUserNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.AddMember.userUC.Detail: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	_, err = uc.repo.DetailMember(ctx, sc, repository.DetailMemberOptions{
+		TeamID: ip.TeamID,
+		UserID: ip.UserID,
+	})
+	if err == nil {
+		return teams.MemberOutput{}, teams.ErrAlreadyMember
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.teams.usecase.AddMember.repo.DetailMember: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	m, err := uc.repo.CreateMember(ctx, sc, repository.CreateMemberOptions{
+		TeamID:  ip.TeamID,
+		UserID:  ip.UserID,
+		Role:    ip.Role,
+		AddedBy: sc.UserID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.AddMember.repo.CreateMember: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	return teams.MemberOutput{
+		Member: m,
+		User:   u.User,
+	}, nil
+}
+
+func (uc implUsecase) UpdateMemberRole(ctx context.Context, sc models.Scope, ip teams.UpdateMemberRoleInput) (teams.MemberOutput, error) {
+	if ip.TeamID == "" || ip.UserID == "" {
+		return teams.MemberOutput{}, teams.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return teams.MemberOutput{}, teams.ErrInvalidRole
+	}
+
+	om, err := uc.detailMember(ctx, sc, ip.TeamID, ip.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.UpdateMemberRole.detailMember: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: ip.TeamID, Role: models.TeamRoleMaintainer}); err != nil {
+		return teams.MemberOutput{}, err
+	}
+
+	if om.Role == models.TeamRoleMaintainer && ip.Role != models.TeamRoleMaintainer {
+		if err := uc.checkOtherMaintainer(ctx, sc, ip.TeamID); err != nil {
+			uc.l.Warnf(ctx, "internal.teams.usecase.UpdateMemberRole.checkOtherMaintainer: %v", err)
+			return teams.MemberOutput{}, err
+		}
+	}
+
+	m, err := uc.repo.UpdateMemberRole(ctx, sc, repository.UpdateMemberRoleOptions{
+		ID:   om.ID,
+		Role: ip.Role,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.UpdateMemberRole.repo.UpdateMemberRole.NotFound: %v", err)
+			return teams.MemberOutput{}, teams.ErrMemberNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.UpdateMemberRole.repo.UpdateMemberRole: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, m.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.UpdateMemberRole.userUC.Detail: %v", err)
+		return teams.MemberOutput{}, err
+	}
+
+	return teams.MemberOutput{
+		Member: m,
+		User:   u.User,
+	}, nil
+}
+
+func (uc implUsecase) RemoveMember(ctx context.Context, sc models.Scope, ip teams.RemoveMemberInput) error {
+	if ip.TeamID == "" || ip.UserID == "" {
+		return teams.ErrFieldRequired
+	}
+
+	om, err := uc.detailMember(ctx, sc, ip.TeamID, ip.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.RemoveMember.detailMember: %v", err)
+		return err
+	}
+
+	// Anyone can leave a team, removing somebody else takes a maintainer
+	if ip.UserID != sc.UserID {
+		if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: ip.TeamID, Role: models.TeamRoleMaintainer}); err != nil {
+			return err
+		}
+	}
+
+	if om.Role == models.TeamRoleMaintainer {
+		if err := uc.checkOtherMaintainer(ctx, sc, ip.TeamID); err != nil {
+			uc.l.Warnf(ctx, "internal.teams.usecase.RemoveMember.checkOtherMaintainer: %v", err)
+			return err
+		}
+	}
+
+	if err := uc.repo.DeleteMember(ctx, sc, om.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.RemoveMember.repo.DeleteMember.NotFound: %v", err)
+			return teams.ErrMemberNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.RemoveMember.repo.DeleteMember: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// detailMember returns the membership of the user, ErrMemberNotFound is only
+// returned to callers that can see the team.
+func (uc implUsecase) detailMember(ctx context.Context, sc models.Scope, teamID, userID string) (models.TeamMember, error) {
+	m, err := uc.repo.DetailMember(ctx, sc, repository.DetailMemberOptions{
+		TeamID: teamID,
+		UserID: userID,
+	})
+	if err == nil {
+		return m, nil
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.teams.usecase.detailMember.repo.DetailMember: %v", err)
+		return models.TeamMember{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: teamID, Role: models.TeamRoleMember}); err != nil {
+		return models.TeamMember{}, err
+	}
+	return models.TeamMember{}, teams.ErrMemberNotFound
+}
+
+// checkOtherMaintainer returns ErrLastMaintainer when the team has a single maintainer.
+func (uc implUsecase) checkOtherMaintainer(ctx context.Context, sc models.Scope, teamID string) error {
+	n, err := uc.repo.CountMembers(ctx, sc, repository.CountMembersOptions{
+		TeamID: teamID,
+		Role:   models.TeamRoleMaintainer,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.checkOtherMaintainer.repo.CountMembers: %v", err)
+		return err
+	}
+	if n <= 1 {
+		return teams.ErrLastMaintainer
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+type implUsecase struct {
+	l        log.Logger
+	repo     repository.Repository
+	userUC   user.UseCase
+	roleUC   role.UseCase
+	memberUC members.UseCase
+	clock    func() time.Time
+}
+
+var _ teams.UseCase = &implUsecase{}
+
+func New(l log.Logger, repo repository.Repository, userUC user.UseCase, roleUC role.UseCase, memberUC members.UseCase) teams.UseCase {
+	return &implUsecase{
+		l:        l,
+		repo:     repo,
+		userUC:   userUC,
+		roleUC:   roleUC,
+		memberUC: memberUC,
+		clock:    util.Now,
+	}
+}
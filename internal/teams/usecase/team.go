@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip teams.GetInput) (teams.GetOutput, error) {
+	memberID, err := uc.memberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Get.memberFilter: %v", err)
+		return teams.GetOutput{}, err
+	}
+
+	ts, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
+		Filter: teams.Filter{
+			IDs:      ip.Filter.IDs,
+			Keyword:  util.BuildAlias(ip.Filter.Keyword),
+			MemberID: memberID,
+		},
+		PagQuery: ip.PagQuery,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Get.repo.Get: %v", err)
+		return teams.GetOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, ts)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Get.listCreators: %v", err)
+		return teams.GetOutput{}, err
+	}
+
+	return teams.GetOutput{
+		Teams:      ts,
+		Users:      us,
+		Pagination: p,
+	}, nil
+}
+
+func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip teams.CreateInput) (teams.DetailOutput, error) {
+	if ip.Name == "" {
+		return teams.DetailOutput{}, teams.ErrFieldRequired
+	}
+
+	if err := uc.roleUC.Authorize(ctx, sc, models.PermissionTeamCreate); err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.Create.roleUC.Authorize: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	t, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		Name:        ip.Name,
+		Alias:       util.BuildAlias(ip.Name),
+		Description: ip.Description,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Create.repo.Create: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	// The creator maintains the team
+	if _, err := uc.repo.CreateMember(ctx, sc, repository.CreateMemberOptions{
+		TeamID:  t.ID,
+		UserID:  sc.UserID,
+		Role:    models.TeamRoleMaintainer,
+		AddedBy: sc.UserID,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Create.repo.CreateMember: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.Team{t})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Create.listCreators: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	return teams.DetailOutput{
+		Team:  t,
+		Users: us,
+	}, nil
+}
+
+func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip teams.UpdateInput) (teams.DetailOutput, error) {
+	if ip.ID == "" || ip.Name == "" {
+		return teams.DetailOutput{}, teams.ErrFieldRequired
+	}
+
+	if _, err := uc.detail(ctx, sc, ip.ID); err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.Update.detail: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: ip.ID, Role: models.TeamRoleMaintainer}); err != nil {
+		return teams.DetailOutput{}, err
+	}
+
+	t, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
+		ID:          ip.ID,
+		Name:        ip.Name,
+		Alias:       util.BuildAlias(ip.Name),
+		Description: ip.Description,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.Update.repo.Update.NotFound: %v", err)
+			return teams.DetailOutput{}, teams.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.Update.repo.Update: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.Team{t})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Update.listCreators: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	return teams.DetailOutput{
+		Team:  t,
+		Users: us,
+	}, nil
+}
+
+func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (teams.DetailOutput, error) {
+	t, err := uc.detail(ctx, sc, ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.Detail.detail: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: t.ID, Role: models.TeamRoleMember}); err != nil {
+		return teams.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.Team{t})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.Detail.listCreators: %v", err)
+		return teams.DetailOutput{}, err
+	}
+
+	return teams.DetailOutput{
+		Team:  t,
+		Users: us,
+	}, nil
+}
+
+func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	if _, err := uc.detail(ctx, sc, ID); err != nil {
+		uc.l.Warnf(ctx, "internal.teams.usecase.Delete.detail: %v", err)
+		return err
+	}
+
+	if err := uc.Authorize(ctx, sc, teams.AuthorizeInput{TeamID: ID, Role: models.TeamRoleMaintainer}); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, sc, ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.Delete.repo.Delete.NotFound: %v", err)
+			return teams.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.Delete.repo.Delete: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) detail(ctx context.Context, sc models.Scope, ID string) (models.Team, error) {
+	t, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.teams.usecase.detail.repo.Detail.NotFound: %v", err)
+			return models.Team{}, teams.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.teams.usecase.detail.repo.Detail: %v", err)
+		return models.Team{}, err
+	}
+
+	return t, nil
+}
+
+func (uc implUsecase) listCreators(ctx context.Context, sc models.Scope, ts []models.Team) ([]models.User, error) {
+	uIDs := make([]string, 0, len(ts))
+	for _, t := range ts {
+		if t.CreatedBy != nil {
+			uIDs = append(uIDs, *t.CreatedBy)
+		}
+	}
+	if len(uIDs) == 0 {
+		return nil, nil
+	}
+
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: util.RemoveDuplicates(uIDs),
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.teams.usecase.listCreators.userUC.List: %v", err)
+		return nil, err
+	}
+
+	return us, nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/teams/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+)
+
+// fakeRepo keeps the teams, members and grants in memory. Calling a method it
+// does not implement panics through the nil embedded interface.
+type fakeRepo struct {
+	repository.Repository
+
+	teams   map[string]models.Team
+	members []models.TeamMember
+	grants  []models.BoardTeamGrant
+}
+
+func (r *fakeRepo) Detail(ctx context.Context, sc models.Scope, id string) (models.Team, error) {
+	t, ok := r.teams[id]
+	if !ok {
+		return models.Team{}, repository.ErrNotFound
+	}
+	return t, nil
+}
+
+func (r *fakeRepo) DetailMember(ctx context.Context, sc models.Scope, opts repository.DetailMemberOptions) (models.TeamMember, error) {
+	for _, m := range r.members {
+		if m.TeamID == opts.TeamID && m.UserID == opts.UserID {
+			return m, nil
+		}
+	}
+	return models.TeamMember{}, repository.ErrNotFound
+}
+
+func (r *fakeRepo) DetailBoardGrant(ctx context.Context, sc models.Scope, opts repository.DetailBoardGrantOptions) (models.BoardTeamGrant, error) {
+	for _, g := range r.grants {
+		if g.BoardID == opts.BoardID && g.TeamID == opts.TeamID {
+			return g, nil
+		}
+	}
+	return models.BoardTeamGrant{}, repository.ErrNotFound
+}
+
+func (r *fakeRepo) CreateBoardGrant(ctx context.Context, sc models.Scope, opts repository.CreateBoardGrantOptions) (models.BoardTeamGrant, error) {
+	g := models.BoardTeamGrant{
+		ID:      "grant-" + opts.TeamID,
+		BoardID: opts.BoardID,
+		TeamID:  opts.TeamID,
+		Role:    opts.Role,
+		AddedBy: &opts.AddedBy,
+	}
+	r.grants = append(r.grants, g)
+	return g, nil
+}
+
+// fakeMemberUC gives the user the roles it holds by board.
+type fakeMemberUC struct {
+	members.UseCase
+
+	roles map[string]models.BoardRole
+}
+
+func (u *fakeMemberUC) Authorize(ctx context.Context, sc models.Scope, ip members.AuthorizeInput) error {
+	role, ok := u.roles[ip.BoardID]
+	if !ok || !role.AtLeast(ip.Role) {
+		return members.ErrForbidden
+	}
+	return nil
+}
+
+// fakeRoleUC grants the permissions it holds to every user.
+type fakeRoleUC struct {
+	role.UseCase
+
+	permissions []string
+}
+
+func (u *fakeRoleUC) HasPermission(ctx context.Context, sc models.Scope, permissions ...string) (bool, error) {
+	for _, p := range permissions {
+		found := false
+		for _, granted := range u.permissions {
+			if granted == p {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type mockDeps struct {
+	repo     *fakeRepo
+	memberUC *fakeMemberUC
+	roleUC   *fakeRoleUC
+}
+
+func initUseCase(t *testing.T, mockTime time.Time) (*implUsecase, mockDeps) {
+	t.Helper()
+
+	repo := &fakeRepo{teams: make(map[string]models.Team)}
+	memberUC := &fakeMemberUC{roles: make(map[string]models.BoardRole)}
+	roleUC := &fakeRoleUC{}
+
+	uc := &implUsecase{
+		l:        log.InitializeTestZapLogger(),
+		repo:     repo,
+		memberUC: memberUC,
+		roleUC:   roleUC,
+		clock:    func() time.Time { return mockTime },
+	}
+
+	return uc, mockDeps{
+		repo:     repo,
+		memberUC: memberUC,
+		roleUC:   roleUC,
+	}
+}
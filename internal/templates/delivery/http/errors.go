@@ -0,0 +1,52 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+)
+
+var (
+	errWrongQuery         = pkgErrors.NewHTTPError(11201, "Wrong query")
+	errFieldRequired      = pkgErrors.NewHTTPError(11202, "Field required")
+	errNotFound           = pkgErrors.NewHTTPError(11203, "Template not found")
+	errForbidden          = &pkgErrors.HTTPError{Code: 11204, Message: "You are not allowed to change this template", StatusCode: http.StatusForbidden}
+	errBuiltin            = &pkgErrors.HTTPError{Code: 11205, Message: "Built-in templates cannot be changed", StatusCode: http.StatusForbidden}
+	errBoardNotFound      = pkgErrors.NewHTTPError(11206, "Board not found")
+	errBoardForbidden     = &pkgErrors.HTTPError{Code: 11207, Message: "You do not have access to this board", StatusCode: http.StatusForbidden}
+	errWorkspaceForbidden = &pkgErrors.HTTPError{Code: 11208, Message: "You do not have access to this workspace", StatusCode: http.StatusForbidden}
+)
+
+func (h handler) mapErrorCode(err error) error {
+	switch err {
+	case templates.ErrFieldRequired:
+		return errFieldRequired
+	case templates.ErrNotFound:
+		return errNotFound
+	case templates.ErrForbidden:
+		return errForbidden
+	case templates.ErrBuiltin:
+		return errBuiltin
+	case boards.ErrNotFound:
+		return errBoardNotFound
+	case members.ErrForbidden:
+		return errBoardForbidden
+	case workspaces.ErrForbidden:
+		return errWorkspaceForbidden
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errNotFound,
+	errForbidden,
+	errBuiltin,
+	errBoardNotFound,
+	errBoardForbidden,
+	errWorkspaceForbidden,
+}
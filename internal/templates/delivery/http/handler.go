@@ -0,0 +1,202 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Get templates
+// @Description List the built-in board templates and the ones the user created or shares through a workspace
+// @Tags Template
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param ids[] query []string false "IDs"
+// @Param keyword query string false "Keyword"
+// @Param workspace_id query string false "Workspace ID"
+// @Param page query integer false "Page"
+// @Param limit query integer false "Limit"
+// @Success 200 {object} getResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/templates [GET]
+func (h handler) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processGetRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.templates.http.Get.processGetRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Get(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.templates.http.Get.uc.Get: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.templates.http.Get.uc.Get: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newGetResp(o))
+}
+
+// @Summary Save board as template
+// @Description Save the lists and labels of a board, and optionally its cards, as a template. Requires access to the board
+// @Tags Template
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param body body createReq true "Template data"
+// @Success 200 {object} templateItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/templates [POST]
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.templates.http.Create.processCreateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Create(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.templates.http.Create.uc.Create: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.templates.http.Create.uc.Create: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Get template
+// @Description Get a template with its lists, labels and sample cards
+// @Tags Template
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Template ID"
+// @Success 200 {object} templateItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/templates/{id} [GET]
+func (h handler) Detail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.templates.http.Detail.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Detail(ctx, sc, id)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.templates.http.Detail.uc.Detail: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.templates.http.Detail.uc.Detail: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Update template
+// @Description Rename a template or change its description. Requires being its creator or an admin of its workspace
+// @Tags Template
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Template ID"
+// @Param body body updateReq true "Template data"
+// @Success 200 {object} templateItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/templates/{id} [PUT]
+func (h handler) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.templates.http.Update.processUpdateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Update(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.templates.http.Update.uc.Update: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.templates.http.Update.uc.Update: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Delete template
+// @Description Delete a template, boards created from it are kept. Requires being its creator or an admin of its workspace
+// @Tags Template
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Template ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/templates/{id} [DELETE]
+func (h handler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.templates.http.Delete.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.Delete(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.templates.http.Delete.uc.Delete: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.templates.http.Delete.uc.Delete: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
@@ -0,0 +1,11 @@
+package http
+
+import "github.com/gin-gonic/gin"
+
+type Handler interface {
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Detail(c *gin.Context)
+	Delete(c *gin.Context)
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/pkg/discord"
+	pkgLog "github.com/nguyentantai21042004/kanban-api/pkg/log"
+)
+
+type handler struct {
+	l  pkgLog.Logger
+	uc templates.UseCase
+	d  *discord.Discord
+}
+
+func New(l pkgLog.Logger, uc templates.UseCase, d *discord.Discord) Handler {
+	h := handler{
+		l:  l,
+		uc: uc,
+		d:  d,
+	}
+	return h
+}
@@ -0,0 +1,213 @@
+package http
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+type respObj struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type templateCardItem struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Priority    string   `json:"priority,omitempty"`
+	Labels      []string `json:"labels,omitempty"`
+	Checklist   []string `json:"checklist,omitempty"`
+}
+
+type templateListItem struct {
+	Name  string             `json:"name"`
+	Cards []templateCardItem `json:"cards"`
+}
+
+type templateLabelItem struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type templateItem struct {
+	ID          string              `json:"id"`
+	Key         string              `json:"key,omitempty"`
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	IsBuiltin   bool                `json:"is_builtin"`
+	WorkspaceID string              `json:"workspace_id,omitempty"`
+	Lists       []templateListItem  `json:"lists"`
+	Labels      []templateLabelItem `json:"labels"`
+	CreatedBy   *respObj            `json:"created_by,omitempty"`
+	CreatedAt   time.Time           `json:"created_at"`
+}
+
+func newTemplateItem(t models.BoardTemplate, userMap map[string]models.User) templateItem {
+	item := templateItem{
+		ID:        t.ID,
+		Name:      t.Name,
+		IsBuiltin: t.IsBuiltin,
+		Lists:     make([]templateListItem, len(t.Content.Lists)),
+		Labels:    make([]templateLabelItem, len(t.Content.Labels)),
+		CreatedAt: t.CreatedAt,
+	}
+	if t.Key != nil {
+		item.Key = *t.Key
+	}
+	if t.Description != nil {
+		item.Description = *t.Description
+	}
+	if t.WorkspaceID != nil {
+		item.WorkspaceID = *t.WorkspaceID
+	}
+	if t.CreatedBy != nil {
+		item.CreatedBy = &respObj{
+			ID:   *t.CreatedBy,
+			Name: userMap[*t.CreatedBy].FullName,
+		}
+	}
+
+	for i, l := range t.Content.Lists {
+		cards := make([]templateCardItem, len(l.Cards))
+		for j, c := range l.Cards {
+			cards[j] = templateCardItem{
+				Name:        c.Name,
+				Description: c.Description,
+				Priority:    string(c.Priority),
+				Labels:      c.Labels,
+				Checklist:   c.Checklist,
+			}
+		}
+		item.Lists[i] = templateListItem{
+			Name:  l.Name,
+			Cards: cards,
+		}
+	}
+	for i, lb := range t.Content.Labels {
+		item.Labels[i] = templateLabelItem{
+			Name:  lb.Name,
+			Color: lb.Color,
+		}
+	}
+
+	return item
+}
+
+func newUserMap(us []models.User) map[string]models.User {
+	userMap := make(map[string]models.User, len(us))
+	for _, u := range us {
+		userMap[u.ID] = u
+	}
+
+	return userMap
+}
+
+// Get
+type getReq struct {
+	IDs         []string `form:"ids[]"`
+	Keyword     string   `form:"keyword"`
+	WorkspaceID string   `form:"workspace_id"`
+	PageQuery   paginator.PaginateQuery
+}
+
+func (req getReq) validate() error {
+	for _, id := range req.IDs {
+		if err := postgres.IsUUID(id); err != nil {
+			return errors.New("invalid id")
+		}
+	}
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+
+	return nil
+}
+
+func (req getReq) toInput() templates.GetInput {
+	return templates.GetInput{
+		Filter: templates.Filter{
+			IDs:         req.IDs,
+			Keyword:     req.Keyword,
+			WorkspaceID: req.WorkspaceID,
+		},
+		PagQuery: req.PageQuery,
+	}
+}
+
+type getResp struct {
+	Items []templateItem              `json:"items"`
+	Meta  paginator.PaginatorResponse `json:"meta"`
+}
+
+func (h handler) newGetResp(o templates.GetOutput) getResp {
+	userMap := newUserMap(o.Users)
+
+	items := make([]templateItem, len(o.Templates))
+	for i, t := range o.Templates {
+		items[i] = newTemplateItem(t, userMap)
+	}
+
+	return getResp{
+		Items: items,
+		Meta:  o.Pagination.ToResponse(),
+	}
+}
+
+func (h handler) newDetailResp(o templates.DetailOutput) templateItem {
+	return newTemplateItem(o.Template, newUserMap(o.Users))
+}
+
+// Create
+type createReq struct {
+	BoardID     string `json:"board_id" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+	// WorkspaceID shares the template with the members of the workspace
+	WorkspaceID string `json:"workspace_id"`
+	// IncludeCards keeps the cards of the board as sample cards
+	IncludeCards bool `json:"include_cards"`
+}
+
+func (req createReq) validate() error {
+	if err := postgres.IsUUID(req.BoardID); err != nil {
+		return errors.New("invalid board id")
+	}
+	if req.WorkspaceID != "" {
+		if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+			return errors.New("invalid workspace id")
+		}
+	}
+
+	return nil
+}
+
+func (req createReq) toInput() templates.CreateInput {
+	return templates.CreateInput{
+		BoardID:      req.BoardID,
+		Name:         req.Name,
+		Description:  req.Description,
+		WorkspaceID:  req.WorkspaceID,
+		IncludeCards: req.IncludeCards,
+	}
+}
+
+// Update
+type updateReq struct {
+	ID          string `json:"-"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+func (req updateReq) toInput() templates.UpdateInput {
+	return templates.UpdateInput{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
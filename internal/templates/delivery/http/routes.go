@@ -0,0 +1,15 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
+)
+
+func MapTemplateRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.Use(mw.Auth())
+	r.GET("", h.Get)
+	r.POST("", h.Create)
+	r.GET("/:id", h.Detail)
+	r.PUT("/:id", h.Update)
+	r.DELETE("/:id", h.Delete)
+}
@@ -0,0 +1,7 @@
+package repository
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("record not found")
+)
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	List(ctx context.Context, sc models.Scope, opts ListOptions) ([]models.BoardTemplate, error)
+	Get(ctx context.Context, sc models.Scope, opts GetOptions) ([]models.BoardTemplate, paginator.Paginator, error)
+	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.BoardTemplate, error)
+	Update(ctx context.Context, sc models.Scope, opts UpdateOptions) (models.BoardTemplate, error)
+	Detail(ctx context.Context, sc models.Scope, id string) (models.BoardTemplate, error)
+	Delete(ctx context.Context, sc models.Scope, id string) error
+}
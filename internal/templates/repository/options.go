@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+type ListOptions struct {
+	Filter templates.Filter
+}
+
+type GetOptions struct {
+	Filter   templates.Filter
+	PagQuery paginator.PaginateQuery
+}
+
+type CreateOptions struct {
+	Name        string
+	Description string
+	Content     models.BoardTemplateContent
+	WorkspaceID string
+}
+
+type UpdateOptions struct {
+	ID          string
+	Name        string
+	Description string
+}
@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildModel(sc models.Scope, opts repository.CreateOptions) (dbmodels.BoardTemplate, error) {
+	content, err := json.Marshal(opts.Content)
+	if err != nil {
+		return dbmodels.BoardTemplate{}, err
+	}
+
+	now := r.clock()
+
+	return dbmodels.BoardTemplate{
+		Name:        opts.Name,
+		Description: null.NewString(opts.Description, opts.Description != ""),
+		Content:     content,
+		WorkspaceID: null.NewString(opts.WorkspaceID, opts.WorkspaceID != ""),
+		CreatedBy:   null.StringFrom(sc.UserID),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+func (r implRepository) buildUpdateModel(ctx context.Context, opts repository.UpdateOptions) (dbmodels.BoardTemplate, []string, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.buildUpdateModel.IsUUID: %v", err)
+		return dbmodels.BoardTemplate{}, nil, err
+	}
+
+	t := dbmodels.BoardTemplate{
+		ID:          opts.ID,
+		Name:        opts.Name,
+		Description: null.NewString(opts.Description, opts.Description != ""),
+		UpdatedAt:   r.clock(),
+	}
+	cols := []string{
+		dbmodels.BoardTemplateColumns.Name,
+		dbmodels.BoardTemplateColumns.Description,
+		dbmodels.BoardTemplateColumns.UpdatedAt,
+	}
+
+	return t, cols, nil
+}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildGetQuery(ctx context.Context, fils templates.Filter) ([]qm.QueryMod, error) {
+	qr := postgres.BuildQueryWithSoftDelete()
+
+	if len(fils.IDs) > 0 {
+		for _, id := range fils.IDs {
+			if err := postgres.IsUUID(id); err != nil {
+				r.l.Errorf(ctx, "internal.templates.repository.postgres.buildGetQuery.InvalidID: %v", err)
+				return nil, err
+			}
+		}
+		qr = append(qr, dbmodels.BoardTemplateWhere.ID.IN(fils.IDs))
+	}
+
+	if fils.Keyword != "" {
+		qr = append(qr, qm.Where("(name ILIKE ? OR description ILIKE ?)", "%"+fils.Keyword+"%", "%"+fils.Keyword+"%"))
+	}
+
+	if fils.Key != "" {
+		qr = append(qr, dbmodels.BoardTemplateWhere.Key.EQ(null.StringFrom(fils.Key)))
+	}
+
+	if fils.WorkspaceID != "" {
+		if err := postgres.IsUUID(fils.WorkspaceID); err != nil {
+			r.l.Errorf(ctx, "internal.templates.repository.postgres.buildGetQuery.InvalidWorkspaceID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, dbmodels.BoardTemplateWhere.WorkspaceID.EQ(null.StringFrom(fils.WorkspaceID)))
+	}
+
+	if fils.VisibleTo != "" {
+		if err := postgres.IsUUID(fils.VisibleTo); err != nil {
+			r.l.Errorf(ctx, "internal.templates.repository.postgres.buildGetQuery.InvalidVisibleTo: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("(is_builtin OR created_by = ? OR workspace_id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?))", fils.VisibleTo, fils.VisibleTo))
+	}
+
+	return qr, nil
+}
+
+func (r implRepository) buildDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.buildDetailQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	qr := postgres.BuildQueryWithSoftDelete()
+	qr = append(qr, dbmodels.BoardTemplateWhere.ID.EQ(ID))
+
+	return qr, nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+func (r implRepository) List(ctx context.Context, sc models.Scope, opts repository.ListOptions) ([]models.BoardTemplate, error) {
+	qr, err := r.buildGetQuery(ctx, opts.Filter)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.List.buildGetQuery: %v", err)
+		return nil, err
+	}
+
+	ts, err := dbmodels.BoardTemplates(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.List.All: %v", err)
+		return nil, err
+	}
+
+	tpls := make([]models.BoardTemplate, len(ts))
+	for i, t := range ts {
+		tpls[i] = models.NewBoardTemplate(*t)
+	}
+
+	return tpls, nil
+}
+
+func (r implRepository) Get(ctx context.Context, sc models.Scope, opts repository.GetOptions) ([]models.BoardTemplate, paginator.Paginator, error) {
+	qr, err := r.buildGetQuery(ctx, opts.Filter)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Get.buildGetQuery: %v", err)
+		return nil, paginator.Paginator{}, err
+	}
+
+	var (
+		total int64
+		ts    dbmodels.BoardTemplateSlice
+	)
+
+	errChan := make(chan error, 2)
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var countErr error
+		total, countErr = dbmodels.BoardTemplates(qr...).Count(ctx, r.database)
+		if countErr != nil {
+			r.l.Errorf(ctx, "internal.templates.repository.postgres.Get.Count: %v", countErr)
+			errChan <- countErr
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pageQr := append(qr[:len(qr):len(qr)],
+			qm.OrderBy(dbmodels.BoardTemplateColumns.IsBuiltin+" DESC, "+dbmodels.BoardTemplateColumns.CreatedAt+" DESC"),
+			qm.Limit(int(opts.PagQuery.Limit)),
+			qm.Offset(int(opts.PagQuery.Offset())),
+		)
+		var allErr error
+		ts, allErr = dbmodels.BoardTemplates(pageQr...).All(ctx, r.database)
+		if allErr != nil {
+			r.l.Errorf(ctx, "internal.templates.repository.postgres.Get.All: %v", allErr)
+			errChan <- allErr
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(errChan)
+	}()
+
+	for err := range errChan {
+		if err != nil {
+			r.l.Errorf(ctx, "internal.templates.repository.postgres.Get.errChan: %v", err)
+			return nil, paginator.Paginator{}, err
+		}
+	}
+
+	tpls := make([]models.BoardTemplate, len(ts))
+	for i, t := range ts {
+		tpls[i] = models.NewBoardTemplate(*t)
+	}
+
+	return tpls, paginator.Paginator{
+		Total:       total,
+		Count:       int64(len(tpls)),
+		PerPage:     opts.PagQuery.Limit,
+		CurrentPage: opts.PagQuery.Page,
+	}, nil
+}
+
+func (r implRepository) Create(ctx context.Context, sc models.Scope, opts repository.CreateOptions) (models.BoardTemplate, error) {
+	m, err := r.buildModel(sc, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Create.buildModel: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Create.Insert: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	return models.NewBoardTemplate(m), nil
+}
+
+func (r implRepository) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.BoardTemplate, error) {
+	t, cols, err := r.buildUpdateModel(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Update.buildUpdateModel: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	n, err := t.Update(ctx, r.database, boil.Whitelist(cols...))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Update.Update: %v", err)
+		return models.BoardTemplate{}, err
+	}
+	if n == 0 {
+		return models.BoardTemplate{}, repository.ErrNotFound
+	}
+
+	if err := t.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Update.Reload: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	return models.NewBoardTemplate(t), nil
+}
+
+func (r implRepository) Detail(ctx context.Context, sc models.Scope, ID string) (models.BoardTemplate, error) {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Detail.buildDetailQuery: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	t, err := dbmodels.BoardTemplates(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.templates.repository.postgres.Detail.One.NoRows: %v", err)
+			return models.BoardTemplate{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Detail.One: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	return models.NewBoardTemplate(*t), nil
+}
+
+func (r implRepository) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Delete.buildDetailQuery: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.BoardTemplates(qr...).DeleteAll(ctx, r.database, false)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.templates.repository.postgres.Delete.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+package templates
+
+import "errors"
+
+var (
+	ErrFieldRequired = errors.New("field required")
+	ErrNotFound      = errors.New("template not found")
+	ErrForbidden     = errors.New("not allowed to change this template")
+	ErrBuiltin       = errors.New("built-in templates cannot be changed")
+)
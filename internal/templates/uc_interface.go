@@ -0,0 +1,24 @@
+package templates
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Get lists the built-in templates and the ones the user created or
+	// shares through a workspace.
+	Get(ctx context.Context, sc models.Scope, ip GetInput) (GetOutput, error)
+	// Create saves the lists and labels of a board, and optionally its cards,
+	// as a template.
+	Create(ctx context.Context, sc models.Scope, ip CreateInput) (DetailOutput, error)
+	Update(ctx context.Context, sc models.Scope, ip UpdateInput) (DetailOutput, error)
+	Detail(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	Delete(ctx context.Context, sc models.Scope, ID string) error
+
+	// Default returns the template boards start with when none is picked. It
+	// returns ErrNotFound when no default template is configured.
+	Default(ctx context.Context, sc models.Scope) (models.BoardTemplate, error)
+}
@@ -0,0 +1,56 @@
+package templates
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+type Filter struct {
+	IDs         []string
+	Keyword     string
+	Key         string
+	WorkspaceID string
+	// VisibleTo keeps the built-in templates and the ones the user created or
+	// shares a workspace with
+	VisibleTo string
+}
+
+type GetInput struct {
+	Filter   Filter
+	PagQuery paginator.PaginateQuery
+}
+
+type CreateInput struct {
+	// BoardID is the board saved as a template
+	BoardID     string
+	Name        string
+	Description string
+	// WorkspaceID shares the template with the members of the workspace
+	WorkspaceID string
+	// IncludeCards keeps the cards of the board as sample cards
+	IncludeCards bool
+}
+
+type UpdateInput struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+type GetOutput struct {
+	Templates  []models.BoardTemplate
+	Users      []models.User
+	Pagination paginator.Paginator
+}
+
+type DetailOutput struct {
+	Template models.BoardTemplate
+	Users    []models.User
+}
+
+// Config picks the template boards are created with when none is given.
+type Config struct {
+	// DefaultKey is the key of the default template, empty creates boards
+	// without lists
+	DefaultKey string
+}
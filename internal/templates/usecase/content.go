@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+// newContent turns the lists, labels and cards of a board into the content of
+// a template. Cards refer to labels by name in a template, so the labels of
+// the cards are translated from the IDs of the board.
+func newContent(b boards.BoardWithDetailsOutput, includeCards bool) models.BoardTemplateContent {
+	labelNames := make(map[string]string, len(b.Labels))
+	labels := make([]models.TemplateLabel, len(b.Labels))
+	for i, l := range b.Labels {
+		labelNames[l.ID] = l.Name
+		labels[i] = models.TemplateLabel{
+			Name:  l.Name,
+			Color: l.Color,
+		}
+	}
+
+	cards := make(map[string][]models.TemplateCard)
+	if includeCards {
+		for _, c := range b.Cards {
+			cards[c.ListID] = append(cards[c.ListID], newTemplateCard(c, labelNames))
+		}
+	}
+
+	lists := make([]models.TemplateList, len(b.Lists))
+	for i, l := range b.Lists {
+		lists[i] = models.TemplateList{
+			Name:  l.Name,
+			Cards: cards[l.ID],
+		}
+	}
+
+	return models.BoardTemplateContent{
+		Lists:  lists,
+		Labels: labels,
+	}
+}
+
+func newTemplateCard(c models.Card, labelNames map[string]string) models.TemplateCard {
+	labels := make([]string, 0, len(c.Labels))
+	for _, l := range c.Labels {
+		if name, ok := labelNames[l]; ok {
+			labels = append(labels, name)
+			continue
+		}
+		labels = append(labels, l)
+	}
+
+	checklist := make([]string, len(c.Checklist))
+	for i, item := range c.Checklist {
+		checklist[i] = item.Content
+	}
+
+	return models.TemplateCard{
+		Name:        c.Name,
+		Description: c.Description,
+		Priority:    c.Priority,
+		Labels:      labels,
+		Checklist:   checklist,
+	}
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+type implUsecase struct {
+	l           log.Logger
+	repo        repository.Repository
+	boardUC     boards.UseCase
+	userUC      user.UseCase
+	workspaceUC workspaces.UseCase
+	cfg         templates.Config
+	clock       func() time.Time
+}
+
+var _ templates.UseCase = &implUsecase{}
+
+func New(l log.Logger, repo repository.Repository, boardUC boards.UseCase, userUC user.UseCase, workspaceUC workspaces.UseCase, cfg templates.Config) templates.UseCase {
+	return &implUsecase{
+		l:           l,
+		repo:        repo,
+		boardUC:     boardUC,
+		userUC:      userUC,
+		workspaceUC: workspaceUC,
+		cfg:         cfg,
+		clock:       util.Now,
+	}
+}
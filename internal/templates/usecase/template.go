@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip templates.GetInput) (templates.GetOutput, error) {
+	ts, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
+		Filter: templates.Filter{
+			IDs:         ip.Filter.IDs,
+			Keyword:     ip.Filter.Keyword,
+			WorkspaceID: ip.Filter.WorkspaceID,
+			VisibleTo:   sc.UserID,
+		},
+		PagQuery: ip.PagQuery,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Get.repo.Get: %v", err)
+		return templates.GetOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, ts)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Get.listCreators: %v", err)
+		return templates.GetOutput{}, err
+	}
+
+	return templates.GetOutput{
+		Templates:  ts,
+		Users:      us,
+		Pagination: p,
+	}, nil
+}
+
+func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip templates.CreateInput) (templates.DetailOutput, error) {
+	if ip.BoardID == "" || ip.Name == "" {
+		return templates.DetailOutput{}, templates.ErrFieldRequired
+	}
+
+	// Any member of a workspace can share templates in it
+	if ip.WorkspaceID != "" {
+		if err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+			uc.l.Warnf(ctx, "internal.templates.usecase.Create.workspaceUC.Authorize: %v", err)
+			return templates.DetailOutput{}, err
+		}
+	}
+
+	// Anyone who can see the board can save it as a template
+	b, err := uc.boardUC.DetailFull(ctx, sc, boards.DetailFullInput{ID: ip.BoardID})
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Create.boardUC.DetailFull: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	t, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		Name:        ip.Name,
+		Description: ip.Description,
+		Content:     newContent(b, ip.IncludeCards),
+		WorkspaceID: ip.WorkspaceID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Create.repo.Create: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.BoardTemplate{t})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Create.listCreators: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	return templates.DetailOutput{
+		Template: t,
+		Users:    us,
+	}, nil
+}
+
+func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip templates.UpdateInput) (templates.DetailOutput, error) {
+	if ip.ID == "" || ip.Name == "" {
+		return templates.DetailOutput{}, templates.ErrFieldRequired
+	}
+
+	old, err := uc.detail(ctx, sc, ip.ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Update.detail: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	if err := uc.authorizeChange(ctx, sc, old); err != nil {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Update.authorizeChange: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	t, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
+		ID:          ip.ID,
+		Name:        ip.Name,
+		Description: ip.Description,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.templates.usecase.Update.repo.Update.NotFound: %v", err)
+			return templates.DetailOutput{}, templates.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.templates.usecase.Update.repo.Update: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.BoardTemplate{t})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Update.listCreators: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	return templates.DetailOutput{
+		Template: t,
+		Users:    us,
+	}, nil
+}
+
+func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (templates.DetailOutput, error) {
+	t, err := uc.detail(ctx, sc, ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Detail.detail: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.BoardTemplate{t})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Detail.listCreators: %v", err)
+		return templates.DetailOutput{}, err
+	}
+
+	return templates.DetailOutput{
+		Template: t,
+		Users:    us,
+	}, nil
+}
+
+func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	t, err := uc.detail(ctx, sc, ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Delete.detail: %v", err)
+		return err
+	}
+
+	if err := uc.authorizeChange(ctx, sc, t); err != nil {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Delete.authorizeChange: %v", err)
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, sc, ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.templates.usecase.Delete.repo.Delete.NotFound: %v", err)
+			return templates.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.templates.usecase.Delete.repo.Delete: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) Default(ctx context.Context, sc models.Scope) (models.BoardTemplate, error) {
+	if uc.cfg.DefaultKey == "" {
+		return models.BoardTemplate{}, templates.ErrNotFound
+	}
+
+	ts, err := uc.repo.List(ctx, sc, repository.ListOptions{
+		Filter: templates.Filter{
+			Key: uc.cfg.DefaultKey,
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.Default.repo.List: %v", err)
+		return models.BoardTemplate{}, err
+	}
+	if len(ts) == 0 {
+		uc.l.Warnf(ctx, "internal.templates.usecase.Default.repo.List.NotFound: no template with key %s", uc.cfg.DefaultKey)
+		return models.BoardTemplate{}, templates.ErrNotFound
+	}
+
+	return ts[0], nil
+}
+
+// detail returns a template the user can see, the others are reported as
+// not found.
+func (uc implUsecase) detail(ctx context.Context, sc models.Scope, ID string) (models.BoardTemplate, error) {
+	t, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.templates.usecase.detail.repo.Detail.NotFound: %v", err)
+			return models.BoardTemplate{}, templates.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.templates.usecase.detail.repo.Detail: %v", err)
+		return models.BoardTemplate{}, err
+	}
+
+	if t.IsBuiltin || (t.CreatedBy != nil && *t.CreatedBy == sc.UserID) {
+		return t, nil
+	}
+
+	if t.WorkspaceID != nil {
+		err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: *t.WorkspaceID, Role: models.WorkspaceRoleMember})
+		if err == nil {
+			return t, nil
+		}
+		if err != workspaces.ErrForbidden {
+			uc.l.Errorf(ctx, "internal.templates.usecase.detail.workspaceUC.Authorize: %v", err)
+			return models.BoardTemplate{}, err
+		}
+	}
+
+	uc.l.Warnf(ctx, "internal.templates.usecase.detail.NotVisible: template %s is not visible to user %s", ID, sc.UserID)
+	return models.BoardTemplate{}, templates.ErrNotFound
+}
+
+// authorizeChange lets the creator of a template change it, and the admins of
+// the workspace it is shared with.
+func (uc implUsecase) authorizeChange(ctx context.Context, sc models.Scope, t models.BoardTemplate) error {
+	if t.IsBuiltin {
+		return templates.ErrBuiltin
+	}
+
+	if t.CreatedBy != nil && *t.CreatedBy == sc.UserID {
+		return nil
+	}
+
+	if t.WorkspaceID != nil {
+		err := uc.workspaceUC.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: *t.WorkspaceID, Role: models.WorkspaceRoleAdmin})
+		if err == nil {
+			return nil
+		}
+		if err != workspaces.ErrForbidden {
+			uc.l.Errorf(ctx, "internal.templates.usecase.authorizeChange.workspaceUC.Authorize: %v", err)
+			return err
+		}
+	}
+
+	return templates.ErrForbidden
+}
+
+func (uc implUsecase) listCreators(ctx context.Context, sc models.Scope, ts []models.BoardTemplate) ([]models.User, error) {
+	uIDs := make([]string, 0, len(ts))
+	for _, t := range ts {
+		if t.CreatedBy != nil {
+			uIDs = append(uIDs, *t.CreatedBy)
+		}
+	}
+	if len(uIDs) == 0 {
+		return nil, nil
+	}
+
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: util.RemoveDuplicates(uIDs),
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.templates.usecase.listCreators.userUC.List: %v", err)
+		return nil, err
+	}
+
+	return us, nil
+}
@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sourceBoard returns a board with two lists, a label and a card labelled by
+// ID.
+func sourceBoard() boards.BoardWithDetailsOutput {
+	return boards.BoardWithDetailsOutput{
+		Board: models.Board{ID: "board-1", Name: "Roadmap"},
+		Lists: []models.List{
+			{ID: "list-1", Name: "Todo"},
+			{ID: "list-2", Name: "Done"},
+		},
+		Labels: []models.Label{{ID: "label-1", Name: "bug", Color: "#ff0000"}},
+		Cards: []models.Card{{
+			ID:        "card-1",
+			ListID:    "list-1",
+			Name:      "Ship it",
+			Priority:  models.CardPriorityHigh,
+			Labels:    []string{"label-1"},
+			Checklist: []models.ChecklistItem{{Content: "Write the notes"}},
+		}},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tcs := map[string]struct {
+		ip        templates.CreateInput
+		wsRole    models.WorkspaceRole
+		wantErr   error
+		wantCards bool
+	}{
+		"lists and labels": {
+			ip: templates.CreateInput{BoardID: "board-1", Name: "Sprint"},
+		},
+		"with the cards": {
+			ip:        templates.CreateInput{BoardID: "board-1", Name: "Sprint", IncludeCards: true},
+			wantCards: true,
+		},
+		"shared with a workspace of the user": {
+			ip:     templates.CreateInput{BoardID: "board-1", Name: "Sprint", WorkspaceID: "workspace-1"},
+			wsRole: models.WorkspaceRoleMember,
+		},
+		"shared with another workspace": {
+			ip:      templates.CreateInput{BoardID: "board-1", Name: "Sprint", WorkspaceID: "workspace-1"},
+			wantErr: workspaces.ErrForbidden,
+		},
+		"missing name": {
+			ip:      templates.CreateInput{BoardID: "board-1"},
+			wantErr: templates.ErrFieldRequired,
+		},
+		"missing board": {
+			ip:      templates.CreateInput{Name: "Sprint"},
+			wantErr: templates.ErrFieldRequired,
+		},
+		"board the user cannot see": {
+			ip:      templates.CreateInput{BoardID: "board-2", Name: "Sprint"},
+			wantErr: boards.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			deps.boardUC.boards["board-1"] = sourceBoard()
+			if tc.wsRole != "" {
+				deps.workspaceUC.roles["workspace-1"] = tc.wsRole
+			}
+
+			o, err := uc.Create(context.Background(), models.Scope{UserID: "user-1"}, tc.ip)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Empty(t, deps.repo.created)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, deps.repo.created, 1)
+			assert.Equal(t, tc.ip.WorkspaceID, deps.repo.created[0].WorkspaceID)
+
+			c := o.Template.Content
+			require.Len(t, c.Lists, 2)
+			assert.Equal(t, "Todo", c.Lists[0].Name)
+			assert.Equal(t, "Done", c.Lists[1].Name)
+			assert.Equal(t, []models.TemplateLabel{{Name: "bug", Color: "#ff0000"}}, c.Labels)
+			if !tc.wantCards {
+				assert.Empty(t, c.Lists[0].Cards)
+				return
+			}
+			// The cards refer to the labels by name, the IDs belong to the board
+			assert.Equal(t, []models.TemplateCard{{
+				Name:      "Ship it",
+				Priority:  models.CardPriorityHigh,
+				Labels:    []string{"bug"},
+				Checklist: []string{"Write the notes"},
+			}}, c.Lists[0].Cards)
+			assert.Empty(t, c.Lists[1].Cards)
+		})
+	}
+}
+
+func TestDetail(t *testing.T) {
+	creator, other := "user-1", "user-2"
+	workspaceID := "workspace-1"
+
+	tcs := map[string]struct {
+		template models.BoardTemplate
+		wsRole   models.WorkspaceRole
+		wantErr  error
+	}{
+		"built-in template": {
+			template: models.BoardTemplate{IsBuiltin: true},
+		},
+		"own template": {
+			template: models.BoardTemplate{CreatedBy: &creator},
+		},
+		"template of a workspace of the user": {
+			template: models.BoardTemplate{CreatedBy: &other, WorkspaceID: &workspaceID},
+			wsRole:   models.WorkspaceRoleMember,
+		},
+		"template of another workspace": {
+			template: models.BoardTemplate{CreatedBy: &other, WorkspaceID: &workspaceID},
+			wantErr:  templates.ErrNotFound,
+		},
+		"private template of another user": {
+			template: models.BoardTemplate{CreatedBy: &other},
+			wantErr:  templates.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			uc, deps := initUseCase(t, time.Now())
+			tc.template.ID = "template-1"
+			deps.repo.templates["template-1"] = tc.template
+			if tc.wsRole != "" {
+				deps.workspaceUC.roles[workspaceID] = tc.wsRole
+			}
+
+			o, err := uc.Detail(context.Background(), models.Scope{UserID: creator}, "template-1")
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "template-1", o.Template.ID)
+		})
+	}
+}
+
+func TestUpdateAndDelete(t *testing.T) {
+	creator, other := "user-1", "user-2"
+	workspaceID := "workspace-1"
+
+	tcs := map[string]struct {
+		template models.BoardTemplate
+		wsRole   models.WorkspaceRole
+		wantErr  error
+	}{
+		"own template": {
+			template: models.BoardTemplate{CreatedBy: &creator},
+		},
+		"workspace admin": {
+			template: models.BoardTemplate{CreatedBy: &other, WorkspaceID: &workspaceID},
+			wsRole:   models.WorkspaceRoleAdmin,
+		},
+		"workspace member": {
+			template: models.BoardTemplate{CreatedBy: &other, WorkspaceID: &workspaceID},
+			wsRole:   models.WorkspaceRoleMember,
+			wantErr:  templates.ErrForbidden,
+		},
+		"built-in template": {
+			template: models.BoardTemplate{IsBuiltin: true},
+			wantErr:  templates.ErrBuiltin,
+		},
+		"private template of another user": {
+			template: models.BoardTemplate{CreatedBy: &other},
+			wantErr:  templates.ErrNotFound,
+		},
+	}
+
+	for name, tc := range tcs {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			sc := models.Scope{UserID: creator}
+			uc, deps := initUseCase(t, time.Now())
+			tc.template.ID = "template-1"
+			deps.repo.templates["template-1"] = tc.template
+			if tc.wsRole != "" {
+				deps.workspaceUC.roles[workspaceID] = tc.wsRole
+			}
+
+			o, err := uc.Update(ctx, sc, templates.UpdateInput{ID: "template-1", Name: "Renamed"})
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.ErrorIs(t, uc.Delete(ctx, sc, "template-1"), tc.wantErr)
+				assert.Empty(t, deps.repo.deleted)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Renamed", o.Template.Name)
+
+			require.NoError(t, uc.Delete(ctx, sc, "template-1"))
+			assert.Equal(t, []string{"template-1"}, deps.repo.deleted)
+		})
+	}
+}
+
+func TestUpdateMissingName(t *testing.T) {
+	uc, _ := initUseCase(t, time.Now())
+
+	_, err := uc.Update(context.Background(), models.Scope{UserID: "user-1"}, templates.UpdateInput{ID: "template-1"})
+	assert.ErrorIs(t, err, templates.ErrFieldRequired)
+}
+
+func TestDefault(t *testing.T) {
+	key := "kanban"
+
+	t.Run("configured template", func(t *testing.T) {
+		uc, deps := initUseCase(t, time.Now())
+		deps.repo.templates["template-1"] = models.BoardTemplate{ID: "template-1", Key: &key, IsBuiltin: true}
+
+		tpl, err := uc.Default(context.Background(), models.Scope{})
+		require.NoError(t, err)
+		assert.Equal(t, "template-1", tpl.ID)
+	})
+
+	t.Run("missing template", func(t *testing.T) {
+		uc, _ := initUseCase(t, time.Now())
+
+		_, err := uc.Default(context.Background(), models.Scope{})
+		assert.ErrorIs(t, err, templates.ErrNotFound)
+	})
+
+	t.Run("no default configured", func(t *testing.T) {
+		uc, deps := initUseCase(t, time.Now())
+		uc.cfg.DefaultKey = ""
+		deps.repo.templates["template-1"] = models.BoardTemplate{ID: "template-1", Key: &key, IsBuiltin: true}
+
+		_, err := uc.Default(context.Background(), models.Scope{})
+		assert.ErrorIs(t, err, templates.ErrNotFound)
+	})
+}
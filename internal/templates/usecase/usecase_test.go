@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates"
+	"github.com/nguyentantai21042004/kanban-api/internal/templates/repository"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+)
+
+// fakeRepo keeps the templates in memory. Calling a method it does not
+// implement panics through the nil embedded interface.
+type fakeRepo struct {
+	repository.Repository
+
+	templates map[string]models.BoardTemplate
+	created   []repository.CreateOptions
+	deleted   []string
+}
+
+func (r *fakeRepo) List(ctx context.Context, sc models.Scope, opts repository.ListOptions) ([]models.BoardTemplate, error) {
+	var ts []models.BoardTemplate
+	for _, t := range r.templates {
+		if t.Key != nil && *t.Key == opts.Filter.Key {
+			ts = append(ts, t)
+		}
+	}
+	return ts, nil
+}
+
+func (r *fakeRepo) Create(ctx context.Context, sc models.Scope, opts repository.CreateOptions) (models.BoardTemplate, error) {
+	r.created = append(r.created, opts)
+	t := models.BoardTemplate{
+		ID:        "template-new",
+		Name:      opts.Name,
+		Content:   opts.Content,
+		CreatedBy: &sc.UserID,
+	}
+	if opts.WorkspaceID != "" {
+		t.WorkspaceID = &opts.WorkspaceID
+	}
+	r.templates[t.ID] = t
+	return t, nil
+}
+
+func (r *fakeRepo) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.BoardTemplate, error) {
+	t, ok := r.templates[opts.ID]
+	if !ok {
+		return models.BoardTemplate{}, repository.ErrNotFound
+	}
+	t.Name = opts.Name
+	r.templates[opts.ID] = t
+	return t, nil
+}
+
+func (r *fakeRepo) Detail(ctx context.Context, sc models.Scope, id string) (models.BoardTemplate, error) {
+	t, ok := r.templates[id]
+	if !ok {
+		return models.BoardTemplate{}, repository.ErrNotFound
+	}
+	return t, nil
+}
+
+func (r *fakeRepo) Delete(ctx context.Context, sc models.Scope, id string) error {
+	if _, ok := r.templates[id]; !ok {
+		return repository.ErrNotFound
+	}
+	delete(r.templates, id)
+	r.deleted = append(r.deleted, id)
+	return nil
+}
+
+// fakeBoardUC returns the boards it holds by ID.
+type fakeBoardUC struct {
+	boards.UseCase
+
+	boards map[string]boards.BoardWithDetailsOutput
+}
+
+func (u fakeBoardUC) DetailFull(ctx context.Context, sc models.Scope, ip boards.DetailFullInput) (boards.BoardWithDetailsOutput, error) {
+	b, ok := u.boards[ip.ID]
+	if !ok {
+		return boards.BoardWithDetailsOutput{}, boards.ErrNotFound
+	}
+	return b, nil
+}
+
+// fakeUserUC returns a user for every ID.
+type fakeUserUC struct {
+	user.UseCase
+}
+
+func (u fakeUserUC) List(ctx context.Context, sc models.Scope, ip user.ListInput) ([]models.User, error) {
+	us := make([]models.User, len(ip.Filter.IDs))
+	for i, id := range ip.Filter.IDs {
+		us[i] = models.User{ID: id}
+	}
+	return us, nil
+}
+
+// fakeWorkspaceUC grants the roles it holds by workspace.
+type fakeWorkspaceUC struct {
+	workspaces.UseCase
+
+	roles map[string]models.WorkspaceRole
+}
+
+func (u fakeWorkspaceUC) Authorize(ctx context.Context, sc models.Scope, ip workspaces.AuthorizeInput) error {
+	r, ok := u.roles[ip.WorkspaceID]
+	if !ok || !r.AtLeast(ip.Role) {
+		return workspaces.ErrForbidden
+	}
+	return nil
+}
+
+type mockDeps struct {
+	repo        *fakeRepo
+	boardUC     *fakeBoardUC
+	workspaceUC *fakeWorkspaceUC
+}
+
+func initUseCase(t *testing.T, mockTime time.Time) (*implUsecase, mockDeps) {
+	t.Helper()
+
+	repo := &fakeRepo{templates: make(map[string]models.BoardTemplate)}
+	boardUC := &fakeBoardUC{boards: make(map[string]boards.BoardWithDetailsOutput)}
+	workspaceUC := &fakeWorkspaceUC{roles: make(map[string]models.WorkspaceRole)}
+
+	uc := &implUsecase{
+		l:           log.InitializeTestZapLogger(),
+		repo:        repo,
+		boardUC:     boardUC,
+		userUC:      fakeUserUC{},
+		workspaceUC: workspaceUC,
+		cfg:         templates.Config{DefaultKey: "kanban"},
+		clock:       func() time.Time { return mockTime },
+	}
+
+	return uc, mockDeps{
+		repo:        repo,
+		boardUC:     boardUC,
+		workspaceUC: workspaceUC,
+	}
+}
@@ -12,4 +12,6 @@ type Repository interface {
 	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.Upload, error)
 	Detail(ctx context.Context, sc models.Scope, ID string) (models.Upload, error)
 	Get(ctx context.Context, sc models.Scope, opts GetOptions) ([]models.Upload, paginator.Paginator, error)
+	List(ctx context.Context, sc models.Scope, opts ListOptions) ([]models.Upload, error)
+	Delete(ctx context.Context, sc models.Scope, IDs []string) error
 }
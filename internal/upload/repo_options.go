@@ -13,3 +13,7 @@ type GetOptions struct {
 	Filter   Filter
 	PagQuery pag.PaginateQuery
 }
+
+type ListOptions struct {
+	IDs []string
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/lib/pq"
+
 	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	"github.com/nguyentantai21042004/kanban-api/internal/upload"
 	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
@@ -206,3 +208,61 @@ func (r *repository) Get(ctx context.Context, sc models.Scope, opts upload.GetOp
 
 	return uploads, paginator, nil
 }
+
+func (r *repository) List(ctx context.Context, sc models.Scope, opts upload.ListOptions) ([]models.Upload, error) {
+	query := `
+		SELECT id, bucket_name, object_name, original_name, size, content_type, 
+			etag, url, source, created_user_id, created_at, updated_at
+		FROM uploads 
+		WHERE id = ANY($1)
+	`
+
+	rows, err := r.database.QueryContext(ctx, query, pq.Array(opts.IDs))
+	if err != nil {
+		r.l.Error(ctx, "Failed to list uploads", "error", err)
+		return nil, fmt.Errorf("failed to list uploads: %w", err)
+	}
+	defer rows.Close()
+
+	var uploads []models.Upload
+	for rows.Next() {
+		var upload models.Upload
+		err := rows.Scan(
+			&upload.ID,
+			&upload.BucketName,
+			&upload.ObjectName,
+			&upload.OriginalName,
+			&upload.Size,
+			&upload.ContentType,
+			&upload.Etag,
+			&upload.URL,
+			&upload.Source,
+			&upload.CreatedUserID,
+			&upload.CreatedAt,
+			&upload.UpdatedAt,
+		)
+		if err != nil {
+			r.l.Error(ctx, "Failed to scan upload row", "error", err)
+			return nil, fmt.Errorf("failed to scan upload row: %w", err)
+		}
+		uploads = append(uploads, upload)
+	}
+
+	if err = rows.Err(); err != nil {
+		r.l.Error(ctx, "Error iterating upload rows", "error", err)
+		return nil, fmt.Errorf("error iterating upload rows: %w", err)
+	}
+
+	return uploads, nil
+}
+
+func (r *repository) Delete(ctx context.Context, sc models.Scope, IDs []string) error {
+	query := `DELETE FROM uploads WHERE id = ANY($1)`
+
+	if _, err := r.database.ExecContext(ctx, query, pq.Array(IDs)); err != nil {
+		r.l.Error(ctx, "Failed to delete uploads", "error", err)
+		return fmt.Errorf("failed to delete uploads: %w", err)
+	}
+
+	return nil
+}
@@ -11,4 +11,11 @@ type UseCase interface {
 	Create(ctx context.Context, sc models.Scope, ip CreateInput) (UploadOutput, error)
 	Detail(ctx context.Context, sc models.Scope, ID string) (UploadOutput, error)
 	Get(ctx context.Context, sc models.Scope, ip GetInput) (GetOutput, error)
+	// List returns the uploads with the given IDs, values that are not upload
+	// IDs are ignored.
+	List(ctx context.Context, sc models.Scope, ip ListInput) ([]models.Upload, error)
+	// Delete removes the files from storage together with their records.
+	Delete(ctx context.Context, sc models.Scope, ip DeleteInput) error
+	// Copy duplicates the files in storage under new records.
+	Copy(ctx context.Context, sc models.Scope, ip CopyInput) (CopyOutput, error)
 }
@@ -26,6 +26,23 @@ type Filter struct {
 	CreatedUserID *string `json:"created_user_id"`
 }
 
+type ListInput struct {
+	IDs []string
+}
+
+type DeleteInput struct {
+	IDs []string
+}
+
+type CopyInput struct {
+	IDs []string
+}
+
+type CopyOutput struct {
+	// IDs maps the ID of each copied upload to the ID of its copy
+	IDs map[string]string
+}
+
 type UploadOutput struct {
 	Upload models.Upload
 }
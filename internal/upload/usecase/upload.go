@@ -110,3 +110,115 @@ func (uc *usecase) Get(ctx context.Context, sc models.Scope, ip upload.GetInput)
 		Paginator: paginator,
 	}, nil
 }
+
+func (uc *usecase) List(ctx context.Context, sc models.Scope, ip upload.ListInput) ([]models.Upload, error) {
+	IDs := make([]string, 0, len(ip.IDs))
+	for _, id := range ip.IDs {
+		if postgres.IsUUID(id) == nil {
+			IDs = append(IDs, id)
+		}
+	}
+	if len(IDs) == 0 {
+		return nil, nil
+	}
+
+	uploads, err := uc.repo.List(ctx, sc, upload.ListOptions{IDs: IDs})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.upload.usecase.List.uc.repo.List: %v", err)
+		return nil, err
+	}
+
+	return uploads, nil
+}
+
+func (uc *usecase) Delete(ctx context.Context, sc models.Scope, ip upload.DeleteInput) error {
+	// Attachments may hold values that are not upload IDs
+	IDs := make([]string, 0, len(ip.IDs))
+	for _, id := range ip.IDs {
+		if postgres.IsUUID(id) == nil {
+			IDs = append(IDs, id)
+		}
+	}
+	if len(IDs) == 0 {
+		return nil
+	}
+
+	uploads, err := uc.repo.List(ctx, sc, upload.ListOptions{IDs: IDs})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.upload.usecase.Delete.uc.repo.List: %v", err)
+		return err
+	}
+
+	// A file that cannot be removed is only logged, its record goes anyway so
+	// it is not served again
+	for _, u := range uploads {
+		if u.Source != upload.MinIO {
+			continue
+		}
+		if err := uc.minio.DeleteFile(ctx, u.BucketName, u.ObjectName); err != nil {
+			uc.l.Errorf(ctx, "internal.upload.usecase.Delete.uc.minio.DeleteFile: %s/%s: %v", u.BucketName, u.ObjectName, err)
+		}
+	}
+
+	if err := uc.repo.Delete(ctx, sc, IDs); err != nil {
+		uc.l.Errorf(ctx, "internal.upload.usecase.Delete.uc.repo.Delete: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc *usecase) Copy(ctx context.Context, sc models.Scope, ip upload.CopyInput) (upload.CopyOutput, error) {
+	IDs := make([]string, 0, len(ip.IDs))
+	for _, id := range ip.IDs {
+		if postgres.IsUUID(id) == nil {
+			IDs = append(IDs, id)
+		}
+	}
+	if len(IDs) == 0 {
+		return upload.CopyOutput{IDs: map[string]string{}}, nil
+	}
+
+	uploads, err := uc.repo.List(ctx, sc, upload.ListOptions{IDs: IDs})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.upload.usecase.Copy.uc.repo.List: %v", err)
+		return upload.CopyOutput{}, err
+	}
+
+	copied := make(map[string]string, len(uploads))
+	for _, u := range uploads {
+		if u.Source != upload.MinIO {
+			continue
+		}
+
+		objectName := fmt.Sprintf("%s/%s%s", time.Now().Format("2006/01/02"), postgres.NewUUID(), filepath.Ext(u.ObjectName))
+		if err := uc.minio.CopyFile(ctx, u.BucketName, u.ObjectName, u.BucketName, objectName); err != nil {
+			uc.l.Errorf(ctx, "internal.upload.usecase.Copy.uc.minio.CopyFile: %s/%s: %v", u.BucketName, u.ObjectName, err)
+			return upload.CopyOutput{}, err
+		}
+
+		now := time.Now()
+		createdUpload, err := uc.repo.Create(ctx, sc, upload.CreateOptions{Upload: models.Upload{
+			ID:            postgres.NewUUID(),
+			BucketName:    u.BucketName,
+			ObjectName:    objectName,
+			OriginalName:  u.OriginalName,
+			Size:          u.Size,
+			ContentType:   u.ContentType,
+			Etag:          u.Etag,
+			URL:           u.URL,
+			Source:        upload.MinIO,
+			CreatedUserID: sc.UserID,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}})
+		if err != nil {
+			uc.l.Errorf(ctx, "internal.upload.usecase.Copy.uc.repo.Create: %v", err)
+			return upload.CopyOutput{}, err
+		}
+
+		copied[u.ID] = createdUpload.ID
+	}
+
+	return upload.CopyOutput{IDs: copied}, nil
+}
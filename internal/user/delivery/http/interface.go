@@ -6,5 +6,5 @@ type Handler interface {
 	Detail(c *gin.Context)
 	DetailMe(c *gin.Context)
 	UpdateProfile(c *gin.Context)
-	Create(c *gin.Context) // Chỉ Super Admin
+	Create(c *gin.Context) // Requires the user.manage permission
 }
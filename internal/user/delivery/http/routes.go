@@ -2,6 +2,7 @@ package http
 
 import (
 	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 
 	"github.com/gin-gonic/gin"
 )
@@ -10,5 +11,5 @@ func MapUserRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
 	r.GET("/me", mw.Auth(), h.DetailMe)
 	r.PUT("/profile", mw.Auth(), h.UpdateProfile)
 	r.GET("/:id", mw.Auth(), h.Detail)
-	r.POST("", mw.Auth(), h.Create)
+	r.POST("", mw.Auth(), mw.RequirePermission(models.PermissionUserManage), h.Create)
 }
@@ -98,7 +98,7 @@ func (h handler) UpdateProfile(c *gin.Context) {
 }
 
 // @Summary Create user
-// @Description Create new user (Super Admin only)
+// @Description Create new user. Requires the user.manage permission
 // @Tags User
 // @Accept json
 // @Produce json
@@ -107,6 +107,7 @@ func (h handler) UpdateProfile(c *gin.Context) {
 // @Success 201 {object} userItem "Created"
 // @Failure 400 {object} response.Resp "Bad Request"
 // @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
 // @Failure 500 {object} response.Resp "Internal Server Error"
 // @Router /api/v1/users [POST]
 func (h handler) Create(c *gin.Context) {
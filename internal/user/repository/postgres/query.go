@@ -21,6 +21,10 @@ func (r implRepository) buildListQuery(ctx context.Context, opts repository.List
 		qr = append(qr, qm.WhereIn("id IN (?)", postgres.ConvertToInterface(opts.Filter.IDs)...))
 	}
 
+	if len(opts.Filter.Usernames) > 0 {
+		qr = append(qr, qm.WhereIn("username IN (?)", postgres.ConvertToInterface(opts.Filter.Usernames)...))
+	}
+
 	return qr, nil
 }
 
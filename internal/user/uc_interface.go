@@ -12,7 +12,10 @@ type UseCase interface {
 	DetailMe(ctx context.Context, sc models.Scope) (UserOutput, error)
 	List(ctx context.Context, sc models.Scope, ip ListInput) ([]models.User, error)
 	UpdateProfile(ctx context.Context, sc models.Scope, ip UpdateProfileInput) (UserOutput, error)
-	Create(ctx context.Context, sc models.Scope, ip CreateInput) (UserOutput, error) // Chỉ Super Admin
+	Create(ctx context.Context, sc models.Scope, ip CreateInput) (UserOutput, error) // Requires the user.manage permission, checked by the route
+	Register(ctx context.Context, sc models.Scope, ip RegisterInput) (UserOutput, error)
+	Activate(ctx context.Context, sc models.Scope, ID string) (UserOutput, error)
+	UpdatePassword(ctx context.Context, sc models.Scope, ip UpdatePasswordInput) (UserOutput, error)
 	GetOne(ctx context.Context, sc models.Scope, ip GetOneInput) (models.User, error)
 	Dashboard(ctx context.Context, sc models.Scope, ip DashboardInput) (UsersDashboardOutput, error)
 }
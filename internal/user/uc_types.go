@@ -13,6 +13,21 @@ type CreateInput struct {
 	RoleID   string `json:"role_id"`
 }
 
+// RegisterInput creates an inactive account that has to be verified before login
+type RegisterInput struct {
+	Username string
+	Password string
+	FullName string
+	RoleID   string
+}
+
+// UpdatePasswordInput sets a new password; callers are responsible for
+// verifying the current password or a reset token first.
+type UpdatePasswordInput struct {
+	UserID   string
+	Password string
+}
+
 type UpdateProfileInput struct {
 	FullName  string `json:"full_name" binding:"required"`
 	AvatarURL string `json:"avatar_url,omitempty"`
@@ -35,7 +50,8 @@ type ListInput struct {
 }
 
 type Filter struct {
-	IDs []string
+	IDs       []string
+	Usernames []string
 }
 
 // Dashboard aggregation for users
@@ -103,6 +103,94 @@ func (uc *usecase) Create(ctx context.Context, sc models.Scope, ip user.CreateIn
 	return user.UserOutput{User: createdUser}, nil
 }
 
+func (uc *usecase) Register(ctx context.Context, sc models.Scope, ip user.RegisterInput) (user.UserOutput, error) {
+	existingUser, err := uc.repo.GetOne(ctx, sc, repository.GetOneOptions{Username: ip.Username})
+	if err == nil && existingUser.ID != "" {
+		return user.UserOutput{}, user.ErrUserExists
+	}
+
+	hashedPassword, err := encrypter.HashPassword(ip.Password)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.user.usecase.Register.encrypter.HashPassword: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	userModel := models.User{
+		ID:           postgres.NewUUID(),
+		Username:     ip.Username,
+		PasswordHash: hashedPassword,
+		FullName:     ip.FullName,
+		RoleID:       ip.RoleID,
+		IsActive:     false,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	createdUser, err := uc.repo.Create(ctx, sc, repository.CreateOptions{User: userModel})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.user.usecase.Register.uc.repo.Create: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	return user.UserOutput{User: createdUser}, nil
+}
+
+func (uc *usecase) Activate(ctx context.Context, sc models.Scope, ID string) (user.UserOutput, error) {
+	userModel, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.user.usecase.Activate.uc.repo.Detail: %v", err)
+			return user.UserOutput{}, user.ErrUserNotFound
+		}
+		uc.l.Errorf(ctx, "internal.user.usecase.Activate.uc.repo.Detail: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	if userModel.IsActive {
+		return user.UserOutput{User: userModel}, nil
+	}
+
+	userModel.IsActive = true
+	userModel.UpdatedAt = time.Now()
+
+	updatedUser, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{User: userModel})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.user.usecase.Activate.uc.repo.Update: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	return user.UserOutput{User: updatedUser}, nil
+}
+
+func (uc *usecase) UpdatePassword(ctx context.Context, sc models.Scope, ip user.UpdatePasswordInput) (user.UserOutput, error) {
+	userModel, err := uc.repo.Detail(ctx, sc, ip.UserID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.user.usecase.UpdatePassword.uc.repo.Detail: %v", err)
+			return user.UserOutput{}, user.ErrUserNotFound
+		}
+		uc.l.Errorf(ctx, "internal.user.usecase.UpdatePassword.uc.repo.Detail: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	hashedPassword, err := encrypter.HashPassword(ip.Password)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.user.usecase.UpdatePassword.encrypter.HashPassword: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	userModel.PasswordHash = hashedPassword
+	userModel.UpdatedAt = time.Now()
+
+	updatedUser, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{User: userModel})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.user.usecase.UpdatePassword.uc.repo.Update: %v", err)
+		return user.UserOutput{}, err
+	}
+
+	return user.UserOutput{User: updatedUser}, nil
+}
+
 func (uc *usecase) GetOne(ctx context.Context, sc models.Scope, ip user.GetOneInput) (models.User, error) {
 	u, err := uc.repo.GetOne(ctx, sc, repository.GetOneOptions{Username: ip.Username})
 	if err != nil {
@@ -1,10 +1,16 @@
 package http
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
+	"github.com/nguyentantai21042004/kanban-api/internal/auth"
+	"github.com/nguyentantai21042004/kanban-api/internal/boards"
+	"github.com/nguyentantai21042004/kanban-api/internal/members"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
 	wsPkg "github.com/nguyentantai21042004/kanban-api/internal/websocket"
 	wsService "github.com/nguyentantai21042004/kanban-api/internal/websocket/service"
 	"github.com/nguyentantai21042004/kanban-api/pkg/log"
@@ -23,14 +29,20 @@ var upgrader = websocket.Upgrader{
 type Handler struct {
 	hub        wsPkg.Hub
 	jwtManager scope.Manager
+	authUC     auth.UseCase
+	memberUC   members.UseCase
+	boardUC    boards.UseCase
 	logger     log.Logger
 }
 
 // New creates a new WebSocket handler
-func New(hub wsPkg.Hub, jwtManager scope.Manager, logger log.Logger) *Handler {
+func New(hub wsPkg.Hub, jwtManager scope.Manager, authUC auth.UseCase, memberUC members.UseCase, boardUC boards.UseCase, logger log.Logger) *Handler {
 	return &Handler{
 		hub:        hub,
 		jwtManager: jwtManager,
+		authUC:     authUC,
+		memberUC:   memberUC,
+		boardUC:    boardUC,
 		logger:     logger,
 	}
 }
@@ -69,12 +81,24 @@ func (h *Handler) ServeWebSocket(c *gin.Context) {
 	// Validate JWT token
 	h.logger.Info(c.Request.Context(), "Validating JWT token")
 	payload, err := h.jwtManager.Verify(token)
-	if err != nil {
+	if err != nil || payload.Refresh || payload.Type == scope.TokenTypeMFAPending {
 		h.logger.Error(c.Request.Context(), "WebSocket JWT validation failed", "error", err)
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
 		return
 	}
 
+	revoked, err := h.authUC.IsTokenRevoked(c.Request.Context(), auth.IsTokenRevokedInput{
+		TokenID:   payload.Id,
+		UserID:    payload.UserID,
+		SessionID: payload.SessionID,
+		IssuedAt:  time.Unix(payload.IssuedAt, 0),
+	})
+	if err != nil || revoked {
+		h.logger.Error(c.Request.Context(), "WebSocket token revoked", "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+		return
+	}
+
 	h.logger.Info(c.Request.Context(), "JWT validation successful", "user_id", payload.UserID)
 
 	userID := payload.UserID
@@ -84,8 +108,26 @@ func (h *Handler) ServeWebSocket(c *gin.Context) {
 		return
 	}
 
+	// Only the members of the board receive its events
+	if err := h.memberUC.Authorize(c.Request.Context(), scope.NewScope(payload), members.AuthorizeInput{
+		BoardID: boardID,
+		Role:    models.BoardRoleObserver,
+	}); err != nil {
+		h.logger.Error(c.Request.Context(), "WebSocket board access denied", "error", err)
+		c.JSON(http.StatusForbidden, gin.H{"error": "board access denied"})
+		return
+	}
+
 	h.logger.Info(c.Request.Context(), "WebSocket connection authorized", "user_id", userID, "board_id", boardID)
 
+	// Opening the live updates of a board counts as a view of it
+	bgCtx := context.WithoutCancel(c.Request.Context())
+	go func() {
+		if err := h.boardUC.RecordView(bgCtx, scope.NewScope(payload), boardID); err != nil {
+			h.logger.Error(bgCtx, "WebSocket view not recorded", "error", err)
+		}
+	}()
+
 	// Upgrade HTTP connection to WebSocket
 	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
@@ -25,6 +25,7 @@ type Hub interface {
 
 	// Broadcasting
 	BroadcastToBoard(ctx context.Context, boardID, msgType string, data interface{}, userID string) error
+	BroadcastToBoardWithVersion(ctx context.Context, boardID, msgType string, data interface{}, userID string, version int64) error
 
 	// Information
 	GetActiveUsersCount(ctx context.Context, boardID string) (int, error)
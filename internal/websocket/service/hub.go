@@ -218,14 +218,24 @@ func (h *Hub) unregisterClient(ctx context.Context, client *Client) error {
 	return nil
 }
 
-// BroadcastToBoard broadcasts a message to all clients in a board
+// BroadcastToBoard broadcasts a message to all clients in a board. The
+// message carries no version, it is used for the events that do not change
+// the board, like typing
 func (h *Hub) BroadcastToBoard(ctx context.Context, boardID, msgType string, data interface{}, userID string) error {
+	return h.BroadcastToBoardWithVersion(ctx, boardID, msgType, data, userID, 0)
+}
+
+// BroadcastToBoardWithVersion broadcasts a change to all clients in a board,
+// stamped with the version of the board the writer read once the change was
+// saved. The hub never reads the version itself
+func (h *Hub) BroadcastToBoardWithVersion(ctx context.Context, boardID, msgType string, data interface{}, userID string, version int64) error {
 	message := websocket.WSMessage{
 		Type:      msgType,
 		BoardID:   boardID,
 		Data:      data,
 		Timestamp: time.Now().Unix(),
 		UserID:    userID,
+		Version:   version,
 	}
 
 	select {
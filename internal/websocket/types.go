@@ -20,7 +20,18 @@ const (
 	MSG_USER_TYPING = "user_typing"
 
 	// Board events
-	MSG_BOARD_UPDATED = "board_updated"
+	MSG_BOARD_UPDATED    = "board_updated"
+	MSG_BOARD_ARCHIVED   = "board_archived"
+	MSG_BOARD_UNARCHIVED = "board_unarchived"
+	MSG_BOARD_DELETED    = "board_deleted"
+	MSG_BOARD_RESTORED   = "board_restored"
+	MSG_BOARD_PURGED     = "board_purged"
+	// Sent on the source board once a copy made in the background is done
+	MSG_BOARD_CLONED       = "board_cloned"
+	MSG_BOARD_CLONE_FAILED = "board_clone_failed"
+
+	// Member events
+	MSG_MEMBER_JOINED = "member_joined"
 
 	// System events
 	MSG_ERROR = "error"
@@ -36,6 +47,9 @@ type WSMessage struct {
 	Data      interface{} `json:"data"`
 	Timestamp int64       `json:"timestamp"`
 	UserID    string      `json:"user_id,omitempty"`
+	// Version is the version of the board once the change was applied,
+	// clients skip the events already contained in their board snapshot
+	Version int64 `json:"version,omitempty"`
 }
 
 // BroadcastMessage represents a message to be broadcasted
@@ -0,0 +1,58 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+)
+
+var (
+	errWrongQuery     = pkgErrors.NewHTTPError(11001, "Wrong query")
+	errFieldRequired  = pkgErrors.NewHTTPError(11002, "Field required")
+	errNotFound       = pkgErrors.NewHTTPError(11003, "Workspace not found")
+	errInvalidRole    = pkgErrors.NewHTTPError(11004, "Role must be owner, admin or member")
+	errForbidden      = &pkgErrors.HTTPError{Code: 11005, Message: "You do not have access to this workspace", StatusCode: http.StatusForbidden}
+	errNotEmpty       = pkgErrors.NewHTTPError(11006, "Move or delete the boards of the workspace first")
+	errMemberNotFound = pkgErrors.NewHTTPError(11007, "Workspace member not found")
+	errAlreadyMember  = pkgErrors.NewHTTPError(11008, "User is already a member of the workspace")
+	errUserNotFound   = pkgErrors.NewHTTPError(11009, "User not found")
+	errLastOwner      = pkgErrors.NewHTTPError(11010, "The workspace must keep at least one owner")
+	errNoPermission   = &pkgErrors.HTTPError{Code: 11011, Message: "You are not allowed to create workspaces", StatusCode: http.StatusForbidden}
+)
+
+func (h handler) mapErrorCode(err error) error {
+	switch err {
+	case workspaces.ErrFieldRequired:
+		return errFieldRequired
+	case role.ErrForbidden:
+		return errNoPermission
+	case workspaces.ErrNotFound:
+		return errNotFound
+	case workspaces.ErrInvalidRole:
+		return errInvalidRole
+	case workspaces.ErrForbidden:
+		return errForbidden
+	case workspaces.ErrNotEmpty:
+		return errNotEmpty
+	case workspaces.ErrMemberNotFound:
+		return errMemberNotFound
+	case workspaces.ErrAlreadyMember:
+		return errAlreadyMember
+	case workspaces.ErrUserNotFound:
+		return errUserNotFound
+	case workspaces.ErrLastOwner:
+		return errLastOwner
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errNotFound,
+	errForbidden,
+	errMemberNotFound,
+	errUserNotFound,
+	errNoPermission,
+}
@@ -0,0 +1,356 @@
+package http
+
+import (
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/pkg/response"
+)
+
+// @Summary Get workspaces
+// @Description List the workspaces the user is a member of. Users with the workspace.manage permission see every workspace
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param ids[] query []string false "IDs"
+// @Param keyword query string false "Keyword"
+// @Param page query integer false "Page"
+// @Param limit query integer false "Limit"
+// @Success 200 {object} getResp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces [GET]
+func (h handler) Get(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processGetRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.Get.processGetRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Get(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.Get.uc.Get: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.Get.uc.Get: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newGetResp(o))
+}
+
+// @Summary Create workspace
+// @Description Create a workspace, the creator becomes its owner
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param body body createReq true "Workspace data"
+// @Success 200 {object} workspaceItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces [POST]
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.Create.processCreateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Create(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.Create.uc.Create: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.Create.uc.Create: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Get workspace
+// @Description Get a workspace. Requires workspace membership
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Success 200 {object} workspaceItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id} [GET]
+func (h handler) Detail(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.Detail.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Detail(ctx, sc, id)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.Detail.uc.Detail: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.Detail.uc.Detail: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Update workspace
+// @Description Rename a workspace or change its description. Requires the admin role
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Param body body updateReq true "Workspace data"
+// @Success 200 {object} workspaceItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id} [PUT]
+func (h handler) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.Update.processUpdateRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.Update(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.Update.uc.Update: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.Update.uc.Update: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newDetailResp(o))
+}
+
+// @Summary Delete workspace
+// @Description Delete a workspace that no longer owns boards. Requires the owner role
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id} [DELETE]
+func (h handler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.Delete.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.Delete(ctx, sc, id); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.Delete.uc.Delete: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.Delete.uc.Delete: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
+
+// @Summary List workspace members
+// @Description List the members of a workspace and their roles. Requires workspace membership
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Success 200 {object} []memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id}/members [GET]
+func (h handler) ListMembers(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.ListMembers.processIDRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.ListMembers(ctx, sc, id)
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.ListMembers.uc.ListMembers: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.ListMembers.uc.ListMembers: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newListMembersResp(o))
+}
+
+// @Summary Add a workspace member
+// @Description Give a user a role in the workspace. Owners and admins of a workspace hold the same role on all of its boards. Requires the admin role, or the owner role to add an owner
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Param body body addMemberReq true "Member data"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id}/members [POST]
+func (h handler) AddMember(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processAddMemberRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.AddMember.processAddMemberRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.AddMember(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.AddMember.uc.AddMember: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.AddMember.uc.AddMember: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newMemberResp(o))
+}
+
+// @Summary Change the role of a workspace member
+// @Description Change the role of a member. Requires the admin role, or the owner role when ownership is granted or taken away. The last owner cannot be demoted
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Param user_id path string true "User ID"
+// @Param body body updateMemberRoleReq true "New role"
+// @Success 200 {object} memberItem "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id}/members/{user_id} [PUT]
+func (h handler) UpdateMemberRole(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processUpdateMemberRoleRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.UpdateMemberRole.processUpdateMemberRoleRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	o, err := h.uc.UpdateMemberRole(ctx, sc, req.toInput())
+	if err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.UpdateMemberRole.uc.UpdateMemberRole: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.UpdateMemberRole.uc.UpdateMemberRole: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, h.newMemberResp(o))
+}
+
+// @Summary Remove a workspace member
+// @Description Remove a member from the workspace. Members can always remove themselves; removing someone else requires the admin role, or the owner role for an owner. The last owner cannot leave
+// @Tags Workspace
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer JWT token" default(Bearer <token>)
+// @Param id path string true "Workspace ID"
+// @Param user_id path string true "User ID"
+// @Success 200 {object} response.Resp "Success"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /api/v1/workspaces/{id}/members/{user_id} [DELETE]
+func (h handler) RemoveMember(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	req, sc, err := h.processRemoveMemberRequest(c)
+	if err != nil {
+		h.l.Warnf(ctx, "internal.workspaces.http.RemoveMember.processRemoveMemberRequest: %v", err)
+		response.Error(c, err, h.d)
+		return
+	}
+
+	if err := h.uc.RemoveMember(ctx, sc, req.toInput()); err != nil {
+		mapErr := h.mapErrorCode(err)
+		if slices.Contains(NotFound, mapErr) {
+			h.l.Warnf(ctx, "internal.workspaces.http.RemoveMember.uc.RemoveMember: %v", err)
+		} else {
+			h.l.Errorf(ctx, "internal.workspaces.http.RemoveMember.uc.RemoveMember: %v", err)
+		}
+		response.Error(c, mapErr, h.d)
+		return
+	}
+
+	response.OK(c, nil)
+}
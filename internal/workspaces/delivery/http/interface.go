@@ -0,0 +1,16 @@
+package http
+
+import "github.com/gin-gonic/gin"
+
+type Handler interface {
+	Get(c *gin.Context)
+	Create(c *gin.Context)
+	Update(c *gin.Context)
+	Detail(c *gin.Context)
+	Delete(c *gin.Context)
+
+	ListMembers(c *gin.Context)
+	AddMember(c *gin.Context)
+	UpdateMemberRole(c *gin.Context)
+	RemoveMember(c *gin.Context)
+}
@@ -0,0 +1,224 @@
+package http
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+type respObj struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type workspaceItem struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Alias       string    `json:"alias,omitempty"`
+	CreatedBy   respObj   `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func newWorkspaceItem(w models.Workspace, userMap map[string]models.User) workspaceItem {
+	item := workspaceItem{
+		ID:        w.ID,
+		Name:      w.Name,
+		Alias:     w.Alias,
+		CreatedAt: w.CreatedAt,
+	}
+	if w.Description != nil {
+		item.Description = *w.Description
+	}
+	if w.CreatedBy != nil {
+		item.CreatedBy = respObj{
+			ID:   *w.CreatedBy,
+			Name: userMap[*w.CreatedBy].FullName,
+		}
+	}
+
+	return item
+}
+
+func newUserMap(us []models.User) map[string]models.User {
+	userMap := make(map[string]models.User, len(us))
+	for _, u := range us {
+		userMap[u.ID] = u
+	}
+
+	return userMap
+}
+
+// Get
+type getReq struct {
+	IDs       []string `form:"ids[]"`
+	Keyword   string   `form:"keyword"`
+	PageQuery paginator.PaginateQuery
+}
+
+func (req getReq) validate() error {
+	for _, id := range req.IDs {
+		if err := postgres.IsUUID(id); err != nil {
+			return errors.New("invalid id")
+		}
+	}
+
+	return nil
+}
+
+func (req getReq) toInput() workspaces.GetInput {
+	return workspaces.GetInput{
+		Filter: workspaces.Filter{
+			IDs:     req.IDs,
+			Keyword: req.Keyword,
+		},
+		PagQuery: req.PageQuery,
+	}
+}
+
+type getResp struct {
+	Items []workspaceItem             `json:"items"`
+	Meta  paginator.PaginatorResponse `json:"meta"`
+}
+
+func (h handler) newGetResp(o workspaces.GetOutput) getResp {
+	userMap := newUserMap(o.Users)
+
+	items := make([]workspaceItem, len(o.Workspaces))
+	for i, w := range o.Workspaces {
+		items[i] = newWorkspaceItem(w, userMap)
+	}
+
+	return getResp{
+		Items: items,
+		Meta:  o.Pagination.ToResponse(),
+	}
+}
+
+func (h handler) newDetailResp(o workspaces.DetailOutput) workspaceItem {
+	return newWorkspaceItem(o.Workspace, newUserMap(o.Users))
+}
+
+// Create
+type createReq struct {
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+func (req createReq) toInput() workspaces.CreateInput {
+	return workspaces.CreateInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+// Update
+type updateReq struct {
+	ID          string `json:"-"`
+	Name        string `json:"name" binding:"required"`
+	Description string `json:"description"`
+}
+
+func (req updateReq) toInput() workspaces.UpdateInput {
+	return workspaces.UpdateInput{
+		ID:          req.ID,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
+
+// Members
+type memberUser struct {
+	ID        string `json:"id"`
+	Username  string `json:"username"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url,omitempty"`
+}
+
+type memberItem struct {
+	ID          string               `json:"id"`
+	WorkspaceID string               `json:"workspace_id"`
+	Role        models.WorkspaceRole `json:"role"`
+	User        memberUser           `json:"user"`
+	AddedBy     string               `json:"added_by,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+func newMemberItem(m models.WorkspaceMember, u models.User) memberItem {
+	item := memberItem{
+		ID:          m.ID,
+		WorkspaceID: m.WorkspaceID,
+		Role:        m.Role,
+		User: memberUser{
+			ID:        m.UserID,
+			Username:  u.Username,
+			FullName:  u.FullName,
+			AvatarURL: u.AvatarURL,
+		},
+		CreatedAt: m.CreatedAt,
+	}
+	if m.AddedBy != nil {
+		item.AddedBy = *m.AddedBy
+	}
+
+	return item
+}
+
+func (h handler) newListMembersResp(o workspaces.ListMembersOutput) []memberItem {
+	userMap := newUserMap(o.Users)
+
+	items := make([]memberItem, len(o.Members))
+	for i, m := range o.Members {
+		items[i] = newMemberItem(m, userMap[m.UserID])
+	}
+
+	return items
+}
+
+func (h handler) newMemberResp(o workspaces.MemberOutput) memberItem {
+	return newMemberItem(o.Member, o.User)
+}
+
+type addMemberReq struct {
+	WorkspaceID string `json:"-"`
+	UserID      string `json:"user_id" binding:"required"`
+	Role        string `json:"role" binding:"required"`
+}
+
+func (req addMemberReq) toInput() workspaces.AddMemberInput {
+	return workspaces.AddMemberInput{
+		WorkspaceID: req.WorkspaceID,
+		UserID:      req.UserID,
+		Role:        models.WorkspaceRole(req.Role),
+	}
+}
+
+type updateMemberRoleReq struct {
+	WorkspaceID string `json:"-"`
+	UserID      string `json:"-"`
+	Role        string `json:"role" binding:"required"`
+}
+
+func (req updateMemberRoleReq) toInput() workspaces.UpdateMemberRoleInput {
+	return workspaces.UpdateMemberRoleInput{
+		WorkspaceID: req.WorkspaceID,
+		UserID:      req.UserID,
+		Role:        models.WorkspaceRole(req.Role),
+	}
+}
+
+type removeMemberReq struct {
+	WorkspaceID string
+	UserID      string
+}
+
+func (req removeMemberReq) toInput() workspaces.RemoveMemberInput {
+	return workspaces.RemoveMemberInput{
+		WorkspaceID: req.WorkspaceID,
+		UserID:      req.UserID,
+	}
+}
@@ -0,0 +1,176 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	pkgErrors "github.com/nguyentantai21042004/kanban-api/pkg/errors"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+	"github.com/nguyentantai21042004/kanban-api/pkg/scope"
+)
+
+func (h handler) processGetRequest(c *gin.Context) (getReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processGetRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return getReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req getReq
+	if err := c.ShouldBindQuery(&req); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processGetRequest.c.ShouldBindQuery: %v", err)
+		return getReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.PageQuery.Adjust()
+	if err := req.validate(); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processGetRequest.req.validate: %v", err)
+		return getReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processCreateRequest(c *gin.Context) (createReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processCreateRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return createReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req createReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processCreateRequest.c.ShouldBindJSON: %v", err)
+		return createReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processUpdateRequest(c *gin.Context) (updateReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return updateReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req updateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateRequest.c.ShouldBindJSON: %v", err)
+		return updateReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.ID = c.Param("id")
+	if err := postgres.IsUUID(req.ID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateRequest.c.Param: %v", err)
+		return updateReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+// processIDRequest reads the workspace ID of the routes that only take the ID.
+func (h handler) processIDRequest(c *gin.Context) (string, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processIDRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return "", models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	id := c.Param("id")
+	if err := postgres.IsUUID(id); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processIDRequest.c.Param: %v", err)
+		return "", models.Scope{}, errWrongQuery
+	}
+
+	return id, scope.NewScope(p), nil
+}
+
+func (h handler) processAddMemberRequest(c *gin.Context) (addMemberReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processAddMemberRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return addMemberReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req addMemberReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processAddMemberRequest.c.ShouldBindJSON: %v", err)
+		return addMemberReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.WorkspaceID = c.Param("id")
+	if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processAddMemberRequest.c.Param: %v", err)
+		return addMemberReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.UserID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processAddMemberRequest.IsUUID: %v", err)
+		return addMemberReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processUpdateMemberRoleRequest(c *gin.Context) (updateMemberRoleReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateMemberRoleRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return updateMemberRoleReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	var req updateMemberRoleReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateMemberRoleRequest.c.ShouldBindJSON: %v", err)
+		return updateMemberRoleReq{}, models.Scope{}, errWrongQuery
+	}
+
+	req.WorkspaceID = c.Param("id")
+	req.UserID = c.Param("user_id")
+	if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateMemberRoleRequest.c.Param: %v", err)
+		return updateMemberRoleReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.UserID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processUpdateMemberRoleRequest.c.Param: %v", err)
+		return updateMemberRoleReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
+
+func (h handler) processRemoveMemberRequest(c *gin.Context) (removeMemberReq, models.Scope, error) {
+	ctx := c.Request.Context()
+
+	p, ok := scope.GetPayloadFromContext(ctx)
+	if !ok {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processRemoveMemberRequest.jwt.GetPayloadFromContext: %v", "payload not found")
+		return removeMemberReq{}, models.Scope{}, pkgErrors.NewUnauthorizedHTTPError()
+	}
+
+	req := removeMemberReq{
+		WorkspaceID: c.Param("id"),
+		UserID:      c.Param("user_id"),
+	}
+	if err := postgres.IsUUID(req.WorkspaceID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processRemoveMemberRequest.c.Param: %v", err)
+		return removeMemberReq{}, models.Scope{}, errWrongQuery
+	}
+	if err := postgres.IsUUID(req.UserID); err != nil {
+		h.l.Errorf(ctx, "internal.workspaces.delivery.http.processRemoveMemberRequest.c.Param: %v", err)
+		return removeMemberReq{}, models.Scope{}, errWrongQuery
+	}
+
+	return req, scope.NewScope(p), nil
+}
@@ -0,0 +1,20 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/nguyentantai21042004/kanban-api/internal/middleware"
+)
+
+func MapWorkspaceRoutes(r *gin.RouterGroup, h Handler, mw middleware.Middleware) {
+	r.Use(mw.Auth())
+	r.GET("", h.Get)
+	r.POST("", h.Create)
+	r.GET("/:id", h.Detail)
+	r.PUT("/:id", h.Update)
+	r.DELETE("/:id", h.Delete)
+
+	r.GET("/:id/members", h.ListMembers)
+	r.POST("/:id/members", h.AddMember)
+	r.PUT("/:id/members/:user_id", h.UpdateMemberRole)
+	r.DELETE("/:id/members/:user_id", h.RemoveMember)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Get(ctx context.Context, sc models.Scope, opts GetOptions) ([]models.Workspace, paginator.Paginator, error)
+	Create(ctx context.Context, sc models.Scope, opts CreateOptions) (models.Workspace, error)
+	Update(ctx context.Context, sc models.Scope, opts UpdateOptions) (models.Workspace, error)
+	Detail(ctx context.Context, sc models.Scope, id string) (models.Workspace, error)
+	Delete(ctx context.Context, sc models.Scope, id string) error
+	// CountBoards counts the boards the workspace owns.
+	CountBoards(ctx context.Context, sc models.Scope, id string) (int64, error)
+
+	ListMembers(ctx context.Context, sc models.Scope, opts ListMembersOptions) ([]models.WorkspaceMember, error)
+	DetailMember(ctx context.Context, sc models.Scope, opts DetailMemberOptions) (models.WorkspaceMember, error)
+	CreateMember(ctx context.Context, sc models.Scope, opts CreateMemberOptions) (models.WorkspaceMember, error)
+	UpdateMemberRole(ctx context.Context, sc models.Scope, opts UpdateMemberRoleOptions) (models.WorkspaceMember, error)
+	DeleteMember(ctx context.Context, sc models.Scope, id string) error
+	CountMembers(ctx context.Context, sc models.Scope, opts CountMembersOptions) (int64, error)
+}
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+type GetOptions struct {
+	Filter   workspaces.Filter
+	PagQuery paginator.PaginateQuery
+}
+
+type CreateOptions struct {
+	Name        string
+	Alias       string
+	Description string
+}
+
+type UpdateOptions struct {
+	ID          string
+	Name        string
+	Alias       string
+	Description string
+}
+
+type ListMembersOptions struct {
+	WorkspaceID string
+}
+
+type DetailMemberOptions struct {
+	WorkspaceID string
+	UserID      string
+}
+
+type CreateMemberOptions struct {
+	WorkspaceID string
+	UserID      string
+	Role        models.WorkspaceRole
+	AddedBy     string
+}
+
+type UpdateMemberRoleOptions struct {
+	ID   string
+	Role models.WorkspaceRole
+}
+
+type CountMembersOptions struct {
+	WorkspaceID string
+	Role        models.WorkspaceRole
+}
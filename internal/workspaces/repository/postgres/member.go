@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) ListMembers(ctx context.Context, sc models.Scope, opts repository.ListMembersOptions) ([]models.WorkspaceMember, error) {
+	qr, err := r.buildListMembersQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.ListMembers.buildListMembersQuery: %v", err)
+		return nil, err
+	}
+
+	ms, err := dbmodels.WorkspaceMembers(qr...).All(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.ListMembers.All: %v", err)
+		return nil, err
+	}
+
+	members := make([]models.WorkspaceMember, len(ms))
+	for i, m := range ms {
+		members[i] = models.NewWorkspaceMember(*m)
+	}
+
+	return members, nil
+}
+
+func (r implRepository) DetailMember(ctx context.Context, sc models.Scope, opts repository.DetailMemberOptions) (models.WorkspaceMember, error) {
+	qr, err := r.buildDetailMemberQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.DetailMember.buildDetailMemberQuery: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+
+	m, err := dbmodels.WorkspaceMembers(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.workspaces.repository.postgres.DetailMember.One.NoRows: %v", err)
+			return models.WorkspaceMember{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.DetailMember.One: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+
+	return models.NewWorkspaceMember(*m), nil
+}
+
+func (r implRepository) CreateMember(ctx context.Context, sc models.Scope, opts repository.CreateMemberOptions) (models.WorkspaceMember, error) {
+	m := r.buildMemberModel(opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.CreateMember.Insert: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+
+	return models.NewWorkspaceMember(m), nil
+}
+
+func (r implRepository) UpdateMemberRole(ctx context.Context, sc models.Scope, opts repository.UpdateMemberRoleOptions) (models.WorkspaceMember, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.UpdateMemberRole.InvalidID: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+
+	m := dbmodels.WorkspaceMember{
+		ID:        opts.ID,
+		Role:      string(opts.Role),
+		UpdatedAt: r.clock(),
+	}
+	n, err := m.Update(ctx, r.database, boil.Whitelist(dbmodels.WorkspaceMemberColumns.Role, dbmodels.WorkspaceMemberColumns.UpdatedAt))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.UpdateMemberRole.Update: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+	if n == 0 {
+		return models.WorkspaceMember{}, repository.ErrNotFound
+	}
+
+	if err := m.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.UpdateMemberRole.Reload: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+
+	return models.NewWorkspaceMember(m), nil
+}
+
+func (r implRepository) DeleteMember(ctx context.Context, sc models.Scope, id string) error {
+	if err := postgres.IsUUID(id); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.DeleteMember.InvalidID: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.WorkspaceMembers(dbmodels.WorkspaceMemberWhere.ID.EQ(id)).DeleteAll(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.DeleteMember.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) CountMembers(ctx context.Context, sc models.Scope, opts repository.CountMembersOptions) (int64, error) {
+	qr, err := r.buildCountMembersQuery(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.CountMembers.buildCountMembersQuery: %v", err)
+		return 0, err
+	}
+
+	n, err := dbmodels.WorkspaceMembers(qr...).Count(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.CountMembers.Count: %v", err)
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/null/v8"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildModel(sc models.Scope, opts repository.CreateOptions) dbmodels.Workspace {
+	now := r.clock()
+
+	return dbmodels.Workspace{
+		Name:        opts.Name,
+		Alias:       null.StringFrom(opts.Alias),
+		Description: null.StringFrom(opts.Description),
+		CreatedBy:   null.StringFrom(sc.UserID),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (r implRepository) buildUpdateModel(ctx context.Context, opts repository.UpdateOptions) (dbmodels.Workspace, []string, error) {
+	if err := postgres.IsUUID(opts.ID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildUpdateModel.IsUUID: %v", err)
+		return dbmodels.Workspace{}, nil, err
+	}
+
+	w := dbmodels.Workspace{
+		ID:          opts.ID,
+		Name:        opts.Name,
+		Alias:       null.StringFrom(opts.Alias),
+		Description: null.StringFrom(opts.Description),
+		UpdatedAt:   r.clock(),
+	}
+	cols := []string{
+		dbmodels.WorkspaceColumns.Name,
+		dbmodels.WorkspaceColumns.Alias,
+		dbmodels.WorkspaceColumns.Description,
+		dbmodels.WorkspaceColumns.UpdatedAt,
+	}
+
+	return w, cols, nil
+}
+
+func (r implRepository) buildMemberModel(opts repository.CreateMemberOptions) dbmodels.WorkspaceMember {
+	now := r.clock()
+
+	return dbmodels.WorkspaceMember{
+		WorkspaceID: opts.WorkspaceID,
+		UserID:      opts.UserID,
+		Role:        string(opts.Role),
+		AddedBy:     null.NewString(opts.AddedBy, opts.AddedBy != ""),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
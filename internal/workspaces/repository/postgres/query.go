@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) buildGetQuery(ctx context.Context, fils workspaces.Filter) ([]qm.QueryMod, error) {
+	qr := postgres.BuildQueryWithSoftDelete()
+
+	if len(fils.IDs) > 0 {
+		for _, id := range fils.IDs {
+			if err := postgres.IsUUID(id); err != nil {
+				r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildGetQuery.InvalidID: %v", err)
+				return nil, err
+			}
+		}
+		qr = append(qr, dbmodels.WorkspaceWhere.ID.IN(fils.IDs))
+	}
+
+	if fils.Keyword != "" {
+		qr = append(qr, qm.Where("(alias ILIKE ? OR description ILIKE ?)", "%"+fils.Keyword+"%", "%"+fils.Keyword+"%"))
+	}
+
+	if fils.MemberID != "" {
+		if err := postgres.IsUUID(fils.MemberID); err != nil {
+			r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildGetQuery.InvalidMemberID: %v", err)
+			return nil, err
+		}
+		qr = append(qr, qm.Where("id IN (SELECT workspace_id FROM workspace_members WHERE user_id = ?)", fils.MemberID))
+	}
+
+	return qr, nil
+}
+
+func (r implRepository) buildDetailQuery(ctx context.Context, ID string) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildDetailQuery.InvalidID: %v", err)
+		return nil, err
+	}
+
+	qr := postgres.BuildQueryWithSoftDelete()
+	qr = append(qr, dbmodels.WorkspaceWhere.ID.EQ(ID))
+
+	return qr, nil
+}
+
+func (r implRepository) buildListMembersQuery(ctx context.Context, opts repository.ListMembersOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.WorkspaceID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildListMembersQuery.InvalidWorkspaceID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.WorkspaceMemberWhere.WorkspaceID.EQ(opts.WorkspaceID),
+		qm.OrderBy(dbmodels.WorkspaceMemberColumns.CreatedAt + " ASC"),
+	}, nil
+}
+
+func (r implRepository) buildDetailMemberQuery(ctx context.Context, opts repository.DetailMemberOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.WorkspaceID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildDetailMemberQuery.InvalidWorkspaceID: %v", err)
+		return nil, err
+	}
+	if err := postgres.IsUUID(opts.UserID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildDetailMemberQuery.InvalidUserID: %v", err)
+		return nil, err
+	}
+
+	return []qm.QueryMod{
+		dbmodels.WorkspaceMemberWhere.WorkspaceID.EQ(opts.WorkspaceID),
+		dbmodels.WorkspaceMemberWhere.UserID.EQ(opts.UserID),
+	}, nil
+}
+
+func (r implRepository) buildCountMembersQuery(ctx context.Context, opts repository.CountMembersOptions) ([]qm.QueryMod, error) {
+	if err := postgres.IsUUID(opts.WorkspaceID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.buildCountMembersQuery.InvalidWorkspaceID: %v", err)
+		return nil, err
+	}
+
+	qr := []qm.QueryMod{dbmodels.WorkspaceMemberWhere.WorkspaceID.EQ(opts.WorkspaceID)}
+	if opts.Role != "" {
+		qr = append(qr, dbmodels.WorkspaceMemberWhere.Role.EQ(string(opts.Role)))
+	}
+
+	return qr, nil
+}
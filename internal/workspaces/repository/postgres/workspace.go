@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/nguyentantai21042004/kanban-api/internal/dbmodels"
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+	"github.com/nguyentantai21042004/kanban-api/pkg/postgres"
+)
+
+func (r implRepository) Get(ctx context.Context, sc models.Scope, opts repository.GetOptions) ([]models.Workspace, paginator.Paginator, error) {
+	qr, err := r.buildGetQuery(ctx, opts.Filter)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Get.buildGetQuery: %v", err)
+		return nil, paginator.Paginator{}, err
+	}
+
+	var (
+		total int64
+		ws    dbmodels.WorkspaceSlice
+	)
+
+	errChan := make(chan error, 2)
+	wg := sync.WaitGroup{}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var countErr error
+		total, countErr = dbmodels.Workspaces(qr...).Count(ctx, r.database)
+		if countErr != nil {
+			r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Get.Count: %v", countErr)
+			errChan <- countErr
+		}
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		pageQr := append(qr[:len(qr):len(qr)],
+			qm.OrderBy(dbmodels.WorkspaceColumns.CreatedAt+" DESC"),
+			qm.Limit(int(opts.PagQuery.Limit)),
+			qm.Offset(int(opts.PagQuery.Offset())),
+		)
+		var allErr error
+		ws, allErr = dbmodels.Workspaces(pageQr...).All(ctx, r.database)
+		if allErr != nil {
+			r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Get.All: %v", allErr)
+			errChan <- allErr
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(errChan)
+	}()
+
+	for err := range errChan {
+		if err != nil {
+			r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Get.errChan: %v", err)
+			return nil, paginator.Paginator{}, err
+		}
+	}
+
+	workspaces := make([]models.Workspace, len(ws))
+	for i, w := range ws {
+		workspaces[i] = models.NewWorkspace(*w)
+	}
+
+	return workspaces, paginator.Paginator{
+		Total:       total,
+		Count:       int64(len(workspaces)),
+		PerPage:     opts.PagQuery.Limit,
+		CurrentPage: opts.PagQuery.Page,
+	}, nil
+}
+
+func (r implRepository) Create(ctx context.Context, sc models.Scope, opts repository.CreateOptions) (models.Workspace, error) {
+	m := r.buildModel(sc, opts)
+
+	if err := m.Insert(ctx, r.database, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Create.Insert: %v", err)
+		return models.Workspace{}, err
+	}
+
+	return models.NewWorkspace(m), nil
+}
+
+func (r implRepository) Update(ctx context.Context, sc models.Scope, opts repository.UpdateOptions) (models.Workspace, error) {
+	w, cols, err := r.buildUpdateModel(ctx, opts)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Update.buildUpdateModel: %v", err)
+		return models.Workspace{}, err
+	}
+
+	n, err := w.Update(ctx, r.database, boil.Whitelist(cols...))
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Update.Update: %v", err)
+		return models.Workspace{}, err
+	}
+	if n == 0 {
+		return models.Workspace{}, repository.ErrNotFound
+	}
+
+	if err := w.Reload(ctx, r.database); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Update.Reload: %v", err)
+		return models.Workspace{}, err
+	}
+
+	return models.NewWorkspace(w), nil
+}
+
+func (r implRepository) Detail(ctx context.Context, sc models.Scope, ID string) (models.Workspace, error) {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Detail.buildDetailQuery: %v", err)
+		return models.Workspace{}, err
+	}
+
+	w, err := dbmodels.Workspaces(qr...).One(ctx, r.database)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			r.l.Warnf(ctx, "internal.workspaces.repository.postgres.Detail.One.NoRows: %v", err)
+			return models.Workspace{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Detail.One: %v", err)
+		return models.Workspace{}, err
+	}
+
+	return models.NewWorkspace(*w), nil
+}
+
+func (r implRepository) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	qr, err := r.buildDetailQuery(ctx, ID)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Delete.buildDetailQuery: %v", err)
+		return err
+	}
+
+	n, err := dbmodels.Workspaces(qr...).DeleteAll(ctx, r.database, false)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.Delete.DeleteAll: %v", err)
+		return err
+	}
+	if n == 0 {
+		return repository.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r implRepository) CountBoards(ctx context.Context, sc models.Scope, ID string) (int64, error) {
+	if err := postgres.IsUUID(ID); err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.CountBoards.InvalidID: %v", err)
+		return 0, err
+	}
+
+	n, err := dbmodels.Boards(
+		dbmodels.BoardWhere.WorkspaceID.EQ(null.StringFrom(ID)),
+		dbmodels.BoardWhere.DeletedAt.IsNull(),
+	).Count(ctx, r.database)
+	if err != nil {
+		r.l.Errorf(ctx, "internal.workspaces.repository.postgres.CountBoards.Count: %v", err)
+		return 0, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,15 @@
+package workspaces
+
+import "errors"
+
+var (
+	ErrFieldRequired  = errors.New("field required")
+	ErrNotFound       = errors.New("workspace not found")
+	ErrInvalidRole    = errors.New("invalid workspace role")
+	ErrForbidden      = errors.New("not allowed in this workspace")
+	ErrNotEmpty       = errors.New("workspace still has boards")
+	ErrMemberNotFound = errors.New("workspace member not found")
+	ErrAlreadyMember  = errors.New("user is already a workspace member")
+	ErrUserNotFound   = errors.New("user not found")
+	ErrLastOwner      = errors.New("workspace must keep an owner")
+)
@@ -0,0 +1,24 @@
+package workspaces
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	Get(ctx context.Context, sc models.Scope, ip GetInput) (GetOutput, error)
+	Create(ctx context.Context, sc models.Scope, ip CreateInput) (DetailOutput, error)
+	Update(ctx context.Context, sc models.Scope, ip UpdateInput) (DetailOutput, error)
+	Detail(ctx context.Context, sc models.Scope, ID string) (DetailOutput, error)
+	Delete(ctx context.Context, sc models.Scope, ID string) error
+
+	// Authorize returns ErrForbidden unless the user holds at least the role in
+	// the workspace. The workspace.manage permission grants every role in every workspace.
+	Authorize(ctx context.Context, sc models.Scope, ip AuthorizeInput) error
+	ListMembers(ctx context.Context, sc models.Scope, workspaceID string) (ListMembersOutput, error)
+	AddMember(ctx context.Context, sc models.Scope, ip AddMemberInput) (MemberOutput, error)
+	UpdateMemberRole(ctx context.Context, sc models.Scope, ip UpdateMemberRoleInput) (MemberOutput, error)
+	RemoveMember(ctx context.Context, sc models.Scope, ip RemoveMemberInput) error
+}
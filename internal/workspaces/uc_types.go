@@ -0,0 +1,72 @@
+package workspaces
+
+import (
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/pkg/paginator"
+)
+
+type Filter struct {
+	IDs     []string
+	Keyword string
+	// MemberID keeps the workspaces the user is a member of
+	MemberID string
+}
+
+type GetInput struct {
+	Filter   Filter
+	PagQuery paginator.PaginateQuery
+}
+
+type CreateInput struct {
+	Name        string
+	Description string
+}
+
+type UpdateInput struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+type GetOutput struct {
+	Workspaces []models.Workspace
+	Users      []models.User
+	Pagination paginator.Paginator
+}
+
+type DetailOutput struct {
+	Workspace models.Workspace
+	Users     []models.User
+}
+
+type AuthorizeInput struct {
+	WorkspaceID string
+	Role        models.WorkspaceRole
+}
+
+type AddMemberInput struct {
+	WorkspaceID string
+	UserID      string
+	Role        models.WorkspaceRole
+}
+
+type UpdateMemberRoleInput struct {
+	WorkspaceID string
+	UserID      string
+	Role        models.WorkspaceRole
+}
+
+type RemoveMemberInput struct {
+	WorkspaceID string
+	UserID      string
+}
+
+type ListMembersOutput struct {
+	Members []models.WorkspaceMember
+	Users   []models.User
+}
+
+type MemberOutput struct {
+	Member models.WorkspaceMember
+	User   models.User
+}
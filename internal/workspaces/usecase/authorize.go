@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+)
+
+func (uc implUsecase) Authorize(ctx context.Context, sc models.Scope, ip workspaces.AuthorizeInput) error {
+	if ip.WorkspaceID == "" {
+		return workspaces.ErrFieldRequired
+	}
+
+	m, err := uc.repo.DetailMember(ctx, sc, repository.DetailMemberOptions{
+		WorkspaceID: ip.WorkspaceID,
+		UserID:      sc.UserID,
+	})
+	if err != nil && err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Authorize.repo.DetailMember: %v", err)
+		return err
+	}
+	if err == nil && m.Role.AtLeast(ip.Role) {
+		return nil
+	}
+
+	admin, err := uc.canManageAll(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Authorize.canManageAll: %v", err)
+		return err
+	}
+	if admin {
+		return nil
+	}
+
+	uc.l.Warnf(ctx, "internal.workspaces.usecase.Authorize.Forbidden: user %s is not %s of workspace %s", sc.UserID, ip.Role, ip.WorkspaceID)
+	return workspaces.ErrForbidden
+}
+
+// memberFilter returns the user workspace queries have to be restricted to,
+// or an empty string when the user can see every workspace.
+func (uc implUsecase) memberFilter(ctx context.Context, sc models.Scope) (string, error) {
+	admin, err := uc.canManageAll(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.memberFilter.canManageAll: %v", err)
+		return "", err
+	}
+	if admin {
+		return "", nil
+	}
+
+	return sc.UserID, nil
+}
+
+// canManageAll reports whether the role of the user grants access to all workspaces.
+func (uc implUsecase) canManageAll(ctx context.Context, sc models.Scope) (bool, error) {
+	ok, err := uc.roleUC.HasPermission(ctx, sc, models.PermissionWorkspaceManage)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.canManageAll.roleUC.HasPermission: %v", err)
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+)
+
+func (uc implUsecase) ListMembers(ctx context.Context, sc models.Scope, workspaceID string) (workspaces.ListMembersOutput, error) {
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: workspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+		return workspaces.ListMembersOutput{}, err
+	}
+
+	ms, err := uc.repo.ListMembers(ctx, sc, repository.ListMembersOptions{WorkspaceID: workspaceID})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.ListMembers.repo.ListMembers: %v", err)
+		return workspaces.ListMembersOutput{}, err
+	}
+
+	uIDs := make([]string, len(ms))
+	for i, m := range ms {
+		uIDs[i] = m.UserID
+	}
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: uIDs,
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.ListMembers.userUC.List: %v", err)
+		return workspaces.ListMembersOutput{}, err
+	}
+
+	return workspaces.ListMembersOutput{
+		Members: ms,
+		Users:   us,
+	}, nil
+}
+
+func (uc implUsecase) AddMember(ctx context.Context, sc models.Scope, ip workspaces.AddMemberInput) (workspaces.MemberOutput, error) {
+	if ip.WorkspaceID == "" || ip.UserID == "" {
+		return workspaces.MemberOutput{}, workspaces.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return workspaces.MemberOutput{}, workspaces.ErrInvalidRole
+	}
+
+	if _, err := uc.detail(ctx, sc, ip.WorkspaceID); err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.AddMember.detail: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	// Admins manage the members, only an owner can make another owner
+	minRole := models.WorkspaceRoleAdmin
+	if ip.Role == models.WorkspaceRoleOwner {
+		minRole = models.WorkspaceRoleOwner
+	}
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: minRole}); err != nil {
+		return workspaces.MemberOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, ip.UserID)
+	if err != nil {
+		if err == user.ErrUserNotFound {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.AddMember.userUC.Detail.NotFound: %v", err)
+			return workspaces.MemberOutput{}, workspaces.ErrUserNotFound
+		}
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.AddMember.userUC.Detail: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	_, err = uc.repo.DetailMember(ctx, sc, repository.DetailMemberOptions{
+		WorkspaceID: ip.WorkspaceID,
+		UserID:      ip.UserID,
+	})
+	if err == nil {
+		return workspaces.MemberOutput{}, workspaces.ErrAlreadyMember
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.AddMember.repo.DetailMember: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	m, err := uc.repo.CreateMember(ctx, sc, repository.CreateMemberOptions{
+		WorkspaceID: ip.WorkspaceID,
+		UserID:      ip.UserID,
+		Role:        ip.Role,
+		AddedBy:     sc.UserID,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.AddMember.repo.CreateMember: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	return workspaces.MemberOutput{
+		Member: m,
+		User:   u.User,
+	}, nil
+}
+
+func (uc implUsecase) UpdateMemberRole(ctx context.Context, sc models.Scope, ip workspaces.UpdateMemberRoleInput) (workspaces.MemberOutput, error) {
+	if ip.WorkspaceID == "" || ip.UserID == "" {
+		return workspaces.MemberOutput{}, workspaces.ErrFieldRequired
+	}
+	if !ip.Role.IsValid() {
+		return workspaces.MemberOutput{}, workspaces.ErrInvalidRole
+	}
+
+	om, err := uc.detailMember(ctx, sc, ip.WorkspaceID, ip.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.UpdateMemberRole.detailMember: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	// Granting or taking away ownership is left to the owners
+	minRole := models.WorkspaceRoleAdmin
+	if om.Role == models.WorkspaceRoleOwner || ip.Role == models.WorkspaceRoleOwner {
+		minRole = models.WorkspaceRoleOwner
+	}
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: minRole}); err != nil {
+		return workspaces.MemberOutput{}, err
+	}
+
+	if om.Role == models.WorkspaceRoleOwner && ip.Role != models.WorkspaceRoleOwner {
+		if err := uc.checkOtherOwner(ctx, sc, ip.WorkspaceID); err != nil {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.UpdateMemberRole.checkOtherOwner: %v", err)
+			return workspaces.MemberOutput{}, err
+		}
+	}
+
+	m, err := uc.repo.UpdateMemberRole(ctx, sc, repository.UpdateMemberRoleOptions{
+		ID:   om.ID,
+		Role: ip.Role,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.UpdateMemberRole.repo.UpdateMemberRole.NotFound: %v", err)
+			return workspaces.MemberOutput{}, workspaces.ErrMemberNotFound
+		}
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.UpdateMemberRole.repo.UpdateMemberRole: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	u, err := uc.userUC.Detail(ctx, sc, m.UserID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.UpdateMemberRole.userUC.Detail: %v", err)
+		return workspaces.MemberOutput{}, err
+	}
+
+	return workspaces.MemberOutput{
+		Member: m,
+		User:   u.User,
+	}, nil
+}
+
+func (uc implUsecase) RemoveMember(ctx context.Context, sc models.Scope, ip workspaces.RemoveMemberInput) error {
+	if ip.WorkspaceID == "" || ip.UserID == "" {
+		return workspaces.ErrFieldRequired
+	}
+
+	om, err := uc.detailMember(ctx, sc, ip.WorkspaceID, ip.UserID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.RemoveMember.detailMember: %v", err)
+		return err
+	}
+
+	// Anyone can leave a workspace, removing somebody else takes an admin, or
+	// an owner when the member is an owner
+	if ip.UserID != sc.UserID {
+		minRole := models.WorkspaceRoleAdmin
+		if om.Role == models.WorkspaceRoleOwner {
+			minRole = models.WorkspaceRoleOwner
+		}
+		if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.WorkspaceID, Role: minRole}); err != nil {
+			return err
+		}
+	}
+
+	if om.Role == models.WorkspaceRoleOwner {
+		if err := uc.checkOtherOwner(ctx, sc, ip.WorkspaceID); err != nil {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.RemoveMember.checkOtherOwner: %v", err)
+			return err
+		}
+	}
+
+	if err := uc.repo.DeleteMember(ctx, sc, om.ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.RemoveMember.repo.DeleteMember.NotFound: %v", err)
+			return workspaces.ErrMemberNotFound
+		}
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.RemoveMember.repo.DeleteMember: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// detailMember returns the membership of the user, ErrMemberNotFound is only
+// returned to callers that can see the workspace.
+func (uc implUsecase) detailMember(ctx context.Context, sc models.Scope, workspaceID, userID string) (models.WorkspaceMember, error) {
+	m, err := uc.repo.DetailMember(ctx, sc, repository.DetailMemberOptions{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+	})
+	if err == nil {
+		return m, nil
+	}
+	if err != repository.ErrNotFound {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.detailMember.repo.DetailMember: %v", err)
+		return models.WorkspaceMember{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: workspaceID, Role: models.WorkspaceRoleMember}); err != nil {
+		return models.WorkspaceMember{}, err
+	}
+	return models.WorkspaceMember{}, workspaces.ErrMemberNotFound
+}
+
+// checkOtherOwner returns ErrLastOwner when the workspace has a single owner.
+func (uc implUsecase) checkOtherOwner(ctx context.Context, sc models.Scope, workspaceID string) error {
+	n, err := uc.repo.CountMembers(ctx, sc, repository.CountMembersOptions{
+		WorkspaceID: workspaceID,
+		Role:        models.WorkspaceRoleOwner,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.checkOtherOwner.repo.CountMembers: %v", err)
+		return err
+	}
+	if n <= 1 {
+		return workspaces.ErrLastOwner
+	}
+
+	return nil
+}
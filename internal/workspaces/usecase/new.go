@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"time"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/role"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/log"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+type implUsecase struct {
+	l      log.Logger
+	repo   repository.Repository
+	userUC user.UseCase
+	roleUC role.UseCase
+	clock  func() time.Time
+}
+
+var _ workspaces.UseCase = &implUsecase{}
+
+func New(l log.Logger, repo repository.Repository, userUC user.UseCase, roleUC role.UseCase) workspaces.UseCase {
+	return &implUsecase{
+		l:      l,
+		repo:   repo,
+		userUC: userUC,
+		roleUC: roleUC,
+		clock:  util.Now,
+	}
+}
@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/nguyentantai21042004/kanban-api/internal/models"
+	"github.com/nguyentantai21042004/kanban-api/internal/user"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces"
+	"github.com/nguyentantai21042004/kanban-api/internal/workspaces/repository"
+	"github.com/nguyentantai21042004/kanban-api/pkg/util"
+)
+
+func (uc implUsecase) Get(ctx context.Context, sc models.Scope, ip workspaces.GetInput) (workspaces.GetOutput, error) {
+	memberID, err := uc.memberFilter(ctx, sc)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Get.memberFilter: %v", err)
+		return workspaces.GetOutput{}, err
+	}
+
+	ws, p, err := uc.repo.Get(ctx, sc, repository.GetOptions{
+		Filter: workspaces.Filter{
+			IDs:      ip.Filter.IDs,
+			Keyword:  util.BuildAlias(ip.Filter.Keyword),
+			MemberID: memberID,
+		},
+		PagQuery: ip.PagQuery,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Get.repo.Get: %v", err)
+		return workspaces.GetOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, ws)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Get.listCreators: %v", err)
+		return workspaces.GetOutput{}, err
+	}
+
+	return workspaces.GetOutput{
+		Workspaces: ws,
+		Users:      us,
+		Pagination: p,
+	}, nil
+}
+
+func (uc implUsecase) Create(ctx context.Context, sc models.Scope, ip workspaces.CreateInput) (workspaces.DetailOutput, error) {
+	if ip.Name == "" {
+		return workspaces.DetailOutput{}, workspaces.ErrFieldRequired
+	}
+
+	if err := uc.roleUC.Authorize(ctx, sc, models.PermissionWorkspaceCreate); err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.Create.roleUC.Authorize: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	w, err := uc.repo.Create(ctx, sc, repository.CreateOptions{
+		Name:        ip.Name,
+		Alias:       util.BuildAlias(ip.Name),
+		Description: ip.Description,
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Create.repo.Create: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	// The creator owns the workspace
+	if _, err := uc.repo.CreateMember(ctx, sc, repository.CreateMemberOptions{
+		WorkspaceID: w.ID,
+		UserID:      sc.UserID,
+		Role:        models.WorkspaceRoleOwner,
+		AddedBy:     sc.UserID,
+	}); err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Create.repo.CreateMember: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.Workspace{w})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Create.listCreators: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	return workspaces.DetailOutput{
+		Workspace: w,
+		Users:     us,
+	}, nil
+}
+
+func (uc implUsecase) Update(ctx context.Context, sc models.Scope, ip workspaces.UpdateInput) (workspaces.DetailOutput, error) {
+	if ip.ID == "" || ip.Name == "" {
+		return workspaces.DetailOutput{}, workspaces.ErrFieldRequired
+	}
+
+	if _, err := uc.detail(ctx, sc, ip.ID); err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.Update.detail: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ip.ID, Role: models.WorkspaceRoleAdmin}); err != nil {
+		return workspaces.DetailOutput{}, err
+	}
+
+	w, err := uc.repo.Update(ctx, sc, repository.UpdateOptions{
+		ID:          ip.ID,
+		Name:        ip.Name,
+		Alias:       util.BuildAlias(ip.Name),
+		Description: ip.Description,
+	})
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.Update.repo.Update.NotFound: %v", err)
+			return workspaces.DetailOutput{}, workspaces.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Update.repo.Update: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.Workspace{w})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Update.listCreators: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	return workspaces.DetailOutput{
+		Workspace: w,
+		Users:     us,
+	}, nil
+}
+
+func (uc implUsecase) Detail(ctx context.Context, sc models.Scope, ID string) (workspaces.DetailOutput, error) {
+	w, err := uc.detail(ctx, sc, ID)
+	if err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.Detail.detail: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: w.ID, Role: models.WorkspaceRoleMember}); err != nil {
+		return workspaces.DetailOutput{}, err
+	}
+
+	us, err := uc.listCreators(ctx, sc, []models.Workspace{w})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Detail.listCreators: %v", err)
+		return workspaces.DetailOutput{}, err
+	}
+
+	return workspaces.DetailOutput{
+		Workspace: w,
+		Users:     us,
+	}, nil
+}
+
+func (uc implUsecase) Delete(ctx context.Context, sc models.Scope, ID string) error {
+	if _, err := uc.detail(ctx, sc, ID); err != nil {
+		uc.l.Warnf(ctx, "internal.workspaces.usecase.Delete.detail: %v", err)
+		return err
+	}
+
+	if err := uc.Authorize(ctx, sc, workspaces.AuthorizeInput{WorkspaceID: ID, Role: models.WorkspaceRoleOwner}); err != nil {
+		return err
+	}
+
+	// Boards are never deleted with their workspace, they have to be moved or deleted first
+	n, err := uc.repo.CountBoards(ctx, sc, ID)
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Delete.repo.CountBoards: %v", err)
+		return err
+	}
+	if n > 0 {
+		return workspaces.ErrNotEmpty
+	}
+
+	if err := uc.repo.Delete(ctx, sc, ID); err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.Delete.repo.Delete.NotFound: %v", err)
+			return workspaces.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.Delete.repo.Delete: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (uc implUsecase) detail(ctx context.Context, sc models.Scope, ID string) (models.Workspace, error) {
+	w, err := uc.repo.Detail(ctx, sc, ID)
+	if err != nil {
+		if err == repository.ErrNotFound {
+			uc.l.Warnf(ctx, "internal.workspaces.usecase.detail.repo.Detail.NotFound: %v", err)
+			return models.Workspace{}, workspaces.ErrNotFound
+		}
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.detail.repo.Detail: %v", err)
+		return models.Workspace{}, err
+	}
+
+	return w, nil
+}
+
+func (uc implUsecase) listCreators(ctx context.Context, sc models.Scope, ws []models.Workspace) ([]models.User, error) {
+	uIDs := make([]string, 0, len(ws))
+	for _, w := range ws {
+		if w.CreatedBy != nil {
+			uIDs = append(uIDs, *w.CreatedBy)
+		}
+	}
+	if len(uIDs) == 0 {
+		return nil, nil
+	}
+
+	us, err := uc.userUC.List(ctx, sc, user.ListInput{
+		Filter: user.Filter{
+			IDs: util.RemoveDuplicates(uIDs),
+		},
+	})
+	if err != nil {
+		uc.l.Errorf(ctx, "internal.workspaces.usecase.listCreators.userUC.List: %v", err)
+		return nil, err
+	}
+
+	return us, nil
+}
@@ -0,0 +1,41 @@
+-- ============================================================================
+-- REFRESH TOKENS
+-- Rotating refresh tokens grouped in families for reuse detection
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Refresh tokens table
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id UUID NOT NULL,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_expires_at ON refresh_tokens (expires_at);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE refresh_tokens IS 'Issued refresh tokens, rotated on every use';
+COMMENT ON COLUMN refresh_tokens.id IS 'Token identifier, used as the jti claim of the refresh JWT';
+COMMENT ON COLUMN refresh_tokens.family_id IS 'Login family - every rotated token of one login shares this ID';
+COMMENT ON COLUMN refresh_tokens.token_hash IS 'SHA-256 hex digest of the signed refresh token';
+COMMENT ON COLUMN refresh_tokens.used_at IS 'When the token was exchanged; a second exchange means the token was stolen';
+COMMENT ON COLUMN refresh_tokens.revoked_at IS 'When the token (or its whole family) was revoked';
@@ -0,0 +1,44 @@
+-- ============================================================================
+-- TOKEN REVOCATION
+-- Revocation list for access tokens, checked on every authenticated request
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Revoked tokens table (one row per revoked jti)
+CREATE TABLE IF NOT EXISTS revoked_tokens (
+    jti VARCHAR(64) PRIMARY KEY,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- User token revocations table (revoke every token of a user at once)
+CREATE TABLE IF NOT EXISTS user_token_revocations (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    revoked_before TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_revoked_tokens_expires_at ON revoked_tokens (expires_at);
+CREATE INDEX IF NOT EXISTS idx_user_token_revocations_expires_at ON user_token_revocations (expires_at);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE revoked_tokens IS 'Access tokens revoked before their natural expiry (logout)';
+COMMENT ON COLUMN revoked_tokens.jti IS 'JWT ID claim of the revoked token';
+COMMENT ON COLUMN revoked_tokens.expires_at IS 'Expiry of the revoked token - the row can be deleted afterwards';
+COMMENT ON TABLE user_token_revocations IS 'Per-user cut-off: tokens issued at or before revoked_before are rejected';
+COMMENT ON COLUMN user_token_revocations.expires_at IS 'When every token issued before the cut-off has expired - the row can be deleted afterwards';
@@ -0,0 +1,40 @@
+-- ============================================================================
+-- SESSIONS
+-- One row per login (device); refresh_tokens.family_id points at sessions.id
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Sessions table
+CREATE TABLE IF NOT EXISTS sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_agent VARCHAR(512),
+    ip_address VARCHAR(64),
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_sessions_active ON sessions (user_id) WHERE revoked_at IS NULL;
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE sessions IS 'Login sessions (devices); every refresh token of a session shares its ID as family_id';
+COMMENT ON COLUMN sessions.user_agent IS 'User-Agent header sent on login';
+COMMENT ON COLUMN sessions.ip_address IS 'Client IP address on login';
+COMMENT ON COLUMN sessions.expires_at IS 'Expiry of the latest refresh token of the session';
+COMMENT ON COLUMN sessions.last_used_at IS 'Last time the session refreshed its tokens';
+COMMENT ON COLUMN sessions.revoked_at IS 'When the session was logged out or revoked';
@@ -0,0 +1,37 @@
+-- ============================================================================
+-- EMAIL VERIFICATIONS
+-- One-time codes sent to self-registered users to activate their account
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Email verifications table
+CREATE TABLE IF NOT EXISTS email_verifications (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    otp_hash VARCHAR(64) NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    verified_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_email_verifications_user_id ON email_verifications (user_id);
+CREATE INDEX IF NOT EXISTS idx_email_verifications_expires_at ON email_verifications (expires_at);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE email_verifications IS 'Verification codes sent by email after self-registration';
+COMMENT ON COLUMN email_verifications.otp_hash IS 'SHA-256 hex digest of the one-time code';
+COMMENT ON COLUMN email_verifications.attempts IS 'Number of wrong codes submitted for this verification';
+COMMENT ON COLUMN email_verifications.verified_at IS 'When the code was accepted and the account activated';
@@ -0,0 +1,35 @@
+-- ============================================================================
+-- PASSWORD RESETS
+-- Single-use tokens sent by email to recover a forgotten password
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Password resets table
+CREATE TABLE IF NOT EXISTS password_resets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) UNIQUE NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_password_resets_user_id ON password_resets (user_id);
+CREATE INDEX IF NOT EXISTS idx_password_resets_expires_at ON password_resets (expires_at);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE password_resets IS 'Password reset tokens issued by the forgot-password flow';
+COMMENT ON COLUMN password_resets.token_hash IS 'SHA-256 hex digest of the reset token sent by email';
+COMMENT ON COLUMN password_resets.used_at IS 'When the token was redeemed; a token can only be used once';
@@ -0,0 +1,50 @@
+-- ============================================================================
+-- TWO-FACTOR AUTHENTICATION
+-- TOTP secrets, recovery codes and per-role enforcement
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- TOTP enrollment of a user (one per user)
+CREATE TABLE IF NOT EXISTS user_mfa (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_encrypted TEXT NOT NULL,
+    enabled_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- One-time recovery codes
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Per-role enforcement
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS mfa_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user_id ON mfa_recovery_codes (user_id);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE user_mfa IS 'TOTP (RFC 6238) enrollment of a user';
+COMMENT ON COLUMN user_mfa.secret_encrypted IS 'Base32 TOTP secret encrypted with the application key';
+COMMENT ON COLUMN user_mfa.enabled_at IS 'When the first code was confirmed; NULL while enrollment is pending';
+COMMENT ON COLUMN user_mfa.last_used_step IS 'Time step of the last accepted code, so a code cannot be replayed';
+COMMENT ON TABLE mfa_recovery_codes IS 'Single-use codes to sign in without the authenticator app';
+COMMENT ON COLUMN mfa_recovery_codes.code_hash IS 'SHA-256 hex digest of the recovery code';
+COMMENT ON COLUMN roles.mfa_required IS 'Users of this role must sign in with two-factor authentication';
@@ -0,0 +1,50 @@
+-- ============================================================================
+-- LOGIN PROTECTION
+-- Audit of failed logins and temporary lockout after repeated failures
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Failed login attempts table
+CREATE TABLE IF NOT EXISTS login_attempts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    username VARCHAR(255) NOT NULL,
+    ip_address VARCHAR(64),
+    user_agent VARCHAR(512),
+    reason VARCHAR(32) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Login lockouts table
+CREATE TABLE IF NOT EXISTS login_lockouts (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_login_attempts_user_id ON login_attempts (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_login_attempts_ip_address ON login_attempts (ip_address, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_login_attempts_created_at ON login_attempts (created_at);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE login_attempts IS 'Audit log of rejected login attempts';
+COMMENT ON COLUMN login_attempts.user_id IS 'Matched user, NULL when the username is unknown';
+COMMENT ON COLUMN login_attempts.username IS 'Username as submitted';
+COMMENT ON COLUMN login_attempts.reason IS 'Why the attempt was rejected: invalid_password, invalid_mfa_code, unknown_user, locked, rate_limited';
+COMMENT ON TABLE login_lockouts IS 'Consecutive login failures per user; removed on a successful login';
+COMMENT ON COLUMN login_lockouts.failed_attempts IS 'Failures since the last successful login or the last lockout';
+COMMENT ON COLUMN login_lockouts.locked_until IS 'Logins are refused until this time';
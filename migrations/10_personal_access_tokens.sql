@@ -0,0 +1,43 @@
+-- ============================================================================
+-- PERSONAL ACCESS TOKENS
+-- Long-lived, scoped API tokens for scripts and CI integrations
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Personal access tokens table
+CREATE TABLE IF NOT EXISTS personal_access_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,
+    scope VARCHAR(16) NOT NULL CHECK (scope IN ('read', 'write')),
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON personal_access_tokens (user_id, created_at DESC) WHERE revoked_at IS NULL;
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE personal_access_tokens IS 'Personal access tokens accepted by the API alongside JWTs';
+COMMENT ON COLUMN personal_access_tokens.name IS 'Label chosen by the user, e.g. the CI job using the token';
+COMMENT ON COLUMN personal_access_tokens.token_hash IS 'SHA-256 hex digest of the token; the token itself is shown only once';
+COMMENT ON COLUMN personal_access_tokens.token_prefix IS 'First characters of the token to recognise it in listings';
+COMMENT ON COLUMN personal_access_tokens.scope IS 'read: safe HTTP methods only, write: full access of the user';
+COMMENT ON COLUMN personal_access_tokens.expires_at IS 'NULL means the token never expires';
+COMMENT ON COLUMN personal_access_tokens.last_used_at IS 'Last time the token authenticated a request (minute precision)';
+COMMENT ON COLUMN personal_access_tokens.revoked_at IS 'When the user revoked the token';
@@ -0,0 +1,54 @@
+-- ============================================================================
+-- OPENID CONNECT LOGIN
+-- External identities linked to users and pending authorization requests
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- User identities table
+CREATE TABLE IF NOT EXISTS user_identities (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    last_login_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (issuer, subject)
+);
+
+-- OIDC login states table
+CREATE TABLE IF NOT EXISTS oidc_login_states (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    state_hash VARCHAR(64) NOT NULL UNIQUE,
+    code_verifier VARCHAR(128) NOT NULL,
+    nonce VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_user_identities_user_id ON user_identities (user_id);
+CREATE INDEX IF NOT EXISTS idx_oidc_login_states_expires_at ON oidc_login_states (expires_at);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE user_identities IS 'Accounts at an OpenID Provider that can log in as the user';
+COMMENT ON COLUMN user_identities.issuer IS 'Issuer URL of the OpenID Provider';
+COMMENT ON COLUMN user_identities.subject IS 'Stable identifier of the account at the issuer (sub claim)';
+COMMENT ON COLUMN user_identities.email IS 'Email claim of the last login, for display only';
+COMMENT ON TABLE oidc_login_states IS 'Authorization requests sent to the OpenID Provider and not completed yet';
+COMMENT ON COLUMN oidc_login_states.state_hash IS 'SHA-256 hex digest of the state parameter; a state can be redeemed once';
+COMMENT ON COLUMN oidc_login_states.code_verifier IS 'PKCE code verifier sent with the code exchange';
+COMMENT ON COLUMN oidc_login_states.nonce IS 'Nonce the ID token has to carry';
@@ -0,0 +1,47 @@
+-- ============================================================================
+-- BOARD MEMBERS
+-- Users who can access a board and the role they hold on it
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Board members table
+CREATE TABLE IF NOT EXISTS board_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin', 'member', 'observer')),
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (board_id, user_id)
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_board_members_user_id ON board_members (user_id);
+
+-- ============================================================================
+-- 3. DATA
+-- ============================================================================
+
+-- Existing boards were only visible to their creator, who becomes the owner
+INSERT INTO board_members (board_id, user_id, role, added_by)
+SELECT id, created_by, 'owner', created_by
+FROM boards
+WHERE created_by IS NOT NULL
+ON CONFLICT (board_id, user_id) DO NOTHING;
+
+-- ============================================================================
+-- 4. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE board_members IS 'Users who can access a board';
+COMMENT ON COLUMN board_members.role IS 'owner, admin, member or observer; owners can delete the board, admins manage it and its members, members edit its content, observers only read';
+COMMENT ON COLUMN board_members.added_by IS 'User who added the member';
@@ -0,0 +1,45 @@
+-- ============================================================================
+-- BOARD INVITATIONS
+-- Invitations to join a board, sent to a user or shared as a join link
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Board invitations table
+CREATE TABLE IF NOT EXISTS board_invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('admin', 'member', 'observer')),
+    invitee_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    max_uses INTEGER CHECK (max_uses > 0),
+    use_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_board_invitations_board_id ON board_invitations (board_id, created_at DESC) WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_board_invitations_invitee_id ON board_invitations (invitee_id) WHERE revoked_at IS NULL;
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE board_invitations IS 'Invitations to join a board';
+COMMENT ON COLUMN board_invitations.token_hash IS 'SHA-256 hex digest of the token; the token itself is shown only once';
+COMMENT ON COLUMN board_invitations.role IS 'Role given to the users who accept; ownership is never granted by invitation';
+COMMENT ON COLUMN board_invitations.invitee_id IS 'User the invitation was sent to; NULL for a join link anyone with the token can use';
+COMMENT ON COLUMN board_invitations.max_uses IS 'How many users can accept; NULL means unlimited';
+COMMENT ON COLUMN board_invitations.use_count IS 'How many users accepted';
+COMMENT ON COLUMN board_invitations.revoked_at IS 'When a board admin revoked the invitation';
+COMMENT ON COLUMN board_invitations.created_by IS 'User who created the invitation';
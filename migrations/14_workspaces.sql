@@ -0,0 +1,72 @@
+-- ============================================================================
+-- WORKSPACES
+-- Workspaces own boards and have their own members and roles
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Workspaces table
+CREATE TABLE IF NOT EXISTS workspaces (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    alias VARCHAR(255),
+    description TEXT,
+    created_by UUID REFERENCES users(id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+-- Workspace members table
+CREATE TABLE IF NOT EXISTS workspace_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID NOT NULL REFERENCES workspaces(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (workspace_id, user_id)
+);
+
+-- Boards belong to a workspace, or to nobody for personal boards
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS workspace_id UUID REFERENCES workspaces(id) ON DELETE SET NULL;
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_workspace_members_user_id ON workspace_members (user_id);
+CREATE INDEX IF NOT EXISTS idx_boards_workspace_id ON boards (workspace_id) WHERE deleted_at IS NULL;
+
+-- ============================================================================
+-- 3. VIEWS
+-- ============================================================================
+
+-- Every role a user holds on a board: the board membership, and the owner or
+-- admin role of the workspace the board belongs to
+CREATE OR REPLACE VIEW board_access AS
+SELECT board_id, user_id, role
+FROM board_members
+UNION ALL
+SELECT b.id AS board_id, wm.user_id, wm.role
+FROM boards b
+JOIN workspaces w ON w.id = b.workspace_id AND w.deleted_at IS NULL
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE wm.role IN ('owner', 'admin');
+
+-- ============================================================================
+-- 4. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE workspaces IS 'Groups of boards and users';
+COMMENT ON TABLE workspace_members IS 'Users who belong to a workspace';
+COMMENT ON COLUMN workspace_members.role IS 'owner, admin or member; owners and admins hold the same role on every board of the workspace';
+COMMENT ON COLUMN workspace_members.added_by IS 'User who added the member';
+COMMENT ON COLUMN boards.workspace_id IS 'Workspace that owns the board; NULL for a personal board';
+COMMENT ON VIEW board_access IS 'Roles users hold on boards, directly or through their workspace';
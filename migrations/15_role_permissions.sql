@@ -0,0 +1,71 @@
+-- ============================================================================
+-- ROLE PERMISSIONS
+-- Fine-grained permissions granted to system roles
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Permissions known by the application
+CREATE TABLE IF NOT EXISTS permissions (
+    code VARCHAR(100) PRIMARY KEY,
+    description VARCHAR(255) NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Permissions granted to each role
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    permission_code VARCHAR(100) NOT NULL REFERENCES permissions(code) ON DELETE CASCADE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (role_id, permission_code)
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_role_permissions_permission_code ON role_permissions (permission_code);
+
+-- ============================================================================
+-- 3. SEED DATA
+-- ============================================================================
+
+INSERT INTO permissions (code, description) VALUES
+    ('board.create', 'Create boards'),
+    ('board.manage', 'Access and administer every board'),
+    ('workspace.create', 'Create workspaces'),
+    ('workspace.manage', 'Access and administer every workspace'),
+    ('user.manage', 'Manage user accounts and their sessions'),
+    ('role.manage', 'Manage roles and their permissions'),
+    ('admin.dashboard.view', 'View the admin dashboard'),
+    ('admin.health.view', 'View the system health')
+ON CONFLICT (code) DO NOTHING;
+
+-- Super admins keep full access
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code
+FROM roles r
+CROSS JOIN permissions p
+WHERE r.code = 'SUPER_ADMIN'
+ON CONFLICT DO NOTHING;
+
+-- Regular users create their own boards and workspaces
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code
+FROM roles r
+JOIN permissions p ON p.code IN ('board.create', 'workspace.create')
+WHERE r.code = 'USER'
+ON CONFLICT DO NOTHING;
+
+-- ============================================================================
+-- 4. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE permissions IS 'Permissions that can be granted to roles';
+COMMENT ON COLUMN permissions.code IS 'Permission code checked by the application, e.g. board.create';
+COMMENT ON TABLE role_permissions IS 'Permissions granted to roles';
@@ -0,0 +1,114 @@
+-- ============================================================================
+-- TEAMS
+-- Teams group users so boards can be shared with all of them at once
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Teams table
+CREATE TABLE IF NOT EXISTS teams (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    alias VARCHAR(255),
+    description TEXT,
+    created_by UUID REFERENCES users(id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+-- Team members table
+CREATE TABLE IF NOT EXISTS team_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('maintainer', 'member')),
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (team_id, user_id)
+);
+
+-- Board team grants table
+CREATE TABLE IF NOT EXISTS board_team_grants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    team_id UUID NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('admin', 'member', 'observer')),
+    added_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (board_id, team_id)
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_team_members_user_id ON team_members (user_id);
+CREATE INDEX IF NOT EXISTS idx_board_team_grants_team_id ON board_team_grants (team_id);
+
+-- ============================================================================
+-- 3. VIEWS
+-- ============================================================================
+
+-- Every role a user holds on a board: the board membership, the owner or
+-- admin role of the workspace the board belongs to, and the grants of the
+-- teams the user is a member of
+CREATE OR REPLACE VIEW board_access AS
+SELECT board_id, user_id, role
+FROM board_members
+UNION ALL
+SELECT b.id AS board_id, wm.user_id, wm.role
+FROM boards b
+JOIN workspaces w ON w.id = b.workspace_id AND w.deleted_at IS NULL
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE wm.role IN ('owner', 'admin')
+UNION ALL
+SELECT g.board_id, tm.user_id, g.role
+FROM board_team_grants g
+JOIN teams t ON t.id = g.team_id AND t.deleted_at IS NULL
+JOIN team_members tm ON tm.team_id = t.id;
+
+-- ============================================================================
+-- 4. SEED DATA
+-- ============================================================================
+
+INSERT INTO permissions (code, description) VALUES
+    ('team.create', 'Create teams'),
+    ('team.manage', 'Access and administer every team')
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code
+FROM roles r
+JOIN permissions p ON p.code IN ('team.create', 'team.manage')
+WHERE r.code = 'SUPER_ADMIN'
+ON CONFLICT DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_code)
+SELECT r.id, p.code
+FROM roles r
+JOIN permissions p ON p.code = 'team.create'
+WHERE r.code = 'USER'
+ON CONFLICT DO NOTHING;
+
+-- ============================================================================
+-- 5. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE teams IS 'Groups of users that can be granted access to boards';
+COMMENT ON TABLE team_members IS 'Users who belong to a team';
+COMMENT ON COLUMN team_members.role IS 'maintainer or member; maintainers manage the team and its members';
+COMMENT ON COLUMN team_members.added_by IS 'User who added the member';
+COMMENT ON TABLE board_team_grants IS 'Roles teams hold on boards';
+COMMENT ON COLUMN board_team_grants.role IS 'admin, member or observer; every team member holds this role on the board';
+COMMENT ON COLUMN board_team_grants.added_by IS 'User who granted the team access';
+COMMENT ON VIEW board_access IS 'Roles users hold on boards, directly or through their workspace and teams';
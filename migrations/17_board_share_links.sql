@@ -0,0 +1,29 @@
+-- ============================================================================
+-- BOARD SHARE LINKS
+-- Public read-only links to a board for people without an account
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Board share links table, a board has at most one active link
+CREATE TABLE IF NOT EXISTS board_share_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL UNIQUE REFERENCES boards(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 2. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE board_share_links IS 'Public read-only links to boards; deleting the row disables the link';
+COMMENT ON COLUMN board_share_links.token_hash IS 'SHA-256 hex digest of the token; rotating the link replaces it';
+COMMENT ON COLUMN board_share_links.expires_at IS 'When the link stops working; NULL means it never expires';
+COMMENT ON COLUMN board_share_links.created_by IS 'User who enabled or last rotated the link';
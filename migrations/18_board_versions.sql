@@ -0,0 +1,77 @@
+-- ============================================================================
+-- BOARD VERSIONS
+-- Every change to a board or its content increments the version of the board
+-- ============================================================================
+
+-- ============================================================================
+-- 1. COLUMNS
+-- ============================================================================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- ============================================================================
+-- 2. FUNCTIONS
+-- ============================================================================
+
+-- Increments the version of a board when the board itself is updated, unless
+-- the statement already did
+CREATE OR REPLACE FUNCTION bump_own_board_version() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.version = OLD.version THEN
+        NEW.version := OLD.version + 1;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Increments the version of the board a row belongs to, and of the board it
+-- was moved from
+CREATE OR REPLACE FUNCTION bump_board_version() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE boards SET version = version + 1 WHERE id = OLD.board_id;
+        RETURN OLD;
+    END IF;
+
+    UPDATE boards SET version = version + 1 WHERE id = NEW.board_id;
+    IF TG_OP = 'UPDATE' AND OLD.board_id <> NEW.board_id THEN
+        UPDATE boards SET version = version + 1 WHERE id = OLD.board_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================================================================
+-- 3. TRIGGERS
+-- ============================================================================
+
+DROP TRIGGER IF EXISTS trg_boards_version ON boards;
+CREATE TRIGGER trg_boards_version
+    BEFORE UPDATE ON boards
+    FOR EACH ROW EXECUTE FUNCTION bump_own_board_version();
+
+DROP TRIGGER IF EXISTS trg_lists_board_version ON lists;
+CREATE TRIGGER trg_lists_board_version
+    AFTER INSERT OR UPDATE OR DELETE ON lists
+    FOR EACH ROW EXECUTE FUNCTION bump_board_version();
+
+DROP TRIGGER IF EXISTS trg_cards_board_version ON cards;
+CREATE TRIGGER trg_cards_board_version
+    AFTER INSERT OR UPDATE OR DELETE ON cards
+    FOR EACH ROW EXECUTE FUNCTION bump_board_version();
+
+DROP TRIGGER IF EXISTS trg_labels_board_version ON labels;
+CREATE TRIGGER trg_labels_board_version
+    AFTER INSERT OR UPDATE OR DELETE ON labels
+    FOR EACH ROW EXECUTE FUNCTION bump_board_version();
+
+DROP TRIGGER IF EXISTS trg_board_members_board_version ON board_members;
+CREATE TRIGGER trg_board_members_board_version
+    AFTER INSERT OR UPDATE OR DELETE ON board_members
+    FOR EACH ROW EXECUTE FUNCTION bump_board_version();
+
+-- ============================================================================
+-- 4. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON COLUMN boards.version IS 'Incremented by triggers on every change to the board, its lists, cards, labels and members';
@@ -0,0 +1,46 @@
+-- ============================================================================
+-- BOARD LIFECYCLE
+-- Boards can be archived, moved to the trash, restored and purged for good
+-- ============================================================================
+
+-- ============================================================================
+-- 1. COLUMNS
+-- ============================================================================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS archived_by UUID REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS deleted_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+-- ============================================================================
+-- 2. TABLES
+-- ============================================================================
+
+-- Board audit logs table, the rows outlive the board so board_id has no
+-- foreign key
+CREATE TABLE IF NOT EXISTS board_audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    board_id UUID NOT NULL,
+    board_name VARCHAR(255) NOT NULL,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('archived', 'unarchived', 'deleted', 'restored', 'purged')),
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- 3. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_boards_deleted_at ON boards (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_board_audit_logs_board_id ON board_audit_logs (board_id, created_at DESC);
+
+-- ============================================================================
+-- 4. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON COLUMN boards.archived_at IS 'When the board was archived; archived boards stay visible but read-only';
+COMMENT ON COLUMN boards.archived_by IS 'User who archived the board';
+COMMENT ON COLUMN boards.deleted_by IS 'User who moved the board to the trash';
+COMMENT ON TABLE board_audit_logs IS 'Archive, trash, restore and purge history of boards, kept after the board is purged';
+COMMENT ON COLUMN board_audit_logs.board_name IS 'Name of the board when the action happened';
+COMMENT ON COLUMN board_audit_logs.action IS 'archived, unarchived, deleted, restored or purged';
@@ -0,0 +1,130 @@
+-- ============================================================================
+-- BOARD TEMPLATES
+-- Lists, labels and sample cards a new board starts with
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Board templates table, built-in templates have a key and no owner
+CREATE TABLE IF NOT EXISTS board_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    key VARCHAR(50) UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    content JSONB NOT NULL DEFAULT '{}',
+    is_builtin BOOLEAN NOT NULL DEFAULT FALSE,
+    workspace_id UUID REFERENCES workspaces(id) ON DELETE CASCADE,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_board_templates_created_by ON board_templates (created_by) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_board_templates_workspace_id ON board_templates (workspace_id) WHERE deleted_at IS NULL;
+
+-- ============================================================================
+-- 3. BUILT-IN TEMPLATES
+-- ============================================================================
+
+INSERT INTO board_templates (key, name, description, content, is_builtin) VALUES
+(
+    'classic',
+    'Classic',
+    'The lists every board used to start with',
+    '{
+        "lists": [
+            {"name": "Backlog"},
+            {"name": "Data Preparation"},
+            {"name": "In Progress"},
+            {"name": "Blocked"},
+            {"name": "Completed"}
+        ]
+    }',
+    TRUE
+),
+(
+    'kanban',
+    'Kanban',
+    'A simple flow from to do to done',
+    '{
+        "lists": [
+            {"name": "To Do", "cards": [
+                {"name": "Welcome to your board", "description": "Move cards to the right as the work progresses", "checklist": ["Add your first card", "Invite your team"]}
+            ]},
+            {"name": "Doing"},
+            {"name": "Done"}
+        ],
+        "labels": [
+            {"name": "Urgent", "color": "#EB5A46"},
+            {"name": "Blocked", "color": "#FF9F1A"},
+            {"name": "Idea", "color": "#61BD4F"}
+        ]
+    }',
+    TRUE
+),
+(
+    'scrum',
+    'Scrum',
+    'Product backlog, sprint backlog and review for sprint based teams',
+    '{
+        "lists": [
+            {"name": "Product Backlog"},
+            {"name": "Sprint Backlog", "cards": [
+                {"name": "Sprint planning", "description": "Pick the stories the team commits to for the sprint", "labels": ["Task"], "checklist": ["Agree on the sprint goal", "Estimate the stories", "Move the committed stories here"]}
+            ]},
+            {"name": "In Progress"},
+            {"name": "In Review"},
+            {"name": "Done"}
+        ],
+        "labels": [
+            {"name": "Story", "color": "#61BD4F"},
+            {"name": "Bug", "color": "#EB5A46"},
+            {"name": "Task", "color": "#0079BF"},
+            {"name": "Spike", "color": "#C377E0"}
+        ]
+    }',
+    TRUE
+),
+(
+    'bug_triage',
+    'Bug triage',
+    'Track reported bugs from triage to verification',
+    '{
+        "lists": [
+            {"name": "Reported", "cards": [
+                {"name": "Example bug report", "description": "Describe what happened and what was expected", "priority": "high", "labels": ["Major"], "checklist": ["Steps to reproduce", "Expected behaviour", "Actual behaviour", "Environment"]}
+            ]},
+            {"name": "Triaged"},
+            {"name": "In Progress"},
+            {"name": "Fixed"},
+            {"name": "Verified"},
+            {"name": "Won''t Fix"}
+        ],
+        "labels": [
+            {"name": "Critical", "color": "#EB5A46"},
+            {"name": "Major", "color": "#FF9F1A"},
+            {"name": "Minor", "color": "#F2D600"},
+            {"name": "Regression", "color": "#C377E0"}
+        ]
+    }',
+    TRUE
+)
+ON CONFLICT (key) DO NOTHING;
+
+-- ============================================================================
+-- 4. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE board_templates IS 'Lists, labels and sample cards new boards can start with';
+COMMENT ON COLUMN board_templates.key IS 'Stable name of a built-in template, BOARD_DEFAULT_TEMPLATE refers to it';
+COMMENT ON COLUMN board_templates.content IS 'Lists with their sample cards and checklists, and labels; cards refer to labels by name';
+COMMENT ON COLUMN board_templates.is_builtin IS 'Built-in templates are shipped with the application and cannot be changed';
+COMMENT ON COLUMN board_templates.workspace_id IS 'Workspace the template is shared with; NULL keeps it to its creator';
@@ -0,0 +1,41 @@
+-- ============================================================================
+-- BOARD IMPORTS
+-- Boards imported from other tools are created in the background
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Board imports table, one row per import with its report
+CREATE TABLE IF NOT EXISTS board_imports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    source VARCHAR(20) NOT NULL CHECK (source IN ('trello')),
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    workspace_id UUID REFERENCES workspaces(id) ON DELETE SET NULL,
+    board_id UUID REFERENCES boards(id) ON DELETE SET NULL,
+    report JSONB,
+    error_message TEXT,
+    created_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMPTZ
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_board_imports_created_by ON board_imports (created_by, created_at DESC);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE board_imports IS 'Imports of boards from other tools, with the report of what was left out';
+COMMENT ON COLUMN board_imports.source IS 'Tool the board comes from: trello';
+COMMENT ON COLUMN board_imports.status IS 'pending, running, completed or failed';
+COMMENT ON COLUMN board_imports.board_id IS 'Board created by the import once it is completed';
+COMMENT ON COLUMN board_imports.report IS 'Items of the source board that were skipped';
+COMMENT ON COLUMN board_imports.error_message IS 'Why a failed import failed';
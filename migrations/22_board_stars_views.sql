@@ -0,0 +1,45 @@
+-- ============================================================================
+-- BOARD STARS AND VIEWS
+-- Boards starred by each user and the boards each user viewed recently
+-- ============================================================================
+
+-- ============================================================================
+-- 1. TABLES
+-- ============================================================================
+
+-- Board stars table, one row per starred board of a user
+CREATE TABLE IF NOT EXISTS board_stars (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, board_id)
+);
+
+-- Board views table, the last view of each board a user opened
+CREATE TABLE IF NOT EXISTS board_views (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+
+    viewed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, board_id)
+);
+
+-- ============================================================================
+-- 2. INDEXES
+-- ============================================================================
+
+CREATE INDEX IF NOT EXISTS idx_board_stars_board_id ON board_stars (board_id);
+CREATE INDEX IF NOT EXISTS idx_board_views_user_id ON board_views (user_id, viewed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_board_views_board_id ON board_views (board_id);
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON TABLE board_stars IS 'Boards starred by each user, listed first when asked';
+COMMENT ON COLUMN board_stars.created_at IS 'When the user starred the board';
+COMMENT ON TABLE board_views IS 'Boards recently viewed by each user, only the latest ones are kept';
+COMMENT ON COLUMN board_views.viewed_at IS 'When the user last opened the board or its live updates';
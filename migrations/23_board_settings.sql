@@ -0,0 +1,47 @@
+-- ============================================================================
+-- BOARD SETTINGS
+-- Visibility, card defaults and appearance of each board
+-- ============================================================================
+
+-- ============================================================================
+-- 1. COLUMNS
+-- ============================================================================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS settings JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- ============================================================================
+-- 2. VIEWS
+-- ============================================================================
+
+-- Every role a user holds on a board: the board membership, the owner or
+-- admin role of the workspace the board belongs to, the grants of the teams
+-- the user is a member of, and the observer role of the workspace members on
+-- the boards visible to the workspace. Public boards are left out, they can
+-- be opened by every user but are not listed as theirs
+CREATE OR REPLACE VIEW board_access AS
+SELECT board_id, user_id, role
+FROM board_members
+UNION ALL
+SELECT b.id AS board_id, wm.user_id, wm.role
+FROM boards b
+JOIN workspaces w ON w.id = b.workspace_id AND w.deleted_at IS NULL
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE wm.role IN ('owner', 'admin')
+UNION ALL
+SELECT g.board_id, tm.user_id, g.role
+FROM board_team_grants g
+JOIN teams t ON t.id = g.team_id AND t.deleted_at IS NULL
+JOIN team_members tm ON tm.team_id = t.id
+UNION ALL
+SELECT b.id AS board_id, wm.user_id, 'observer' AS role
+FROM boards b
+JOIN workspaces w ON w.id = b.workspace_id AND w.deleted_at IS NULL
+JOIN workspace_members wm ON wm.workspace_id = w.id
+WHERE b.settings->>'visibility' = 'workspace';
+
+-- ============================================================================
+-- 3. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON COLUMN boards.settings IS 'Board settings: visibility (private, workspace or public), default_priority of new cards, card_key_prefix, background_color, background_image (upload ID) and comments_enabled';
+COMMENT ON VIEW board_access IS 'Roles users hold on boards, directly, through their workspace and teams, or through the workspace visibility of the board';
@@ -0,0 +1,90 @@
+-- ============================================================================
+-- CARD KEYS
+-- Every card gets a sequential number on its board and a key like PROJ-123
+-- ============================================================================
+
+-- ============================================================================
+-- 1. COLUMNS
+-- ============================================================================
+
+ALTER TABLE boards ADD COLUMN IF NOT EXISTS card_counter BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS number BIGINT;
+
+-- ============================================================================
+-- 2. FUNCTIONS
+-- ============================================================================
+
+-- The prefix of the card keys of a board: the card_key_prefix setting, or the
+-- first letters and digits of the board name when it is not set
+CREATE OR REPLACE FUNCTION board_card_key_prefix(board_name TEXT, board_settings JSONB) RETURNS TEXT AS $$
+    SELECT COALESCE(
+        NULLIF(board_settings->>'card_key_prefix', ''),
+        NULLIF(UPPER(LEFT(REGEXP_REPLACE(board_name, '[^A-Za-z0-9]', '', 'g'), 4)), ''),
+        'CARD'
+    );
+$$ LANGUAGE sql IMMUTABLE;
+
+-- Takes the next number of the board of a new card and builds its key. The
+-- board row stays locked until the transaction ends, so concurrent inserts on
+-- the same board get different numbers. The numbers of deleted cards and of
+-- rolled back inserts are not reused
+CREATE OR REPLACE FUNCTION assign_card_key() RETURNS TRIGGER AS $$
+DECLARE
+    prefix TEXT;
+BEGIN
+    UPDATE boards SET card_counter = card_counter + 1
+    WHERE id = NEW.board_id
+    RETURNING card_counter, board_card_key_prefix(name, settings) INTO NEW.number, prefix;
+
+    NEW.alias := prefix || '-' || NEW.number;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================================================================
+-- 3. BACKFILL
+-- ============================================================================
+
+-- The existing cards are numbered on their board in the order they were
+-- created, after the numbers already taken
+WITH numbered AS (
+    SELECT c.id, b.card_counter + ROW_NUMBER() OVER (PARTITION BY c.board_id ORDER BY c.created_at, c.id) AS number
+    FROM cards c
+    JOIN boards b ON b.id = c.board_id
+    WHERE c.number IS NULL
+)
+UPDATE cards c
+SET number = n.number,
+    alias = board_card_key_prefix(b.name, b.settings) || '-' || n.number
+FROM numbered n, boards b
+WHERE c.id = n.id AND b.id = c.board_id;
+
+UPDATE boards b
+SET card_counter = m.number
+FROM (SELECT board_id, MAX(number) AS number FROM cards GROUP BY board_id) m
+WHERE m.board_id = b.id AND b.card_counter < m.number;
+
+ALTER TABLE cards ALTER COLUMN number SET NOT NULL;
+
+-- ============================================================================
+-- 4. INDEXES
+-- ============================================================================
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cards_board_number ON cards (board_id, number);
+
+-- ============================================================================
+-- 5. TRIGGERS
+-- ============================================================================
+
+DROP TRIGGER IF EXISTS trg_cards_key ON cards;
+CREATE TRIGGER trg_cards_key
+    BEFORE INSERT ON cards
+    FOR EACH ROW EXECUTE FUNCTION assign_card_key();
+
+-- ============================================================================
+-- 6. COLUMN COMMENTS
+-- ============================================================================
+
+COMMENT ON COLUMN boards.card_counter IS 'Last number given to a card of the board, incremented by a trigger on every new card';
+COMMENT ON COLUMN cards.number IS 'Sequential number of the card on its board, set by a trigger on insert';
+COMMENT ON COLUMN cards.alias IS 'Key of the card, the card key prefix of the board and the number of the card (e.g., PROJ-123, BUG-001). Set on insert and never changed';
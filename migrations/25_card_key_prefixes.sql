@@ -0,0 +1,73 @@
+-- ============================================================================
+-- CARD KEY PREFIXES
+-- Every board holds a card key prefix of its own, no two boards share one
+-- ============================================================================
+
+-- ============================================================================
+-- 1. FUNCTIONS
+-- ============================================================================
+
+-- The default prefix of a board: the first letters and digits of its name,
+-- leading digits left out, followed by a number when another board has them
+-- already. The API builds the prefixes of new boards the same way
+CREATE OR REPLACE FUNCTION generate_card_key_prefix(board_name TEXT) RETURNS TEXT AS $$
+DECLARE
+    base TEXT;
+    prefix TEXT;
+    n INT := 1;
+BEGIN
+    base := LEFT(REGEXP_REPLACE(REGEXP_REPLACE(UPPER(board_name), '[^A-Z0-9]', '', 'g'), '^[0-9]+', ''), 4);
+    IF LENGTH(base) < 2 THEN
+        base := 'CARD';
+    END IF;
+
+    prefix := base;
+    WHILE EXISTS (SELECT 1 FROM boards WHERE settings->>'card_key_prefix' = prefix) LOOP
+        n := n + 1;
+        prefix := base || n;
+    END LOOP;
+
+    RETURN prefix;
+END;
+$$ LANGUAGE plpgsql;
+
+-- ============================================================================
+-- 2. BACKFILL
+-- ============================================================================
+
+-- A prefix set on several boards stays on the oldest of them
+UPDATE boards b
+SET settings = b.settings - 'card_key_prefix'
+WHERE b.settings->>'card_key_prefix' = ''
+   OR EXISTS (
+        SELECT 1 FROM boards o
+        WHERE o.settings->>'card_key_prefix' = b.settings->>'card_key_prefix'
+          AND (o.created_at, o.id) < (b.created_at, b.id)
+   );
+
+-- The boards without a prefix get their default one in the order they were
+-- created, so the oldest board keeps the prefix its cards were keyed with.
+-- The keys of the existing cards are not changed
+DO $$
+DECLARE
+    b RECORD;
+BEGIN
+    FOR b IN
+        SELECT id, name FROM boards
+        WHERE settings->>'card_key_prefix' IS NULL
+        ORDER BY created_at, id
+    LOOP
+        UPDATE boards
+        SET settings = jsonb_set(settings, '{card_key_prefix}', to_jsonb(generate_card_key_prefix(b.name)))
+        WHERE id = b.id;
+    END LOOP;
+END;
+$$;
+
+-- ============================================================================
+-- 3. INDEXES
+-- ============================================================================
+
+-- The boards in the trash keep their prefix, they can be restored. The
+-- pattern operators let the prefixes be searched by their start
+CREATE UNIQUE INDEX IF NOT EXISTS idx_boards_card_key_prefix ON boards ((settings->>'card_key_prefix') text_pattern_ops);
@@ -7,4 +7,6 @@ var emailTemplates embed.FS
 
 const (
 	EmailVerificationTemplate = "email_verification"
+	PasswordResetTemplate     = "password_reset"
+	PasswordChangedTemplate   = "password_changed"
 )
@@ -0,0 +1,7 @@
+package email
+
+import "errors"
+
+var (
+	ErrRecipientRequired = errors.New("email recipient is required")
+)
@@ -0,0 +1,113 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+)
+
+// Sender delivers a rendered email. Consumers depend on this interface so a
+// fake implementation can replace the SMTP server in tests.
+type Sender interface {
+	Send(ctx context.Context, e Email) error
+}
+
+type smtpSender struct {
+	cfg  SMTPConfig
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPSender(cfg SMTPConfig) Sender {
+	return &smtpSender{
+		cfg:  cfg,
+		send: smtp.SendMail,
+	}
+}
+
+func (s smtpSender) Send(ctx context.Context, e Email) error {
+	if e.Recipient == "" {
+		return ErrRecipientRequired
+	}
+
+	msg, err := s.buildMessage(e)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	to := append([]string{e.Recipient}, e.CC...)
+	addr := fmt.Sprintf("%s:%d", s.cfg.Host, s.cfg.Port)
+
+	return s.send(addr, auth, s.cfg.From, to, msg)
+}
+
+// buildMessage renders the email as an HTML MIME message, switching to
+// multipart/mixed when there are attachments.
+func (s smtpSender) buildMessage(e Email) ([]byte, error) {
+	var buf bytes.Buffer
+
+	from := s.cfg.From
+	if s.cfg.FromName != "" {
+		from = fmt.Sprintf("%s <%s>", mime.QEncoding.Encode("utf-8", s.cfg.FromName), s.cfg.From)
+	}
+
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", e.Recipient)
+	if len(e.CC) > 0 {
+		fmt.Fprintf(&buf, "Cc: %s\r\n", strings.Join(e.CC, ", "))
+	}
+	if e.ReplyTo != "" {
+		fmt.Fprintf(&buf, "Reply-To: %s\r\n", e.ReplyTo)
+	}
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", e.Subject))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	if len(e.Attachments) == 0 {
+		buf.WriteString("Content-Type: text/html; charset=\"utf-8\"\r\n\r\n")
+		buf.WriteString(e.Body)
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", mw.Boundary())
+
+	body, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"text/html; charset=\"utf-8\""},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := body.Write([]byte(e.Body)); err != nil {
+		return nil, err
+	}
+
+	for _, a := range e.Attachments {
+		part, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {a.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write([]byte(base64.StdEncoding.EncodeToString(a.Data))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -1,15 +1,35 @@
 package email
 
+import "time"
+
 type EmailMeta struct {
 	Recipient    string
 	CC           []string
 	TemplateType string
 }
 type Email struct {
-	Recipient string
-	Subject   string
-	Body      string
-	CC        []string
+	Recipient   string
+	Subject     string
+	Body        string
+	CC          []string
+	ReplyTo     string
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// SMTPConfig is the configuration of the SMTP server used to deliver emails
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	FromName string
 }
 
 // These types are used to apply data to email templates
@@ -19,3 +39,16 @@ type EmailVerification struct {
 	OTP          string
 	OTPExpireMin string
 }
+
+type PasswordReset struct {
+	Name      string
+	Email     string
+	Token     string
+	ExpireMin string
+}
+
+type PasswordChanged struct {
+	Name      string
+	Email     string
+	ChangedAt time.Time
+}
@@ -27,6 +27,21 @@ func translateData(lang string, templateType string, data interface{}, translare
 		(*translareData)["Source"] = localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "email_verification.Name"})
 		(*translareData)["SupportMail"] = localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "email_verification.support_email"})
 		(*translareData)["Email"] = d.Email
+	case PasswordResetTemplate:
+		d := data.(PasswordReset)
+		(*translareData)["Name"] = d.Name
+		(*translareData)["Token"] = d.Token
+		(*translareData)["ExpireMin"] = d.ExpireMin
+		(*translareData)["Source"] = localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_reset.Name"})
+		(*translareData)["SupportMail"] = localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_reset.support_email"})
+		(*translareData)["Email"] = d.Email
+	case PasswordChangedTemplate:
+		d := data.(PasswordChanged)
+		(*translareData)["Name"] = d.Name
+		(*translareData)["ChangedAt"] = d.ChangedAt.Format(localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_changed.time_format"}))
+		(*translareData)["Source"] = localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_changed.Name"})
+		(*translareData)["SupportMail"] = localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_changed.support_email"})
+		(*translareData)["Email"] = d.Email
 	}
 }
 
@@ -36,6 +51,10 @@ func getEmailSubject(lang string, from string) string {
 	switch from {
 	case EmailVerificationTemplate:
 		return localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "email_verification.email_subject"})
+	case PasswordResetTemplate:
+		return localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_reset.email_subject"})
+	case PasswordChangedTemplate:
+		return localizer.MustLocalize(&i18n.LocalizeConfig{MessageID: "password_changed.email_subject"})
 	}
 	return ""
 }
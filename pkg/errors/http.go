@@ -33,6 +33,14 @@ func NewForbiddenHTTPError() *HTTPError {
 	}
 }
 
+func NewTooManyRequestsHTTPError() *HTTPError {
+	return &HTTPError{
+		Code:       429,
+		Message:    "Too many requests",
+		StatusCode: http.StatusTooManyRequests,
+	}
+}
+
 // Error returns the error message.
 func (e HTTPError) Error() string {
 	return e.Message
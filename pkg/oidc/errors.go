@@ -0,0 +1,14 @@
+package oidc
+
+import "errors"
+
+var (
+	ErrDiscovery      = errors.New("oidc discovery failed")
+	ErrIssuerMismatch = errors.New("oidc issuer mismatch")
+	ErrExchange       = errors.New("oidc code exchange failed")
+	ErrMissingIDToken = errors.New("oidc token response has no id_token")
+	ErrInvalidIDToken = errors.New("invalid oidc id token")
+	ErrNonceMismatch  = errors.New("oidc nonce mismatch")
+	ErrUnknownKey     = errors.New("oidc signing key not found")
+	ErrUnsupportedKey = errors.New("unsupported oidc signing key")
+)
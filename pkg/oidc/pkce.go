@@ -0,0 +1,31 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// verifierSize is the number of random bytes of a code verifier, which
+// encodes to 43 characters, the minimum length allowed by RFC 7636.
+const verifierSize = 32
+
+// GenerateVerifier returns a new random PKCE code verifier.
+func GenerateVerifier() (string, error) {
+	return randomString(verifierSize)
+}
+
+// ChallengeS256 returns the S256 code challenge of the verifier.
+func ChallengeS256(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// randomString returns n random bytes encoded as URL-safe base64.
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
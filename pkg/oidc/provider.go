@@ -0,0 +1,263 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// defaultTimeout applies when Config.Timeout is not set.
+	defaultTimeout = 10 * time.Second
+	// maxResponseSize caps the provider responses read into memory.
+	maxResponseSize = 1 << 20
+)
+
+// signingMethods are the ID token algorithms accepted. "none" and HMAC are
+// never accepted, the client secret must not be usable as a signing key.
+var signingMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+// Provider runs the authorization code flow with PKCE against an OpenID Provider.
+type Provider interface {
+	// Issuer returns the configured issuer, which scopes the subject of the claims.
+	Issuer() string
+	// AuthCodeURL returns the authorization endpoint URL the user is sent to.
+	// The code challenge is derived from the verifier with S256.
+	AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error)
+	// Exchange redeems the authorization code and returns the claims of the
+	// ID token once its signature, issuer, audience, expiry and nonce are verified.
+	Exchange(ctx context.Context, code, codeVerifier, nonce string) (Claims, error)
+}
+
+type implProvider struct {
+	cfg    Config
+	client *http.Client
+
+	mu   sync.Mutex
+	meta *discovery
+	keys map[string]interface{}
+}
+
+// New returns a provider for the configuration. The discovery document is
+// fetched on first use, so the provider can be unreachable at startup.
+func New(cfg Config) Provider {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	cfg.IssuerURL = strings.TrimSuffix(cfg.IssuerURL, "/")
+
+	return &implProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *implProvider) Issuer() string {
+	return p.cfg.IssuerURL
+}
+
+func (p *implProvider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", p.cfg.ClientID)
+	v.Set("redirect_uri", p.cfg.RedirectURL)
+	v.Set("scope", strings.Join(append([]string{"openid"}, p.cfg.Scopes...), " "))
+	v.Set("state", state)
+	v.Set("nonce", nonce)
+	v.Set("code_challenge", ChallengeS256(codeVerifier))
+	v.Set("code_challenge_method", "S256")
+
+	sep := "?"
+	if strings.Contains(meta.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return meta.AuthorizationEndpoint + sep + v.Encode(), nil
+}
+
+func (p *implProvider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (Claims, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("client_id", p.cfg.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Claims{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var tr tokenResponse
+	if err := p.do(req, &tr); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrExchange, err)
+	}
+	if tr.IDToken == "" {
+		return Claims{}, ErrMissingIDToken
+	}
+
+	return p.verifyIDToken(ctx, meta, tr.IDToken, nonce)
+}
+
+// verifyIDToken checks the ID token as required by OpenID Connect Core 3.1.3.7.
+func (p *implProvider) verifyIDToken(ctx context.Context, meta discovery, raw, nonce string) (Claims, error) {
+	parser := jwt.Parser{ValidMethods: signingMethods}
+
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(raw, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.signingKey(ctx, meta, kid)
+	}); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+
+	if !claims.VerifyIssuer(meta.Issuer, true) {
+		return Claims{}, ErrIssuerMismatch
+	}
+	if !claims.VerifyAudience(p.cfg.ClientID, true) {
+		return Claims{}, fmt.Errorf("%w: audience mismatch", ErrInvalidIDToken)
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return Claims{}, fmt.Errorf("%w: missing expiry", ErrInvalidIDToken)
+	}
+
+	got, _ := claims["nonce"].(string)
+	if subtle.ConstantTimeCompare([]byte(got), []byte(nonce)) != 1 {
+		return Claims{}, ErrNonceMismatch
+	}
+
+	b, err := json.Marshal(claims)
+	if err != nil {
+		return Claims{}, err
+	}
+	var c Claims
+	if err := json.Unmarshal(b, &c); err != nil {
+		return Claims{}, fmt.Errorf("%w: %v", ErrInvalidIDToken, err)
+	}
+	if c.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+
+	return c, nil
+}
+
+// signingKey returns the key with the ID. The key set is fetched again when
+// the ID is unknown, providers rotate their keys without notice.
+func (p *implProvider) signingKey(ctx context.Context, meta discovery, kid string) (interface{}, error) {
+	p.mu.Lock()
+	keys := p.keys
+	p.mu.Unlock()
+
+	if key, ok := lookupKey(keys, kid); ok {
+		return key, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, meta.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set jsonWebKeySet
+	if err := p.do(req, &set); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownKey, err)
+	}
+	keys = parseKeySet(set)
+
+	p.mu.Lock()
+	p.keys = keys
+	p.mu.Unlock()
+
+	if key, ok := lookupKey(keys, kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnknownKey
+}
+
+// lookupKey finds the key by ID. A token without ID is accepted only when
+// the set holds a single key.
+func lookupKey(keys map[string]interface{}, kid string) (interface{}, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, k := range keys {
+			return k, true
+		}
+	}
+	k, ok := keys[kid]
+	return k, ok
+}
+
+// discover fetches and caches the provider metadata.
+func (p *implProvider) discover(ctx context.Context) (discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return *p.meta, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.IssuerURL+discoveryPath, nil)
+	if err != nil {
+		return discovery{}, err
+	}
+
+	var meta discovery
+	if err := p.do(req, &meta); err != nil {
+		return discovery{}, fmt.Errorf("%w: %v", ErrDiscovery, err)
+	}
+	if strings.TrimSuffix(meta.Issuer, "/") != p.cfg.IssuerURL {
+		return discovery{}, ErrIssuerMismatch
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return discovery{}, fmt.Errorf("%w: incomplete provider metadata", ErrDiscovery)
+	}
+
+	p.meta = &meta
+	return meta, nil
+}
+
+// do sends the request and decodes the JSON response into v.
+func (p *implProvider) do(req *http.Request, v interface{}) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var e tokenErrorResponse
+		if json.Unmarshal(body, &e) == nil && e.Error != "" {
+			return fmt.Errorf("%s: %s", e.Error, e.ErrorDescription)
+		}
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, req.URL.Path)
+	}
+
+	return json.Unmarshal(body, v)
+}
@@ -0,0 +1,333 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testClientID     = "kanban"
+	testClientSecret = "s3cret"
+	testRedirectURL  = "http://localhost:8080/api/v1/auth/oidc/callback"
+	testCode         = "auth-code"
+)
+
+// mockProvider is a minimal OpenID Provider. It issues an ID token for
+// testCode when the code verifier matches the challenge it was given.
+type mockProvider struct {
+	t      *testing.T
+	srv    *httptest.Server
+	key    *rsa.PrivateKey
+	kid    string
+	claims jwt.MapClaims
+	// tokenKid overrides the key ID put in the ID token header.
+	tokenKid string
+
+	challenge string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	m := &mockProvider{t: t, key: key, kid: "key-1"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, discovery{
+			Issuer:                m.srv.URL,
+			AuthorizationEndpoint: m.srv.URL + "/authorize",
+			TokenEndpoint:         m.srv.URL + "/token",
+			JWKSURI:               m.srv.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, jsonWebKeySet{Keys: []jsonWebKey{{
+			Kty: "RSA",
+			Kid: m.kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+
+		id, secret, _ := r.BasicAuth()
+		if id != testClientID || secret != testClientSecret {
+			writeJSON(w, http.StatusUnauthorized, tokenErrorResponse{Error: "invalid_client"})
+			return
+		}
+		if r.PostForm.Get("code") != testCode ||
+			r.PostForm.Get("redirect_uri") != testRedirectURL ||
+			ChallengeS256(r.PostForm.Get("code_verifier")) != m.challenge {
+			writeJSON(w, http.StatusBadRequest, tokenErrorResponse{Error: "invalid_grant"})
+			return
+		}
+
+		writeJSON(w, http.StatusOK, tokenResponse{
+			AccessToken: "access",
+			TokenType:   "Bearer",
+			IDToken:     m.sign(m.claims),
+			ExpiresIn:   3600,
+		})
+	})
+	m.srv = httptest.NewServer(mux)
+	t.Cleanup(m.srv.Close)
+
+	return m
+}
+
+func (m *mockProvider) sign(claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = m.kid
+	if m.tokenKid != "" {
+		token.Header["kid"] = m.tokenKid
+	}
+	s, err := token.SignedString(m.key)
+	require.NoError(m.t, err)
+	return s
+}
+
+func (m *mockProvider) validClaims(nonce string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":                m.srv.URL,
+		"sub":                "user-42",
+		"aud":                []string{testClientID},
+		"exp":                now.Add(time.Minute).Unix(),
+		"iat":                now.Unix(),
+		"nonce":              nonce,
+		"email":              "jane@example.com",
+		"email_verified":     true,
+		"name":               "Jane Doe",
+		"preferred_username": "jane",
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestProvider(m *mockProvider) Provider {
+	return New(Config{
+		IssuerURL:    m.srv.URL,
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"email", "profile"},
+	})
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	m := newMockProvider(t)
+	p := newTestProvider(m)
+
+	verifier, err := GenerateVerifier()
+	require.NoError(t, err)
+
+	raw, err := p.AuthCodeURL(context.Background(), "state-1", "nonce-1", verifier)
+	require.NoError(t, err)
+
+	u, err := url.Parse(raw)
+	require.NoError(t, err)
+	assert.Equal(t, m.srv.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+
+	q := u.Query()
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, testClientID, q.Get("client_id"))
+	assert.Equal(t, testRedirectURL, q.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, "state-1", q.Get("state"))
+	assert.Equal(t, "nonce-1", q.Get("nonce"))
+	assert.Equal(t, ChallengeS256(verifier), q.Get("code_challenge"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+}
+
+func TestExchange(t *testing.T) {
+	m := newMockProvider(t)
+	p := newTestProvider(m)
+	ctx := context.Background()
+
+	verifier, err := GenerateVerifier()
+	require.NoError(t, err)
+	m.challenge = ChallengeS256(verifier)
+	m.claims = m.validClaims("nonce-1")
+
+	c, err := p.Exchange(ctx, testCode, verifier, "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, m.srv.URL, c.Issuer)
+	assert.Equal(t, "user-42", c.Subject)
+	assert.Equal(t, "jane@example.com", c.Email)
+	require.NotNil(t, c.EmailVerified)
+	assert.True(t, *c.EmailVerified)
+	assert.Equal(t, "Jane Doe", c.Name)
+	assert.Equal(t, "jane", c.PreferredUsername)
+}
+
+func TestExchangeRejects(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		mutate   func(m *mockProvider, claims jwt.MapClaims)
+		verifier string
+		nonce    string
+		wantErr  error
+	}{
+		{
+			name:     "wrong code verifier",
+			verifier: "not-the-verifier-that-was-challenged-000000",
+			wantErr:  ErrExchange,
+		},
+		{
+			name:    "wrong nonce",
+			nonce:   "other",
+			wantErr: ErrNonceMismatch,
+		},
+		{
+			name:    "wrong audience",
+			mutate:  func(_ *mockProvider, c jwt.MapClaims) { c["aud"] = "someone-else" },
+			wantErr: ErrInvalidIDToken,
+		},
+		{
+			name:    "wrong issuer",
+			mutate:  func(_ *mockProvider, c jwt.MapClaims) { c["iss"] = "https://evil.example.com" },
+			wantErr: ErrIssuerMismatch,
+		},
+		{
+			name:    "expired",
+			mutate:  func(_ *mockProvider, c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+			wantErr: ErrInvalidIDToken,
+		},
+		{
+			name:    "missing expiry",
+			mutate:  func(_ *mockProvider, c jwt.MapClaims) { delete(c, "exp") },
+			wantErr: ErrInvalidIDToken,
+		},
+		{
+			name:    "unknown key",
+			mutate:  func(m *mockProvider, _ jwt.MapClaims) { m.tokenKid = "rotated" },
+			wantErr: ErrInvalidIDToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMockProvider(t)
+			p := newTestProvider(m)
+
+			verifier, err := GenerateVerifier()
+			require.NoError(t, err)
+			m.challenge = ChallengeS256(verifier)
+			m.claims = m.validClaims("nonce-1")
+			if tt.mutate != nil {
+				tt.mutate(m, m.claims)
+			}
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+			nonce := "nonce-1"
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+
+			_, err = p.Exchange(ctx, testCode, verifier, nonce)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr), "got %v", err)
+		})
+	}
+}
+
+func TestExchangeRejectsHMAC(t *testing.T) {
+	m := newMockProvider(t)
+	p := newTestProvider(m).(*implProvider)
+
+	meta, err := p.discover(context.Background())
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, m.validClaims("nonce-1"))
+	raw, err := token.SignedString([]byte(testClientSecret))
+	require.NoError(t, err)
+
+	_, err = p.verifyIDToken(context.Background(), meta, raw, "nonce-1")
+	assert.True(t, errors.Is(err, ErrInvalidIDToken), "got %v", err)
+}
+
+func TestDiscoveryIssuerMismatch(t *testing.T) {
+	m := newMockProvider(t)
+	p := New(Config{IssuerURL: m.srv.URL + "/other", ClientID: testClientID})
+
+	_, err := p.AuthCodeURL(context.Background(), "s", "n", "v")
+	assert.Error(t, err)
+}
+
+func TestChallengeS256(t *testing.T) {
+	// BASE64URL(SHA256(verifier)) without padding, as computed by openssl.
+	assert.Equal(t, "r_bV33Wn7UyPDvbCtHB1o0lSJGBFaYoAsFZ88L6oc2Y", ChallengeS256("code-verifier-for-a-known-challenge-value-01"))
+}
+
+func TestExchangeVerifiedEmail(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		mutate func(c jwt.MapClaims)
+		want   string
+	}{
+		{
+			name: "verified",
+			want: "jane@example.com",
+		},
+		{
+			name:   "unverified",
+			mutate: func(c jwt.MapClaims) { c["email_verified"] = false },
+		},
+		{
+			name:   "missing email_verified",
+			mutate: func(c jwt.MapClaims) { delete(c, "email_verified") },
+		},
+		{
+			name:   "missing email",
+			mutate: func(c jwt.MapClaims) { delete(c, "email") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMockProvider(t)
+			p := newTestProvider(m)
+
+			verifier, err := GenerateVerifier()
+			require.NoError(t, err)
+			m.challenge = ChallengeS256(verifier)
+			m.claims = m.validClaims("nonce-1")
+			if tt.mutate != nil {
+				tt.mutate(m.claims)
+			}
+
+			c, err := p.Exchange(ctx, testCode, verifier, "nonce-1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, c.VerifiedEmail())
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package oidc
+
+import "time"
+
+// Config identifies this application as a client of an OpenID Provider.
+type Config struct {
+	// IssuerURL is the issuer of the provider, its discovery document is
+	// served at IssuerURL + "/.well-known/openid-configuration".
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the callback registered at the provider.
+	RedirectURL string
+	// Scopes requested in addition to "openid".
+	Scopes []string
+	// Timeout bounds every request made to the provider.
+	Timeout time.Duration
+}
+
+// Claims are the identity claims of a verified ID token.
+type Claims struct {
+	Issuer            string `json:"iss"`
+	Subject           string `json:"sub"`
+	Email             string `json:"email"`
+	EmailVerified     *bool  `json:"email_verified,omitempty"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// VerifiedEmail returns the email of the claims when the provider reports it
+// as verified, and an empty string otherwise. A missing email_verified claim
+// counts as unverified, the provider has made no statement about the address.
+func (c Claims) VerifiedEmail() string {
+	if c.EmailVerified == nil || !*c.EmailVerified {
+		return ""
+	}
+
+	return c.Email
+}
+
+// discovery is the part of the provider metadata used by the client.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type tokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Limiter is a sliding window rate limiter.
+type Limiter interface {
+	// Allow records a request for the key and reports whether it fits in the policy.
+	// Rejected requests are not recorded, so they do not extend the wait.
+	Allow(ctx context.Context, key string, p Policy) (Result, error)
+	// Reset forgets every request recorded for the key.
+	Reset(ctx context.Context, key string) error
+}
+
+// New returns a Redis backed limiter that falls back to process memory while
+// Redis is unreachable. Without a client the limiter only uses memory, which
+// is fine for a single instance.
+func New(client *redis.Client) Limiter {
+	memory := NewMemoryLimiter()
+	if client == nil {
+		return memory
+	}
+
+	return fallbackLimiter{
+		primary:  NewRedisLimiter(client),
+		fallback: memory,
+	}
+}
+
+type fallbackLimiter struct {
+	primary  Limiter
+	fallback Limiter
+}
+
+func (l fallbackLimiter) Allow(ctx context.Context, key string, p Policy) (Result, error) {
+	res, err := l.primary.Allow(ctx, key, p)
+	if err != nil {
+		return l.fallback.Allow(ctx, key, p)
+	}
+
+	return res, nil
+}
+
+func (l fallbackLimiter) Reset(ctx context.Context, key string) error {
+	// Both stores may hold requests for the key after an outage.
+	_ = l.fallback.Reset(ctx, key)
+	return l.primary.Reset(ctx, key)
+}
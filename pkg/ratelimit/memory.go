@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepEvery is the number of Allow calls between two sweeps of idle keys.
+const sweepEvery = 1000
+
+type memoryLimiter struct {
+	mu      sync.Mutex
+	windows map[string]*window
+	calls   int
+	clock   func() time.Time
+}
+
+// window holds the timestamps of the accepted requests of a key, oldest first.
+type window struct {
+	hits   []time.Time
+	length time.Duration
+}
+
+// NewMemoryLimiter returns a limiter that keeps its state in process memory.
+func NewMemoryLimiter() Limiter {
+	return &memoryLimiter{
+		windows: make(map[string]*window),
+		clock:   time.Now,
+	}
+}
+
+func (l *memoryLimiter) Allow(ctx context.Context, key string, p Policy) (Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.clock()
+	l.calls++
+	if l.calls%sweepEvery == 0 {
+		l.sweep(now)
+	}
+
+	w, ok := l.windows[key]
+	if !ok {
+		w = &window{}
+		l.windows[key] = w
+	}
+	w.length = p.Window
+	w.prune(now)
+
+	if len(w.hits) >= p.Limit {
+		var retryAfter time.Duration
+		if len(w.hits) > 0 {
+			retryAfter = w.hits[0].Add(p.Window).Sub(now)
+		}
+		return Result{Allowed: false, RetryAfter: retryAfter}, nil
+	}
+
+	w.hits = append(w.hits, now)
+	return Result{Allowed: true, Remaining: p.Limit - len(w.hits)}, nil
+}
+
+func (l *memoryLimiter) Reset(ctx context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.windows, key)
+	return nil
+}
+
+// sweep drops the keys without requests in their window.
+func (l *memoryLimiter) sweep(now time.Time) {
+	for key, w := range l.windows {
+		w.prune(now)
+		if len(w.hits) == 0 {
+			delete(l.windows, key)
+		}
+	}
+}
+
+func (w *window) prune(now time.Time) {
+	start := now.Add(-w.length)
+	i := 0
+	for i < len(w.hits) && !w.hits[i].After(start) {
+		i++
+	}
+	w.hits = w.hits[i:]
+}
@@ -0,0 +1,98 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMemoryLimiter(now *time.Time) *memoryLimiter {
+	l := NewMemoryLimiter().(*memoryLimiter)
+	l.clock = func() time.Time { return *now }
+	return l
+}
+
+func TestMemoryLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	l := newTestMemoryLimiter(&now)
+	p := Policy{Limit: 3, Window: time.Minute}
+
+	for i := 0; i < 3; i++ {
+		res, err := l.Allow(ctx, "k", p)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 2-i, res.Remaining)
+		now = now.Add(10 * time.Second)
+	}
+
+	res, err := l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	// The first request leaves the window 60s after it was made, 30s from now.
+	assert.Equal(t, 30*time.Second, res.RetryAfter)
+
+	// Other keys are not affected.
+	res, err = l.Allow(ctx, "other", p)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	// The window slides: once the first request is out, one more is allowed.
+	now = now.Add(30 * time.Second)
+	res, err = l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	res, err = l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+}
+
+func TestMemoryLimiterReset(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	l := newTestMemoryLimiter(&now)
+	p := Policy{Limit: 1, Window: time.Minute}
+
+	res, err := l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	res, err = l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	require.NoError(t, l.Reset(ctx, "k"))
+
+	res, err = l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(ctx context.Context, key string, p Policy) (Result, error) {
+	return Result{}, errors.New("connection refused")
+}
+
+func (failingLimiter) Reset(ctx context.Context, key string) error {
+	return errors.New("connection refused")
+}
+
+func TestFallbackLimiter(t *testing.T) {
+	ctx := context.Background()
+	l := fallbackLimiter{primary: failingLimiter{}, fallback: NewMemoryLimiter()}
+	p := Policy{Limit: 1, Window: time.Minute}
+
+	res, err := l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+
+	res, err = l.Allow(ctx, "k", p)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix namespaces the limiter keys in a shared Redis.
+const keyPrefix = "ratelimit:"
+
+// slidingWindowScript keeps the accepted requests of a key in a sorted set
+// scored by their time in milliseconds.
+//
+// KEYS[1] the key, ARGV[1] now, ARGV[2] window, ARGV[3] limit, ARGV[4] member.
+// It returns {allowed, remaining, retry after in milliseconds}.
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+if count >= limit then
+	local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+	local retry = 0
+	if oldest[2] then
+		retry = tonumber(oldest[2]) + window - now
+	end
+	return {0, 0, retry}
+end
+
+redis.call('ZADD', KEYS[1], now, ARGV[4])
+redis.call('PEXPIRE', KEYS[1], window)
+return {1, limit - count - 1, 0}
+`)
+
+type redisLimiter struct {
+	client redis.UniversalClient
+	clock  func() time.Time
+}
+
+// NewRedisLimiter returns a limiter whose state is shared by every instance
+// connected to the same Redis.
+func NewRedisLimiter(client redis.UniversalClient) Limiter {
+	return redisLimiter{
+		client: client,
+		clock:  time.Now,
+	}
+}
+
+func (l redisLimiter) Allow(ctx context.Context, key string, p Policy) (Result, error) {
+	now := l.clock()
+	// The member has to be unique, two requests in the same millisecond both count.
+	member := strconv.FormatInt(now.UnixNano(), 10)
+
+	res, err := slidingWindowScript.Run(ctx, l.client, []string{keyPrefix + key},
+		now.UnixMilli(), p.Window.Milliseconds(), p.Limit, member).Int64Slice()
+	if err != nil {
+		return Result{}, err
+	}
+	if len(res) != 3 {
+		return Result{}, ErrUnexpectedReply
+	}
+
+	return Result{
+		Allowed:    res[0] == 1,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Millisecond,
+	}, nil
+}
+
+func (l redisLimiter) Reset(ctx context.Context, key string) error {
+	return l.client.Del(ctx, keyPrefix+key).Err()
+}
@@ -0,0 +1,18 @@
+package ratelimit
+
+import "time"
+
+// Policy allows at most Limit requests per key in any Window long period.
+type Policy struct {
+	Limit  int
+	Window time.Duration
+}
+
+// Result is the outcome of a single Allow call.
+type Result struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long the caller has to wait before the next request
+	// is allowed. It is zero when the request was allowed.
+	RetryAfter time.Duration
+}
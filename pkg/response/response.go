@@ -42,6 +42,10 @@ func Forbidden(c *gin.Context) {
 	c.JSON(parseError(pkgErrors.NewForbiddenHTTPError(), c, nil))
 }
 
+func TooManyRequests(c *gin.Context) {
+	c.JSON(parseError(pkgErrors.NewTooManyRequestsHTTPError(), c, nil))
+}
+
 func parseError(err error, c *gin.Context, d *discord.Discord) (int, Resp) {
 	switch parsedErr := err.(type) {
 	case *pkgErrors.ValidationError:
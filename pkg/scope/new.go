@@ -18,12 +18,26 @@ type Manager interface {
 
 type Payload struct {
 	jwt.StandardClaims
-	UserID   string `json:"sub"`
-	Username string `json:"username"`
-	Type     string `json:"type"`
-	Refresh  bool   `json:"refresh"`
+	UserID    string `json:"sub"`
+	Username  string `json:"username"`
+	Type      string `json:"type"`
+	Refresh   bool   `json:"refresh"`
+	SessionID string `json:"sid,omitempty"`
+	// TokenScope is the scope of a personal access token, see models.Scope.
+	TokenScope string `json:"token_scope,omitempty"`
 }
 
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+	// TokenTypeMFAPending is the short-lived challenge issued after the password
+	// check when a second factor is still required. It grants no API access.
+	TokenTypeMFAPending = "mfa_pending"
+	// TokenTypePersonal marks a payload built from a personal access token
+	// instead of a signed JWT.
+	TokenTypePersonal = "personal_access_token"
+)
+
 type implManager struct {
 	secretKey string
 }
@@ -64,11 +78,18 @@ func (m implManager) Verify(token string) (Payload, error) {
 	return *payload, nil
 }
 
+// CreateToken signs the payload. Claims set by the caller are kept,
+// missing ones fall back to a one-week token valid from now.
 func (m implManager) CreateToken(payload Payload) (string, error) {
-	payload.StandardClaims = jwt.StandardClaims{
-		ExpiresAt: time.Now().Add(ONE_WEEK).Unix(),
-		Id:        time.Now().String(),
-		NotBefore: time.Now().Unix(),
+	now := time.Now()
+	if payload.ExpiresAt == 0 {
+		payload.ExpiresAt = now.Add(ONE_WEEK).Unix()
+	}
+	if payload.Id == "" {
+		payload.Id = now.String()
+	}
+	if payload.NotBefore == 0 {
+		payload.NotBefore = now.Unix()
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, payload)
@@ -10,8 +10,9 @@ import (
 // NewScope creates a new scope.
 func NewScope(payload Payload) models.Scope {
 	return models.Scope{
-		UserID:   payload.UserID,
-		Username: payload.Username,
+		UserID:     payload.UserID,
+		Username:   payload.Username,
+		TokenScope: payload.TokenScope,
 	}
 }
 
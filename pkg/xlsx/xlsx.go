@@ -0,0 +1,237 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+)
+
+const (
+	// ContentType is the media type of the written workbooks.
+	ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+	defaultSheetName = "Sheet1"
+	// maxSheetNameLength is the longest sheet name spreadsheet apps accept.
+	maxSheetNameLength = 31
+)
+
+var ErrClosed = errors.New("xlsx writer closed")
+
+// Writer writes a workbook of a single sheet, one row at a time, so large
+// sheets are streamed instead of being held in memory. Like csv.Writer the
+// records are buffered, Flush sends them to the underlying writer. Close must
+// be called to finish the workbook.
+type Writer struct {
+	// SheetName is the name of the sheet, "Sheet1" when empty. It must be set
+	// before the first record is written.
+	SheetName string
+	// NumberColumns are the indexes of the columns written as numbers, the
+	// values of these columns that are not numbers are written as text.
+	NumberColumns []int
+
+	zw      *zip.Writer
+	sheet   *bufio.Writer
+	numbers map[int]bool
+	row     int
+	closed  bool
+	err     error
+}
+
+// NewWriter returns a Writer that writes the workbook to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{
+		zw: zip.NewWriter(w),
+	}
+}
+
+// Write writes a record as the next row of the sheet. Empty values are left
+// out of the row.
+func (w *Writer) Write(record []string) error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.closed {
+		return ErrClosed
+	}
+	if w.sheet == nil {
+		if w.err = w.start(); w.err != nil {
+			return w.err
+		}
+	}
+
+	w.row++
+	r := strconv.Itoa(w.row)
+
+	w.writeString(`<row r="` + r + `">`)
+	for i, v := range record {
+		if v == "" {
+			continue
+		}
+
+		ref := ColumnName(i) + r
+		if w.numbers[i] {
+			if f, err := strconv.ParseFloat(v, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+				w.writeString(`<c r="` + ref + `"><v>` + strconv.FormatFloat(f, 'g', -1, 64) + `</v></c>`)
+				continue
+			}
+		}
+
+		w.writeString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)
+		if w.err == nil {
+			w.err = xml.EscapeText(w.sheet, []byte(v))
+		}
+		w.writeString(`</t></is></c>`)
+	}
+	w.writeString(`</row>`)
+
+	return w.err
+}
+
+// Flush writes the buffered rows to the underlying writer.
+func (w *Writer) Flush() {
+	if w.err != nil || w.sheet == nil {
+		return
+	}
+	if w.err = w.sheet.Flush(); w.err != nil {
+		return
+	}
+	w.err = w.zw.Flush()
+}
+
+// Error reports any error that has occurred during a previous Write, Flush or
+// Close.
+func (w *Writer) Error() error {
+	return w.err
+}
+
+// Close finishes the sheet and the workbook. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return w.err
+	}
+	if w.err == nil && w.sheet == nil {
+		w.err = w.start()
+	}
+	w.closed = true
+
+	w.writeString(`</sheetData></worksheet>`)
+	if w.err == nil {
+		w.err = w.sheet.Flush()
+	}
+	if err := w.zw.Close(); w.err == nil {
+		w.err = err
+	}
+
+	return w.err
+}
+
+// start writes the parts of the workbook before the rows of the sheet.
+func (w *Writer) start() error {
+	name := w.SheetName
+	if name == "" {
+		name = defaultSheetName
+	}
+	if r := []rune(name); len(r) > maxSheetNameLength {
+		name = string(r[:maxSheetNameLength])
+	}
+
+	w.numbers = make(map[int]bool, len(w.NumberColumns))
+	for _, i := range w.NumberColumns {
+		w.numbers[i] = true
+	}
+
+	parts := []struct {
+		name    string
+		content string
+	}{
+		{"[Content_Types].xml", contentTypesXML},
+		{"_rels/.rels", relsXML},
+		{"xl/workbook.xml", workbookXMLStart + escape(name) + workbookXMLEnd},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXML},
+		{"xl/styles.xml", stylesXML},
+	}
+	for _, p := range parts {
+		f, err := w.zw.Create(p.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, p.content); err != nil {
+			return err
+		}
+	}
+
+	f, err := w.zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(f)
+	_, err = w.sheet.WriteString(sheetXMLStart)
+
+	return err
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.sheet.WriteString(s)
+}
+
+// ColumnName returns the letters of the column at index i, starting at "A".
+func ColumnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+
+	return name
+}
+
+// escape escapes s for the text and the attributes of the XML parts.
+func escape(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+
+	return b.String()
+}
+
+const (
+	xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+	contentTypesXML = xmlHeader + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`</Types>`
+
+	relsXML = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookXMLStart = xmlHeader + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="`
+	workbookXMLEnd = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	workbookRelsXML = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+		`</Relationships>`
+
+	stylesXML = xmlHeader + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+		`<fonts count="1"><font><sz val="11"/><name val="Calibri"/></font></fonts>` +
+		`<fills count="1"><fill><patternFill patternType="none"/></fill></fills>` +
+		`<borders count="1"><border/></borders>` +
+		`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+		`<cellXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/></cellXfs>` +
+		`</styleSheet>`
+
+	sheetXMLStart = xmlHeader + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+)
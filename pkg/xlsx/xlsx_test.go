@@ -0,0 +1,96 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readPart(t *testing.T, data []byte, name string) string {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	f, err := zr.Open(name)
+	require.NoError(t, err, "part %s", name)
+	defer f.Close()
+
+	b, err := io.ReadAll(f)
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		index    int
+		expected string
+	}{
+		{0, "A"},
+		{1, "B"},
+		{25, "Z"},
+		{26, "AA"},
+		{27, "AB"},
+		{51, "AZ"},
+		{52, "BA"},
+		{701, "ZZ"},
+		{702, "AAA"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ColumnName(tt.index), "index %d", tt.index)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	w.SheetName = "Cards & more"
+	w.NumberColumns = []int{1}
+
+	require.NoError(t, w.Write([]string{"Name", "Hours"}))
+	require.NoError(t, w.Write([]string{"Fix <login>", "1.5"}))
+	require.NoError(t, w.Write([]string{"No hours", ""}))
+	require.NoError(t, w.Write([]string{"", "soon"}))
+	w.Flush()
+	require.NoError(t, w.Error())
+	require.NoError(t, w.Close())
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/_rels/workbook.xml.rels", "xl/styles.xml"} {
+		readPart(t, buf.Bytes(), name)
+	}
+
+	workbook := readPart(t, buf.Bytes(), "xl/workbook.xml")
+	assert.Contains(t, workbook, `<sheet name="Cards &amp; more"`)
+
+	sheet := readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml")
+	assert.True(t, strings.HasSuffix(sheet, `</sheetData></worksheet>`))
+	assert.Contains(t, sheet, `<row r="1"><c r="A1" t="inlineStr"><is><t xml:space="preserve">Name</t></is></c><c r="B1" t="inlineStr"><is><t xml:space="preserve">Hours</t></is></c></row>`)
+	assert.Contains(t, sheet, `<c r="A2" t="inlineStr"><is><t xml:space="preserve">Fix &lt;login&gt;</t></is></c><c r="B2"><v>1.5</v></c>`)
+	assert.Contains(t, sheet, `<row r="3"><c r="A3" t="inlineStr"><is><t xml:space="preserve">No hours</t></is></c></row>`)
+	assert.Contains(t, sheet, `<row r="4"><c r="B4" t="inlineStr"><is><t xml:space="preserve">soon</t></is></c></row>`)
+}
+
+func TestWriterEmpty(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.Close())
+
+	assert.Contains(t, readPart(t, buf.Bytes(), "xl/workbook.xml"), `<sheet name="Sheet1"`)
+	assert.Contains(t, readPart(t, buf.Bytes(), "xl/worksheets/sheet1.xml"), `<sheetData></sheetData>`)
+}
+
+func TestWriterClosed(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	require.NoError(t, w.Close())
+
+	assert.ErrorIs(t, w.Write([]string{"late"}), ErrClosed)
+	assert.NoError(t, w.Close())
+}